@@ -114,6 +114,41 @@ var activityResponseToSchema = copier.TypeConverter{
 				return nil, err
 			}
 
+		case model.ActivityTypeScheduledChangeApplied:
+			if activity.ScheduledChangeApplied == nil {
+				return nil, errors.New("ScheduledChangeApplied detail is nil")
+			}
+
+			err := dst.FromScheduledChangeAppliedActivity(api.ScheduledChangeAppliedActivity{
+				Id:   int(activity.ID),
+				Type: api.ScheduledChangeApplied,
+				Time: activity.Time,
+				TargetType: api.ScheduledChangeTargetType(
+					activity.ScheduledChangeApplied.TargetType,
+				),
+				TargetId: int(activity.ScheduledChangeApplied.TargetID),
+				Field:    api.ScheduledChangeField(activity.ScheduledChangeApplied.Field),
+				Value:    activity.ScheduledChangeApplied.Value,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+		case model.ActivityTypeGuidebookPublished:
+			if activity.GuidebookPublished == nil {
+				return nil, errors.New("GuidebookPublished detail is nil")
+			}
+
+			err := dst.FromGuidebookPublishedActivity(api.GuidebookPublishedActivity{
+				Id:         int(activity.ID),
+				Type:       api.GuidebookPublished,
+				Time:       activity.Time,
+				RevisionId: int(activity.GuidebookPublished.RevisionID),
+			})
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, errors.New("unknown activity type: " + string(activity.Type))
 		}
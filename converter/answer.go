@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/jinzhu/copier"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/api"
@@ -55,6 +56,48 @@ var answerSchemaToModel = copier.TypeConverter{
 			for i, optionId := range multipleChoiceAnswerRequest.OptionIds {
 				dst.SelectedOptions[i] = model.Option{Model: gorm.Model{ID: uint(optionId)}}
 			}
+		} else if dateAnswerRequest, err := req.AsDateAnswerRequest(); err == nil &&
+			dateAnswerRequest.Type == api.DateAnswerRequestTypeDate {
+			if err := copier.Copy(&dst, &dateAnswerRequest); err != nil {
+				return nil, err
+			}
+
+			dst.DateContent = &dateAnswerRequest.Content.Time
+		} else if dateTimeAnswerRequest, err := req.AsDateTimeAnswerRequest(); err == nil &&
+			dateTimeAnswerRequest.Type == api.DateTimeAnswerRequestTypeDatetime {
+			if err := copier.Copy(&dst, &dateTimeAnswerRequest); err != nil {
+				return nil, err
+			}
+
+			dst.DateContent = &dateTimeAnswerRequest.Content
+		} else if scaleAnswerRequest, err := req.AsScaleAnswerRequest(); err == nil &&
+			scaleAnswerRequest.Type == api.ScaleAnswerRequestTypeScale {
+			if err := copier.Copy(&dst, &scaleAnswerRequest); err != nil {
+				return nil, err
+			}
+
+			dst.ScaleContent = &scaleAnswerRequest.Content
+		} else if rankingAnswerRequest, err := req.AsRankingAnswerRequest(); err == nil &&
+			rankingAnswerRequest.Type == api.RankingAnswerRequestTypeRanking {
+			if err := copier.Copy(&dst, &rankingAnswerRequest); err != nil {
+				return nil, err
+			}
+
+			dst.RankedOptions = make([]model.AnswerRankedOption, len(rankingAnswerRequest.OptionIds))
+			for i, optionID := range rankingAnswerRequest.OptionIds {
+				dst.RankedOptions[i] = model.AnswerRankedOption{
+					Position: i + 1,
+					OptionID: uint(optionID),
+				}
+			}
+		} else if fileAnswerRequest, err := req.AsFileAnswerRequest(); err == nil &&
+			fileAnswerRequest.Type == api.FileAnswerRequestTypeFile {
+			if err := copier.Copy(&dst, &fileAnswerRequest); err != nil {
+				return nil, err
+			}
+
+			imageID := uint(fileAnswerRequest.ImageId)
+			dst.FileImageID = &imageID
 		} else {
 			return nil, errors.New("unknown answer type")
 		}
@@ -152,6 +195,94 @@ var answerModelToSchema = copier.TypeConverter{
 				return nil, err
 			}
 
+		case model.DateQuestion:
+			var dateAnswer api.DateAnswerResponse
+
+			if err := copier.Copy(&dateAnswer, &answerModel); err != nil {
+				return nil, err
+			}
+
+			if answerModel.DateContent == nil {
+				return nil, errors.New("DateContent is nil")
+			}
+
+			dateAnswer.Content = openapi_types.Date{Time: *answerModel.DateContent}
+
+			if err := dst.FromDateAnswerResponse(dateAnswer); err != nil {
+				return nil, err
+			}
+
+		case model.DateTimeQuestion:
+			var dateTimeAnswer api.DateTimeAnswerResponse
+
+			if err := copier.Copy(&dateTimeAnswer, &answerModel); err != nil {
+				return nil, err
+			}
+
+			if answerModel.DateContent == nil {
+				return nil, errors.New("DateContent is nil")
+			}
+
+			dateTimeAnswer.Content = *answerModel.DateContent
+
+			if err := dst.FromDateTimeAnswerResponse(dateTimeAnswer); err != nil {
+				return nil, err
+			}
+
+		case model.ScaleQuestion:
+			var scaleAnswer api.ScaleAnswerResponse
+
+			if err := copier.Copy(&scaleAnswer, &answerModel); err != nil {
+				return nil, err
+			}
+
+			if answerModel.ScaleContent == nil {
+				return nil, errors.New("ScaleContent is nil")
+			}
+
+			scaleAnswer.Content = *answerModel.ScaleContent
+
+			if err := dst.FromScaleAnswerResponse(scaleAnswer); err != nil {
+				return nil, err
+			}
+
+		case model.RankingQuestion:
+			var rankingAnswer api.RankingAnswerResponse
+
+			if err := copier.Copy(&rankingAnswer, &answerModel); err != nil {
+				return nil, err
+			}
+
+			rankingAnswer.RankedOptions = make([]api.OptionResponse, len(answerModel.RankedOptions))
+
+			for i, rankedOption := range answerModel.RankedOptions {
+				rankingAnswer.RankedOptions[i] = api.OptionResponse{
+					Id:      int(rankedOption.OptionID),
+					Content: rankedOption.Option.Content,
+				}
+			}
+
+			if err := dst.FromRankingAnswerResponse(rankingAnswer); err != nil {
+				return nil, err
+			}
+
+		case model.FileQuestion:
+			var fileAnswer api.FileAnswerResponse
+
+			if err := copier.Copy(&fileAnswer, &answerModel); err != nil {
+				return nil, err
+			}
+
+			if answerModel.FileImageID == nil {
+				return nil, errors.New("FileImageID is nil")
+			}
+
+			fileAnswer.ImageId = int(*answerModel.FileImageID)
+
+			if err := dst.FromFileAnswerResponse(fileAnswer); err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, errors.New("unknown answer type")
 		}
@@ -159,3 +290,68 @@ var answerModelToSchema = copier.TypeConverter{
 		return dst, nil
 	},
 }
+
+var answerRevisionModelToSchema = copier.TypeConverter{
+	SrcType: model.AnswerRevision{},
+	DstType: api.AnswerRevisionResponse{},
+	Fn: func(src any) (any, error) {
+		revision, ok := src.(model.AnswerRevision)
+
+		if !ok {
+			return nil, errors.New("src is not a model.AnswerRevision")
+		}
+
+		dst, err := answerModelToSchema.Fn(revision.AsAnswer())
+
+		if err != nil {
+			return nil, err
+		}
+
+		answer, ok := dst.(api.AnswerResponse)
+
+		if !ok {
+			return nil, errors.New("dst is not an api.AnswerResponse")
+		}
+
+		return api.AnswerRevisionResponse{
+			Id:        int(revision.ID),
+			AnswerId:  int(revision.AnswerID),
+			EditorId:  revision.EditorID,
+			CreatedAt: revision.CreatedAt,
+			Answer:    answer,
+		}, nil
+	},
+}
+
+var answerFlagModelToSchema = copier.TypeConverter{
+	SrcType: model.AnswerFlag{},
+	DstType: api.FlaggedAnswerResponse{},
+	Fn: func(src any) (any, error) {
+		flag, ok := src.(model.AnswerFlag)
+
+		if !ok {
+			return nil, errors.New("src is not a model.AnswerFlag")
+		}
+
+		dst, err := answerModelToSchema.Fn(flag.Answer)
+
+		if err != nil {
+			return nil, err
+		}
+
+		answer, ok := dst.(api.AnswerResponse)
+
+		if !ok {
+			return nil, errors.New("dst is not an api.AnswerResponse")
+		}
+
+		return api.FlaggedAnswerResponse{
+			Answer: answer,
+			RemovedOption: api.OptionResponse{
+				Id:      int(flag.Option.ID),
+				Content: flag.Option.Content,
+			},
+			FlaggedAt: flag.CreatedAt,
+		}, nil
+	},
+}
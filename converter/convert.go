@@ -10,10 +10,20 @@ func Convert[T any](src any) (T, error) {
 			activityResponseToSchema,
 			answerSchemaToModel,
 			answerModelToSchema,
+			answerRevisionModelToSchema,
+			answerFlagModelToSchema,
 			campSchemaToModel,
 			campModelToSchema,
 			eventSchemaToModel,
 			eventModelToSchema,
+			feeRuleSchemaToModel,
+			feeRuleModelToSchema,
+			paymentSchemaToModel,
+			paymentModelToSchema,
+			paymentLineItemModelToSchema,
+			paymentLineItemSchemaToModel,
+			paymentTransactionSchemaToModel,
+			paymentTransactionModelToSchema,
 			postQuestionGroupSchemaToModel,
 			putQuestionGroupSchemaToModel,
 			questionGroupModelToSchema,
@@ -23,6 +33,9 @@ func Convert[T any](src any) (T, error) {
 			rollCallModelToSchema,
 			rollCallSchemaToModel,
 			roomSchemaToModel,
+			scheduledChangeSchemaToModel,
+			scheduledChangeModelToSchema,
+			guidebookRevisionModelToSchema,
 		},
 	})
 
@@ -84,6 +84,8 @@ var eventModelToSchema = copier.TypeConverter{
 				return nil, err
 			}
 
+			durationEvent.Attendance = summarizeAttendances(eventModel.Attendances)
+
 			if err := dst.FromDurationEventResponse(durationEvent); err != nil {
 				return nil, err
 			}
@@ -106,6 +108,8 @@ var eventModelToSchema = copier.TypeConverter{
 				return nil, err
 			}
 
+			officialEvent.Attendance = summarizeAttendances(eventModel.Attendances)
+
 			if err := dst.FromOfficialEventResponse(officialEvent); err != nil {
 				return nil, err
 			}
@@ -117,3 +121,22 @@ var eventModelToSchema = copier.TypeConverter{
 		return dst, nil
 	},
 }
+
+func summarizeAttendances(attendances []model.EventAttendance) api.EventAttendanceSummary {
+	var summary api.EventAttendanceSummary
+
+	for _, attendance := range attendances {
+		switch {
+		case attendance.IsWaitlisted:
+			summary.Waitlisted++
+		case attendance.Status == model.EventAttendanceStatusGoing:
+			summary.Going++
+		case attendance.Status == model.EventAttendanceStatusMaybe:
+			summary.Maybe++
+		case attendance.Status == model.EventAttendanceStatusNotGoing:
+			summary.NotGoing++
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"errors"
+
+	"github.com/jinzhu/copier"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+)
+
+var guidebookRevisionModelToSchema = copier.TypeConverter{
+	SrcType: model.GuidebookRevision{},
+	DstType: api.GuidebookRevisionResponse{},
+	Fn: func(src any) (any, error) {
+		revision, ok := src.(model.GuidebookRevision)
+
+		if !ok {
+			return nil, errors.New("src is not a model.GuidebookRevision")
+		}
+
+		return api.GuidebookRevisionResponse{
+			Id:          int(revision.ID),
+			CampId:      int(revision.CampID),
+			Content:     revision.Content,
+			Author:      revision.AuthorID,
+			CreatedAt:   revision.CreatedAt,
+			PublishAt:   revision.PublishAt,
+			PublishedAt: revision.PublishedAt,
+		}, nil
+	},
+}
@@ -0,0 +1,220 @@
+package converter
+
+import (
+	"errors"
+
+	"github.com/jinzhu/copier"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+)
+
+var paymentSchemaToModel = copier.TypeConverter{
+	SrcType: api.PaymentRequest{},
+	DstType: model.Payment{},
+	Fn: func(src any) (any, error) {
+		payment, ok := src.(api.PaymentRequest)
+
+		if !ok {
+			return nil, errors.New("src is not an api.PaymentRequest")
+		}
+
+		dst := model.Payment{
+			UserID: payment.UserId,
+			Amount: payment.Amount,
+		}
+
+		if payment.AmountPaid != nil {
+			dst.AmountPaid = *payment.AmountPaid
+		}
+
+		return dst, nil
+	},
+}
+
+var paymentModelToSchema = copier.TypeConverter{
+	SrcType: model.Payment{},
+	DstType: api.PaymentResponse{},
+	Fn: func(src any) (any, error) {
+		payment, ok := src.(model.Payment)
+
+		if !ok {
+			return nil, errors.New("src is not a model.Payment")
+		}
+
+		dst := api.PaymentResponse{
+			Id:            int(payment.ID),
+			UserId:        payment.UserID,
+			CampId:        int(payment.CampID),
+			Amount:        payment.Amount,
+			AmountPaid:    payment.AmountPaid,
+			ReferenceCode: payment.ReferenceCode(),
+		}
+
+		// 明細がない支払いではlineItemsを含めない
+		if len(payment.LineItems) > 0 {
+			lineItems := make([]api.PaymentLineItemResponse, len(payment.LineItems))
+
+			for i, lineItem := range payment.LineItems {
+				lineItems[i] = paymentLineItemToSchema(lineItem)
+			}
+
+			dst.LineItems = &lineItems
+		}
+
+		return dst, nil
+	},
+}
+
+var paymentLineItemModelToSchema = copier.TypeConverter{
+	SrcType: model.PaymentLineItem{},
+	DstType: api.PaymentLineItemResponse{},
+	Fn: func(src any) (any, error) {
+		lineItem, ok := src.(model.PaymentLineItem)
+
+		if !ok {
+			return nil, errors.New("src is not a model.PaymentLineItem")
+		}
+
+		return paymentLineItemToSchema(lineItem), nil
+	},
+}
+
+var paymentLineItemSchemaToModel = copier.TypeConverter{
+	SrcType: api.PaymentLineItemRequest{},
+	DstType: model.PaymentLineItem{},
+	Fn: func(src any) (any, error) {
+		lineItem, ok := src.(api.PaymentLineItemRequest)
+
+		if !ok {
+			return nil, errors.New("src is not an api.PaymentLineItemRequest")
+		}
+
+		return model.PaymentLineItem{
+			Category:    model.PaymentLineItemCategory(lineItem.Category),
+			Description: lineItem.Description,
+			Amount:      lineItem.Amount,
+		}, nil
+	},
+}
+
+var feeRuleSchemaToModel = copier.TypeConverter{
+	SrcType: api.FeeRuleRequest{},
+	DstType: model.FeeRule{},
+	Fn: func(src any) (any, error) {
+		feeRule, ok := src.(api.FeeRuleRequest)
+
+		if !ok {
+			return nil, errors.New("src is not an api.FeeRuleRequest")
+		}
+
+		return model.FeeRule{
+			Category:    model.PaymentLineItemCategory(feeRule.Category),
+			Description: feeRule.Description,
+			Amount:      feeRule.Amount,
+			QuestionID:  intPtrToUintPtr(feeRule.QuestionId),
+			OptionID:    intPtrToUintPtr(feeRule.OptionId),
+		}, nil
+	},
+}
+
+var feeRuleModelToSchema = copier.TypeConverter{
+	SrcType: model.FeeRule{},
+	DstType: api.FeeRuleResponse{},
+	Fn: func(src any) (any, error) {
+		feeRule, ok := src.(model.FeeRule)
+
+		if !ok {
+			return nil, errors.New("src is not a model.FeeRule")
+		}
+
+		return api.FeeRuleResponse{
+			Id:          int(feeRule.ID),
+			CampId:      int(feeRule.CampID),
+			Category:    api.PaymentLineItemCategory(feeRule.Category),
+			Description: feeRule.Description,
+			Amount:      feeRule.Amount,
+			QuestionId:  uintPtrToIntPtr(feeRule.QuestionID),
+			OptionId:    uintPtrToIntPtr(feeRule.OptionID),
+		}, nil
+	},
+}
+
+var paymentTransactionSchemaToModel = copier.TypeConverter{
+	SrcType: api.PaymentTransactionRequest{},
+	DstType: model.PaymentTransaction{},
+	Fn: func(src any) (any, error) {
+		transaction, ok := src.(api.PaymentTransactionRequest)
+
+		if !ok {
+			return nil, errors.New("src is not an api.PaymentTransactionRequest")
+		}
+
+		dst := model.PaymentTransaction{
+			Amount:       transaction.Amount,
+			Method:       model.PaymentMethod(transaction.Method),
+			ReceivedByID: transaction.ReceivedBy,
+		}
+
+		if transaction.ReceivedAt != nil {
+			dst.ReceivedAt = *transaction.ReceivedAt
+		}
+
+		if transaction.Memo != nil {
+			dst.Memo = *transaction.Memo
+		}
+
+		return dst, nil
+	},
+}
+
+var paymentTransactionModelToSchema = copier.TypeConverter{
+	SrcType: model.PaymentTransaction{},
+	DstType: api.PaymentTransactionResponse{},
+	Fn: func(src any) (any, error) {
+		transaction, ok := src.(model.PaymentTransaction)
+
+		if !ok {
+			return nil, errors.New("src is not a model.PaymentTransaction")
+		}
+
+		return api.PaymentTransactionResponse{
+			Id:         int(transaction.ID),
+			Amount:     transaction.Amount,
+			Method:     api.PaymentMethod(transaction.Method),
+			ReceivedBy: transaction.ReceivedByID,
+			ReceivedAt: transaction.ReceivedAt,
+			Memo:       transaction.Memo,
+		}, nil
+	},
+}
+
+func paymentLineItemToSchema(lineItem model.PaymentLineItem) api.PaymentLineItemResponse {
+	return api.PaymentLineItemResponse{
+		Id:          int(lineItem.ID),
+		Category:    api.PaymentLineItemCategory(lineItem.Category),
+		Description: lineItem.Description,
+		Amount:      lineItem.Amount,
+		FeeRuleId:   uintPtrToIntPtr(lineItem.FeeRuleID),
+	}
+}
+
+func uintPtrToIntPtr(v *uint) *int {
+	if v == nil {
+		return nil
+	}
+
+	i := int(*v)
+
+	return &i
+}
+
+func intPtrToUintPtr(v *int) *uint {
+	if v == nil {
+		return nil
+	}
+
+	u := uint(*v)
+
+	return &u
+}
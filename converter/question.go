@@ -64,6 +64,56 @@ var postQuestionSchemaToModel = copier.TypeConverter{
 				return nil, err
 			}
 
+		case model.DateQuestion:
+			dateQuestionRequest, err := req.AsDateQuestionRequest()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := copier.Copy(&dst, &dateQuestionRequest); err != nil {
+				return nil, err
+			}
+
+		case model.DateTimeQuestion:
+			dateTimeQuestionRequest, err := req.AsDateTimeQuestionRequest()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := copier.Copy(&dst, &dateTimeQuestionRequest); err != nil {
+				return nil, err
+			}
+
+		case model.ScaleQuestion:
+			scaleQuestionRequest, err := req.AsScaleQuestionRequest()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := copyScaleQuestionRequest(&dst, &scaleQuestionRequest); err != nil {
+				return nil, err
+			}
+
+		case model.RankingQuestion:
+			rankingQuestionRequest, err := req.AsPostRankingQuestionRequest()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := copier.Copy(&dst, &rankingQuestionRequest); err != nil {
+				return nil, err
+			}
+
+		case model.FileQuestion:
+			fileQuestionRequest, err := req.AsFileQuestionRequest()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := copier.Copy(&dst, &fileQuestionRequest); err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, errors.New("unknown question type")
 		}
@@ -108,6 +158,31 @@ var putQuestionSchemaToModel = copier.TypeConverter{
 			if err := copier.Copy(&dst, &multipleChoiceQuestionRequest); err != nil {
 				return nil, err
 			}
+		} else if dateQuestionRequest, err := req.AsDateQuestionRequest(); err == nil &&
+			dateQuestionRequest.Type == api.DateQuestionRequestTypeDate {
+			if err := copier.Copy(&dst, &dateQuestionRequest); err != nil {
+				return nil, err
+			}
+		} else if dateTimeQuestionRequest, err := req.AsDateTimeQuestionRequest(); err == nil &&
+			dateTimeQuestionRequest.Type == api.DateTimeQuestionRequestTypeDatetime {
+			if err := copier.Copy(&dst, &dateTimeQuestionRequest); err != nil {
+				return nil, err
+			}
+		} else if scaleQuestionRequest, err := req.AsScaleQuestionRequest(); err == nil &&
+			scaleQuestionRequest.Type == api.ScaleQuestionRequestTypeScale {
+			if err := copyScaleQuestionRequest(&dst, &scaleQuestionRequest); err != nil {
+				return nil, err
+			}
+		} else if rankingQuestionRequest, err := req.AsPutRankingQuestionRequest(); err == nil &&
+			rankingQuestionRequest.Type == api.PutRankingQuestionRequestTypeRanking {
+			if err := copier.Copy(&dst, &rankingQuestionRequest); err != nil {
+				return nil, err
+			}
+		} else if fileQuestionRequest, err := req.AsFileQuestionRequest(); err == nil &&
+			fileQuestionRequest.Type == api.FileQuestionRequestTypeFile {
+			if err := copier.Copy(&dst, &fileQuestionRequest); err != nil {
+				return nil, err
+			}
 		} else {
 			return nil, errors.New("unknown question type")
 		}
@@ -173,6 +248,68 @@ var questionModelToSchema = copier.TypeConverter{
 				return nil, err
 			}
 
+		case model.DateQuestion:
+			var dateQuestion api.DateQuestionResponse
+
+			if err := copier.Copy(&dateQuestion, &questionModel); err != nil {
+				return nil, err
+			}
+
+			if err := dst.FromDateQuestionResponse(dateQuestion); err != nil {
+				return nil, err
+			}
+
+		case model.DateTimeQuestion:
+			var dateTimeQuestion api.DateTimeQuestionResponse
+
+			if err := copier.Copy(&dateTimeQuestion, &questionModel); err != nil {
+				return nil, err
+			}
+
+			if err := dst.FromDateTimeQuestionResponse(dateTimeQuestion); err != nil {
+				return nil, err
+			}
+
+		case model.ScaleQuestion:
+			var scaleQuestion api.ScaleQuestionResponse
+
+			if err := copier.Copy(&scaleQuestion, &questionModel); err != nil {
+				return nil, err
+			}
+
+			if questionModel.ScaleMin == nil || questionModel.ScaleMax == nil {
+				return nil, errors.New("ScaleMin or ScaleMax is nil")
+			}
+
+			scaleQuestion.ScaleMin = *questionModel.ScaleMin
+			scaleQuestion.ScaleMax = *questionModel.ScaleMax
+
+			if err := dst.FromScaleQuestionResponse(scaleQuestion); err != nil {
+				return nil, err
+			}
+
+		case model.RankingQuestion:
+			var rankingQuestion api.RankingQuestionResponse
+
+			if err := copier.Copy(&rankingQuestion, &questionModel); err != nil {
+				return nil, err
+			}
+
+			if err := dst.FromRankingQuestionResponse(rankingQuestion); err != nil {
+				return nil, err
+			}
+
+		case model.FileQuestion:
+			var fileQuestion api.FileQuestionResponse
+
+			if err := copier.Copy(&fileQuestion, &questionModel); err != nil {
+				return nil, err
+			}
+
+			if err := dst.FromFileQuestionResponse(fileQuestion); err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, errors.New("unknown question type")
 		}
@@ -180,3 +317,15 @@ var questionModelToSchema = copier.TypeConverter{
 		return dst, nil
 	},
 }
+
+// copyScaleQuestionRequest は線形スケールの質問のリクエストをモデルにコピーする
+func copyScaleQuestionRequest(dst *model.Question, req *api.ScaleQuestionRequest) error {
+	if err := copier.Copy(dst, req); err != nil {
+		return err
+	}
+
+	dst.ScaleMin = &req.ScaleMin
+	dst.ScaleMax = &req.ScaleMax
+
+	return nil
+}
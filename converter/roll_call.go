@@ -2,6 +2,7 @@ package converter
 
 import (
 	"errors"
+	"time"
 
 	"github.com/jinzhu/copier"
 
@@ -56,6 +57,7 @@ var rollCallModelToSchema = copier.TypeConverter{
 			subjects[i] = subject.ID
 		}
 		dst.Subjects = subjects
+		dst.IsOpen = rollCall.IsOpen(time.Now())
 
 		return dst, nil
 	},
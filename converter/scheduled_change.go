@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"errors"
+
+	"github.com/jinzhu/copier"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+)
+
+var scheduledChangeSchemaToModel = copier.TypeConverter{
+	SrcType: api.ScheduledChangeRequest{},
+	DstType: model.ScheduledChange{},
+	Fn: func(src any) (any, error) {
+		change, ok := src.(api.ScheduledChangeRequest)
+
+		if !ok {
+			return nil, errors.New("src is not an api.ScheduledChangeRequest")
+		}
+
+		dst := model.ScheduledChange{
+			TargetType:  model.ScheduledChangeTargetType(change.TargetType),
+			Field:       model.ScheduledChangeField(change.Field),
+			Value:       change.Value,
+			ScheduledAt: change.ScheduledAt,
+		}
+
+		if change.TargetId != nil {
+			dst.TargetID = uint(*change.TargetId)
+		}
+
+		return dst, nil
+	},
+}
+
+var scheduledChangeModelToSchema = copier.TypeConverter{
+	SrcType: model.ScheduledChange{},
+	DstType: api.ScheduledChangeResponse{},
+	Fn: func(src any) (any, error) {
+		change, ok := src.(model.ScheduledChange)
+
+		if !ok {
+			return nil, errors.New("src is not a model.ScheduledChange")
+		}
+
+		return api.ScheduledChangeResponse{
+			Id:            int(change.ID),
+			CampId:        int(change.CampID),
+			TargetType:    api.ScheduledChangeTargetType(change.TargetType),
+			TargetId:      int(change.TargetID),
+			Field:         api.ScheduledChangeField(change.Field),
+			Value:         change.Value,
+			ScheduledAt:   change.ScheduledAt,
+			CreatedBy:     change.CreatedByID,
+			ProcessedAt:   change.ProcessedAt,
+			FailureReason: change.FailureReason,
+		}, nil
+	},
+}
@@ -24,7 +24,10 @@ import (
 	"github.com/traPtitech/rucQ/repository/gormrepository"
 	"github.com/traPtitech/rucQ/router"
 	activityservice "github.com/traPtitech/rucQ/service/activity"
+	archiveservice "github.com/traPtitech/rucQ/service/archive"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/notification"
+	"github.com/traPtitech/rucQ/service/reconciliation"
 	"github.com/traPtitech/rucQ/service/scheduler"
 	"github.com/traPtitech/rucQ/service/traq"
 )
@@ -126,9 +129,6 @@ func main() {
 		}))
 	}
 
-	// botがtraQからのイベントを受け取るエンドポイントを設定
-	e.POST("/api/traq-events", router.TraqEventHandler)
-
 	repo, err := gormrepository.NewGormRepository(db)
 	if err != nil {
 		log.Fatal(err)
@@ -139,14 +139,64 @@ func main() {
 	traqService := traq.NewTraqService(traqBaseURL, botAccessToken)
 	notificationService := notification.NewNotificationService(repo, traqService)
 	activityService := activityservice.NewActivityService(repo)
-	schedulerService := scheduler.NewSchedulerService(repo, traqService)
+	archiveService := archiveservice.NewArchiveService(repo)
+	reconciliationService := reconciliation.NewReconciliationService(repo)
+	// 再接続したクライアントに再送するため、合宿ごとに直近のイベントを保持する
+	const (
+		campEventBufferSize = 256
+		pubSubPollInterval  = 500 * time.Millisecond
+	)
+
+	// 複数のインスタンスで動かす場合はdatabaseを指定する
+	usePubSubDatabase := os.Getenv("RUCQ_PUBSUB_BACKEND") == "database"
+
+	var eventBus eventbus.EventBus
+
+	if usePubSubDatabase {
+		eventBus = eventbus.NewOutboxEventBus(ctx, repo, pubSubPollInterval, campEventBufferSize)
+	} else {
+		eventBus = eventbus.NewEventBus(campEventBufferSize)
+	}
+
+	schedulerService := scheduler.NewSchedulerService(
+		repo,
+		activityService,
+		traqService,
+		eventBus,
+	)
 
 	go schedulerService.Start(ctx)
 
-	api.RegisterHandlers(
-		e,
-		router.NewServer(ctx, repo, activityService, notificationService, traqService, isDev),
+	traqBotVerificationToken := os.Getenv("TRAQ_BOT_VERIFICATION_TOKEN")
+
+	if traqBotVerificationToken == "" {
+		slog.Warn("TRAQ_BOT_VERIFICATION_TOKEN is not set; traQ bot events will be rejected")
+	}
+
+	server := router.NewServer(
+		ctx,
+		repo,
+		activityService,
+		archiveService,
+		reconciliationService,
+		notificationService,
+		traqService,
+		eventBus,
+		router.TraqBotConfig{
+			VerificationToken: traqBotVerificationToken,
+			RollCallChannelID: os.Getenv("TRAQ_ROLL_CALL_CHANNEL_ID"),
+		},
+		router.PubSubConfig{
+			UseDatabase:  usePubSubDatabase,
+			PollInterval: pubSubPollInterval,
+		},
+		isDev,
 	)
+
+	// botがtraQからのイベントを受け取るエンドポイントを設定
+	e.POST("/api/traq-events", server.TraqEventHandler)
+
+	api.RegisterHandlers(e, server)
 	srv := &http.Server{
 		Addr:    "0.0.0.0:8080",
 		Handler: e,
@@ -8,6 +8,11 @@ import (
 )
 
 func Migrate(db *gorm.DB) error {
+	// 参加登録した時刻を記録するため、合宿の参加者の中間テーブルにモデルを指定する
+	if err := db.SetupJoinTable(&model.Camp{}, "Participants", &model.CampParticipant{}); err != nil {
+		return err
+	}
+
 	m := gormigrate.New(db, gormigrate.DefaultOptions, getAllMigrations())
 
 	m.InitSchema(func(db *gorm.DB) error {
@@ -19,12 +24,31 @@ func Migrate(db *gorm.DB) error {
 
 func getAllMigrations() []*gormigrate.Migration {
 	return []*gormigrate.Migration{
-		v1(), // questionsテーブルにis_requiredカラムを追加
-		v2(), // ゼロ値で上書きされてしまっていたcreated_atを修正
-		v3(), // messagesテーブルにsent_atカラムを追加
-		v4(), // roll_callsテーブルにcamp_idカラムを追加
-		v5(), // room_statuses, room_status_logsテーブルを追加
-		v6(), // activitiesテーブルを追加
-		v7(), // camps.display_idにユニークインデックスを追加
+		v1(),  // questionsテーブルにis_requiredカラムを追加
+		v2(),  // ゼロ値で上書きされてしまっていたcreated_atを修正
+		v3(),  // messagesテーブルにsent_atカラムを追加
+		v4(),  // roll_callsテーブルにcamp_idカラムを追加
+		v5(),  // room_statuses, room_status_logsテーブルを追加
+		v6(),  // activitiesテーブルを追加
+		v7(),  // camps.display_idにユニークインデックスを追加
+		v8(),  // eventsテーブルにcapacityカラム、event_attendancesテーブルを追加
+		v9(),  // event_remindersテーブルを追加
+		v10(), // roll_callsテーブルにdeadline、closed_atカラムを追加
+		v11(), // roll_callsテーブルにrestrict_responsesカラムを追加
+		v12(), // roll_callsテーブルにlast_nudged_atカラムを追加
+		v13(), // roll_callsテーブルにtraq_message_idカラムを追加
+		v14(), // pub_sub_messagesテーブルを追加
+		v15(), // answer_revisions, answer_revision_optionsテーブルを追加
+		v16(), // 質問の種類にdate, datetime, scale, ranking, fileを追加
+		v17(), // question_groups, questions, optionsテーブルにsort_orderカラムを追加
+		v18(), // optionsテーブルにremoved_atカラムを追加し、answer_flagsテーブルを作成
+		v19(), // payment_line_items, fee_rulesテーブルを作成
+		v20(), // payment_transactionsテーブルを作成し、既存の支払済み金額を移行
+		v21(), // campsテーブルのis_draft等のフラグをstatusカラムと予定時刻のカラムに置き換え
+		v22(), // scheduled_changesテーブルを作成
+		v23(), // guidebook_revisionsテーブルを作成し、既存のしおりを最初の版として移行
+		v24(), // camp_participantsテーブルにcreated_atカラムを追加
+		v25(), // answer_revision_ranked_optionsテーブルの選択肢の外部キーをRESTRICTに変更
+		v26(), // roll_call_reactionsテーブルにfrom_traq_stampカラムを追加
 	}
 }
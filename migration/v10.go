@@ -0,0 +1,38 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v10RollCall struct {
+	gorm.Model
+	Deadline *time.Time
+	ClosedAt *time.Time
+}
+
+func (v10RollCall) TableName() string {
+	return "roll_calls"
+}
+
+func v10() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "10",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().AddColumn(&v10RollCall{}, "deadline"); err != nil {
+				return err
+			}
+
+			return db.Migrator().AddColumn(&v10RollCall{}, "closed_at")
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropColumn(&v10RollCall{}, "closed_at"); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropColumn(&v10RollCall{}, "deadline")
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v11RollCall struct {
+	gorm.Model
+	RestrictResponses bool `gorm:"not null;default:false"`
+}
+
+func (v11RollCall) TableName() string {
+	return "roll_calls"
+}
+
+func v11() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "11",
+		Migrate: func(db *gorm.DB) error {
+			return db.Migrator().AddColumn(&v11RollCall{}, "restrict_responses")
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropColumn(&v11RollCall{}, "restrict_responses")
+		},
+	}
+}
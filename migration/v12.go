@@ -0,0 +1,29 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v12RollCall struct {
+	gorm.Model
+	LastNudgedAt *time.Time
+}
+
+func (v12RollCall) TableName() string {
+	return "roll_calls"
+}
+
+func v12() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "12",
+		Migrate: func(db *gorm.DB) error {
+			return db.Migrator().AddColumn(&v12RollCall{}, "last_nudged_at")
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropColumn(&v12RollCall{}, "last_nudged_at")
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v13RollCall struct {
+	gorm.Model
+	TraqMessageID *string `gorm:"size:36;uniqueIndex"`
+}
+
+func (v13RollCall) TableName() string {
+	return "roll_calls"
+}
+
+func v13() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "13",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().AddColumn(&v13RollCall{}, "traq_message_id"); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateIndex(&v13RollCall{}, "TraqMessageID")
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropIndex(&v13RollCall{}, "TraqMessageID"); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropColumn(&v13RollCall{}, "traq_message_id")
+		},
+	}
+}
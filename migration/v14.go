@@ -0,0 +1,31 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v14PubSubMessage struct {
+	ID        uint      `gorm:"primaryKey"`
+	Channel   string    `gorm:"size:64;not null"`
+	Payload   string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"not null;index"`
+}
+
+func (v14PubSubMessage) TableName() string {
+	return "pub_sub_messages"
+}
+
+func v14() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "14",
+		Migrate: func(db *gorm.DB) error {
+			return db.Migrator().CreateTable(&v14PubSubMessage{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable(&v14PubSubMessage{})
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v15AnswerRevision struct {
+	gorm.Model
+	AnswerID          uint       `gorm:"not null;index"`
+	Answer            *v15Answer `gorm:"foreignKey:AnswerID;references:ID;constraint:OnDelete:CASCADE"`
+	QuestionID        uint       `gorm:"not null;index:idx_answer_revisions_question_id_user_id"`
+	UserID            string     `gorm:"not null;size:32;index:idx_answer_revisions_question_id_user_id"`
+	Type              string     `gorm:"type:enum('free_text', 'free_number', 'single', 'multiple')"`
+	FreeTextContent   *string
+	FreeNumberContent *float64
+	EditorID          string   `gorm:"not null;size:32"`
+	Editor            *v15User `gorm:"foreignKey:EditorID;references:ID;constraint:OnDelete:RESTRICT"`
+}
+
+func (v15AnswerRevision) TableName() string {
+	return "answer_revisions"
+}
+
+// v15AnswerRevisionOption はAnswerRevision.SelectedOptionsの中間テーブル
+type v15AnswerRevisionOption struct {
+	AnswerRevisionID uint               `gorm:"primaryKey"`
+	AnswerRevision   *v15AnswerRevision `gorm:"foreignKey:AnswerRevisionID;references:ID"`
+	OptionID         uint               `gorm:"primaryKey"`
+	Option           *v15Option         `gorm:"foreignKey:OptionID;references:ID"`
+}
+
+func (v15AnswerRevisionOption) TableName() string {
+	return "answer_revision_options"
+}
+
+type v15Answer struct {
+	gorm.Model
+}
+
+func (v15Answer) TableName() string {
+	return "answers"
+}
+
+type v15Option struct {
+	gorm.Model
+}
+
+func (v15Option) TableName() string {
+	return "options"
+}
+
+type v15User struct {
+	ID string `gorm:"primaryKey;size:32"`
+}
+
+func (v15User) TableName() string {
+	return "users"
+}
+
+func v15() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "15",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().CreateTable(&v15AnswerRevision{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateTable(&v15AnswerRevisionOption{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropTable(&v15AnswerRevisionOption{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropTable(&v15AnswerRevision{})
+		},
+	}
+}
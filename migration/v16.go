@@ -0,0 +1,182 @@
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+const (
+	v16QuestionTypeEnum = "enum('free_text', 'free_number', 'single', 'multiple', " +
+		"'date', 'datetime', 'scale', 'ranking', 'file')"
+	v16OldQuestionTypeEnum = "enum('free_text', 'free_number', 'single', 'multiple')"
+)
+
+type v16Question struct {
+	gorm.Model
+	ScaleMin      *int
+	ScaleMax      *int
+	ScaleMinLabel *string
+	ScaleMaxLabel *string
+}
+
+func (v16Question) TableName() string {
+	return "questions"
+}
+
+type v16Answer struct {
+	gorm.Model
+	DateContent  *time.Time
+	ScaleContent *int
+	FileImageID  *uint
+	FileImage    *v16Image `gorm:"foreignKey:FileImageID;references:ID;constraint:OnDelete:RESTRICT"`
+}
+
+func (v16Answer) TableName() string {
+	return "answers"
+}
+
+type v16AnswerRankedOption struct {
+	AnswerID uint       `gorm:"primaryKey"`
+	Answer   *v16Answer `gorm:"foreignKey:AnswerID;references:ID;constraint:OnDelete:CASCADE"`
+	Position int        `gorm:"primaryKey;autoIncrement:false"`
+	OptionID uint
+	Option   *v16Option `gorm:"foreignKey:OptionID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (v16AnswerRankedOption) TableName() string {
+	return "answer_ranked_options"
+}
+
+type v16AnswerRevision struct {
+	gorm.Model
+	DateContent  *time.Time
+	ScaleContent *int
+	FileImageID  *uint
+}
+
+func (v16AnswerRevision) TableName() string {
+	return "answer_revisions"
+}
+
+type v16AnswerRevisionRankedOption struct {
+	AnswerRevisionID uint               `gorm:"primaryKey"`
+	AnswerRevision   *v16AnswerRevision `gorm:"foreignKey:AnswerRevisionID;references:ID;constraint:OnDelete:CASCADE"`
+	Position         int                `gorm:"primaryKey;autoIncrement:false"`
+	OptionID         uint
+	Option           *v16Option `gorm:"foreignKey:OptionID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (v16AnswerRevisionRankedOption) TableName() string {
+	return "answer_revision_ranked_options"
+}
+
+type v16Option struct {
+	gorm.Model
+}
+
+func (v16Option) TableName() string {
+	return "options"
+}
+
+type v16Image struct {
+	gorm.Model
+}
+
+func (v16Image) TableName() string {
+	return "images"
+}
+
+// v16AlterTypeColumns は質問の種類を表すenumカラムを変更する
+func v16AlterTypeColumns(db *gorm.DB, enum string) error {
+	for _, table := range []string{"questions", "answers", "answer_revisions"} {
+		if err := db.Exec(
+			fmt.Sprintf("ALTER TABLE `%s` MODIFY COLUMN `type` %s", table, enum),
+		).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func v16() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "16",
+		Migrate: func(db *gorm.DB) error {
+			if err := v16AlterTypeColumns(db, v16QuestionTypeEnum); err != nil {
+				return err
+			}
+
+			for _, column := range []string{
+				"scale_min",
+				"scale_max",
+				"scale_min_label",
+				"scale_max_label",
+			} {
+				if err := db.Migrator().AddColumn(&v16Question{}, column); err != nil {
+					return err
+				}
+			}
+
+			for _, column := range []string{"date_content", "scale_content", "file_image_id"} {
+				if err := db.Migrator().AddColumn(&v16Answer{}, column); err != nil {
+					return err
+				}
+
+				if err := db.Migrator().AddColumn(&v16AnswerRevision{}, column); err != nil {
+					return err
+				}
+			}
+
+			if err := db.Migrator().CreateConstraint(&v16Answer{}, "FileImage"); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().CreateTable(&v16AnswerRankedOption{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateTable(&v16AnswerRevisionRankedOption{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropTable(&v16AnswerRevisionRankedOption{}); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().DropTable(&v16AnswerRankedOption{}); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().DropConstraint(&v16Answer{}, "FileImage"); err != nil {
+				return err
+			}
+
+			for _, column := range []string{"date_content", "scale_content", "file_image_id"} {
+				if err := db.Migrator().DropColumn(&v16AnswerRevision{}, column); err != nil {
+					return err
+				}
+
+				if err := db.Migrator().DropColumn(&v16Answer{}, column); err != nil {
+					return err
+				}
+			}
+
+			for _, column := range []string{
+				"scale_min",
+				"scale_max",
+				"scale_min_label",
+				"scale_max_label",
+			} {
+				if err := db.Migrator().DropColumn(&v16Question{}, column); err != nil {
+					return err
+				}
+			}
+
+			// 追加した種類の質問や回答が残っているとenumを戻せないため、先に削除しておく必要がある
+			return v16AlterTypeColumns(db, v16OldQuestionTypeEnum)
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v17QuestionGroup struct {
+	SortOrder int `gorm:"not null;default:0"`
+}
+
+func (v17QuestionGroup) TableName() string {
+	return "question_groups"
+}
+
+type v17Question struct {
+	SortOrder int `gorm:"not null;default:0"`
+}
+
+func (v17Question) TableName() string {
+	return "questions"
+}
+
+type v17Option struct {
+	SortOrder int `gorm:"not null;default:0"`
+}
+
+func (v17Option) TableName() string {
+	return "options"
+}
+
+func v17() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "17",
+		// 既存のデータはsort_orderが全て0になり、これまで通りID順に並ぶ
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().AddColumn(&v17QuestionGroup{}, "sort_order"); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().AddColumn(&v17Question{}, "sort_order"); err != nil {
+				return err
+			}
+
+			return db.Migrator().AddColumn(&v17Option{}, "sort_order")
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropColumn(&v17Option{}, "sort_order"); err != nil {
+				return err
+			}
+
+			if err := db.Migrator().DropColumn(&v17Question{}, "sort_order"); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropColumn(&v17QuestionGroup{}, "sort_order")
+		},
+	}
+}
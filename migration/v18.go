@@ -0,0 +1,58 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v18Option struct {
+	gorm.Model
+	RemovedAt *time.Time
+}
+
+func (v18Option) TableName() string {
+	return "options"
+}
+
+type v18Answer struct {
+	gorm.Model
+}
+
+func (v18Answer) TableName() string {
+	return "answers"
+}
+
+type v18AnswerFlag struct {
+	ID        uint      `gorm:"primaryKey"`
+	AnswerID  uint      `gorm:"not null;uniqueIndex:idx_answer_flags_answer_id_option_id"`
+	Answer    v18Answer `gorm:"foreignKey:AnswerID;references:ID;constraint:OnDelete:CASCADE"`
+	OptionID  uint      `gorm:"not null;uniqueIndex:idx_answer_flags_answer_id_option_id"`
+	Option    v18Option `gorm:"foreignKey:OptionID;references:ID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+func (v18AnswerFlag) TableName() string {
+	return "answer_flags"
+}
+
+func v18() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "18",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().AddColumn(&v18Option{}, "RemovedAt"); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateTable(&v18AnswerFlag{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropTable(&v18AnswerFlag{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropColumn(&v18Option{}, "RemovedAt")
+		},
+	}
+}
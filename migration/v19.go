@@ -0,0 +1,62 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v19Payment struct {
+	gorm.Model
+}
+
+func (v19Payment) TableName() string {
+	return "payments"
+}
+
+type v19PaymentLineItem struct {
+	ID          uint       `gorm:"primaryKey"`
+	PaymentID   uint       `gorm:"not null;index"`
+	Payment     v19Payment `gorm:"foreignKey:PaymentID;references:ID;constraint:OnDelete:CASCADE"`
+	Category    string     `gorm:"type:enum('lodging', 'meal', 'bus', 'late_registration', 'discount', 'other');not null"`
+	Description string
+	Amount      int
+	FeeRuleID   *uint
+}
+
+func (v19PaymentLineItem) TableName() string {
+	return "payment_line_items"
+}
+
+type v19FeeRule struct {
+	gorm.Model
+	CampID      uint   `gorm:"not null;index"`
+	Category    string `gorm:"type:enum('lodging', 'meal', 'bus', 'late_registration', 'discount', 'other');not null"`
+	Description string
+	Amount      int
+	QuestionID  *uint
+	OptionID    *uint
+}
+
+func (v19FeeRule) TableName() string {
+	return "fee_rules"
+}
+
+func v19() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "19",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().CreateTable(&v19PaymentLineItem{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateTable(&v19FeeRule{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropTable(&v19FeeRule{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropTable(&v19PaymentLineItem{})
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v20Payment struct {
+	gorm.Model
+	AmountPaid int
+}
+
+func (v20Payment) TableName() string {
+	return "payments"
+}
+
+type v20User struct {
+	ID string `gorm:"primaryKey;size:32"`
+}
+
+func (v20User) TableName() string {
+	return "users"
+}
+
+type v20PaymentTransaction struct {
+	gorm.Model
+	PaymentID    uint       `gorm:"not null;index"`
+	Payment      v20Payment `gorm:"foreignKey:PaymentID;references:ID;constraint:OnDelete:CASCADE"`
+	Amount       int        `gorm:"not null"`
+	Method       string     `gorm:"type:enum('cash', 'bank_transfer', 'other');not null"`
+	ReceivedByID *string    `gorm:"size:32"`
+	ReceivedBy   *v20User   `gorm:"foreignKey:ReceivedByID;references:ID"`
+	ReceivedAt   time.Time  `gorm:"not null"`
+	Memo         string
+}
+
+func (v20PaymentTransaction) TableName() string {
+	return "payment_transactions"
+}
+
+const paymentTransactionBatchSize = 1000
+
+func v20() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20",
+		Migrate: func(db *gorm.DB) error {
+			ctx := context.Background()
+
+			if err := db.Migrator().CreateTable(&v20PaymentTransaction{}); err != nil {
+				return err
+			}
+
+			// 既存の支払済み金額を1件の記録として移行する
+			payments, err := gorm.G[v20Payment](db).Where("amount_paid <> 0").Find(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			if len(payments) == 0 {
+				return nil
+			}
+
+			transactions := make([]v20PaymentTransaction, len(payments))
+
+			for i, p := range payments {
+				transactions[i] = v20PaymentTransaction{
+					PaymentID: p.ID,
+					Amount:    p.AmountPaid,
+					Method:    "other",
+					// いつ受け取ったかは分からないため、最後に更新された日時とする
+					ReceivedAt: p.UpdatedAt,
+					Memo:       "記録開始前の入金額",
+				}
+			}
+
+			return gorm.G[v20PaymentTransaction](db).CreateInBatches(
+				ctx,
+				&transactions,
+				paymentTransactionBatchSize,
+			)
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable(&v20PaymentTransaction{})
+		},
+	}
+}
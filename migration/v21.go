@@ -0,0 +1,95 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v21Camp struct {
+	gorm.Model
+	IsDraft                 bool
+	IsPaymentOpen           bool
+	IsRegistrationOpen      bool
+	Status                  string `gorm:"type:enum('draft', 'registration', 'confirmed', 'ongoing', 'finished', 'archived');not null;default:'draft'"`
+	RegistrationScheduledAt *time.Time
+	ConfirmedScheduledAt    *time.Time
+	OngoingScheduledAt      *time.Time
+	FinishedScheduledAt     *time.Time
+	ArchivedScheduledAt     *time.Time
+}
+
+func (v21Camp) TableName() string {
+	return "camps"
+}
+
+var (
+	v21FlagColumns   = []string{"is_draft", "is_payment_open", "is_registration_open"}
+	v21StatusColumns = []string{
+		"status",
+		"registration_scheduled_at",
+		"confirmed_scheduled_at",
+		"ongoing_scheduled_at",
+		"finished_scheduled_at",
+		"archived_scheduled_at",
+	}
+)
+
+func v21() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "21",
+		Migrate: func(db *gorm.DB) error {
+			for _, column := range v21StatusColumns {
+				if err := db.Migrator().AddColumn(&v21Camp{}, column); err != nil {
+					return err
+				}
+			}
+
+			// 下書きでも申し込み受付中でもない合宿は、日程から状態を決める。
+			// is_payment_openは申し込みの締め切り後に使われていたため、状態の決定には使わない
+			if err := db.Exec(
+				"UPDATE `camps` SET `status` = CASE" +
+					" WHEN `is_draft` THEN 'draft'" +
+					" WHEN `is_registration_open` THEN 'registration'" +
+					" WHEN `date_end` < NOW() THEN 'finished'" +
+					" WHEN `date_start` <= NOW() THEN 'ongoing'" +
+					" ELSE 'confirmed' END",
+			).Error; err != nil {
+				return err
+			}
+
+			for _, column := range v21FlagColumns {
+				if err := db.Migrator().DropColumn(&v21Camp{}, column); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(db *gorm.DB) error {
+			for _, column := range v21FlagColumns {
+				if err := db.Migrator().AddColumn(&v21Camp{}, column); err != nil {
+					return err
+				}
+			}
+
+			if err := db.Exec(
+				"UPDATE `camps` SET" +
+					" `is_draft` = `status` = 'draft'," +
+					" `is_registration_open` = `status` = 'registration'," +
+					" `is_payment_open` = `status` IN ('confirmed', 'ongoing')",
+			).Error; err != nil {
+				return err
+			}
+
+			for _, column := range v21StatusColumns {
+				if err := db.Migrator().DropColumn(&v21Camp{}, column); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v22Camp struct {
+	gorm.Model
+}
+
+func (v22Camp) TableName() string {
+	return "camps"
+}
+
+type v22User struct {
+	ID string `gorm:"primaryKey;size:32"`
+}
+
+func (v22User) TableName() string {
+	return "users"
+}
+
+type v22ScheduledChange struct {
+	gorm.Model
+	CampID        uint      `gorm:"not null;index"`
+	Camp          *v22Camp  `gorm:"foreignKey:CampID;references:ID;constraint:OnDelete:CASCADE"`
+	TargetType    string    `gorm:"type:enum('camp', 'question_group');not null"`
+	TargetID      uint      `gorm:"not null"`
+	Field         string    `gorm:"type:enum('name', 'status', 'description', 'due');not null"`
+	Value         string    `gorm:"type:text;not null"`
+	ScheduledAt   time.Time `gorm:"not null;index"`
+	CreatedByID   string    `gorm:"size:32;not null"`
+	CreatedBy     *v22User  `gorm:"foreignKey:CreatedByID;references:ID"`
+	ProcessedAt   *time.Time
+	FailureReason *string `gorm:"type:text"`
+}
+
+func (v22ScheduledChange) TableName() string {
+	return "scheduled_changes"
+}
+
+func v22() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "22",
+		Migrate: func(db *gorm.DB) error {
+			return db.Migrator().CreateTable(&v22ScheduledChange{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable(&v22ScheduledChange{})
+		},
+	}
+}
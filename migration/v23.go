@@ -0,0 +1,79 @@
+package migration
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v23Camp struct {
+	gorm.Model
+	Guidebook string
+}
+
+func (v23Camp) TableName() string {
+	return "camps"
+}
+
+type v23User struct {
+	ID string `gorm:"primaryKey;size:32"`
+}
+
+func (v23User) TableName() string {
+	return "users"
+}
+
+type v23GuidebookRevision struct {
+	gorm.Model
+	CampID      uint       `gorm:"not null;index"`
+	Camp        *v23Camp   `gorm:"foreignKey:CampID;references:ID;constraint:OnDelete:CASCADE"`
+	Content     string     `gorm:"not null"`
+	AuthorID    *string    `gorm:"size:32"`
+	Author      *v23User   `gorm:"foreignKey:AuthorID;references:ID"`
+	PublishAt   *time.Time `gorm:"index"`
+	PublishedAt *time.Time
+}
+
+func (v23GuidebookRevision) TableName() string {
+	return "guidebook_revisions"
+}
+
+func v23() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "23",
+		Migrate: func(db *gorm.DB) error {
+			ctx := context.Background()
+
+			if err := db.Migrator().CreateTable(&v23GuidebookRevision{}); err != nil {
+				return err
+			}
+
+			// 既存のしおりを公開済みの最初の版として移行する
+			camps, err := gorm.G[v23Camp](db).Where("guidebook <> ''").Find(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, camp := range camps {
+				// いつ公開したかは分からないため、最後に更新された日時とする
+				revision := v23GuidebookRevision{
+					CampID:      camp.ID,
+					Content:     camp.Guidebook,
+					PublishedAt: &camp.UpdatedAt,
+				}
+
+				if err := gorm.G[v23GuidebookRevision](db).Create(ctx, &revision); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable(&v23GuidebookRevision{})
+		},
+	}
+}
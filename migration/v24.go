@@ -0,0 +1,31 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v24CampParticipant struct {
+	CampID    uint   `gorm:"primaryKey"`
+	UserID    string `gorm:"primaryKey;size:32"`
+	CreatedAt *time.Time
+}
+
+func (v24CampParticipant) TableName() string {
+	return "camp_participants"
+}
+
+func v24() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "24",
+		Migrate: func(db *gorm.DB) error {
+			// 既存の参加者の登録時刻は分からないためNULLのままにする
+			return db.Migrator().AddColumn(&v24CampParticipant{}, "created_at")
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropColumn(&v24CampParticipant{}, "created_at")
+		},
+	}
+}
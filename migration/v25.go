@@ -0,0 +1,58 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v25Option struct {
+	gorm.Model
+}
+
+func (v25Option) TableName() string {
+	return "options"
+}
+
+// v25OldAnswerRevisionRankedOption は選択肢の削除に合わせて履歴も削除していた制約
+type v25OldAnswerRevisionRankedOption struct {
+	AnswerRevisionID uint `gorm:"primaryKey"`
+	Position         int  `gorm:"primaryKey;autoIncrement:false"`
+	OptionID         uint
+	Option           *v25Option `gorm:"foreignKey:OptionID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (v25OldAnswerRevisionRankedOption) TableName() string {
+	return "answer_revision_ranked_options"
+}
+
+// v25AnswerRevisionRankedOption は履歴から参照されている選択肢を削除できなくする制約
+type v25AnswerRevisionRankedOption struct {
+	AnswerRevisionID uint `gorm:"primaryKey"`
+	Position         int  `gorm:"primaryKey;autoIncrement:false"`
+	OptionID         uint
+	Option           *v25Option `gorm:"foreignKey:OptionID;references:ID;constraint:OnDelete:RESTRICT"`
+}
+
+func (v25AnswerRevisionRankedOption) TableName() string {
+	return "answer_revision_ranked_options"
+}
+
+func v25() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "25",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().DropConstraint(&v25OldAnswerRevisionRankedOption{}, "Option"); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateConstraint(&v25AnswerRevisionRankedOption{}, "Option")
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropConstraint(&v25AnswerRevisionRankedOption{}, "Option"); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateConstraint(&v25OldAnswerRevisionRankedOption{}, "Option")
+		},
+	}
+}
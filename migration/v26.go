@@ -0,0 +1,28 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v26RollCallReaction struct {
+	gorm.Model
+	FromTraqStamp bool `gorm:"not null;default:false"`
+}
+
+func (v26RollCallReaction) TableName() string {
+	return "roll_call_reactions"
+}
+
+func v26() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "26",
+		Migrate: func(db *gorm.DB) error {
+			// 既存のリアクションはどこで回答されたか分からないため、スタンプ以外として扱う
+			return db.Migrator().AddColumn(&v26RollCallReaction{}, "from_traq_stamp")
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropColumn(&v26RollCallReaction{}, "from_traq_stamp")
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v8Event struct {
+	gorm.Model
+	Capacity *int
+}
+
+func (v8Event) TableName() string {
+	return "events"
+}
+
+type v8EventAttendance struct {
+	gorm.Model
+	EventID      uint     `gorm:"not null;uniqueIndex:idx_event_attendances_event_user"`
+	Event        *v8Event `gorm:"foreignKey:EventID;references:ID;constraint:OnDelete:CASCADE"`
+	UserID       string   `gorm:"not null;size:32;uniqueIndex:idx_event_attendances_event_user"`
+	User         *v8User  `gorm:"foreignKey:UserID;references:ID"`
+	Status       string   `gorm:"type:enum('going', 'maybe', 'not_going');not null"`
+	IsWaitlisted bool     `gorm:"not null;default:false"`
+}
+
+func (v8EventAttendance) TableName() string {
+	return "event_attendances"
+}
+
+type v8User struct {
+	ID string `gorm:"primaryKey;size:32"`
+}
+
+func (v8User) TableName() string {
+	return "users"
+}
+
+func v8() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "8",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Migrator().AddColumn(&v8Event{}, "capacity"); err != nil {
+				return err
+			}
+
+			return db.Migrator().CreateTable(&v8EventAttendance{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			if err := db.Migrator().DropTable(&v8EventAttendance{}); err != nil {
+				return err
+			}
+
+			return db.Migrator().DropColumn(&v8Event{}, "capacity")
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v9EventReminder struct {
+	gorm.Model
+	EventID       uint      `gorm:"not null;index"`
+	Event         *v9Event  `gorm:"foreignKey:EventID;references:ID;constraint:OnDelete:CASCADE"`
+	MinutesBefore int       `gorm:"not null"`
+	SendAt        time.Time `gorm:"not null;index"`
+	SentAt        *time.Time
+}
+
+func (v9EventReminder) TableName() string {
+	return "event_reminders"
+}
+
+type v9Event struct {
+	gorm.Model
+}
+
+func (v9Event) TableName() string {
+	return "events"
+}
+
+func v9() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "9",
+		Migrate: func(db *gorm.DB) error {
+			return db.Migrator().CreateTable(&v9EventReminder{})
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Migrator().DropTable(&v9EventReminder{})
+		},
+	}
+}
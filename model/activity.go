@@ -5,12 +5,14 @@ import "gorm.io/gorm"
 type ActivityType string
 
 const (
-	ActivityTypeRoomCreated          ActivityType = "room_created"
-	ActivityTypePaymentCreated       ActivityType = "payment_created"
-	ActivityTypePaymentAmountChanged ActivityType = "payment_amount_changed"
-	ActivityTypePaymentPaidChanged   ActivityType = "payment_paid_changed"
-	ActivityTypeRollCallCreated      ActivityType = "roll_call_created"
-	ActivityTypeQuestionCreated      ActivityType = "question_created"
+	ActivityTypeRoomCreated            ActivityType = "room_created"
+	ActivityTypePaymentCreated         ActivityType = "payment_created"
+	ActivityTypePaymentAmountChanged   ActivityType = "payment_amount_changed"
+	ActivityTypePaymentPaidChanged     ActivityType = "payment_paid_changed"
+	ActivityTypeRollCallCreated        ActivityType = "roll_call_created"
+	ActivityTypeQuestionCreated        ActivityType = "question_created"
+	ActivityTypeScheduledChangeApplied ActivityType = "scheduled_change_applied"
+	ActivityTypeGuidebookPublished     ActivityType = "guidebook_published"
 )
 
 type Activity struct {
@@ -20,6 +22,6 @@ type Activity struct {
 	Camp        *Camp        `gorm:"foreignKey:CampID;references:ID;constraint:OnDelete:CASCADE"`
 	UserID      *string      `gorm:"size:32"` // payment_* のみ使用
 	User        *User        `gorm:"foreignKey:UserID;references:ID"`
-	ReferenceID uint         `gorm:"not null"` // RoomID / PaymentID / RollCallID / QuestionGroupID
+	ReferenceID uint         `gorm:"not null"` // RoomID / PaymentID / RollCallID / QuestionGroupID / ScheduledChangeID / GuidebookRevisionID
 	Amount      *int         // payment_* のみ使用
 }
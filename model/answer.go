@@ -1,13 +1,33 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Answer struct {
 	gorm.Model
 	QuestionID        uint         `gorm:"uniqueIndex:idx_question_id_user_id"`
 	UserID            string       `gorm:"uniqueIndex:idx_question_id_user_id"`
-	Type              QuestionType `gorm:"type:enum('free_text', 'free_number', 'single', 'multiple')"`
+	Type              QuestionType `gorm:"type:enum('free_text', 'free_number', 'single', 'multiple', 'date', 'datetime', 'scale', 'ranking', 'file')"`
 	FreeTextContent   *string
 	FreeNumberContent *float64
-	SelectedOptions   []Option `gorm:"many2many:answer_options;ForeignKey:id;References:id"`
+	// Typeがdateの場合は日付のみを使用する
+	DateContent     *time.Time
+	ScaleContent    *int
+	FileImageID     *uint
+	FileImage       *Image   `gorm:"foreignKey:FileImageID;references:ID;constraint:OnDelete:RESTRICT"`
+	SelectedOptions []Option `gorm:"many2many:answer_options;ForeignKey:id;References:id"`
+	// 順位付けの質問への回答で、順位の高い順に並ぶ
+	RankedOptions []AnswerRankedOption `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// AnswerRankedOption は順位付けの質問への回答で、選択肢に付けられた順位
+type AnswerRankedOption struct {
+	AnswerID uint `gorm:"primaryKey"`
+	// 1から始まる順位
+	Position int `gorm:"primaryKey;autoIncrement:false"`
+	OptionID uint
+	Option   Option `gorm:"constraint:OnDelete:CASCADE"`
 }
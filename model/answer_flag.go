@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// AnswerFlag は選択した選択肢が質問から取り除かれ、見直しが必要になった回答を表す
+// 回答が更新されるか、選択肢が戻されると削除される
+type AnswerFlag struct {
+	ID        uint   `gorm:"primaryKey"`
+	AnswerID  uint   `gorm:"not null;uniqueIndex:idx_answer_flags_answer_id_option_id"`
+	Answer    Answer `gorm:"constraint:OnDelete:CASCADE"`
+	OptionID  uint   `gorm:"not null;uniqueIndex:idx_answer_flags_answer_id_option_id"`
+	Option    Option `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
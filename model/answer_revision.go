@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AnswerRevision は回答が作成・更新されるたびに保存される回答の内容
+type AnswerRevision struct {
+	gorm.Model
+	AnswerID          uint         `gorm:"not null;index"`
+	Answer            *Answer      `gorm:"foreignKey:AnswerID;references:ID;constraint:OnDelete:CASCADE"`
+	QuestionID        uint         `gorm:"not null;index:idx_answer_revisions_question_id_user_id"`
+	UserID            string       `gorm:"not null;size:32;index:idx_answer_revisions_question_id_user_id"`
+	Type              QuestionType `gorm:"type:enum('free_text', 'free_number', 'single', 'multiple', 'date', 'datetime', 'scale', 'ranking', 'file')"`
+	FreeTextContent   *string
+	FreeNumberContent *float64
+	DateContent       *time.Time
+	ScaleContent      *int
+	// 画像が削除されても履歴は残すため、外部キー制約は付けない
+	FileImageID     *uint
+	SelectedOptions []Option                     `gorm:"many2many:answer_revision_options"`
+	RankedOptions   []AnswerRevisionRankedOption `gorm:"constraint:OnDelete:CASCADE"`
+	EditorID        string                       `gorm:"not null;size:32"`
+	Editor          *User                        `gorm:"foreignKey:EditorID;references:ID;constraint:OnDelete:RESTRICT"`
+}
+
+// AnswerRevisionRankedOption はリビジョンに保存された、選択肢に付けられた順位
+type AnswerRevisionRankedOption struct {
+	AnswerRevisionID uint `gorm:"primaryKey"`
+	Position         int  `gorm:"primaryKey;autoIncrement:false"`
+	OptionID         uint
+	// 選択肢が削除されても履歴が消えないよう、参照されている選択肢は削除できなくする
+	Option Option `gorm:"constraint:OnDelete:RESTRICT"`
+}
+
+// NewAnswerRevision は回答の現在の内容からリビジョンを作成する
+func NewAnswerRevision(answer Answer, editorID string) AnswerRevision {
+	rankedOptions := make([]AnswerRevisionRankedOption, len(answer.RankedOptions))
+
+	for i, rankedOption := range answer.RankedOptions {
+		rankedOptions[i] = AnswerRevisionRankedOption{
+			Position: rankedOption.Position,
+			OptionID: rankedOption.OptionID,
+			Option:   rankedOption.Option,
+		}
+	}
+
+	return AnswerRevision{
+		AnswerID:          answer.ID,
+		QuestionID:        answer.QuestionID,
+		UserID:            answer.UserID,
+		Type:              answer.Type,
+		FreeTextContent:   answer.FreeTextContent,
+		FreeNumberContent: answer.FreeNumberContent,
+		DateContent:       answer.DateContent,
+		ScaleContent:      answer.ScaleContent,
+		FileImageID:       answer.FileImageID,
+		SelectedOptions:   answer.SelectedOptions,
+		RankedOptions:     rankedOptions,
+		EditorID:          editorID,
+	}
+}
+
+// AsAnswer はリビジョンの内容を回答として返す
+func (r AnswerRevision) AsAnswer() Answer {
+	rankedOptions := make([]AnswerRankedOption, len(r.RankedOptions))
+
+	for i, rankedOption := range r.RankedOptions {
+		rankedOptions[i] = AnswerRankedOption{
+			AnswerID: r.AnswerID,
+			Position: rankedOption.Position,
+			OptionID: rankedOption.OptionID,
+			Option:   rankedOption.Option,
+		}
+	}
+
+	return Answer{
+		Model:             gorm.Model{ID: r.AnswerID},
+		QuestionID:        r.QuestionID,
+		UserID:            r.UserID,
+		Type:              r.Type,
+		FreeTextContent:   r.FreeTextContent,
+		FreeNumberContent: r.FreeNumberContent,
+		DateContent:       r.DateContent,
+		ScaleContent:      r.ScaleContent,
+		FileImageID:       r.FileImageID,
+		SelectedOptions:   r.SelectedOptions,
+		RankedOptions:     rankedOptions,
+	}
+}
@@ -1,21 +1,34 @@
 package model
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+var (
+	ErrInvalidCampStatusTransition = errors.New("invalid camp status transition")
+	ErrInvalidCampStatusSchedule   = errors.New("invalid camp status schedule")
+)
+
 type Camp struct {
 	gorm.Model
-	DisplayID          string `gorm:"uniqueIndex"`
-	Name               string
-	Guidebook          string
-	IsDraft            bool
-	IsPaymentOpen      bool
-	IsRegistrationOpen bool
-	DateStart          time.Time
-	DateEnd            time.Time
+	DisplayID string `gorm:"uniqueIndex"`
+	Name      string
+	Guidebook string
+	Status    CampStatus `gorm:"type:enum('draft', 'registration', 'confirmed', 'ongoing', 'finished', 'archived');not null;default:'draft'"`
+	DateStart time.Time
+	DateEnd   time.Time
+
+	// 各状態に自動で移行する予定時刻。nilの場合は手動で移行する
+	RegistrationScheduledAt *time.Time
+	ConfirmedScheduledAt    *time.Time
+	OngoingScheduledAt      *time.Time
+	FinishedScheduledAt     *time.Time
+	ArchivedScheduledAt     *time.Time
 
 	Participants   []User `gorm:"many2many:camp_participants;"`
 	Payments       []Payment
@@ -25,3 +38,167 @@ type Camp struct {
 	RoomGroups     []RoomGroup
 	Images         []Image
 }
+
+// CampStatus は合宿の状態。draft → registration → confirmed → ongoing → finished → archived の順に進む
+type CampStatus string
+
+const (
+	CampStatusDraft        CampStatus = "draft"
+	CampStatusRegistration CampStatus = "registration"
+	CampStatusConfirmed    CampStatus = "confirmed"
+	CampStatusOngoing      CampStatus = "ongoing"
+	CampStatusFinished     CampStatus = "finished"
+	CampStatusArchived     CampStatus = "archived"
+)
+
+// campStatusOrder は合宿の状態が進む順番
+var campStatusOrder = []CampStatus{
+	CampStatusDraft,
+	CampStatusRegistration,
+	CampStatusConfirmed,
+	CampStatusOngoing,
+	CampStatusFinished,
+	CampStatusArchived,
+}
+
+// campStatusRollbacks は前の状態に戻せる状態。参加者の確定後に申し込みを再開する場合などに使う
+var campStatusRollbacks = map[CampStatus]CampStatus{
+	CampStatusRegistration: CampStatusDraft,
+	CampStatusConfirmed:    CampStatusRegistration,
+}
+
+// next は次の状態を返す。最後の状態の場合はfalseを返す
+func (s CampStatus) next() (CampStatus, bool) {
+	i := slices.Index(campStatusOrder, s)
+
+	if i < 0 || i == len(campStatusOrder)-1 {
+		return "", false
+	}
+
+	return campStatusOrder[i+1], true
+}
+
+// CanTransitionTo は状態をnextに移行できるかを返す。
+// 次の状態に進めるか、一部の状態から1つ前の状態に戻すことだけができる
+func (s CampStatus) CanTransitionTo(next CampStatus) bool {
+	if n, ok := s.next(); ok && n == next {
+		return true
+	}
+
+	if prev, ok := campStatusRollbacks[s]; ok && prev == next {
+		return true
+	}
+
+	return false
+}
+
+// AcceptsRegistration は参加の申し込みと取り消しを受け付けているかを返す
+func (c *Camp) AcceptsRegistration() bool {
+	return c.Status == CampStatusRegistration
+}
+
+// AcceptsAnswers は参加者が質問に回答できるかを返す
+func (c *Camp) AcceptsAnswers() bool {
+	switch c.Status {
+	case CampStatusRegistration, CampStatusConfirmed, CampStatusOngoing:
+		return true
+	default:
+		return false
+	}
+}
+
+// AcceptsPayments は支払い情報の作成や入出金の記録ができるかを返す。
+// 合宿の終了後も集金が続くことがあるため、アーカイブされるまでは受け付ける
+func (c *Camp) AcceptsPayments() bool {
+	switch c.Status {
+	case CampStatusRegistration, CampStatusConfirmed, CampStatusOngoing, CampStatusFinished:
+		return true
+	default:
+		return false
+	}
+}
+
+// scheduledAt は状態statusに移行する予定時刻を保持するフィールドを返す
+func (c *Camp) scheduledAt(status CampStatus) **time.Time {
+	switch status {
+	case CampStatusRegistration:
+		return &c.RegistrationScheduledAt
+	case CampStatusConfirmed:
+		return &c.ConfirmedScheduledAt
+	case CampStatusOngoing:
+		return &c.OngoingScheduledAt
+	case CampStatusFinished:
+		return &c.FinishedScheduledAt
+	case CampStatusArchived:
+		return &c.ArchivedScheduledAt
+	default:
+		return nil
+	}
+}
+
+// ValidateSchedule は予定時刻が状態の進む順に並んでいるかを検証する
+func (c *Camp) ValidateSchedule() error {
+	var (
+		prev       *time.Time
+		prevStatus CampStatus
+	)
+
+	for _, status := range campStatusOrder {
+		field := c.scheduledAt(status)
+
+		if field == nil || *field == nil {
+			continue
+		}
+
+		if prev != nil && !(*field).After(*prev) {
+			return fmt.Errorf(
+				"%w: %s must be scheduled after %s",
+				ErrInvalidCampStatusSchedule,
+				status,
+				prevStatus,
+			)
+		}
+
+		prev = *field
+		prevStatus = status
+	}
+
+	return nil
+}
+
+// TransitionTo は状態をnextに移行する。
+// 前の状態に戻す場合は、すぐに自動で進んでしまわないように過ぎている予定時刻を取り消す
+func (c *Camp) TransitionTo(next CampStatus, now time.Time) error {
+	if !c.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidCampStatusTransition, c.Status, next)
+	}
+
+	if slices.Index(campStatusOrder, next) < slices.Index(campStatusOrder, c.Status) {
+		for status, ok := next.next(); ok; status, ok = status.next() {
+			if field := c.scheduledAt(status); *field != nil && !(*field).After(now) {
+				*field = nil
+			}
+		}
+	}
+
+	c.Status = next
+
+	return nil
+}
+
+// DueStatus は予定時刻を過ぎた状態のうち、現在の状態から順に進めて到達できる最後の状態を返す
+func (c *Camp) DueStatus(now time.Time) CampStatus {
+	status := c.Status
+
+	for next, ok := status.next(); ok; next, ok = next.next() {
+		field := c.scheduledAt(next)
+
+		if *field == nil || (*field).After(now) {
+			break
+		}
+
+		status = next
+	}
+
+	return status
+}
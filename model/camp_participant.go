@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// CampParticipant はCampのParticipantsの中間テーブル。参加登録した時刻を記録するために使う
+type CampParticipant struct {
+	CampID uint   `gorm:"primaryKey"`
+	UserID string `gorm:"primaryKey;size:32"`
+	// 参加登録した時刻。記録を始める前に登録した参加者ではnil
+	CreatedAt *time.Time
+}
@@ -24,6 +24,8 @@ type Event struct {
 	TimeEnd      *time.Time
 	OrganizerID  *string
 	DisplayColor *string
+	Capacity     *int // nilの場合は定員なし
 
-	CampID uint
+	CampID      uint
+	Attendances []EventAttendance
 }
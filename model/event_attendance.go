@@ -0,0 +1,21 @@
+package model
+
+import "gorm.io/gorm"
+
+type EventAttendanceStatus string
+
+const (
+	EventAttendanceStatusGoing    EventAttendanceStatus = "going"
+	EventAttendanceStatusMaybe    EventAttendanceStatus = "maybe"
+	EventAttendanceStatusNotGoing EventAttendanceStatus = "not_going"
+)
+
+type EventAttendance struct {
+	gorm.Model
+	EventID      uint                  `gorm:"not null;uniqueIndex:idx_event_attendances_event_user"`
+	Event        *Event                `gorm:"foreignKey:EventID;references:ID;constraint:OnDelete:CASCADE"`
+	UserID       string                `gorm:"not null;size:32;uniqueIndex:idx_event_attendances_event_user"`
+	User         *User                 `gorm:"foreignKey:UserID;references:ID"`
+	Status       EventAttendanceStatus `gorm:"type:enum('going', 'maybe', 'not_going');not null"`
+	IsWaitlisted bool                  `gorm:"not null;default:false"` // 定員を超えてgoingにした場合true
+}
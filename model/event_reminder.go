@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EventReminder struct {
+	gorm.Model
+	EventID       uint       `gorm:"not null;index"`
+	Event         *Event     `gorm:"foreignKey:EventID;references:ID;constraint:OnDelete:CASCADE"`
+	MinutesBefore int        `gorm:"not null"`
+	SendAt        time.Time  `gorm:"not null;index"` // イベントのTimeStartのMinutesBefore分前
+	SentAt        *time.Time // 送信時刻。nilの場合は未送信
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+// FeeRule は参加者の支払い金額の明細を作るための料金ルール
+type FeeRule struct {
+	gorm.Model
+	CampID      uint                    `gorm:"not null;index"`
+	Category    PaymentLineItemCategory `gorm:"type:enum('lodging', 'meal', 'bus', 'late_registration', 'discount', 'other');not null"`
+	Description string
+	Amount      int
+	// 指定した場合、QuestionIDの質問でOptionIDの選択肢を選んだ参加者にだけ適用する
+	// 質問は単一選択か複数選択に限る
+	// 指定しない場合は全ての参加者に適用する
+	QuestionID *uint
+	OptionID   *uint
+}
+
+// AppliesTo は参加者の回答に対して料金ルールが適用されるかを返す
+func (r *FeeRule) AppliesTo(answers []Answer) bool {
+	if r.QuestionID == nil || r.OptionID == nil {
+		return true
+	}
+
+	for _, answer := range answers {
+		if answer.QuestionID != *r.QuestionID {
+			continue
+		}
+
+		if slices.ContainsFunc(answer.SelectedOptions, func(option Option) bool {
+			return option.ID == *r.OptionID
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LineItem は料金ルールから明細を作る
+func (r *FeeRule) LineItem() PaymentLineItem {
+	return PaymentLineItem{
+		Category:    r.Category,
+		Description: r.Description,
+		Amount:      r.Amount,
+		FeeRuleID:   &r.ID,
+	}
+}
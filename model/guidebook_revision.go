@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GuidebookRevision は合宿のしおりの版。公開するとCampのGuidebookが版の内容で置き換わる
+type GuidebookRevision struct {
+	gorm.Model
+	CampID  uint   `gorm:"not null;index"`
+	Camp    *Camp  `gorm:"foreignKey:CampID;references:ID;constraint:OnDelete:CASCADE"`
+	Content string `gorm:"not null"`
+	// 版を作成したスタッフ。版の記録を始める前のしおりから作られた版ではnil
+	AuthorID *string `gorm:"size:32"`
+	Author   *User   `gorm:"foreignKey:AuthorID;references:ID"`
+	// 公開する予定時刻。nilの場合は予約していない
+	PublishAt *time.Time `gorm:"index"`
+	// 公開した時刻。nilの場合は未公開
+	PublishedAt *time.Time
+}
+
+// IsPublished は版が公開されたことがあるかを返す
+func (r *GuidebookRevision) IsPublished() bool {
+	return r.PublishedAt != nil
+}
@@ -1,6 +1,9 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrAlreadyExists = errors.New("already exists")
@@ -8,17 +11,29 @@ var (
 	ErrNotFound      = errors.New("not found")
 )
 
+// JST は日本時間。合宿の日付や通知に表示する時刻は日本時間として扱う
+var JST = time.FixedZone("Asia/Tokyo", 9*60*60)
+
 // 全モデルを書いておく
 func GetAllModels() []any {
 	return []any{
 		&Camp{},
 		&Event{},
+		&EventAttendance{},
+		&EventReminder{},
 		&User{},
 		&Payment{},
+		&PaymentLineItem{},
+		&PaymentTransaction{},
+		&FeeRule{},
 		&QuestionGroup{},
 		&Question{},
 		&Option{},
 		&Answer{},
+		&AnswerRankedOption{},
+		&AnswerFlag{},
+		&AnswerRevision{},
+		&AnswerRevisionRankedOption{},
 		&Room{},
 		&RoomGroup{},
 		&RoomStatus{},
@@ -28,5 +43,8 @@ func GetAllModels() []any {
 		&RollCall{},
 		&RollCallReaction{},
 		&Activity{},
+		&ScheduledChange{},
+		&GuidebookRevision{},
+		&PubSubMessage{},
 	}
 }
@@ -1,9 +1,17 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Option struct {
 	gorm.Model
 	QuestionID uint
 	Content    string
+	// 質問内での表示順。小さいほど先に表示する
+	SortOrder int `gorm:"not null;default:0"`
+	// 質問から取り除かれた日時。過去の回答から参照できるよう行は残す
+	RemovedAt *time.Time
 }
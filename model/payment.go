@@ -1,12 +1,132 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Payment struct {
 	gorm.Model
-	Amount     int
+	// 明細がある場合は明細の合計金額になる
+	Amount int
+	// 入出金の記録がある場合は記録の合計金額になる
 	AmountPaid int
 	UserID     string
 
-	CampID uint
+	CampID       uint
+	LineItems    []PaymentLineItem    `gorm:"constraint:OnDelete:CASCADE"`
+	Transactions []PaymentTransaction `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// LineItemsTotal は明細の合計金額を返す
+func (p *Payment) LineItemsTotal() int {
+	total := 0
+
+	for _, lineItem := range p.LineItems {
+		total += lineItem.Amount
+	}
+
+	return total
+}
+
+// paymentReferenceCodePrefix は照合用のコードの接頭辞
+const paymentReferenceCodePrefix = "RQ"
+
+var paymentReferenceCodePattern = regexp.MustCompile(paymentReferenceCodePrefix + `\s*([0-9]{5,})`)
+
+// ReferenceCode は銀行振込の依頼人名に含めてもらう、支払いごとの照合用のコードを返す
+func (p *Payment) ReferenceCode() string {
+	return fmt.Sprintf("%s%05d", paymentReferenceCodePrefix, p.ID)
+}
+
+// ParsePaymentReferenceCode は文字列に含まれる照合用のコードから支払いのIDを取り出す
+// 振込の依頼人名は大文字で記録されることが多いため、英字の大文字小文字は区別しない
+func ParsePaymentReferenceCode(s string) (uint, bool) {
+	match := paymentReferenceCodePattern.FindStringSubmatch(strings.ToUpper(s))
+
+	if match == nil {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(match[1], 10, 0)
+
+	if err != nil || id == 0 {
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+type PaymentLineItemCategory string
+
+const (
+	PaymentLineItemCategoryLodging          PaymentLineItemCategory = "lodging"
+	PaymentLineItemCategoryMeal             PaymentLineItemCategory = "meal"
+	PaymentLineItemCategoryBus              PaymentLineItemCategory = "bus"
+	PaymentLineItemCategoryLateRegistration PaymentLineItemCategory = "late_registration"
+	PaymentLineItemCategoryDiscount         PaymentLineItemCategory = "discount"
+	PaymentLineItemCategoryOther            PaymentLineItemCategory = "other"
+)
+
+// IsValidAmount は金額の符号が種類に合っているかを返す
+// 割引は0以下、割引とその他以外は0以上でなければならない
+func (c PaymentLineItemCategory) IsValidAmount(amount int) bool {
+	switch c {
+	case PaymentLineItemCategoryDiscount:
+		return amount <= 0
+	case PaymentLineItemCategoryOther:
+		return true
+	default:
+		return amount >= 0
+	}
+}
+
+// PaymentLineItem は支払い金額の明細
+type PaymentLineItem struct {
+	ID          uint                    `gorm:"primaryKey"`
+	PaymentID   uint                    `gorm:"not null;index"`
+	Category    PaymentLineItemCategory `gorm:"type:enum('lodging', 'meal', 'bus', 'late_registration', 'discount', 'other');not null"`
+	Description string
+	Amount      int
+	// 料金ルールから作られた明細の場合はそのルールのID。料金ルールを適用し直すと置き換えられる
+	FeeRuleID *uint
+}
+
+type PaymentMethod string
+
+const (
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodOther        PaymentMethod = "other"
+)
+
+// PaymentTransaction は入金や返金の記録
+type PaymentTransaction struct {
+	gorm.Model
+	PaymentID uint `gorm:"not null;index"`
+	// 返金の場合は負の値
+	Amount int           `gorm:"not null"`
+	Method PaymentMethod `gorm:"type:enum('cash', 'bank_transfer', 'other');not null"`
+	// 受け取った、または返金したスタッフ。記録を始める前の入金額から作られた記録ではnil
+	ReceivedByID *string   `gorm:"size:32"`
+	ReceivedBy   *User     `gorm:"foreignKey:ReceivedByID;references:ID"`
+	ReceivedAt   time.Time `gorm:"not null"`
+	Memo         string
+}
+
+// IsBankTransferOn は日本時間でdateと同じ日に受け取ったamount円の銀行振込の記録かを返す
+func (t *PaymentTransaction) IsBankTransferOn(amount int, date time.Time) bool {
+	if t.Method != PaymentMethodBankTransfer || t.Amount != amount {
+		return false
+	}
+
+	ty, tm, td := t.ReceivedAt.In(JST).Date()
+	dy, dm, dd := date.In(JST).Date()
+
+	return ty == dy && tm == dm && td == dd
 }
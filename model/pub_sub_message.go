@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// PubSubMessage は複数のインスタンス間でストリームのイベントを共有するためのメッセージ。
+// 各インスタンスがポーリングして配信し、一定時間が経ったものは削除される
+type PubSubMessage struct {
+	ID        uint      `gorm:"primaryKey"`
+	Channel   string    `gorm:"size:64;not null"`
+	Payload   string    `gorm:"type:text;not null"` // JSONにエンコードしたメッセージ
+	CreatedAt time.Time `gorm:"not null;index"`
+}
@@ -9,18 +9,30 @@ const (
 	FreeNumberQuestion     QuestionType = "free_number"
 	SingleChoiceQuestion   QuestionType = "single"
 	MultipleChoiceQuestion QuestionType = "multiple"
+	DateQuestion           QuestionType = "date"
+	DateTimeQuestion       QuestionType = "datetime"
+	ScaleQuestion          QuestionType = "scale"
+	RankingQuestion        QuestionType = "ranking"
+	FileQuestion           QuestionType = "file"
 )
 
 type Question struct {
 	gorm.Model
-	Type            QuestionType `gorm:"type:enum('free_text', 'free_number', 'single', 'multiple')"`
+	Type            QuestionType `gorm:"type:enum('free_text', 'free_number', 'single', 'multiple', 'date', 'datetime', 'scale', 'ranking', 'file')"`
 	QuestionGroupID uint
 	Title           string
 	Description     *string
 	IsPublic        bool
 	IsOpen          bool
 	IsRequired      bool `gorm:"not null;default:false"`
-	Options         []Option
+	// 質問グループ内での表示順。小さいほど先に表示する
+	SortOrder int `gorm:"not null;default:0"`
+	// 以下はTypeがscaleの場合のみ使用する
+	ScaleMin      *int
+	ScaleMax      *int
+	ScaleMinLabel *string
+	ScaleMaxLabel *string
+	Options       []Option
 
 	Answers []Answer
 }
@@ -11,7 +11,23 @@ type QuestionGroup struct {
 	Name        string
 	Description *string
 	Due         time.Time
-	Questions   []Question
+	// 合宿内での表示順。小さいほど先に表示する
+	SortOrder int `gorm:"not null;default:0"`
+	Questions []Question
 
 	CampID uint
 }
+
+// AnswerDeadline は回答を締め切る時刻を返す
+// Dueは日付のみを表すため、日本時間でその日の終わりまで回答を受け付ける
+func (g *QuestionGroup) AnswerDeadline() time.Time {
+	y, m, d := g.Due.Date()
+
+	return time.Date(y, m, d+1, 0, 0, 0, 0, JST)
+}
+
+// IsAnswerLocked は締切を過ぎて質問への回答を変更できなくなっているかを返す
+// 締切後でも、QuestionのIsOpenがtrueであれば回答を受け付ける
+func (g *QuestionGroup) IsAnswerLocked(question *Question, now time.Time) bool {
+	return !now.Before(g.AnswerDeadline()) && !question.IsOpen
+}
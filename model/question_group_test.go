@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuestionGroup_IsAnswerLocked(t *testing.T) {
+	t.Parallel()
+
+	// openapi_types.Dateは日付をUTCの0時として扱う
+	group := QuestionGroup{Due: time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("締切日の日本時間の終わりまでは回答を受け付ける", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, group.IsAnswerLocked(&Question{}, time.Date(2026, 10, 20, 9, 0, 0, 0, JST)))
+		assert.False(
+			t,
+			group.IsAnswerLocked(&Question{}, time.Date(2026, 10, 20, 23, 59, 59, 0, JST)),
+		)
+	})
+
+	t.Run("締切日の翌日になると回答を締め切る", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, group.IsAnswerLocked(&Question{}, time.Date(2026, 10, 21, 0, 0, 0, 0, JST)))
+	})
+
+	t.Run("IsOpenの質問は締切後も回答を受け付ける", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(
+			t,
+			group.IsAnswerLocked(&Question{IsOpen: true}, time.Date(2026, 10, 21, 0, 0, 0, 0, JST)),
+		)
+	})
+}
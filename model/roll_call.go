@@ -1,15 +1,42 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type RollCall struct {
 	gorm.Model
 	Name        string
 	Description string
-	Options     []string `gorm:"serializer:json"`
-	Subjects    []User   `gorm:"many2many:roll_call_subjects;"`
+	Options     []string   `gorm:"serializer:json"`
+	Subjects    []User     `gorm:"many2many:roll_call_subjects;"`
+	Deadline    *time.Time // nilの場合は締め切りなし
+	ClosedAt    *time.Time // 手動で締め切られた時刻
+	// trueの場合、Subjectsに含まれるユーザーだけがOptionsのいずれかで1回だけリアクションできる
+	RestrictResponses bool       `gorm:"not null;default:false"`
+	LastNudgedAt      *time.Time // 未回答者に最後に再通知した時刻
+	TraqMessageID     *string    `gorm:"size:36;uniqueIndex"` // traQのチャンネルに投稿したメッセージのUUID
 
 	Reactions []RollCallReaction
 
 	CampID uint `gorm:"not null"`
 }
+
+// IsOpen は点呼がリアクションを受け付けているかを返す
+func (r *RollCall) IsOpen(now time.Time) bool {
+	if r.ClosedAt != nil {
+		return false
+	}
+
+	return r.Deadline == nil || now.Before(*r.Deadline)
+}
+
+// IsSubject はユーザーが点呼の対象者かを返す
+func (r *RollCall) IsSubject(userID string) bool {
+	return slices.ContainsFunc(r.Subjects, func(subject User) bool {
+		return subject.ID == userID
+	})
+}
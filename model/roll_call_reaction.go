@@ -6,6 +6,8 @@ type RollCallReaction struct {
 	gorm.Model
 	Content string
 	UserID  string
+	// traQで点呼のメッセージに押したスタンプによる回答か。スタンプを外すとリアクションも削除する
+	FromTraqStamp bool `gorm:"not null;default:false"`
 
 	RollCallID uint `gorm:"index"`
 }
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrInvalidScheduledChange = errors.New("invalid scheduled change")
+
+// ScheduledChangeTargetType は予約した変更の対象の種類
+type ScheduledChangeTargetType string
+
+const (
+	ScheduledChangeTargetTypeCamp          ScheduledChangeTargetType = "camp"
+	ScheduledChangeTargetTypeQuestionGroup ScheduledChangeTargetType = "question_group"
+)
+
+// ScheduledChangeField は予約した変更で書き換える項目
+type ScheduledChangeField string
+
+const (
+	ScheduledChangeFieldName ScheduledChangeField = "name"
+	// 合宿の状態。状態の変更はCampの*ScheduledAtで予約するため、新しくは予約できない。
+	// 以前に予約された変更を表示するためだけに残している
+	ScheduledChangeFieldStatus      ScheduledChangeField = "status"
+	ScheduledChangeFieldDescription ScheduledChangeField = "description"
+	ScheduledChangeFieldDue         ScheduledChangeField = "due"
+)
+
+// scheduledChangeFields は対象の種類ごとに変更できる項目
+var scheduledChangeFields = map[ScheduledChangeTargetType][]ScheduledChangeField{
+	ScheduledChangeTargetTypeCamp: {
+		ScheduledChangeFieldName,
+	},
+	ScheduledChangeTargetTypeQuestionGroup: {
+		ScheduledChangeFieldName,
+		ScheduledChangeFieldDescription,
+		ScheduledChangeFieldDue,
+	},
+}
+
+// scheduledChangeDueLayout は質問グループの締切を変更するときの値の形式
+const scheduledChangeDueLayout = time.DateOnly
+
+// ScheduledChange はScheduledAtに対象のFieldをValueに書き換える予約
+type ScheduledChange struct {
+	gorm.Model
+	CampID      uint                      `gorm:"not null;index"`
+	Camp        *Camp                     `gorm:"foreignKey:CampID;references:ID;constraint:OnDelete:CASCADE"`
+	TargetType  ScheduledChangeTargetType `gorm:"type:enum('camp', 'question_group');not null"`
+	TargetID    uint                      `gorm:"not null"`
+	Field       ScheduledChangeField      `gorm:"type:enum('name', 'status', 'description', 'due');not null"`
+	Value       string                    `gorm:"type:text;not null"`
+	ScheduledAt time.Time                 `gorm:"not null;index"`
+	// 予約したスタッフ。適用したときにDMで知らせる
+	CreatedByID string `gorm:"size:32;not null"`
+	CreatedBy   *User  `gorm:"foreignKey:CreatedByID;references:ID"`
+	// 処理した時刻。nilの場合は未処理
+	ProcessedAt *time.Time
+	// 変更を適用できなかった場合の理由。nilの場合は適用済み
+	FailureReason *string `gorm:"type:text"`
+}
+
+// Validate は対象の種類に対して変更できる項目か、値が項目の形式に合っているかを検証する
+func (c *ScheduledChange) Validate() error {
+	fields, ok := scheduledChangeFields[c.TargetType]
+
+	if !ok {
+		return fmt.Errorf("%w: unknown target type %s", ErrInvalidScheduledChange, c.TargetType)
+	}
+
+	if !slices.Contains(fields, c.Field) {
+		return fmt.Errorf(
+			"%w: %s of %s cannot be scheduled",
+			ErrInvalidScheduledChange,
+			c.Field,
+			c.TargetType,
+		)
+	}
+
+	switch c.Field {
+	case ScheduledChangeFieldName:
+		if c.Value == "" {
+			return fmt.Errorf("%w: name must not be empty", ErrInvalidScheduledChange)
+		}
+	case ScheduledChangeFieldDue:
+		if _, err := time.Parse(scheduledChangeDueLayout, c.Value); err != nil {
+			return fmt.Errorf("%w: due must be a date like 2006-01-02", ErrInvalidScheduledChange)
+		}
+	}
+
+	return nil
+}
+
+// ApplyToCamp は合宿に変更を適用する
+func (c *ScheduledChange) ApplyToCamp(camp *Camp) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if c.TargetType != ScheduledChangeTargetTypeCamp {
+		return fmt.Errorf("%w: target is not a camp", ErrInvalidScheduledChange)
+	}
+
+	if c.Field == ScheduledChangeFieldName {
+		camp.Name = c.Value
+	}
+
+	return nil
+}
+
+// ApplyToQuestionGroup は質問グループに変更を適用する。説明を空にした場合は説明を削除する
+func (c *ScheduledChange) ApplyToQuestionGroup(questionGroup *QuestionGroup) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if c.TargetType != ScheduledChangeTargetTypeQuestionGroup {
+		return fmt.Errorf("%w: target is not a question group", ErrInvalidScheduledChange)
+	}
+
+	switch c.Field {
+	case ScheduledChangeFieldName:
+		questionGroup.Name = c.Value
+	case ScheduledChangeFieldDescription:
+		if c.Value == "" {
+			questionGroup.Description = nil
+		} else {
+			description := c.Value
+			questionGroup.Description = &description
+		}
+	case ScheduledChangeFieldDue:
+		due, err := time.Parse(scheduledChangeDueLayout, c.Value)
+
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidScheduledChange, err)
+		}
+
+		questionGroup.Due = due
+	}
+
+	return nil
+}
@@ -10,4 +10,14 @@ import (
 type ActivityRepository interface {
 	CreateActivity(ctx context.Context, activity *model.Activity) error
 	GetActivitiesByCampID(ctx context.Context, campID uint) ([]model.Activity, error)
+	// GetRecentActivities は合宿のアクティビティを新しい順にlimit件まで取得します。
+	// 他のユーザーの支払いのアクティビティは含みません。
+	// beforeIDを指定すると、そのIDより前のアクティビティを取得します
+	GetRecentActivities(
+		ctx context.Context,
+		campID uint,
+		userID string,
+		beforeID *uint,
+		limit int,
+	) ([]model.Activity, error)
 }
@@ -3,10 +3,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/traPtitech/rucQ/model"
 )
 
+var ErrAnswerRevisionNotFound = errors.New("answer revision not found")
+
 type GetAnswersQuery struct {
 	UserID                 *string
 	QuestionGroupID        *uint
@@ -15,10 +18,27 @@ type GetAnswersQuery struct {
 	IncludeNonParticipants bool
 }
 
+// AnswerRepository は回答を扱う。
+// 回答を作成・更新するメソッドは、editorIDを変更者として回答のリビジョンも保存する
 type AnswerRepository interface {
-	CreateAnswer(ctx context.Context, answer *model.Answer) error
-	CreateAnswers(ctx context.Context, answers *[]model.Answer) error
+	CreateAnswer(ctx context.Context, answer *model.Answer, editorID string) error
+	CreateAnswers(ctx context.Context, answers *[]model.Answer, editorID string) error
 	GetAnswerByID(ctx context.Context, id uint) (*model.Answer, error)
 	GetAnswers(ctx context.Context, query GetAnswersQuery) ([]model.Answer, error)
-	UpdateAnswer(ctx context.Context, answerID uint, answer *model.Answer) error
+	UpdateAnswer(
+		ctx context.Context,
+		answerID uint,
+		answer *model.Answer,
+		editorID string,
+	) error
+	// GetAnswerRevisions はユーザーのある質問への回答のリビジョンを古い順に取得する
+	GetAnswerRevisions(
+		ctx context.Context,
+		questionID uint,
+		userID string,
+	) ([]model.AnswerRevision, error)
+	GetAnswerRevisionByID(ctx context.Context, id uint) (*model.AnswerRevision, error)
+	// GetAnswerFlags は質問から取り除かれた選択肢を含むため見直しが必要な回答の一覧を取得する
+	// 質問が存在しない場合はErrQuestionNotFoundを返す
+	GetAnswerFlags(ctx context.Context, questionID uint) ([]model.AnswerFlag, error)
 }
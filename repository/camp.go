@@ -4,6 +4,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/traPtitech/rucQ/model"
 )
@@ -12,16 +13,37 @@ var (
 	ErrCampNotFound        = errors.New("camp not found")
 	ErrCampAlreadyExists   = errors.New("camp with this display ID already exists")
 	ErrParticipantNotFound = errors.New("participant not found")
+	ErrCampStatusChanged   = errors.New("camp status has been changed")
 )
 
+// CampRegistrationCount は1日に参加登録した人数
+type CampRegistrationCount struct {
+	// 登録した日。登録時刻の記録を始める前に登録した参加者の場合はnil
+	Date  *time.Time
+	Count int
+}
+
 type CampRepository interface {
 	CreateCamp(camp *model.Camp) error
 	GetCamps() ([]model.Camp, error)
 	GetCampByID(ctx context.Context, id uint) (*model.Camp, error)
+	// UpdateCamp は合宿の情報と状態の予定時刻を更新します。状態は更新しません
 	UpdateCamp(ctx context.Context, campID uint, camp *model.Camp) error
+	// UpdateCampStatus は合宿の状態がpreviousStatusのままの場合に、状態と予定時刻を更新します
+	// 合宿が存在しない場合はErrCampNotFoundを、状態が既に変更されていた場合はErrCampStatusChangedを返します
+	UpdateCampStatus(
+		ctx context.Context,
+		campID uint,
+		previousStatus model.CampStatus,
+		camp *model.Camp,
+	) error
+	// GetCampsWithDueSchedule はいずれかの予定時刻がnow以前で、アーカイブされていない合宿を取得します
+	GetCampsWithDueSchedule(ctx context.Context, now time.Time) ([]model.Camp, error)
 	DeleteCamp(ctx context.Context, campID uint) error
 	AddCampParticipant(ctx context.Context, campID uint, user *model.User) error
 	RemoveCampParticipant(ctx context.Context, campID uint, user *model.User) error
 	GetCampParticipants(ctx context.Context, campID uint) ([]model.User, error)
 	IsCampParticipant(ctx context.Context, campID uint, userID string) (bool, error)
+	// GetCampRegistrationCounts は合宿の参加登録の人数を登録した日ごとに古い順で集計します
+	GetCampRegistrationCounts(ctx context.Context, campID uint) ([]CampRegistrationCount, error)
 }
@@ -3,14 +3,20 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/traPtitech/rucQ/model"
 )
 
+var ErrEventNotFound = errors.New("event not found")
+
 type EventRepository interface {
 	GetEvents(ctx context.Context, campID uint) ([]model.Event, error)
 	GetEventByID(id uint) (*model.Event, error)
 	CreateEvent(event *model.Event) error
 	UpdateEvent(ctx context.Context, ID uint, event *model.Event) error
 	DeleteEvent(ID uint) error
+	// GetUserEvents は公式イベントと、ユーザーが主催するイベント、
+	// 参加または参加するかもしれないと回答したイベントを開始時刻の順に取得します
+	GetUserEvents(ctx context.Context, campID uint, userID string) ([]model.Event, error)
 }
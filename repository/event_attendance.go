@@ -0,0 +1,20 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var ErrEventAttendanceNotFound = errors.New("event attendance not found")
+
+type EventAttendanceRepository interface {
+	// GetEventAttendances は返答した順（更新日時の昇順）に出欠を返す。
+	// トランザクション内で呼んだ場合はイベントの行をロックし、同じイベントの出欠の変更を直列化する
+	GetEventAttendances(ctx context.Context, eventID uint) ([]model.EventAttendance, error)
+	// SaveEventAttendance はEventIDとUserIDが同じ出欠が既にあれば上書きする
+	SaveEventAttendance(ctx context.Context, attendance *model.EventAttendance) error
+	DeleteEventAttendance(ctx context.Context, eventID uint, userID string) error
+}
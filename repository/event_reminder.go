@@ -0,0 +1,24 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var ErrEventReminderNotFound = errors.New("event reminder not found")
+
+type EventReminderRepository interface {
+	CreateEventReminder(ctx context.Context, reminder *model.EventReminder) error
+	GetEventReminders(ctx context.Context, eventID uint) ([]model.EventReminder, error)
+	GetEventReminderByID(ctx context.Context, reminderID uint) (*model.EventReminder, error)
+	// UpdateEventReminder は送信予定時刻と送信時刻を更新します
+	UpdateEventReminder(ctx context.Context, reminderID uint, reminder *model.EventReminder) error
+	DeleteEventReminder(ctx context.Context, reminderID uint) error
+	// DeleteEventReminders はイベントに紐づくリマインダーをすべて削除します
+	DeleteEventReminders(ctx context.Context, eventID uint) error
+	// GetReadyToSendEventReminders は送信予定時刻を過ぎた未送信のリマインダーをEventとともに取得します
+	GetReadyToSendEventReminders(ctx context.Context) ([]model.EventReminder, error)
+}
@@ -0,0 +1,19 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var ErrFeeRuleNotFound = errors.New("fee rule not found")
+
+type FeeRuleRepository interface {
+	CreateFeeRule(ctx context.Context, feeRule *model.FeeRule) error
+	GetFeeRules(ctx context.Context, campID uint) ([]model.FeeRule, error)
+	GetFeeRuleByID(ctx context.Context, feeRuleID uint) (*model.FeeRule, error)
+	UpdateFeeRule(ctx context.Context, feeRuleID uint, feeRule *model.FeeRule) error
+	DeleteFeeRule(ctx context.Context, feeRuleID uint) error
+}
@@ -21,3 +21,25 @@ func (r *Repository) GetActivitiesByCampID(
 		Order("created_at DESC").
 		Find(ctx)
 }
+
+func (r *Repository) GetRecentActivities(
+	ctx context.Context,
+	campID uint,
+	userID string,
+	beforeID *uint,
+	limit int,
+) ([]model.Activity, error) {
+	query := gorm.G[model.Activity](r.db).
+		Where("camp_id = ?", campID).
+		Where("user_id IS NULL OR user_id = ?", userID)
+
+	if beforeID != nil {
+		query = query.Where("id < ?", *beforeID)
+	}
+
+	// ページングのため作成順と同じIDの順で並べる
+	return query.
+		Order("id DESC").
+		Limit(limit).
+		Find(ctx)
+}
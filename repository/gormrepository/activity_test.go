@@ -88,3 +88,71 @@ func TestGetActivitiesByCampID(t *testing.T) {
 		}
 	})
 }
+
+func TestGetRecentActivities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("新しい順にlimit件まで取得する", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		otherUser := mustCreateUser(t, r)
+		userID := user.ID
+		otherUserID := otherUser.ID
+
+		activity1 := model.Activity{
+			Type:        model.ActivityTypeRoomCreated,
+			CampID:      camp.ID,
+			ReferenceID: uint(random.PositiveInt(t)),
+		}
+		activity2 := model.Activity{
+			Type:        model.ActivityTypePaymentCreated,
+			CampID:      camp.ID,
+			UserID:      &userID,
+			ReferenceID: uint(random.PositiveInt(t)),
+		}
+		otherUserActivity := model.Activity{
+			Type:        model.ActivityTypePaymentCreated,
+			CampID:      camp.ID,
+			UserID:      &otherUserID,
+			ReferenceID: uint(random.PositiveInt(t)),
+		}
+		activity3 := model.Activity{
+			Type:        model.ActivityTypeRollCallCreated,
+			CampID:      camp.ID,
+			ReferenceID: uint(random.PositiveInt(t)),
+		}
+		otherCampActivity := model.Activity{
+			Type:        model.ActivityTypeQuestionCreated,
+			CampID:      otherCamp.ID,
+			ReferenceID: uint(random.PositiveInt(t)),
+		}
+
+		require.NoError(t, r.CreateActivity(t.Context(), &activity1))
+		require.NoError(t, r.CreateActivity(t.Context(), &activity2))
+		require.NoError(t, r.CreateActivity(t.Context(), &otherUserActivity))
+		require.NoError(t, r.CreateActivity(t.Context(), &activity3))
+		require.NoError(t, r.CreateActivity(t.Context(), &otherCampActivity))
+
+		activities, err := r.GetRecentActivities(t.Context(), camp.ID, userID, nil, 2)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, activities, 2) {
+			assert.Equal(t, activity3.ID, activities[0].ID)
+			assert.Equal(t, activity2.ID, activities[1].ID)
+		}
+
+		// 続きを取得する
+		activities, err = r.GetRecentActivities(t.Context(), camp.ID, userID, &activity2.ID, 2)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, activities, 1) {
+			assert.Equal(t, activity1.ID, activities[0].ID)
+		}
+	})
+}
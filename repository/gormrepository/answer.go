@@ -10,38 +10,54 @@ import (
 	"github.com/traPtitech/rucQ/repository"
 )
 
-func (r *Repository) CreateAnswer(ctx context.Context, answer *model.Answer) error {
-	if err := gorm.G[model.Answer](r.db).Create(ctx, answer); err != nil {
-		if errors.Is(err, gorm.ErrForeignKeyViolated) {
-			return model.ErrNotFound
-		}
+func (r *Repository) CreateAnswer(
+	ctx context.Context,
+	answer *model.Answer,
+	editorID string,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &Repository{db: tx}
+
+		if err := gorm.G[model.Answer](tx).Create(ctx, answer); err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return model.ErrNotFound
+			}
 
-		return err
-	}
+			return err
+		}
 
-	// 選択肢を反映するため再取得する
-	newAnswer, err := r.GetAnswerByID(ctx, answer.ID)
+		// 選択肢を反映するため再取得する
+		newAnswer, err := txRepo.GetAnswerByID(ctx, answer.ID)
 
-	if err != nil {
-		return err
-	}
+		if err != nil {
+			return err
+		}
 
-	*answer = *newAnswer
+		*answer = *newAnswer
 
-	return nil
+		return txRepo.createAnswerRevisions(ctx, editorID, *answer)
+	})
 }
 
-func (r *Repository) CreateAnswers(ctx context.Context, answers *[]model.Answer) error {
-	if err := gorm.G[[]model.Answer](r.db).Create(ctx, answers); err != nil {
-		return err
-	}
+func (r *Repository) CreateAnswers(
+	ctx context.Context,
+	answers *[]model.Answer,
+	editorID string,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := gorm.G[[]model.Answer](tx).Create(ctx, answers); err != nil {
+			return err
+		}
 
-	return nil
+		return (&Repository{db: tx}).createAnswerRevisions(ctx, editorID, *answers...)
+	})
 }
 
 func (r *Repository) GetAnswerByID(ctx context.Context, id uint) (*model.Answer, error) {
 	answer, err := gorm.G[model.Answer](r.db).
-		Preload("SelectedOptions", nil).
+		Preload("SelectedOptions", orderBySortOrder).
+		Preload("RankedOptions", orderByPosition).
+		Preload("RankedOptions.Option", nil).
 		Where("id = ?", id).
 		First(ctx)
 
@@ -152,7 +168,9 @@ func (r *Repository) GetAnswers(
 
 	answers, err := gorm.G[model.Answer](r.db).
 		Scopes(scopes...).
-		Preload("SelectedOptions", nil).
+		Preload("SelectedOptions", orderBySortOrder).
+		Preload("RankedOptions", orderByPosition).
+		Preload("RankedOptions.Option", nil).
 		Find(ctx)
 
 	if err != nil {
@@ -166,31 +184,126 @@ func (r *Repository) GetAnswers(
 	return answers, nil
 }
 
-func (r *Repository) UpdateAnswer(ctx context.Context, answerID uint, answer *model.Answer) error {
+func (r *Repository) UpdateAnswer(
+	ctx context.Context,
+	answerID uint,
+	answer *model.Answer,
+	editorID string,
+) error {
 	answer.ID = answerID
 
-	if _, err := gorm.G[*model.Answer](
-		r.db,
-	).Omit("SelectedOptions").
-		Where("id = ?", answerID).
-		Updates(ctx, answer); err != nil {
-		return err
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &Repository{db: tx}
+
+		if _, err := gorm.G[*model.Answer](
+			tx,
+		).Omit("SelectedOptions", "RankedOptions").
+			Where("id = ?", answerID).
+			Updates(ctx, answer); err != nil {
+			return err
+		}
+
+		if err := tx.WithContext(ctx).
+			Model(answer).
+			Association("SelectedOptions").
+			Replace(answer.SelectedOptions); err != nil {
+			return err
+		}
+
+		if err := txRepo.replaceRankedOptions(ctx, answerID, answer.RankedOptions); err != nil {
+			return err
+		}
+
+		// 回答し直されたため見直しの印を外す
+		if _, err := gorm.G[model.AnswerFlag](tx).
+			Where("answer_id = ?", answerID).
+			Delete(ctx); err != nil {
+			return err
+		}
+
+		// 更新後のデータを取得してanswerに反映
+		updatedAnswer, err := txRepo.GetAnswerByID(ctx, answerID)
+		if err != nil {
+			return err
+		}
+
+		*answer = *updatedAnswer
+
+		return txRepo.createAnswerRevisions(ctx, editorID, *answer)
+	})
+}
+
+func (r *Repository) GetAnswerFlags(
+	ctx context.Context,
+	questionID uint,
+) ([]model.AnswerFlag, error) {
+	if _, err := gorm.G[model.Question](r.db).
+		Where("id = ?", questionID).
+		First(ctx); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrQuestionNotFound
+		}
+
+		return nil, err
+	}
+
+	flags, err := gorm.G[model.AnswerFlag](r.db).
+		Where(
+			"answer_id IN (?)",
+			r.db.Model(&model.Answer{}).Select("id").Where("question_id = ?", questionID),
+		).
+		Preload("Answer", nil).
+		Preload("Answer.SelectedOptions", orderBySortOrder).
+		Preload("Answer.RankedOptions", orderByPosition).
+		Preload("Answer.RankedOptions.Option", nil).
+		Preload("Option", nil).
+		Order("created_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
 	}
 
-	if err := r.db.WithContext(ctx).
-		Model(answer).
-		Association("SelectedOptions").
-		Replace(answer.SelectedOptions); err != nil {
+	return flags, nil
+}
+
+// replaceRankedOptions は順位付けの回答の選択肢を置き換える
+func (r *Repository) replaceRankedOptions(
+	ctx context.Context,
+	answerID uint,
+	rankedOptions []model.AnswerRankedOption,
+) error {
+	if _, err := gorm.G[model.AnswerRankedOption](r.db).
+		Where("answer_id = ?", answerID).
+		Delete(ctx); err != nil {
 		return err
 	}
 
-	// 更新後のデータを取得してanswerに反映
-	updatedAnswer, err := r.GetAnswerByID(ctx, answerID)
-	if err != nil {
+	if len(rankedOptions) == 0 {
+		return nil
+	}
+
+	for i := range rankedOptions {
+		rankedOptions[i].AnswerID = answerID
+	}
+
+	if err := gorm.G[model.AnswerRankedOption](r.db).
+		Omit("Option").
+		CreateInBatches(ctx, &rankedOptions, len(rankedOptions)); err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return model.ErrNotFound
+		}
+
 		return err
 	}
 
-	*answer = *updatedAnswer
+	return nil
+}
+
+// orderByPosition は順位付けの回答の選択肢を順位順に読み込む
+func orderByPosition(db gorm.PreloadBuilder) error {
+	db.Order("position")
 
 	return nil
 }
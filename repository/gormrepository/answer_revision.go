@@ -0,0 +1,95 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// createAnswerRevisions は回答の現在の内容をリビジョンとして保存する
+func (r *Repository) createAnswerRevisions(
+	ctx context.Context,
+	editorID string,
+	answers ...model.Answer,
+) error {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	revisions := make([]model.AnswerRevision, len(answers))
+
+	for i, answer := range answers {
+		revisions[i] = model.NewAnswerRevision(answer, editorID)
+	}
+
+	if err := gorm.G[model.AnswerRevision](r.db).
+		CreateInBatches(ctx, &revisions, len(revisions)); err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return repository.ErrUserNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetAnswerRevisions(
+	ctx context.Context,
+	questionID uint,
+	userID string,
+) ([]model.AnswerRevision, error) {
+	revisions, err := gorm.G[model.AnswerRevision](r.db).
+		Preload("SelectedOptions", orderBySortOrder).
+		Preload("RankedOptions", orderByPosition).
+		Preload("RankedOptions.Option", nil).
+		Where("question_id = ? AND user_id = ?", questionID, userID).
+		Order("created_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// リビジョンが見つからなかった場合、質問が存在しない可能性を考慮して質問の存在確認を行う
+	if len(revisions) == 0 {
+		if _, err := gorm.G[model.Question](r.db).
+			Where("id = ?", questionID).
+			Take(ctx); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, repository.ErrQuestionNotFound
+			}
+
+			return nil, err
+		}
+	}
+
+	return revisions, nil
+}
+
+func (r *Repository) GetAnswerRevisionByID(
+	ctx context.Context,
+	id uint,
+) (*model.AnswerRevision, error) {
+	revision, err := gorm.G[model.AnswerRevision](r.db).
+		Preload("SelectedOptions", orderBySortOrder).
+		Preload("RankedOptions", orderByPosition).
+		Preload("RankedOptions.Option", nil).
+		Where("id = ?", id).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrAnswerRevisionNotFound
+		}
+
+		return nil, err
+	}
+
+	return &revision, nil
+}
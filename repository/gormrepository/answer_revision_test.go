@@ -0,0 +1,140 @@
+package gormrepository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestRepository_GetAnswerRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		staff := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(
+			t,
+			r,
+			questionGroup.ID,
+			model.MultipleChoiceQuestion,
+			nil,
+		)
+		answer := model.Answer{
+			QuestionID:      question.ID,
+			UserID:          user.ID,
+			Type:            model.MultipleChoiceQuestion,
+			SelectedOptions: question.Options[:1],
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		answer.SelectedOptions = question.Options[1:]
+
+		require.NoError(t, r.UpdateAnswer(t.Context(), answer.ID, &answer, staff.ID))
+
+		revisions, err := r.GetAnswerRevisions(t.Context(), question.ID, user.ID)
+
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+
+		// 変更前の選択肢もリビジョンに残る
+		assert.Equal(t, user.ID, revisions[0].EditorID)
+		assert.Equal(t, answer.ID, revisions[0].AnswerID)
+		assert.Len(t, revisions[0].SelectedOptions, 1)
+		assert.Equal(t, question.Options[0].ID, revisions[0].SelectedOptions[0].ID)
+
+		assert.Equal(t, staff.ID, revisions[1].EditorID)
+		assert.Equal(t, user.ID, revisions[1].UserID)
+		assert.Len(t, revisions[1].SelectedOptions, len(question.Options)-1)
+	})
+
+	t.Run("No revisions", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.FreeTextQuestion, nil)
+
+		revisions, err := r.GetAnswerRevisions(
+			t.Context(),
+			question.ID,
+			random.AlphaNumericString(t, 32),
+		)
+
+		assert.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+
+	t.Run("Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetAnswerRevisions(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			random.AlphaNumericString(t, 32),
+		)
+
+		assert.ErrorIs(t, err, repository.ErrQuestionNotFound)
+	})
+}
+
+func TestRepository_GetAnswerRevisionByID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.FreeTextQuestion, nil)
+		content := random.AlphaNumericString(t, 20)
+		answer := model.Answer{
+			QuestionID:      question.ID,
+			UserID:          user.ID,
+			Type:            model.FreeTextQuestion,
+			FreeTextContent: &content,
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		revisions, err := r.GetAnswerRevisions(t.Context(), question.ID, user.ID)
+
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+
+		revision, err := r.GetAnswerRevisionByID(t.Context(), revisions[0].ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, answer.ID, revision.AnswerID)
+		assert.Equal(t, model.FreeTextQuestion, revision.Type)
+
+		if assert.NotNil(t, revision.FreeTextContent) {
+			assert.Equal(t, content, *revision.FreeTextContent)
+		}
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetAnswerRevisionByID(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrAnswerRevisionNotFound)
+	})
+}
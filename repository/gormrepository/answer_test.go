@@ -2,6 +2,7 @@ package gormrepository
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -75,7 +76,7 @@ func TestCreateAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user.ID)
 
 		assert.NoError(t, err)
 	})
@@ -99,7 +100,7 @@ func TestCreateAnswer(t *testing.T) {
 			Type:            model.FreeTextQuestion,
 			FreeTextContent: &freeTextContent,
 		}
-		err := r.CreateAnswer(t.Context(), answer)
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
 
 		if assert.NoError(t, err) {
 			assert.NotZero(t, answer.ID)
@@ -132,7 +133,7 @@ func TestCreateAnswer(t *testing.T) {
 				Model: gorm.Model{ID: question.Options[0].ID}, // 最初のオプションを選択（IDのみで指定）
 			}},
 		}
-		err := r.CreateAnswer(t.Context(), answer)
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
 
 		if assert.NoError(t, err) {
 			assert.NotZero(t, answer.ID)
@@ -172,7 +173,7 @@ func TestCreateAnswer(t *testing.T) {
 			Type:            model.MultipleChoiceQuestion,
 			SelectedOptions: selectedOptions,
 		}
-		err := r.CreateAnswer(t.Context(), answer)
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
 
 		if assert.NoError(t, err) {
 			assert.NotZero(t, answer.ID)
@@ -207,7 +208,7 @@ func TestCreateAnswer(t *testing.T) {
 			Type:              model.FreeNumberQuestion,
 			FreeNumberContent: &freeNumberContent,
 		}
-		err := r.CreateAnswer(t.Context(), answer)
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
 
 		if assert.NoError(t, err) {
 			assert.NotZero(t, answer.ID)
@@ -220,6 +221,71 @@ func TestCreateAnswer(t *testing.T) {
 		}
 	})
 
+	t.Run("Success_RankingQuestion", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.RankingQuestion, nil)
+		rankedOptions := make([]model.AnswerRankedOption, len(question.Options))
+
+		// 作成順とは逆の順位を付ける
+		for i := range question.Options {
+			rankedOptions[i] = model.AnswerRankedOption{
+				Position: i + 1,
+				OptionID: question.Options[len(question.Options)-1-i].ID,
+			}
+		}
+
+		answer := &model.Answer{
+			QuestionID:    question.ID,
+			UserID:        user.ID,
+			Type:          model.RankingQuestion,
+			RankedOptions: rankedOptions,
+		}
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, model.RankingQuestion, answer.Type)
+
+			if assert.Len(t, answer.RankedOptions, len(question.Options)) {
+				for i, rankedOption := range answer.RankedOptions {
+					option := question.Options[len(question.Options)-1-i]
+
+					assert.Equal(t, i+1, rankedOption.Position)
+					assert.Equal(t, option.ID, rankedOption.OptionID)
+					assert.Equal(t, option.Content, rankedOption.Option.Content)
+				}
+			}
+
+			assert.Empty(t, answer.SelectedOptions)
+		}
+	})
+
+	t.Run("Success_DateTimeQuestion", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.DateTimeQuestion, nil)
+		dateContent := random.Time(t)
+		answer := &model.Answer{
+			QuestionID:  question.ID,
+			UserID:      user.ID,
+			Type:        model.DateTimeQuestion,
+			DateContent: &dateContent,
+		}
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
+
+		if assert.NoError(t, err) && assert.NotNil(t, answer.DateContent) {
+			assert.WithinDuration(t, dateContent, *answer.DateContent, time.Second)
+		}
+	})
+
 	t.Run("Failure_NonExistentQuestion", func(t *testing.T) {
 		t.Parallel()
 
@@ -233,7 +299,7 @@ func TestCreateAnswer(t *testing.T) {
 			Type:            model.FreeTextQuestion,
 			FreeTextContent: &freeTextContent,
 		}
-		err := r.CreateAnswer(t.Context(), answer)
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
 
 		if assert.Error(t, err) {
 			assert.Equal(t, model.ErrNotFound, err)
@@ -257,7 +323,7 @@ func TestCreateAnswer(t *testing.T) {
 				Model: gorm.Model{ID: nonExistentOptionID},
 			}},
 		}
-		err := r.CreateAnswer(t.Context(), answer)
+		err := r.CreateAnswer(t.Context(), answer, user.ID)
 
 		if assert.Error(t, err) {
 			assert.Equal(t, model.ErrNotFound, err)
@@ -322,7 +388,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user.ID)
 		assert.NoError(t, err)
 
 		// 特定のquestion groupの回答のみ取得
@@ -388,7 +454,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user1.ID)
 		assert.NoError(t, err)
 
 		// 別のユーザーで検索
@@ -432,7 +498,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user1.ID)
 		require.NoError(t, err)
 
 		// QuestionIDでAnswerを取得
@@ -507,7 +573,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user.ID)
 		require.NoError(t, err)
 
 		// QuestionIDでAnswerを取得（SelectedOptionsも含む）
@@ -570,7 +636,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user1.ID)
 		require.NoError(t, err)
 
 		// Public質問の回答を取得
@@ -626,7 +692,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user.ID)
 		require.NoError(t, err)
 
 		// Private質問の回答を取得
@@ -692,7 +758,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user.ID)
 		require.NoError(t, err)
 
 		// Public質問の回答を取得（SelectedOptionsも含む）
@@ -754,7 +820,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		require.NoError(t, r.CreateAnswers(t.Context(), &answers))
+		require.NoError(t, r.CreateAnswers(t.Context(), &answers, user1.ID))
 
 		// Get all answers for the question group
 		query := repository.GetAnswersQuery{
@@ -828,7 +894,7 @@ func TestGetAnswers(t *testing.T) {
 				},
 			}
 
-			require.NoError(t, r.CreateAnswers(t.Context(), &answers))
+			require.NoError(t, r.CreateAnswers(t.Context(), &answers, user.ID))
 
 			// Get answers for only question group 1
 			query := repository.GetAnswersQuery{
@@ -898,7 +964,7 @@ func TestGetAnswers(t *testing.T) {
 				},
 			}
 
-			err = r.CreateAnswers(t.Context(), &answers)
+			err = r.CreateAnswers(t.Context(), &answers, participant.ID)
 			require.NoError(t, err)
 
 			// IncludeNonParticipants = false (デフォルト): 参加者の回答のみが返される
@@ -950,7 +1016,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err = r.CreateAnswers(t.Context(), &answers)
+		err = r.CreateAnswers(t.Context(), &answers, participant.ID)
 		require.NoError(t, err)
 
 		// IncludeNonParticipants = true: 全ての回答が返される
@@ -1005,7 +1071,7 @@ func TestGetAnswers(t *testing.T) {
 			},
 		}
 
-		err = r.CreateAnswers(t.Context(), &answers)
+		err = r.CreateAnswers(t.Context(), &answers, participant.ID)
 		require.NoError(t, err)
 
 		// QuestionGroupIDでの絞り込み + IncludeNonParticipants = false
@@ -1052,7 +1118,7 @@ func TestUpdateAnswer(t *testing.T) {
 			},
 		}
 
-		err := r.CreateAnswers(t.Context(), &answers)
+		err := r.CreateAnswers(t.Context(), &answers, user.ID)
 		require.NoError(t, err)
 
 		// CreateAnswers後に作成されたanswerのIDを取得
@@ -1069,7 +1135,7 @@ func TestUpdateAnswer(t *testing.T) {
 			},
 		}
 
-		err = r.UpdateAnswer(t.Context(), createdAnswer.ID, &createdAnswer)
+		err = r.UpdateAnswer(t.Context(), createdAnswer.ID, &createdAnswer, user.ID)
 		assert.NoError(t, err)
 
 		assert.Equal(t, 1, len(createdAnswer.SelectedOptions))
@@ -1080,4 +1146,101 @@ func TestUpdateAnswer(t *testing.T) {
 			createdAnswer.SelectedOptions[0].Content,
 		)
 	})
+
+	t.Run("Success with RankingQuestion", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.RankingQuestion, nil)
+		rankedOptions := make([]model.AnswerRankedOption, len(question.Options))
+
+		for i, option := range question.Options {
+			rankedOptions[i] = model.AnswerRankedOption{Position: i + 1, OptionID: option.ID}
+		}
+
+		answer := model.Answer{
+			QuestionID:    question.ID,
+			UserID:        user.ID,
+			Type:          model.RankingQuestion,
+			RankedOptions: rankedOptions,
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		// 1位と最下位を入れ替える
+		last := len(question.Options) - 1
+		newRankedOptions := make([]model.AnswerRankedOption, len(question.Options))
+
+		for i, option := range question.Options {
+			newRankedOptions[i] = model.AnswerRankedOption{Position: i + 1, OptionID: option.ID}
+		}
+
+		newRankedOptions[0].OptionID, newRankedOptions[last].OptionID =
+			newRankedOptions[last].OptionID, newRankedOptions[0].OptionID
+		answer.RankedOptions = newRankedOptions
+
+		err := r.UpdateAnswer(t.Context(), answer.ID, &answer, user.ID)
+
+		if assert.NoError(t, err) && assert.Len(t, answer.RankedOptions, len(question.Options)) {
+			assert.Equal(t, question.Options[last].ID, answer.RankedOptions[0].OptionID)
+			assert.Equal(t, question.Options[0].ID, answer.RankedOptions[last].OptionID)
+			assert.Equal(
+				t,
+				question.Options[last].Content,
+				answer.RankedOptions[0].Option.Content,
+			)
+		}
+
+		revisions, err := r.GetAnswerRevisions(t.Context(), question.ID, user.ID)
+
+		require.NoError(t, err)
+
+		// 更新前の順位もリビジョンに残っている
+		if assert.Len(t, revisions, 2) && assert.Len(t, revisions[0].RankedOptions, last+1) {
+			assert.Equal(t, question.Options[0].ID, revisions[0].RankedOptions[0].OptionID)
+			assert.Equal(t, question.Options[last].ID, revisions[1].RankedOptions[0].OptionID)
+		}
+	})
+	t.Run("Clears flags", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+		answer := model.Answer{
+			QuestionID:      question.ID,
+			UserID:          user.ID,
+			Type:            model.SingleChoiceQuestion,
+			SelectedOptions: []model.Option{question.Options[0]},
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		// 選んだ選択肢を質問から取り除いて回答に印を付ける
+		newQuestion := question
+		newQuestion.Options = question.Options[1:]
+
+		require.NoError(t, r.UpdateQuestion(t.Context(), question.ID, &newQuestion))
+
+		flags, err := r.GetAnswerFlags(t.Context(), question.ID)
+
+		require.NoError(t, err)
+		require.Len(t, flags, 1)
+
+		answer.SelectedOptions = []model.Option{question.Options[1]}
+
+		err = r.UpdateAnswer(t.Context(), answer.ID, &answer, user.ID)
+
+		require.NoError(t, err)
+
+		flags, err = r.GetAnswerFlags(t.Context(), question.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, flags)
+	})
 }
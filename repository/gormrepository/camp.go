@@ -3,6 +3,7 @@ package gormrepository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -53,11 +54,13 @@ func (r *Repository) UpdateCamp(ctx context.Context, campID uint, camp *model.Ca
 			"display_id",
 			"name",
 			"guidebook",
-			"is_draft",
-			"is_payment_open",
-			"is_registration_open",
 			"date_start",
 			"date_end",
+			"registration_scheduled_at",
+			"confirmed_scheduled_at",
+			"ongoing_scheduled_at",
+			"finished_scheduled_at",
+			"archived_scheduled_at",
 		).
 		Updates(ctx, camp)
 
@@ -76,6 +79,76 @@ func (r *Repository) UpdateCamp(ctx context.Context, campID uint, camp *model.Ca
 	return nil
 }
 
+func (r *Repository) UpdateCampStatus(
+	ctx context.Context,
+	campID uint,
+	previousStatus model.CampStatus,
+	camp *model.Camp,
+) error {
+	// 状態を読み取ってから更新するまでの間に変更された場合は上書きしない
+	rowsAffected, err := gorm.G[*model.Camp](r.db).
+		Where("id = ? AND status = ?", campID, previousStatus).
+		Select(
+			"status",
+			"registration_scheduled_at",
+			"confirmed_scheduled_at",
+			"ongoing_scheduled_at",
+			"finished_scheduled_at",
+			"archived_scheduled_at",
+		).
+		Updates(ctx, camp)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	// 条件に合わなかったのか、変更がなかったのかを区別する
+	current, err := gorm.G[model.Camp](r.db).
+		Select("status").
+		Where("id = ?", campID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repository.ErrCampNotFound
+		}
+
+		return err
+	}
+
+	if current.Status != previousStatus {
+		return repository.ErrCampStatusChanged
+	}
+
+	return nil
+}
+
+func (r *Repository) GetCampsWithDueSchedule(
+	ctx context.Context,
+	now time.Time,
+) ([]model.Camp, error) {
+	camps, err := gorm.G[model.Camp](r.db).
+		Where("status <> ?", model.CampStatusArchived).
+		Where(
+			r.db.Where("registration_scheduled_at <= ?", now).
+				Or("confirmed_scheduled_at <= ?", now).
+				Or("ongoing_scheduled_at <= ?", now).
+				Or("finished_scheduled_at <= ?", now).
+				Or("archived_scheduled_at <= ?", now),
+		).
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return camps, nil
+}
+
 func (r *Repository) DeleteCamp(ctx context.Context, campID uint) error {
 	_, err := gorm.G[*model.Camp](r.db).Where(&model.Camp{
 		Model: gorm.Model{
@@ -195,3 +268,23 @@ func (r *Repository) campExists(ctx context.Context, campID uint) (bool, error)
 
 	return count > 0, nil
 }
+
+func (r *Repository) GetCampRegistrationCounts(
+	ctx context.Context,
+	campID uint,
+) ([]repository.CampRegistrationCount, error) {
+	var counts []repository.CampRegistrationCount
+
+	// 登録時刻がNULLの参加者はDateがnilの1行にまとめられ、先頭に並ぶ
+	if err := r.db.WithContext(ctx).
+		Table("camp_participants").
+		Select("DATE(created_at) AS date, COUNT(*) AS count").
+		Where("camp_id = ?", campID).
+		Group("DATE(created_at)").
+		Order("date").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
@@ -23,14 +23,12 @@ func TestCreateCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		camp := model.Camp{
-			DisplayID:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          dateStart,
-			DateEnd:            dateEnd,
+			DisplayID: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status:    random.SelectFrom(t, model.CampStatusDraft, model.CampStatusRegistration),
+			DateStart: dateStart,
+			DateEnd:   dateEnd,
 		}
 		err := r.CreateCamp(&camp)
 
@@ -47,28 +45,24 @@ func TestCreateCamp(t *testing.T) {
 
 		// 1つ目のキャンプを作成
 		camp1 := model.Camp{
-			DisplayID:          displayID,
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          dateStart,
-			DateEnd:            dateEnd,
+			DisplayID: displayID,
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status:    random.SelectFrom(t, model.CampStatusDraft, model.CampStatusRegistration),
+			DateStart: dateStart,
+			DateEnd:   dateEnd,
 		}
 		err := r.CreateCamp(&camp1)
 		require.NoError(t, err)
 
 		// 同じDisplayIDで2つ目のキャンプを作成
 		camp2 := model.Camp{
-			DisplayID:          displayID, // 同じDisplayID
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          random.Time(t),
-			DateEnd:            random.Time(t),
+			DisplayID: displayID, // 同じDisplayID
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status:    random.SelectFrom(t, model.CampStatusDraft, model.CampStatusRegistration),
+			DateStart: random.Time(t),
+			DateEnd:   random.Time(t),
 		}
 		err = r.CreateCamp(&camp2)
 
@@ -113,9 +107,7 @@ func TestRepository_GetCampByID(t *testing.T) {
 			assert.Equal(t, camp.DisplayID, retrievedCamp.DisplayID)
 			assert.Equal(t, camp.Name, retrievedCamp.Name)
 			assert.Equal(t, camp.Guidebook, retrievedCamp.Guidebook)
-			assert.Equal(t, camp.IsDraft, retrievedCamp.IsDraft)
-			assert.Equal(t, camp.IsPaymentOpen, retrievedCamp.IsPaymentOpen)
-			assert.Equal(t, camp.IsRegistrationOpen, retrievedCamp.IsRegistrationOpen)
+			assert.Equal(t, camp.Status, retrievedCamp.Status)
 			assert.WithinDuration(t, camp.DateStart, retrievedCamp.DateStart, time.Second)
 			assert.WithinDuration(t, camp.DateEnd, retrievedCamp.DateEnd, time.Second)
 		}
@@ -147,21 +139,21 @@ func TestUpdateCamp(t *testing.T) {
 		// 更新するデータを準備
 		newName := random.AlphaNumericString(t, 30)
 		newGuidebook := random.AlphaNumericString(t, 200)
-		newIsDraft := !camp.IsDraft
-		newIsPaymentOpen := !camp.IsPaymentOpen
-		newIsRegistrationOpen := !camp.IsRegistrationOpen
 		newDateStart := random.Time(t)
 		newDateEnd := newDateStart.Add(time.Duration(random.PositiveInt(t)))
+		newRegistrationScheduledAt := random.Time(t)
+		newConfirmedScheduledAt := newRegistrationScheduledAt.Add(time.Duration(random.PositiveInt(t)))
 
 		updatedCamp := model.Camp{
-			DisplayID:          camp.DisplayID, // DisplayIDは更新しない
-			Name:               newName,
-			Guidebook:          newGuidebook,
-			IsDraft:            newIsDraft,
-			IsPaymentOpen:      newIsPaymentOpen,
-			IsRegistrationOpen: newIsRegistrationOpen,
-			DateStart:          newDateStart,
-			DateEnd:            newDateEnd,
+			DisplayID: camp.DisplayID, // DisplayIDは更新しない
+			Name:      newName,
+			Guidebook: newGuidebook,
+			DateStart: newDateStart,
+			DateEnd:   newDateEnd,
+			// 状態はUpdateCampでは更新されない
+			Status:                  model.CampStatusArchived,
+			RegistrationScheduledAt: &newRegistrationScheduledAt,
+			ConfirmedScheduledAt:    &newConfirmedScheduledAt,
 		}
 
 		err := r.UpdateCamp(t.Context(), camp.ID, &updatedCamp)
@@ -175,9 +167,17 @@ func TestUpdateCamp(t *testing.T) {
 		assert.Equal(t, camp.DisplayID, retrievedCamp.DisplayID)
 		assert.Equal(t, newName, retrievedCamp.Name)
 		assert.Equal(t, newGuidebook, retrievedCamp.Guidebook)
-		assert.Equal(t, newIsDraft, retrievedCamp.IsDraft)
-		assert.Equal(t, newIsPaymentOpen, retrievedCamp.IsPaymentOpen)
-		assert.Equal(t, newIsRegistrationOpen, retrievedCamp.IsRegistrationOpen)
+		assert.Equal(t, camp.Status, retrievedCamp.Status)
+
+		if assert.NotNil(t, retrievedCamp.RegistrationScheduledAt) {
+			assert.WithinDuration(t, newRegistrationScheduledAt, *retrievedCamp.RegistrationScheduledAt, time.Second)
+		}
+
+		if assert.NotNil(t, retrievedCamp.ConfirmedScheduledAt) {
+			assert.WithinDuration(t, newConfirmedScheduledAt, *retrievedCamp.ConfirmedScheduledAt, time.Second)
+		}
+
+		assert.Nil(t, retrievedCamp.OngoingScheduledAt)
 		// 時刻の比較は秒単位で行う（MySQLの時刻精度の問題を回避）
 		assert.WithinDuration(t, newDateStart, retrievedCamp.DateStart, time.Second)
 		assert.WithinDuration(t, newDateEnd, retrievedCamp.DateEnd, time.Second)
@@ -192,11 +192,8 @@ func TestUpdateCamp(t *testing.T) {
 		camp := mustCreateCamp(t, r)
 
 		zeroValueUpdate := model.Camp{
-			Name:               "", // 空文字列
-			Guidebook:          "", // 空文字列
-			IsDraft:            false,
-			IsPaymentOpen:      false,
-			IsRegistrationOpen: false,
+			Name:      "", // 空文字列
+			Guidebook: "", // 空文字列
 		}
 
 		err := r.UpdateCamp(t.Context(), camp.ID, &zeroValueUpdate)
@@ -209,9 +206,8 @@ func TestUpdateCamp(t *testing.T) {
 		// ゼロ値が設定されていることを確認
 		assert.Equal(t, "", retrievedCamp.Name)
 		assert.Equal(t, "", retrievedCamp.Guidebook)
-		assert.False(t, retrievedCamp.IsDraft)
-		assert.False(t, retrievedCamp.IsPaymentOpen)
-		assert.False(t, retrievedCamp.IsRegistrationOpen)
+		assert.Nil(t, retrievedCamp.RegistrationScheduledAt)
+		assert.Equal(t, camp.Status, retrievedCamp.Status)
 		// CreatedAtが変わっていないことを確認
 		assert.WithinDuration(t, camp.CreatedAt, retrievedCamp.CreatedAt, time.Second)
 	})
@@ -223,13 +219,11 @@ func TestUpdateCamp(t *testing.T) {
 		nonExistentID := uint(random.PositiveInt(t))
 
 		camp := model.Camp{
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          random.Time(t),
-			DateEnd:            random.Time(t),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status:    random.SelectFrom(t, model.CampStatusDraft, model.CampStatusRegistration),
+			DateStart: random.Time(t),
+			DateEnd:   random.Time(t),
 		}
 
 		err := r.UpdateCamp(t.Context(), nonExistentID, &camp)
@@ -247,14 +241,12 @@ func TestUpdateCamp(t *testing.T) {
 
 		// camp2のDisplayIDをcamp1と同じものに更新しようとする
 		updatedCamp := model.Camp{
-			DisplayID:          camp1.DisplayID, // camp1と同じDisplayID
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          random.Time(t),
-			DateEnd:            random.Time(t),
+			DisplayID: camp1.DisplayID, // camp1と同じDisplayID
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status:    random.SelectFrom(t, model.CampStatusDraft, model.CampStatusRegistration),
+			DateStart: random.Time(t),
+			DateEnd:   random.Time(t),
 		}
 
 		err := r.UpdateCamp(t.Context(), camp2.ID, &updatedCamp)
@@ -264,6 +256,119 @@ func TestUpdateCamp(t *testing.T) {
 	})
 }
 
+func TestRepository_UpdateCampStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		previousStatus := camp.Status
+		ongoingScheduledAt := random.Time(t)
+		newName := random.AlphaNumericString(t, 20)
+
+		camp.Status = model.CampStatusConfirmed
+		camp.OngoingScheduledAt = &ongoingScheduledAt
+		// 状態と予定時刻以外は更新されない
+		camp.Name = newName
+
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
+
+		require.NoError(t, err)
+
+		retrievedCamp, err := r.GetCampByID(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.CampStatusConfirmed, retrievedCamp.Status)
+		assert.NotEqual(t, newName, retrievedCamp.Name)
+
+		if assert.NotNil(t, retrievedCamp.OngoingScheduledAt) {
+			assert.WithinDuration(t, ongoingScheduledAt, *retrievedCamp.OngoingScheduledAt, time.Second)
+		}
+	})
+
+	t.Run("Status changed", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		currentStatus := camp.Status
+
+		camp.Status = model.CampStatusArchived
+
+		// 読み取った後に別の状態に変更された場合は上書きしない
+		err := r.UpdateCampStatus(t.Context(), camp.ID, model.CampStatusArchived, &camp)
+
+		assert.ErrorIs(t, err, repository.ErrCampStatusChanged)
+
+		retrievedCamp, err := r.GetCampByID(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, currentStatus, retrievedCamp.Status)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		err := r.UpdateCampStatus(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			model.CampStatusDraft,
+			&model.Camp{Status: model.CampStatusRegistration},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrCampNotFound)
+	})
+}
+
+func TestRepository_GetCampsWithDueSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		now := time.Now()
+		past := now.Add(-time.Hour)
+		future := now.Add(time.Hour)
+		dueCamp := mustCreateCamp(t, r)
+		notDueCamp := mustCreateCamp(t, r)
+		archivedCamp := mustCreateCamp(t, r)
+		_ = mustCreateCamp(t, r) // 予定時刻がない合宿
+		previousStatuses := map[uint]model.CampStatus{
+			dueCamp.ID:      dueCamp.Status,
+			notDueCamp.ID:   notDueCamp.Status,
+			archivedCamp.ID: archivedCamp.Status,
+		}
+
+		dueCamp.Status = model.CampStatusRegistration
+		dueCamp.ConfirmedScheduledAt = &past
+		dueCamp.OngoingScheduledAt = &future
+		notDueCamp.Status = model.CampStatusRegistration
+		notDueCamp.ConfirmedScheduledAt = &future
+		archivedCamp.Status = model.CampStatusArchived
+		archivedCamp.ArchivedScheduledAt = &past
+
+		for _, camp := range []*model.Camp{&dueCamp, &notDueCamp, &archivedCamp} {
+			require.NoError(
+				t,
+				r.UpdateCampStatus(t.Context(), camp.ID, previousStatuses[camp.ID], camp),
+			)
+		}
+
+		camps, err := r.GetCampsWithDueSchedule(t.Context(), now)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, camps, 1) {
+			assert.Equal(t, dueCamp.ID, camps[0].ID)
+			assert.Equal(t, model.CampStatusRegistration, camps[0].Status)
+		}
+	})
+}
+
 func TestIsCampParticipant(t *testing.T) {
 	t.Parallel()
 
@@ -275,8 +380,9 @@ func TestIsCampParticipant(t *testing.T) {
 		user := mustCreateUser(t, r)
 
 		// 参加受付を開く
-		camp.IsRegistrationOpen = true
-		err := r.UpdateCamp(t.Context(), camp.ID, &camp)
+		previousStatus := camp.Status
+		camp.Status = model.CampStatusRegistration
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
 		require.NoError(t, err)
 
 		err = r.AddCampParticipant(t.Context(), camp.ID, &user)
@@ -334,8 +440,9 @@ func TestIsCampParticipant(t *testing.T) {
 		user3 := mustCreateUser(t, r)
 
 		// 参加受付を開く
-		camp.IsRegistrationOpen = true
-		err := r.UpdateCamp(t.Context(), camp.ID, &camp)
+		previousStatus := camp.Status
+		camp.Status = model.CampStatusRegistration
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
 		require.NoError(t, err)
 
 		// user1とuser3を参加者に追加
@@ -366,8 +473,9 @@ func TestIsCampParticipant(t *testing.T) {
 		user := mustCreateUser(t, r)
 
 		// 参加受付を開く
-		camp.IsRegistrationOpen = true
-		err := r.UpdateCamp(t.Context(), camp.ID, &camp)
+		previousStatus := camp.Status
+		camp.Status = model.CampStatusRegistration
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
 		require.NoError(t, err)
 
 		// ユーザーを合宿に参加させる
@@ -395,8 +503,9 @@ func TestIsCampParticipant(t *testing.T) {
 		user := mustCreateUser(t, r)
 
 		// 参加受付を開く
-		camp.IsRegistrationOpen = true
-		err := r.UpdateCamp(t.Context(), camp.ID, &camp)
+		previousStatus := camp.Status
+		camp.Status = model.CampStatusRegistration
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
 		require.NoError(t, err)
 
 		err = r.AddCampParticipant(t.Context(), camp.ID, &user)
@@ -490,8 +599,9 @@ func TestAddCampParticipant(t *testing.T) {
 		user := mustCreateUser(t, r)
 
 		// 参加受付を開く
-		camp.IsRegistrationOpen = true
-		err := r.UpdateCamp(t.Context(), camp.ID, &camp)
+		previousStatus := camp.Status
+		camp.Status = model.CampStatusRegistration
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
 		require.NoError(t, err)
 
 		// 同じユーザーを2回追加
@@ -518,8 +628,9 @@ func TestAddCampParticipant(t *testing.T) {
 		user3 := mustCreateUser(t, r)
 
 		// 参加受付を開く
-		camp.IsRegistrationOpen = true
-		err := r.UpdateCamp(t.Context(), camp.ID, &camp)
+		previousStatus := camp.Status
+		camp.Status = model.CampStatusRegistration
+		err := r.UpdateCampStatus(t.Context(), camp.ID, previousStatus, &camp)
 		require.NoError(t, err)
 
 		// 複数のユーザーを追加
@@ -647,3 +758,51 @@ func TestRepository_RemoveCampParticipant(t *testing.T) {
 		assert.False(t, isParticipant2)
 	})
 }
+
+func TestRepository_GetCampRegistrationCounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		user3 := mustCreateUser(t, r)
+
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &user1))
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &user2))
+		require.NoError(t, r.AddCampParticipant(t.Context(), otherCamp.ID, &user3))
+
+		// 登録時刻の記録を始める前に登録した参加者
+		require.NoError(t, r.db.Exec(
+			"INSERT INTO camp_participants (camp_id, user_id) VALUES (?, ?)",
+			camp.ID,
+			user3.ID,
+		).Error)
+
+		counts, err := r.GetCampRegistrationCounts(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		require.Len(t, counts, 2)
+		assert.Nil(t, counts[0].Date)
+		assert.Equal(t, 1, counts[0].Count)
+		require.NotNil(t, counts[1].Date)
+		assert.WithinDuration(t, time.Now(), *counts[1].Date, 48*time.Hour)
+		assert.Equal(t, 2, counts[1].Count)
+	})
+
+	t.Run("No participants", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+
+		counts, err := r.GetCampRegistrationCounts(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+}
@@ -2,14 +2,17 @@ package gormrepository
 
 import (
 	"context"
+	"errors"
 
 	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
 )
 
 func (r *Repository) GetEvents(ctx context.Context, campID uint) ([]model.Event, error) {
 	events, err := gorm.G[model.Event](r.db).
+		Preload("Attendances", nil).
 		Where(&model.Event{CampID: campID}).
 		Find(ctx)
 
@@ -23,7 +26,11 @@ func (r *Repository) GetEvents(ctx context.Context, campID uint) ([]model.Event,
 func (r *Repository) GetEventByID(id uint) (*model.Event, error) {
 	var event model.Event
 
-	if err := r.db.First(&event, id).Error; err != nil {
+	if err := r.db.Preload("Attendances").First(&event, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrEventNotFound
+		}
+
 		return nil, err
 	}
 
@@ -39,11 +46,22 @@ func (r *Repository) CreateEvent(event *model.Event) error {
 }
 
 func (r *Repository) UpdateEvent(ctx context.Context, ID uint, event *model.Event) error {
+	// 定員や終了時刻などをnilに戻せるよう、更新するカラムを明示する
 	if _, err := gorm.G[*model.Event](r.db).Where(&model.Event{
 		Model: gorm.Model{
 			ID: ID,
 		},
-	}).Updates(ctx, event); err != nil {
+	}).Select(
+		"type",
+		"name",
+		"description",
+		"location",
+		"time_start",
+		"time_end",
+		"organizer_id",
+		"display_color",
+		"capacity",
+	).Updates(ctx, event); err != nil {
 		return err
 	}
 
@@ -58,3 +76,35 @@ func (r *Repository) DeleteEvent(ID uint) error {
 
 	return nil
 }
+
+func (r *Repository) GetUserEvents(
+	ctx context.Context,
+	campID uint,
+	userID string,
+) ([]model.Event, error) {
+	events, err := gorm.G[model.Event](r.db).
+		Preload("Attendances", nil).
+		Where("camp_id = ?", campID).
+		Where(
+			"type = ? OR organizer_id = ? OR EXISTS ("+
+				"SELECT 1 FROM event_attendances WHERE event_attendances.event_id = events.id "+
+				"AND event_attendances.user_id = ? AND event_attendances.status IN ? "+
+				"AND event_attendances.deleted_at IS NULL)",
+			model.EventTypeOfficial,
+			userID,
+			userID,
+			[]model.EventAttendanceStatus{
+				model.EventAttendanceStatusGoing,
+				model.EventAttendanceStatusMaybe,
+			},
+		).
+		Order("time_start").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
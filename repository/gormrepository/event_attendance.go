@@ -0,0 +1,122 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+func (r *Repository) GetEventAttendances(
+	ctx context.Context,
+	eventID uint,
+) ([]model.EventAttendance, error) {
+	// 出欠がまだ無いイベントでも同時に定員を数えないよう、出欠の行ではなくイベントの行をロックする
+	if _, err := gorm.G[model.Event](
+		r.db,
+		clause.Locking{Strength: clause.LockingStrengthUpdate},
+	).
+		Where("id = ?", eventID).
+		First(ctx); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrEventNotFound
+		}
+
+		return nil, err
+	}
+
+	attendances, err := gorm.G[model.EventAttendance](r.db).
+		Where("event_id = ?", eventID).
+		Order("updated_at ASC").
+		Order("id ASC").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return attendances, nil
+}
+
+func (r *Repository) SaveEventAttendance(
+	ctx context.Context,
+	attendance *model.EventAttendance,
+) error {
+	attendance.UpdatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns(
+				[]string{"status", "is_waitlisted", "updated_at"},
+			),
+		}).
+		Create(attendance).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			eventExists, err := r.eventExists(ctx, attendance.EventID)
+
+			if err != nil {
+				return err
+			}
+
+			if !eventExists {
+				return repository.ErrEventNotFound
+			}
+
+			userExists, err := r.userExists(ctx, attendance.UserID)
+
+			if err != nil {
+				return err
+			}
+
+			if !userExists {
+				return repository.ErrUserNotFound
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteEventAttendance(
+	ctx context.Context,
+	eventID uint,
+	userID string,
+) error {
+	// 再度出欠を登録したときにユニークインデックスと衝突しないよう物理削除する
+	rowsAffected, err := gorm.G[model.EventAttendance](r.db.Unscoped()).
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Delete(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrEventAttendanceNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) eventExists(ctx context.Context, eventID uint) (bool, error) {
+	var count int64
+
+	if err := r.db.
+		WithContext(ctx).
+		Model(&model.Event{}).
+		Where("id = ?", eventID).
+		Count(&count).
+		Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,182 @@
+package gormrepository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestRepository_SaveEventAttendance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		user := mustCreateUser(t, r)
+		attendance := model.EventAttendance{
+			EventID: event.ID,
+			UserID:  user.ID,
+			Status:  model.EventAttendanceStatusGoing,
+		}
+
+		err := r.SaveEventAttendance(t.Context(), &attendance)
+
+		require.NoError(t, err)
+
+		attendances, err := r.GetEventAttendances(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		require.Len(t, attendances, 1)
+		assert.Equal(t, user.ID, attendances[0].UserID)
+		assert.Equal(t, model.EventAttendanceStatusGoing, attendances[0].Status)
+		assert.False(t, attendances[0].IsWaitlisted)
+	})
+
+	t.Run("既存の出欠を上書きする", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		user := mustCreateUser(t, r)
+
+		err := r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+			EventID: event.ID,
+			UserID:  user.ID,
+			Status:  model.EventAttendanceStatusGoing,
+		})
+
+		require.NoError(t, err)
+
+		err = r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+			EventID:      event.ID,
+			UserID:       user.ID,
+			Status:       model.EventAttendanceStatusMaybe,
+			IsWaitlisted: false,
+		})
+
+		require.NoError(t, err)
+
+		attendances, err := r.GetEventAttendances(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		require.Len(t, attendances, 1)
+		assert.Equal(t, model.EventAttendanceStatusMaybe, attendances[0].Status)
+	})
+
+	t.Run("イベントが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		user := mustCreateUser(t, r)
+
+		err := r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+			EventID: uint(random.PositiveInt(t)),
+			UserID:  user.ID,
+			Status:  model.EventAttendanceStatusGoing,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrEventNotFound)
+	})
+}
+
+func TestRepository_GetEventAttendances(t *testing.T) {
+	t.Parallel()
+
+	t.Run("登録順に返す", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+
+		for _, user := range []model.User{user1, user2} {
+			err := r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+				EventID: event.ID,
+				UserID:  user.ID,
+				Status:  model.EventAttendanceStatusGoing,
+			})
+
+			require.NoError(t, err)
+		}
+
+		attendances, err := r.GetEventAttendances(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		require.Len(t, attendances, 2)
+		assert.Equal(t, user1.ID, attendances[0].UserID)
+		assert.Equal(t, user2.ID, attendances[1].UserID)
+	})
+
+	t.Run("イベントが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetEventAttendances(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrEventNotFound)
+	})
+}
+
+func TestRepository_DeleteEventAttendance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		user := mustCreateUser(t, r)
+
+		err := r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+			EventID: event.ID,
+			UserID:  user.ID,
+			Status:  model.EventAttendanceStatusGoing,
+		})
+
+		require.NoError(t, err)
+
+		err = r.DeleteEventAttendance(t.Context(), event.ID, user.ID)
+
+		require.NoError(t, err)
+
+		attendances, err := r.GetEventAttendances(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, attendances)
+
+		// 削除後に再度登録できる
+		err = r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+			EventID: event.ID,
+			UserID:  user.ID,
+			Status:  model.EventAttendanceStatusMaybe,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("出欠が存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		user := mustCreateUser(t, r)
+
+		err := r.DeleteEventAttendance(t.Context(), event.ID, user.ID)
+
+		assert.ErrorIs(t, err, repository.ErrEventAttendanceNotFound)
+	})
+}
@@ -0,0 +1,143 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+func (r *Repository) CreateEventReminder(ctx context.Context, reminder *model.EventReminder) error {
+	if err := gorm.G[model.EventReminder](r.db).Create(ctx, reminder); err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return repository.ErrEventNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetEventReminders(
+	ctx context.Context,
+	eventID uint,
+) ([]model.EventReminder, error) {
+	reminders, err := gorm.G[model.EventReminder](r.db).
+		Where("event_id = ?", eventID).
+		Order("send_at ASC").
+		Order("id ASC").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reminders) == 0 {
+		eventExists, err := r.eventExists(ctx, eventID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !eventExists {
+			return nil, repository.ErrEventNotFound
+		}
+	}
+
+	return reminders, nil
+}
+
+func (r *Repository) GetEventReminderByID(
+	ctx context.Context,
+	reminderID uint,
+) (*model.EventReminder, error) {
+	reminder, err := gorm.G[model.EventReminder](r.db).
+		Where("id = ?", reminderID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrEventReminderNotFound
+		}
+
+		return nil, err
+	}
+
+	return &reminder, nil
+}
+
+func (r *Repository) UpdateEventReminder(
+	ctx context.Context,
+	reminderID uint,
+	reminder *model.EventReminder,
+) error {
+	if reminderID == 0 {
+		return repository.ErrEventReminderNotFound
+	}
+
+	// 再送のためにSentAtをnilに戻せるよう、更新するカラムを明示する
+	rowsAffected, err := gorm.G[*model.EventReminder](r.db).
+		Where("id = ?", reminderID).
+		Select("send_at", "sent_at").
+		Updates(ctx, reminder)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrEventReminderNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteEventReminder(ctx context.Context, reminderID uint) error {
+	rowsAffected, err := gorm.G[model.EventReminder](r.db).
+		Where("id = ?", reminderID).
+		Delete(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrEventReminderNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteEventReminders(ctx context.Context, eventID uint) error {
+	if _, err := gorm.G[model.EventReminder](r.db).
+		Where("event_id = ?", eventID).
+		Delete(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetReadyToSendEventReminders(
+	ctx context.Context,
+) ([]model.EventReminder, error) {
+	var reminders []model.EventReminder
+
+	// 削除されたイベントのリマインダーは送信しない
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN events ON events.id = event_reminders.event_id AND events.deleted_at IS NULL").
+		Preload("Event").
+		Where("event_reminders.sent_at IS NULL").
+		Where("event_reminders.send_at <= ?", time.Now()).
+		Order("event_reminders.send_at ASC").
+		Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+
+	return reminders, nil
+}
@@ -0,0 +1,269 @@
+package gormrepository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func mustCreateEventReminder(
+	t *testing.T,
+	r *Repository,
+	eventID uint,
+	sendAt time.Time,
+) model.EventReminder {
+	t.Helper()
+
+	reminder := model.EventReminder{
+		EventID:       eventID,
+		MinutesBefore: random.PositiveInt(t),
+		SendAt:        sendAt,
+	}
+
+	require.NoError(t, r.CreateEventReminder(t.Context(), &reminder))
+
+	return reminder
+}
+
+func TestRepository_CreateEventReminder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		reminder := mustCreateEventReminder(t, r, event.ID, time.Now().Add(time.Hour))
+
+		assert.NotZero(t, reminder.ID)
+
+		reminders, err := r.GetEventReminders(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		require.Len(t, reminders, 1)
+		assert.Equal(t, reminder.ID, reminders[0].ID)
+		assert.Equal(t, reminder.MinutesBefore, reminders[0].MinutesBefore)
+		assert.Nil(t, reminders[0].SentAt)
+	})
+
+	t.Run("イベントが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.CreateEventReminder(t.Context(), &model.EventReminder{
+			EventID:       uint(random.PositiveInt(t)),
+			MinutesBefore: random.PositiveInt(t),
+			SendAt:        time.Now(),
+		})
+
+		assert.ErrorIs(t, err, repository.ErrEventNotFound)
+	})
+}
+
+func TestRepository_GetEventReminders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("送信予定時刻順に返す", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		later := mustCreateEventReminder(t, r, event.ID, time.Now().Add(2*time.Hour))
+		earlier := mustCreateEventReminder(t, r, event.ID, time.Now().Add(time.Hour))
+
+		reminders, err := r.GetEventReminders(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		require.Len(t, reminders, 2)
+		assert.Equal(t, earlier.ID, reminders[0].ID)
+		assert.Equal(t, later.ID, reminders[1].ID)
+	})
+
+	t.Run("イベントが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetEventReminders(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrEventNotFound)
+	})
+}
+
+func TestRepository_GetEventReminderByID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		reminder := mustCreateEventReminder(t, r, event.ID, time.Now().Add(time.Hour))
+
+		got, err := r.GetEventReminderByID(t.Context(), reminder.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, reminder.ID, got.ID)
+		assert.Equal(t, event.ID, got.EventID)
+	})
+
+	t.Run("リマインダーが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetEventReminderByID(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrEventReminderNotFound)
+	})
+}
+
+func TestRepository_UpdateEventReminder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("送信時刻をnilに戻せる", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		reminder := mustCreateEventReminder(t, r, event.ID, time.Now().Add(-time.Hour))
+		sentAt := time.Now()
+
+		reminder.SentAt = &sentAt
+
+		require.NoError(t, r.UpdateEventReminder(t.Context(), reminder.ID, &reminder))
+
+		newSendAt := time.Now().Add(time.Hour)
+
+		err := r.UpdateEventReminder(t.Context(), reminder.ID, &model.EventReminder{
+			SendAt: newSendAt,
+		})
+
+		require.NoError(t, err)
+
+		got, err := r.GetEventReminderByID(t.Context(), reminder.ID)
+
+		require.NoError(t, err)
+		assert.Nil(t, got.SentAt)
+		assert.WithinDuration(t, newSendAt, got.SendAt, time.Second)
+	})
+
+	t.Run("リマインダーが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.UpdateEventReminder(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			&model.EventReminder{SendAt: time.Now()},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrEventReminderNotFound)
+	})
+}
+
+func TestRepository_DeleteEventReminder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		reminder := mustCreateEventReminder(t, r, event.ID, time.Now().Add(time.Hour))
+
+		require.NoError(t, r.DeleteEventReminder(t.Context(), reminder.ID))
+
+		_, err := r.GetEventReminderByID(t.Context(), reminder.ID)
+
+		assert.ErrorIs(t, err, repository.ErrEventReminderNotFound)
+	})
+
+	t.Run("リマインダーが存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.DeleteEventReminder(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrEventReminderNotFound)
+	})
+}
+
+func TestRepository_DeleteEventReminders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		otherEvent := mustCreateEvent(t, r, camp.ID)
+
+		mustCreateEventReminder(t, r, event.ID, time.Now().Add(time.Hour))
+		mustCreateEventReminder(t, r, event.ID, time.Now().Add(2*time.Hour))
+		otherReminder := mustCreateEventReminder(t, r, otherEvent.ID, time.Now().Add(time.Hour))
+
+		require.NoError(t, r.DeleteEventReminders(t.Context(), event.ID))
+
+		reminders, err := r.GetEventReminders(t.Context(), event.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, reminders)
+
+		// 他のイベントのリマインダーは削除されない
+		_, err = r.GetEventReminderByID(t.Context(), otherReminder.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestRepository_GetReadyToSendEventReminders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		event := mustCreateEvent(t, r, camp.ID)
+		deletedEvent := mustCreateEvent(t, r, camp.ID)
+		readyReminder := mustCreateEventReminder(t, r, event.ID, time.Now().Add(-time.Hour))
+
+		// 未来の送信予定時刻のリマインダー
+		mustCreateEventReminder(t, r, event.ID, time.Now().Add(time.Hour))
+
+		// 送信済みのリマインダー
+		sentReminder := mustCreateEventReminder(t, r, event.ID, time.Now().Add(-time.Hour))
+		sentAt := time.Now()
+		sentReminder.SentAt = &sentAt
+
+		require.NoError(t, r.UpdateEventReminder(t.Context(), sentReminder.ID, &sentReminder))
+
+		// 削除されたイベントのリマインダー
+		mustCreateEventReminder(t, r, deletedEvent.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, r.DeleteEvent(deletedEvent.ID))
+
+		reminders, err := r.GetReadyToSendEventReminders(t.Context())
+
+		require.NoError(t, err)
+		require.Len(t, reminders, 1)
+		assert.Equal(t, readyReminder.ID, reminders[0].ID)
+		require.NotNil(t, reminders[0].Event)
+		assert.Equal(t, event.ID, reminders[0].Event.ID)
+	})
+}
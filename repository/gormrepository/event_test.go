@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/testutil/random"
@@ -202,3 +203,87 @@ func TestCreateEvent(t *testing.T) {
 		// TODO: 具体的なエラー内容を確認するためのアサーションを追加する
 	})
 }
+
+func TestRepository_UpdateEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("定員を無くせる", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		capacity := random.PositiveIntN(t, 100)
+		event := model.Event{
+			Type:      model.EventTypeOfficial,
+			Name:      random.AlphaNumericString(t, 20),
+			TimeStart: random.Time(t),
+			CampID:    camp.ID,
+			Capacity:  &capacity,
+		}
+
+		require.NoError(t, r.CreateEvent(&event))
+
+		event.Capacity = nil
+
+		err := r.UpdateEvent(t.Context(), event.ID, &event)
+
+		require.NoError(t, err)
+
+		updated, err := r.GetEventByID(event.ID)
+
+		require.NoError(t, err)
+		assert.Nil(t, updated.Capacity)
+	})
+}
+
+func TestRepository_GetUserEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		timeStart := random.Time(t)
+		createEvent := func(eventType model.EventType, organizerID *string, offset time.Duration) model.Event {
+			event := model.Event{
+				Type:        eventType,
+				Name:        random.AlphaNumericString(t, 20),
+				TimeStart:   timeStart.Add(offset),
+				OrganizerID: organizerID,
+				CampID:      camp.ID,
+			}
+
+			require.NoError(t, r.CreateEvent(&event))
+
+			return event
+		}
+
+		official := createEvent(model.EventTypeOfficial, nil, 3*time.Hour)
+		organized := createEvent(model.EventTypeMoment, &user.ID, 2*time.Hour)
+		going := createEvent(model.EventTypeMoment, nil, time.Hour)
+		notGoing := createEvent(model.EventTypeMoment, nil, 0)
+		_ = createEvent(model.EventTypeMoment, nil, 0)
+
+		for eventID, status := range map[uint]model.EventAttendanceStatus{
+			going.ID:    model.EventAttendanceStatusGoing,
+			notGoing.ID: model.EventAttendanceStatusNotGoing,
+		} {
+			require.NoError(t, r.SaveEventAttendance(t.Context(), &model.EventAttendance{
+				EventID: eventID,
+				UserID:  user.ID,
+				Status:  status,
+			}))
+		}
+
+		events, err := r.GetUserEvents(t.Context(), camp.ID, user.ID)
+
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, going.ID, events[0].ID)
+		assert.Equal(t, organized.ID, events[1].ID)
+		assert.Equal(t, official.ID, events[2].ID)
+		assert.Len(t, events[0].Attendances, 1)
+	})
+}
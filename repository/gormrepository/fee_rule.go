@@ -0,0 +1,83 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+func (r *Repository) CreateFeeRule(ctx context.Context, feeRule *model.FeeRule) error {
+	if err := gorm.G[model.FeeRule](r.db).Create(ctx, feeRule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetFeeRules(ctx context.Context, campID uint) ([]model.FeeRule, error) {
+	feeRules, err := gorm.G[model.FeeRule](r.db).
+		Where("camp_id = ?", campID).
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return feeRules, nil
+}
+
+func (r *Repository) GetFeeRuleByID(ctx context.Context, feeRuleID uint) (*model.FeeRule, error) {
+	feeRule, err := gorm.G[model.FeeRule](r.db).
+		Where("id = ?", feeRuleID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrFeeRuleNotFound
+		}
+
+		return nil, err
+	}
+
+	return &feeRule, nil
+}
+
+func (r *Repository) UpdateFeeRule(
+	ctx context.Context,
+	feeRuleID uint,
+	feeRule *model.FeeRule,
+) error {
+	if _, err := r.GetFeeRuleByID(ctx, feeRuleID); err != nil {
+		return err
+	}
+
+	if _, err := gorm.G[*model.FeeRule](r.db).
+		Select("category", "description", "amount", "question_id", "option_id").
+		Where("id = ?", feeRuleID).
+		Updates(ctx, feeRule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteFeeRule(ctx context.Context, feeRuleID uint) error {
+	rowsAffected, err := gorm.G[model.FeeRule](r.db).
+		Where("id = ?", feeRuleID).
+		Delete(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrFeeRuleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package gormrepository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func mustCreateFeeRule(t *testing.T, r *Repository, campID uint) model.FeeRule {
+	t.Helper()
+
+	feeRule := model.FeeRule{
+		CampID:      campID,
+		Category:    model.PaymentLineItemCategoryLodging,
+		Description: random.AlphaNumericString(t, 20),
+		Amount:      random.PositiveInt(t),
+	}
+
+	err := r.CreateFeeRule(t.Context(), &feeRule)
+
+	require.NoError(t, err)
+
+	return feeRule
+}
+
+func TestRepository_GetFeeRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		feeRule1 := mustCreateFeeRule(t, r, camp.ID)
+		feeRule2 := mustCreateFeeRule(t, r, camp.ID)
+		_ = mustCreateFeeRule(t, r, otherCamp.ID)
+
+		feeRules, err := r.GetFeeRules(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		require.Len(t, feeRules, 2)
+		assert.Equal(t, feeRule1.ID, feeRules[0].ID)
+		assert.Equal(t, feeRule1.Amount, feeRules[0].Amount)
+		assert.Equal(t, feeRule2.ID, feeRules[1].ID)
+	})
+}
+
+func TestRepository_UpdateFeeRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+		feeRule := mustCreateFeeRule(t, r, camp.ID)
+		update := model.FeeRule{
+			Category:    model.PaymentLineItemCategoryBus,
+			Description: random.AlphaNumericString(t, 20),
+			Amount:      2000,
+			QuestionID:  &question.ID,
+			OptionID:    &question.Options[0].ID,
+		}
+
+		err := r.UpdateFeeRule(t.Context(), feeRule.ID, &update)
+
+		require.NoError(t, err)
+
+		got, err := r.GetFeeRuleByID(t.Context(), feeRule.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, camp.ID, got.CampID)
+		assert.Equal(t, update.Category, got.Category)
+		assert.Equal(t, update.Description, got.Description)
+		assert.Equal(t, update.Amount, got.Amount)
+		assert.Equal(t, update.QuestionID, got.QuestionID)
+		assert.Equal(t, update.OptionID, got.OptionID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.UpdateFeeRule(t.Context(), uint(random.PositiveInt(t)), &model.FeeRule{})
+
+		assert.ErrorIs(t, err, repository.ErrFeeRuleNotFound)
+	})
+}
+
+func TestRepository_DeleteFeeRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		feeRule := mustCreateFeeRule(t, r, camp.ID)
+
+		err := r.DeleteFeeRule(t.Context(), feeRule.ID)
+
+		require.NoError(t, err)
+
+		_, err = r.GetFeeRuleByID(t.Context(), feeRule.ID)
+
+		assert.ErrorIs(t, err, repository.ErrFeeRuleNotFound)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.DeleteFeeRule(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrFeeRuleNotFound)
+	})
+}
@@ -0,0 +1,192 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+func (r *Repository) CreateGuidebookRevision(
+	ctx context.Context,
+	revision *model.GuidebookRevision,
+) error {
+	if err := gorm.G[model.GuidebookRevision](r.db).Create(ctx, revision); err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return repository.ErrCampNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetGuidebookRevisions(
+	ctx context.Context,
+	campID uint,
+) ([]model.GuidebookRevision, error) {
+	revisions, err := gorm.G[model.GuidebookRevision](r.db).
+		Where("camp_id = ?", campID).
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (r *Repository) GetGuidebookRevisionByID(
+	ctx context.Context,
+	revisionID uint,
+) (*model.GuidebookRevision, error) {
+	revision, err := gorm.G[model.GuidebookRevision](r.db).
+		Where("id = ?", revisionID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrGuidebookRevisionNotFound
+		}
+
+		return nil, err
+	}
+
+	return &revision, nil
+}
+
+func (r *Repository) GetPreviousGuidebookRevision(
+	ctx context.Context,
+	revision *model.GuidebookRevision,
+) (*model.GuidebookRevision, error) {
+	previous, err := gorm.G[model.GuidebookRevision](r.db).
+		Where("camp_id = ?", revision.CampID).
+		Where("id < ?", revision.ID).
+		Order("id DESC").
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrGuidebookRevisionNotFound
+		}
+
+		return nil, err
+	}
+
+	return &previous, nil
+}
+
+func (r *Repository) DeleteGuidebookRevision(ctx context.Context, revisionID uint) error {
+	rowsAffected, err := gorm.G[model.GuidebookRevision](r.db).
+		Where("id = ?", revisionID).
+		Delete(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrGuidebookRevisionNotFound
+	}
+
+	return nil
+}
+
+// newerPublishedGuidebookRevision は同じ合宿で後に作成された版が公開済みであるかの条件
+const newerPublishedGuidebookRevision = "EXISTS (SELECT 1 FROM guidebook_revisions AS newer " +
+	"WHERE newer.camp_id = guidebook_revisions.camp_id AND newer.id > guidebook_revisions.id " +
+	"AND newer.published_at IS NOT NULL AND newer.deleted_at IS NULL)"
+
+func (r *Repository) PublishGuidebookRevision(
+	ctx context.Context,
+	revisionID uint,
+	publishedAt time.Time,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		revision, err := gorm.G[model.GuidebookRevision](
+			tx,
+			clause.Locking{Strength: clause.LockingStrengthUpdate},
+		).
+			Where("id = ?", revisionID).
+			First(ctx)
+
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrGuidebookRevisionNotFound
+			}
+
+			return err
+		}
+
+		if revision.IsPublished() {
+			return repository.ErrGuidebookRevisionAlreadyPublished
+		}
+
+		// 同じ合宿の版の公開が同時に行われた場合に古い版で上書きしないよう、合宿の行をロックする
+		if _, err := gorm.G[model.Camp](
+			tx,
+			clause.Locking{Strength: clause.LockingStrengthUpdate},
+		).
+			Where("id = ?", revision.CampID).
+			First(ctx); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrCampNotFound
+			}
+
+			return err
+		}
+
+		outdated, err := gorm.G[model.GuidebookRevision](tx).
+			Where("id = ?", revisionID).
+			Where(newerPublishedGuidebookRevision).
+			Count(ctx, "id")
+
+		if err != nil {
+			return err
+		}
+
+		if outdated > 0 {
+			return repository.ErrGuidebookRevisionOutdated
+		}
+
+		if _, err := gorm.G[model.GuidebookRevision](tx).
+			Where("id = ?", revisionID).
+			Update(ctx, "published_at", publishedAt); err != nil {
+			return err
+		}
+
+		if _, err := gorm.G[model.Camp](tx).
+			Where("id = ?", revision.CampID).
+			Update(ctx, "guidebook", revision.Content); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetDueGuidebookRevisions(
+	ctx context.Context,
+	now time.Time,
+) ([]model.GuidebookRevision, error) {
+	revisions, err := gorm.G[model.GuidebookRevision](r.db).
+		Where("published_at IS NULL").
+		Where("publish_at <= ?", now).
+		Where("NOT " + newerPublishedGuidebookRevision).
+		Order("publish_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
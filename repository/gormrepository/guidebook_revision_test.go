@@ -0,0 +1,234 @@
+package gormrepository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func mustCreateGuidebookRevision(
+	t *testing.T,
+	r *Repository,
+	campID uint,
+	publishAt *time.Time,
+) model.GuidebookRevision {
+	t.Helper()
+
+	revision := model.GuidebookRevision{
+		CampID:    campID,
+		Content:   random.AlphaNumericString(t, 100),
+		PublishAt: publishAt,
+	}
+
+	err := r.CreateGuidebookRevision(t.Context(), &revision)
+
+	require.NoError(t, err)
+
+	return revision
+}
+
+func TestRepository_GetGuidebookRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		first := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+		second := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+		_ = mustCreateGuidebookRevision(t, r, otherCamp.ID, nil)
+
+		revisions, err := r.GetGuidebookRevisions(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, first.ID, revisions[0].ID)
+		assert.Equal(t, second.ID, revisions[1].ID)
+		assert.Equal(t, second.Content, revisions[1].Content)
+	})
+}
+
+func TestRepository_GetPreviousGuidebookRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		first := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+		second := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+		third := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+
+		previous, err := r.GetPreviousGuidebookRevision(t.Context(), &third)
+
+		require.NoError(t, err)
+		assert.Equal(t, second.ID, previous.ID)
+
+		_, err = r.GetPreviousGuidebookRevision(t.Context(), &first)
+
+		assert.ErrorIs(t, err, repository.ErrGuidebookRevisionNotFound)
+	})
+}
+
+func TestRepository_PublishGuidebookRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		revision := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+		publishedAt := time.Now().Truncate(time.Second)
+
+		err := r.Transaction(t.Context(), func(tx repository.Repository) error {
+			return tx.PublishGuidebookRevision(t.Context(), revision.ID, publishedAt)
+		})
+
+		require.NoError(t, err)
+
+		published, err := r.GetGuidebookRevisionByID(t.Context(), revision.ID)
+
+		require.NoError(t, err)
+		require.NotNil(t, published.PublishedAt)
+		assert.WithinDuration(t, publishedAt, *published.PublishedAt, time.Second)
+
+		updatedCamp, err := r.GetCampByID(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, revision.Content, updatedCamp.Guidebook)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.PublishGuidebookRevision(t.Context(), uint(random.PositiveInt(t)), time.Now())
+
+		assert.ErrorIs(t, err, repository.ErrGuidebookRevisionNotFound)
+	})
+
+	t.Run("Already published", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		revision := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+
+		require.NoError(t, r.PublishGuidebookRevision(t.Context(), revision.ID, time.Now()))
+
+		err := r.PublishGuidebookRevision(t.Context(), revision.ID, time.Now())
+
+		assert.ErrorIs(t, err, repository.ErrGuidebookRevisionAlreadyPublished)
+	})
+
+	t.Run("Outdated", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		older := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+		newer := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+
+		require.NoError(t, r.PublishGuidebookRevision(t.Context(), newer.ID, time.Now()))
+
+		err := r.PublishGuidebookRevision(t.Context(), older.ID, time.Now())
+
+		assert.ErrorIs(t, err, repository.ErrGuidebookRevisionOutdated)
+
+		// 新しい版のしおりが残っている
+		updatedCamp, err := r.GetCampByID(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, newer.Content, updatedCamp.Guidebook)
+	})
+}
+
+func TestRepository_GetDueGuidebookRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		now := time.Now()
+		past := now.Add(-time.Hour)
+		earlier := now.Add(-2 * time.Hour)
+		future := now.Add(time.Hour)
+		published := mustCreateGuidebookRevision(t, r, camp.ID, &past)
+		due := mustCreateGuidebookRevision(t, r, camp.ID, &past)
+		dueEarlier := mustCreateGuidebookRevision(t, r, camp.ID, &earlier)
+		_ = mustCreateGuidebookRevision(t, r, camp.ID, &future)
+		_ = mustCreateGuidebookRevision(t, r, camp.ID, nil)
+
+		err := r.PublishGuidebookRevision(t.Context(), published.ID, now.Add(-time.Minute))
+
+		require.NoError(t, err)
+
+		revisions, err := r.GetDueGuidebookRevisions(t.Context(), now)
+
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, dueEarlier.ID, revisions[0].ID)
+		assert.Equal(t, due.ID, revisions[1].ID)
+	})
+
+	t.Run("後に作成された版が公開済みの版は含まない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		now := time.Now()
+		past := now.Add(-time.Hour)
+		_ = mustCreateGuidebookRevision(t, r, camp.ID, &past)
+		newer := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+
+		require.NoError(t, r.PublishGuidebookRevision(t.Context(), newer.ID, now))
+
+		revisions, err := r.GetDueGuidebookRevisions(t.Context(), now)
+
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+}
+
+func TestRepository_DeleteGuidebookRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		revision := mustCreateGuidebookRevision(t, r, camp.ID, nil)
+
+		err := r.DeleteGuidebookRevision(t.Context(), revision.ID)
+
+		require.NoError(t, err)
+
+		_, err = r.GetGuidebookRevisionByID(t.Context(), revision.ID)
+
+		assert.ErrorIs(t, err, repository.ErrGuidebookRevisionNotFound)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.DeleteGuidebookRevision(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrGuidebookRevisionNotFound)
+	})
+}
@@ -0,0 +1,48 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+func (r *Repository) GetImageByID(ctx context.Context, imageID uint) (*model.Image, error) {
+	image, err := gorm.G[model.Image](r.db).
+		Where("id = ?", imageID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrImageNotFound
+		}
+
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+func (r *Repository) CountCampImages(
+	ctx context.Context,
+	campID uint,
+	imageIDs []uint,
+) (int, error) {
+	if len(imageIDs) == 0 {
+		return 0, nil
+	}
+
+	count, err := gorm.G[model.Image](r.db).
+		Where("camp_id = ?", campID).
+		Where("id IN ?", imageIDs).
+		Count(ctx, "id")
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
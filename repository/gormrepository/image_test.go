@@ -0,0 +1,69 @@
+package gormrepository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func mustCreateImage(t *testing.T, r *Repository, campID uint) model.Image {
+	t.Helper()
+
+	image := model.Image{CampID: campID}
+
+	require.NoError(t, gorm.G[model.Image](r.db).Create(t.Context(), &image))
+
+	return image
+}
+
+func TestRepository_GetImageByID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		image := mustCreateImage(t, r, camp.ID)
+
+		got, err := r.GetImageByID(t.Context(), image.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, camp.ID, got.CampID)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetImageByID(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrImageNotFound)
+	})
+}
+
+func TestRepository_CountCampImages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		image := mustCreateImage(t, r, camp.ID)
+		otherImage := mustCreateImage(t, r, otherCamp.ID)
+
+		count, err := r.CountCampImages(t.Context(), camp.ID, []uint{image.ID, otherImage.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
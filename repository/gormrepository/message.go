@@ -25,6 +25,22 @@ func (r *Repository) CreateMessage(ctx context.Context, message *model.Message)
 	return nil
 }
 
+func (r *Repository) CreateMessages(ctx context.Context, messages *[]model.Message) error {
+	if len(*messages) == 0 {
+		return nil
+	}
+
+	if err := gorm.G[model.Message](r.db).CreateInBatches(ctx, messages, len(*messages)); err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return repository.ErrUserNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repository) GetReadyToSendMessages(ctx context.Context) ([]model.Message, error) {
 	messages, err := gorm.G[model.Message](r.db).
 		Where("sent_at IS NULL").
@@ -56,3 +72,23 @@ func (r *Repository) UpdateMessage(
 
 	return nil
 }
+
+func (r *Repository) CountUndeliveredMessages(ctx context.Context, campID uint) (int, error) {
+	var count int64
+
+	// Messageは合宿に紐づかないため、合宿の参加者宛てのメッセージを数える
+	if err := r.db.WithContext(ctx).
+		Model(&model.Message{}).
+		Where("sent_at IS NULL").
+		Where(
+			"EXISTS (SELECT 1 FROM camp_participants "+
+				"WHERE camp_participants.camp_id = ? "+
+				"AND camp_participants.user_id = messages.target_user_id)",
+			campID,
+		).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
@@ -75,6 +75,66 @@ func TestRepository_CreateMessage(t *testing.T) {
 	})
 }
 
+func TestRepository_CreateMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		messages := []model.Message{
+			{
+				TargetUserID: user1.ID,
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       time.Now().Add(-time.Minute),
+			},
+			{
+				TargetUserID: user2.ID,
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       time.Now().Add(-time.Minute),
+			},
+		}
+
+		require.NoError(t, r.CreateMessages(t.Context(), &messages))
+
+		for _, message := range messages {
+			assert.NotZero(t, message.ID)
+		}
+
+		readyMessages, err := r.GetReadyToSendMessages(t.Context())
+
+		require.NoError(t, err)
+		assert.Len(t, readyMessages, 2)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		assert.NoError(t, r.CreateMessages(t.Context(), &[]model.Message{}))
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		messages := []model.Message{
+			{
+				TargetUserID: random.AlphaNumericString(t, 32),
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       time.Now(),
+			},
+		}
+
+		err := r.CreateMessages(t.Context(), &messages)
+
+		assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	})
+}
+
 func TestRepository_GetReadyToSendMessages(t *testing.T) {
 	t.Parallel()
 
@@ -221,3 +281,50 @@ func TestRepository_UpdateMessage(t *testing.T) {
 		assert.ErrorIs(t, err, context.Canceled)
 	})
 }
+
+func TestRepository_CountUndeliveredMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		participant := mustCreateUser(t, r)
+		outsider := mustCreateUser(t, r)
+
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &participant))
+
+		sentAt := time.Now()
+		messages := []model.Message{
+			{
+				TargetUserID: participant.ID,
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       random.Time(t),
+			},
+			{
+				TargetUserID: participant.ID,
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       random.Time(t),
+			},
+			{
+				TargetUserID: participant.ID,
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       random.Time(t),
+				SentAt:       &sentAt,
+			},
+			{
+				TargetUserID: outsider.ID,
+				Content:      random.AlphaNumericString(t, 100),
+				SendAt:       random.Time(t),
+			},
+		}
+
+		require.NoError(t, r.CreateMessages(t.Context(), &messages))
+
+		count, err := r.CountUndeliveredMessages(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
@@ -1,16 +1,37 @@
 package gormrepository
 
 import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
 )
 
-func (r *Repository) CreateOption(option *model.Option) error {
-	if err := r.db.Create(option).Error; err != nil {
-		return err
-	}
+func (r *Repository) CreateOption(ctx context.Context, option *model.Option) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sortOrder, err := nextSortOrder(
+			ctx,
+			tx,
+			"questions",
+			"options",
+			"question_id",
+			option.QuestionID,
+		)
 
-	return nil
+		if err != nil {
+			return err
+		}
+
+		option.SortOrder = sortOrder
+
+		return tx.Create(option).Error
+	})
 }
 
 func (r *Repository) GetOptions(query *repository.GetOptionsQuery) ([]model.Option, error) {
@@ -22,9 +43,375 @@ func (r *Repository) GetOptions(query *repository.GetOptionsQuery) ([]model.Opti
 
 	var options []model.Option
 
-	if err := tx.Find(&options).Error; err != nil {
+	if err := tx.Scopes(activeOptionsScope).Find(&options).Error; err != nil {
 		return nil, err
 	}
 
 	return options, nil
 }
+
+func (r *Repository) ReorderOptions(
+	ctx context.Context,
+	questionID uint,
+	optionIDs []uint,
+) error {
+	if _, err := gorm.G[model.Question](r.db).
+		Where("id = ?", questionID).
+		First(ctx); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repository.ErrQuestionNotFound
+		}
+
+		return err
+	}
+
+	return r.reorder(ctx, "options", "question_id", questionID, optionIDs, notRemovedScope)
+}
+
+func (r *Repository) MergeOptions(
+	ctx context.Context,
+	questionID uint,
+	sourceOptionID uint,
+	targetOptionID uint,
+	editorID string,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &Repository{db: tx}
+
+		if _, err := gorm.G[model.Question](tx).
+			Where("id = ?", questionID).
+			First(ctx); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrQuestionNotFound
+			}
+
+			return err
+		}
+
+		var optionCount int64
+
+		if err := tx.Model(&model.Option{}).
+			Where("id IN ? AND question_id = ?", []uint{sourceOptionID, targetOptionID}, questionID).
+			Scopes(notRemovedScope).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Count(&optionCount).Error; err != nil {
+			return err
+		}
+
+		if optionCount != 2 {
+			return repository.ErrOptionNotFound
+		}
+
+		answerIDs, err := txRepo.answerIDsWithOption(ctx, sourceOptionID)
+
+		if err != nil {
+			return err
+		}
+
+		if err := txRepo.moveAnswerOptions(ctx, sourceOptionID, targetOptionID); err != nil {
+			return err
+		}
+
+		if err := txRepo.moveRankedOptions(ctx, sourceOptionID, targetOptionID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		if _, err := gorm.G[model.Option](tx).
+			Where("id = ?", sourceOptionID).
+			Update(ctx, "removed_at", &now); err != nil {
+			return err
+		}
+
+		if len(answerIDs) == 0 {
+			return nil
+		}
+
+		answers, err := gorm.G[model.Answer](tx).
+			Preload("SelectedOptions", orderBySortOrder).
+			Preload("RankedOptions", orderByPosition).
+			Preload("RankedOptions.Option", nil).
+			Where("id IN ?", answerIDs).
+			Find(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		return txRepo.createAnswerRevisions(ctx, editorID, answers...)
+	})
+}
+
+// syncOptions は質問の選択肢をoptionsに揃える
+// IDが0の選択肢は作成し、optionsに含まれない選択肢は質問から取り除いてその選択肢を選んだ回答に印を付ける
+func (r *Repository) syncOptions(
+	ctx context.Context,
+	questionID uint,
+	options []model.Option,
+) error {
+	existingOptions, err := gorm.G[model.Option](r.db).
+		Where("question_id = ?", questionID).
+		Find(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	existingOptionMap := make(map[uint]model.Option, len(existingOptions))
+
+	for _, option := range existingOptions {
+		existingOptionMap[option.ID] = option
+	}
+
+	setOptionSortOrders(options)
+
+	for i := range options {
+		options[i].QuestionID = questionID
+
+		if options[i].ID == 0 {
+			if err := gorm.G[model.Option](r.db).Create(ctx, &options[i]); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		existingOption, ok := existingOptionMap[options[i].ID]
+
+		if !ok {
+			return repository.ErrOptionNotFound
+		}
+
+		// 取り除いた選択肢を戻した場合は、その選択肢に対する回答の印も外す
+		if existingOption.RemovedAt != nil {
+			if _, err := gorm.G[model.AnswerFlag](r.db).
+				Where("option_id = ?", existingOption.ID).
+				Delete(ctx); err != nil {
+				return err
+			}
+		}
+
+		options[i].RemovedAt = nil
+
+		if _, err := gorm.G[model.Option](r.db).
+			Where("id = ?", options[i].ID).
+			Select("content", "sort_order", "removed_at").
+			Updates(ctx, options[i]); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+
+	for _, existingOption := range existingOptions {
+		if existingOption.RemovedAt != nil {
+			continue
+		}
+
+		if slices.ContainsFunc(options, func(option model.Option) bool {
+			return option.ID == existingOption.ID
+		}) {
+			continue
+		}
+
+		if _, err := gorm.G[model.Option](r.db).
+			Where("id = ?", existingOption.ID).
+			Update(ctx, "removed_at", &now); err != nil {
+			return err
+		}
+
+		if err := r.flagAnswersWithOption(ctx, existingOption.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flagAnswersWithOption は選択肢を選んだ回答に見直しが必要な印を付ける
+func (r *Repository) flagAnswersWithOption(ctx context.Context, optionID uint) error {
+	answerIDs, err := r.answerIDsWithOption(ctx, optionID)
+
+	if err != nil {
+		return err
+	}
+
+	if len(answerIDs) == 0 {
+		return nil
+	}
+
+	flags := make([]model.AnswerFlag, len(answerIDs))
+
+	for i, answerID := range answerIDs {
+		flags[i] = model.AnswerFlag{
+			AnswerID: answerID,
+			OptionID: optionID,
+		}
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(&flags, len(flags)).Error
+}
+
+// answerIDsWithOption は選択肢を選択または順位付けした回答のIDを取得する
+func (r *Repository) answerIDsWithOption(ctx context.Context, optionID uint) ([]uint, error) {
+	var selectedAnswerIDs []uint
+
+	if err := r.db.WithContext(ctx).
+		Table("answer_options").
+		Where("option_id = ?", optionID).
+		Pluck("answer_id", &selectedAnswerIDs).Error; err != nil {
+		return nil, err
+	}
+
+	var rankedAnswerIDs []uint
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.AnswerRankedOption{}).
+		Where("option_id = ?", optionID).
+		Pluck("answer_id", &rankedAnswerIDs).Error; err != nil {
+		return nil, err
+	}
+
+	answerIDs := slices.Concat(selectedAnswerIDs, rankedAnswerIDs)
+
+	slices.Sort(answerIDs)
+
+	return slices.Compact(answerIDs), nil
+}
+
+// moveAnswerOptions は選択肢を選んだ回答を別の選択肢に付け替える
+// 両方を選んでいた回答は付け替え先の選択肢だけを残す
+func (r *Repository) moveAnswerOptions(
+	ctx context.Context,
+	sourceOptionID uint,
+	targetOptionID uint,
+) error {
+	var answerIDsWithTarget []uint
+
+	if err := r.db.WithContext(ctx).
+		Table("answer_options").
+		Where("option_id = ?", targetOptionID).
+		Pluck("answer_id", &answerIDsWithTarget).Error; err != nil {
+		return err
+	}
+
+	if len(answerIDsWithTarget) > 0 {
+		if err := r.db.WithContext(ctx).
+			Exec(
+				"DELETE FROM answer_options WHERE option_id = ? AND answer_id IN ?",
+				sourceOptionID,
+				answerIDsWithTarget,
+			).Error; err != nil {
+			return err
+		}
+	}
+
+	return r.db.WithContext(ctx).
+		Table("answer_options").
+		Where("option_id = ?", sourceOptionID).
+		Update("option_id", targetOptionID).Error
+}
+
+// moveRankedOptions は順位付けの回答で選択肢を別の選択肢に付け替える
+// 両方を順位付けしていた回答は付け替え元の順位を削除し、残りの順位を詰める
+func (r *Repository) moveRankedOptions(
+	ctx context.Context,
+	sourceOptionID uint,
+	targetOptionID uint,
+) error {
+	var answerIDsWithTarget []uint
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.AnswerRankedOption{}).
+		Where("option_id = ?", targetOptionID).
+		Pluck("answer_id", &answerIDsWithTarget).Error; err != nil {
+		return err
+	}
+
+	if len(answerIDsWithTarget) > 0 {
+		if _, err := gorm.G[model.AnswerRankedOption](r.db).
+			Where("option_id = ? AND answer_id IN ?", sourceOptionID, answerIDsWithTarget).
+			Delete(ctx); err != nil {
+			return err
+		}
+
+		if err := r.renumberRankedOptions(ctx, answerIDsWithTarget); err != nil {
+			return err
+		}
+	}
+
+	if _, err := gorm.G[model.AnswerRankedOption](r.db).
+		Where("option_id = ?", sourceOptionID).
+		Update(ctx, "option_id", targetOptionID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// renumberRankedOptions は回答の順位を1から連続する番号に振り直す
+func (r *Repository) renumberRankedOptions(ctx context.Context, answerIDs []uint) error {
+	rankedOptions, err := gorm.G[model.AnswerRankedOption](r.db).
+		Where("answer_id IN ?", answerIDs).
+		Order("answer_id").
+		Order("position").
+		Find(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	var (
+		answerID uint
+		position int
+	)
+
+	// 順位の小さい方から詰めるため、変更先の順位は常に空いている
+	for _, rankedOption := range rankedOptions {
+		if rankedOption.AnswerID != answerID {
+			answerID = rankedOption.AnswerID
+			position = 0
+		}
+
+		position++
+
+		if rankedOption.Position == position {
+			continue
+		}
+
+		if _, err := gorm.G[model.AnswerRankedOption](r.db).
+			Where("answer_id = ? AND position = ?", rankedOption.AnswerID, rankedOption.Position).
+			Update(ctx, "position", position); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setOptionSortOrders は選択肢の表示順をスライスの順にする
+func setOptionSortOrders(options []model.Option) {
+	for i := range options {
+		options[i].SortOrder = i
+	}
+}
+
+// notRemovedScope は質問から取り除かれていない選択肢に絞り込む
+func notRemovedScope(db *gorm.DB) *gorm.DB {
+	return db.Where("removed_at IS NULL")
+}
+
+// activeOptionsScope は質問から取り除かれていない選択肢を表示順に並べる
+func activeOptionsScope(db *gorm.DB) *gorm.DB {
+	return db.Scopes(notRemovedScope).Order("sort_order").Order("id")
+}
+
+// orderActiveOptions は質問から取り除かれていない選択肢を表示順に読み込む
+func orderActiveOptions(db gorm.PreloadBuilder) error {
+	db.Where("removed_at IS NULL")
+
+	return orderBySortOrder(db)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
@@ -22,6 +23,7 @@ func (r *Repository) CreatePayment(ctx context.Context, payment *model.Payment)
 
 func (r *Repository) GetPayments(ctx context.Context, campID uint) ([]model.Payment, error) {
 	payments, err := gorm.G[model.Payment](r.db).
+		Preload("LineItems", orderByID).
 		Where("camp_id = ?", campID).
 		Find(ctx)
 	if err != nil {
@@ -37,6 +39,7 @@ func (r *Repository) GetPaymentByUserID(
 	userID string,
 ) (*model.Payment, error) {
 	payment, err := gorm.G[model.Payment](r.db).
+		Preload("LineItems", orderByID).
 		Where("camp_id = ?", campID).
 		Where("user_id = ?", userID).
 		First(ctx)
@@ -60,7 +63,7 @@ func (r *Repository) UpdatePayment(
 	payment.ID = paymentID
 
 	rowsAffected, err := gorm.G[*model.Payment](r.db).
-		Select("amount", "amount_paid").
+		Select("amount").
 		Where("id = ?", paymentID).
 		Updates(ctx, payment)
 
@@ -80,6 +83,7 @@ func (r *Repository) GetPaymentByID(
 	paymentID uint,
 ) (*model.Payment, error) {
 	payment, err := gorm.G[model.Payment](r.db).
+		Preload("LineItems", orderByID).
 		Where("id = ?", paymentID).
 		First(ctx)
 
@@ -93,3 +97,151 @@ func (r *Repository) GetPaymentByID(
 
 	return &payment, nil
 }
+
+func (r *Repository) ReplacePaymentLineItems(
+	ctx context.Context,
+	paymentID uint,
+	lineItems []model.PaymentLineItem,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		payment := model.Payment{LineItems: lineItems}
+
+		payment.Amount = payment.LineItemsTotal()
+
+		rowsAffected, err := gorm.G[model.Payment](tx).
+			Where("id = ?", paymentID).
+			Update(ctx, "amount", payment.Amount)
+
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			// 金額が変わらない場合も0になるため、存在確認を行う
+			if _, err := (&Repository{db: tx}).GetPaymentByID(ctx, paymentID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := gorm.G[model.PaymentLineItem](tx).
+			Where("payment_id = ?", paymentID).
+			Delete(ctx); err != nil {
+			return err
+		}
+
+		if len(lineItems) == 0 {
+			return nil
+		}
+
+		for i := range lineItems {
+			lineItems[i].ID = 0
+			lineItems[i].PaymentID = paymentID
+		}
+
+		return gorm.G[model.PaymentLineItem](tx).CreateInBatches(ctx, &lineItems, len(lineItems))
+	})
+}
+
+func (r *Repository) CreatePaymentTransaction(
+	ctx context.Context,
+	transaction *model.PaymentTransaction,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 同時に記録された場合に合計がずれないよう、支払いの行をロックする
+		if _, err := gorm.G[model.Payment](
+			tx,
+			clause.Locking{Strength: clause.LockingStrengthUpdate},
+		).
+			Where("id = ?", transaction.PaymentID).
+			First(ctx); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrPaymentNotFound
+			}
+
+			return err
+		}
+
+		if err := gorm.G[model.PaymentTransaction](tx).Create(ctx, transaction); err != nil {
+			return err
+		}
+
+		amountPaid := tx.Model(&model.PaymentTransaction{}).
+			Select("COALESCE(SUM(amount), 0)").
+			Where("payment_id = ?", transaction.PaymentID)
+
+		return tx.Model(&model.Payment{}).
+			Where("id = ?", transaction.PaymentID).
+			Update("amount_paid", amountPaid).Error
+	})
+}
+
+func (r *Repository) GetPaymentTransactions(
+	ctx context.Context,
+	paymentID uint,
+) ([]model.PaymentTransaction, error) {
+	transactions, err := gorm.G[model.PaymentTransaction](r.db).
+		Where("payment_id = ?", paymentID).
+		Order("received_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (r *Repository) GetCampPaymentTransactions(
+	ctx context.Context,
+	campID uint,
+) ([]model.PaymentTransaction, error) {
+	transactions, err := gorm.G[model.PaymentTransaction](r.db).
+		Where(
+			"payment_id IN (?)",
+			r.db.Model(&model.Payment{}).Select("id").Where("camp_id = ?", campID),
+		).
+		Order("received_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// orderByID は作成順に読み込む
+func orderByID(db gorm.PreloadBuilder) error {
+	db.Order("id")
+
+	return nil
+}
+
+func (r *Repository) GetPaymentCollection(
+	ctx context.Context,
+	campID uint,
+) (*repository.PaymentCollection, error) {
+	var collection repository.PaymentCollection
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.Payment{}).
+		Select(
+			"COUNT(*) AS payment_count, "+
+				"COALESCE(SUM(amount_paid >= amount), 0) AS paid_count, "+
+				"COALESCE(SUM(amount), 0) AS total_amount, "+
+				"COALESCE(SUM(amount_paid), 0) AS total_amount_paid",
+		).
+		Where("camp_id = ?", campID).
+		Where(
+			"EXISTS (SELECT 1 FROM camp_participants " +
+				"WHERE camp_participants.camp_id = payments.camp_id " +
+				"AND camp_participants.user_id = payments.user_id)",
+		).
+		Scan(&collection).Error; err != nil {
+		return nil, err
+	}
+
+	return &collection, nil
+}
@@ -2,6 +2,7 @@ package gormrepository
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -201,7 +202,8 @@ func TestRepository_UpdatePayment(t *testing.T) {
 
 		require.NotNil(t, foundPayment, "updated payment should be found")
 		assert.Equal(t, updatedAmount, foundPayment.Amount)
-		assert.Equal(t, updatedAmountPaid, foundPayment.AmountPaid)
+		// 支払済み金額は入出金の記録からのみ変更される
+		assert.Equal(t, originalPayment.AmountPaid, foundPayment.AmountPaid)
 		assert.Equal(t, user.ID, foundPayment.UserID)
 		assert.Equal(t, camp.ID, foundPayment.CampID)
 	})
@@ -237,7 +239,7 @@ func TestRepository_UpdatePayment(t *testing.T) {
 		foundPayment := payments[0]
 
 		assert.Equal(t, updatePayment.Amount, foundPayment.Amount)
-		assert.Equal(t, updatePayment.AmountPaid, foundPayment.AmountPaid)
+		assert.Equal(t, originalPayment.AmountPaid, foundPayment.AmountPaid)
 		assert.Equal(t, user.ID, foundPayment.UserID)
 		assert.Equal(t, camp.ID, foundPayment.CampID)
 	})
@@ -263,3 +265,274 @@ func TestRepository_UpdatePayment(t *testing.T) {
 		assert.ErrorIs(t, err, repository.ErrPaymentNotFound)
 	})
 }
+
+func TestRepository_ReplacePaymentLineItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		payment := mustCreatePayment(t, r, user.ID, camp.ID)
+
+		err := r.ReplacePaymentLineItems(t.Context(), payment.ID, []model.PaymentLineItem{
+			{
+				Category:    model.PaymentLineItemCategoryLodging,
+				Description: random.AlphaNumericString(t, 20),
+				Amount:      10000,
+			},
+		})
+
+		require.NoError(t, err)
+
+		lineItems := []model.PaymentLineItem{
+			{
+				Category:    model.PaymentLineItemCategoryMeal,
+				Description: random.AlphaNumericString(t, 20),
+				Amount:      3000,
+			},
+			{
+				Category:    model.PaymentLineItemCategoryDiscount,
+				Description: random.AlphaNumericString(t, 20),
+				Amount:      -1000,
+			},
+		}
+
+		err = r.ReplacePaymentLineItems(t.Context(), payment.ID, lineItems)
+
+		require.NoError(t, err)
+
+		got, err := r.GetPaymentByID(t.Context(), payment.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2000, got.Amount)
+		assert.Equal(t, payment.AmountPaid, got.AmountPaid)
+		require.Len(t, got.LineItems, 2)
+
+		for i, lineItem := range got.LineItems {
+			assert.Equal(t, payment.ID, lineItem.PaymentID)
+			assert.Equal(t, lineItems[i].Category, lineItem.Category)
+			assert.Equal(t, lineItems[i].Description, lineItem.Description)
+			assert.Equal(t, lineItems[i].Amount, lineItem.Amount)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.ReplacePaymentLineItems(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			[]model.PaymentLineItem{},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrPaymentNotFound)
+	})
+}
+
+func TestRepository_CreatePaymentTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		staff := mustCreateUser(t, r)
+		payment := model.Payment{
+			Amount: 10000,
+			UserID: user.ID,
+			CampID: camp.ID,
+		}
+
+		require.NoError(t, r.CreatePayment(t.Context(), &payment))
+
+		receivedAt := random.Time(t)
+		transactions := []model.PaymentTransaction{
+			{
+				PaymentID:    payment.ID,
+				Amount:       8000,
+				Method:       model.PaymentMethodCash,
+				ReceivedByID: &staff.ID,
+				ReceivedAt:   receivedAt.Add(time.Hour),
+				Memo:         random.AlphaNumericString(t, 20),
+			},
+			{
+				PaymentID:    payment.ID,
+				Amount:       -3000,
+				Method:       model.PaymentMethodBankTransfer,
+				ReceivedByID: &staff.ID,
+				ReceivedAt:   receivedAt,
+			},
+		}
+
+		for i := range transactions {
+			err := r.CreatePaymentTransaction(t.Context(), &transactions[i])
+
+			require.NoError(t, err)
+			assert.NotZero(t, transactions[i].ID)
+		}
+
+		got, err := r.GetPaymentByID(t.Context(), payment.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5000, got.AmountPaid)
+		assert.Equal(t, payment.Amount, got.Amount)
+
+		gotTransactions, err := r.GetPaymentTransactions(t.Context(), payment.ID)
+
+		require.NoError(t, err)
+		require.Len(t, gotTransactions, 2)
+		// 受け取った日時の古い順に並ぶ
+		assert.Equal(t, transactions[1].ID, gotTransactions[0].ID)
+		assert.Equal(t, transactions[0].ID, gotTransactions[1].ID)
+		assert.Equal(t, transactions[0].Amount, gotTransactions[1].Amount)
+		assert.Equal(t, transactions[0].Method, gotTransactions[1].Method)
+		assert.Equal(t, &staff.ID, gotTransactions[1].ReceivedByID)
+		assert.WithinDuration(t, transactions[0].ReceivedAt, gotTransactions[1].ReceivedAt, time.Second)
+		assert.Equal(t, transactions[0].Memo, gotTransactions[1].Memo)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.CreatePaymentTransaction(t.Context(), &model.PaymentTransaction{
+			PaymentID:  uint(random.PositiveInt(t)),
+			Amount:     random.PositiveInt(t),
+			Method:     model.PaymentMethodCash,
+			ReceivedAt: random.Time(t),
+		})
+
+		assert.ErrorIs(t, err, repository.ErrPaymentNotFound)
+	})
+}
+
+func TestRepository_GetCampPaymentTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		receivedAt := random.Time(t)
+		payments := []model.Payment{
+			{Amount: 10000, UserID: mustCreateUser(t, r).ID, CampID: camp.ID},
+			{Amount: 10000, UserID: mustCreateUser(t, r).ID, CampID: camp.ID},
+			{Amount: 10000, UserID: mustCreateUser(t, r).ID, CampID: otherCamp.ID},
+		}
+
+		for i := range payments {
+			require.NoError(t, r.CreatePayment(t.Context(), &payments[i]))
+		}
+
+		transactions := []model.PaymentTransaction{
+			{
+				PaymentID:  payments[0].ID,
+				Amount:     3000,
+				Method:     model.PaymentMethodCash,
+				ReceivedAt: receivedAt.Add(time.Hour),
+			},
+			{
+				PaymentID:  payments[1].ID,
+				Amount:     10000,
+				Method:     model.PaymentMethodBankTransfer,
+				ReceivedAt: receivedAt,
+			},
+			{
+				PaymentID:  payments[2].ID,
+				Amount:     10000,
+				Method:     model.PaymentMethodCash,
+				ReceivedAt: receivedAt,
+			},
+		}
+
+		for i := range transactions {
+			require.NoError(t, r.CreatePaymentTransaction(t.Context(), &transactions[i]))
+		}
+
+		got, err := r.GetCampPaymentTransactions(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		// 受け取った日時の古い順に並び、他の合宿の記録は含まない
+		assert.Equal(t, transactions[1].ID, got[0].ID)
+		assert.Equal(t, transactions[0].ID, got[1].ID)
+		assert.Equal(t, transactions[0].PaymentID, got[1].PaymentID)
+		assert.Equal(t, transactions[0].Method, got[1].Method)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+
+		got, err := r.GetCampPaymentTransactions(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestRepository_GetPaymentCollection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		paidUser := mustCreateUser(t, r)
+		unpaidUser := mustCreateUser(t, r)
+		formerUser := mustCreateUser(t, r)
+
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &paidUser))
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &unpaidUser))
+
+		require.NoError(t, r.CreatePayment(t.Context(), &model.Payment{
+			Amount:     10000,
+			AmountPaid: 10000,
+			UserID:     paidUser.ID,
+			CampID:     camp.ID,
+		}))
+		require.NoError(t, r.CreatePayment(t.Context(), &model.Payment{
+			Amount:     8000,
+			AmountPaid: 3000,
+			UserID:     unpaidUser.ID,
+			CampID:     camp.ID,
+		}))
+		// 参加を取り消したユーザーの支払いは数えない
+		_ = mustCreatePayment(t, r, formerUser.ID, camp.ID)
+
+		collection, err := r.GetPaymentCollection(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &repository.PaymentCollection{
+			PaymentCount:    2,
+			PaidCount:       1,
+			TotalAmount:     18000,
+			TotalAmountPaid: 13000,
+		}, collection)
+	})
+
+	t.Run("No payments", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+
+		collection, err := r.GetPaymentCollection(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &repository.PaymentCollection{}, collection)
+	})
+}
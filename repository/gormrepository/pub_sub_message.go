@@ -0,0 +1,48 @@
+package gormrepository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+func (r *Repository) CreatePubSubMessage(ctx context.Context, message *model.PubSubMessage) error {
+	return gorm.G[model.PubSubMessage](r.db).Create(ctx, message)
+}
+
+func (r *Repository) GetLatestPubSubMessageID(ctx context.Context) (uint, error) {
+	var latestID uint
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.PubSubMessage{}).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&latestID).Error; err != nil {
+		return 0, err
+	}
+
+	return latestID, nil
+}
+
+func (r *Repository) GetPubSubMessages(
+	ctx context.Context,
+	afterID uint,
+	ids []uint,
+) ([]model.PubSubMessage, error) {
+	query := gorm.G[model.PubSubMessage](r.db).Where("id > ?", afterID)
+
+	// 遅れてコミットされる可能性がある欠番も取得する
+	if len(ids) > 0 {
+		query = query.Or("id IN ?", ids)
+	}
+
+	return query.Order("id").Find(ctx)
+}
+
+func (r *Repository) DeletePubSubMessagesBefore(ctx context.Context, before time.Time) error {
+	_, err := gorm.G[model.PubSubMessage](r.db).Where("created_at < ?", before).Delete(ctx)
+
+	return err
+}
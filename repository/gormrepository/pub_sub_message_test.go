@@ -0,0 +1,121 @@
+package gormrepository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func mustCreatePubSubMessage(t *testing.T, r *Repository) model.PubSubMessage {
+	t.Helper()
+
+	message := model.PubSubMessage{
+		Channel: random.AlphaNumericString(t, 10),
+		Payload: `{"value":"` + random.AlphaNumericString(t, 20) + `"}`,
+	}
+
+	require.NoError(t, r.CreatePubSubMessage(t.Context(), &message))
+
+	return message
+}
+
+func TestRepository_GetLatestPubSubMessageID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		mustCreatePubSubMessage(t, r)
+		latest := mustCreatePubSubMessage(t, r)
+
+		latestID, err := r.GetLatestPubSubMessageID(t.Context())
+
+		assert.NoError(t, err)
+		assert.Equal(t, latest.ID, latestID)
+	})
+
+	t.Run("No messages", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		latestID, err := r.GetLatestPubSubMessageID(t.Context())
+
+		assert.NoError(t, err)
+		assert.Zero(t, latestID)
+	})
+}
+
+func TestRepository_GetPubSubMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		message1 := mustCreatePubSubMessage(t, r)
+		message2 := mustCreatePubSubMessage(t, r)
+		message3 := mustCreatePubSubMessage(t, r)
+		message4 := mustCreatePubSubMessage(t, r)
+
+		messages, err := r.GetPubSubMessages(t.Context(), message2.ID, []uint{message1.ID})
+
+		require.NoError(t, err)
+		require.Len(t, messages, 3)
+		assert.Equal(t, message1.ID, messages[0].ID)
+		assert.Equal(t, message3.ID, messages[1].ID)
+		assert.Equal(t, message4.ID, messages[2].ID)
+		assert.Equal(t, message1.Channel, messages[0].Channel)
+		assert.Equal(t, message1.Payload, messages[0].Payload)
+	})
+
+	t.Run("Without ids", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		message1 := mustCreatePubSubMessage(t, r)
+		message2 := mustCreatePubSubMessage(t, r)
+
+		messages, err := r.GetPubSubMessages(t.Context(), message1.ID, nil)
+
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, message2.ID, messages[0].ID)
+	})
+}
+
+func TestRepository_DeletePubSubMessagesBefore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		message := mustCreatePubSubMessage(t, r)
+
+		err := r.DeletePubSubMessagesBefore(t.Context(), message.CreatedAt.Add(-time.Minute))
+
+		require.NoError(t, err)
+
+		messages, err := r.GetPubSubMessages(t.Context(), 0, nil)
+
+		require.NoError(t, err)
+		assert.Len(t, messages, 1)
+
+		err = r.DeletePubSubMessagesBefore(t.Context(), message.CreatedAt.Add(time.Minute))
+
+		require.NoError(t, err)
+
+		messages, err = r.GetPubSubMessages(t.Context(), 0, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+}
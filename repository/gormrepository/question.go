@@ -2,24 +2,45 @@ package gormrepository
 
 import (
 	"context"
+	"errors"
 
 	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
 )
 
-func (r *Repository) CreateQuestion(question *model.Question) error {
-	if err := r.db.Create(question).Error; err != nil {
-		return err
-	}
+func (r *Repository) CreateQuestion(ctx context.Context, question *model.Question) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sortOrder, err := nextSortOrder(
+			ctx,
+			tx,
+			"question_groups",
+			"questions",
+			"question_group_id",
+			question.QuestionGroupID,
+		)
 
-	return nil
+		if err != nil {
+			return err
+		}
+
+		question.SortOrder = sortOrder
+
+		setOptionSortOrders(question.Options)
+
+		return tx.Create(question).Error
+	})
 }
 
 func (r *Repository) GetQuestions() ([]model.Question, error) {
 	var questions []model.Question
 
-	if err := r.db.Preload("Options").Find(&questions).Error; err != nil {
+	if err := r.db.
+		Preload("Options", activeOptionsScope).
+		Order("sort_order").
+		Order("id").
+		Find(&questions).Error; err != nil {
 		return nil, err
 	}
 
@@ -29,7 +50,9 @@ func (r *Repository) GetQuestions() ([]model.Question, error) {
 func (r *Repository) GetQuestionByID(id uint) (*model.Question, error) {
 	var question model.Question
 
-	if err := r.db.Preload("Options").First(&question, id).Error; err != nil {
+	if err := r.db.
+		Preload("Options", activeOptionsScope).
+		First(&question, id).Error; err != nil {
 		return nil, err
 	}
 
@@ -51,20 +74,67 @@ func (r *Repository) UpdateQuestion(
 ) error {
 	question.ID = questionID
 
-	if err := r.db.WithContext(ctx).
-		Session(&gorm.Session{FullSaveAssociations: true}).
-		Select(
-			"type",
-			"title",
-			"description",
-			"is_public",
-			"is_open",
-			"is_required",
-			"Options",
-		).
-		Updates(question).Error; err != nil {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Select(
+				"type",
+				"title",
+				"description",
+				"is_public",
+				"is_open",
+				"is_required",
+				"scale_min",
+				"scale_max",
+				"scale_min_label",
+				"scale_max_label",
+			).
+			Updates(question).Error; err != nil {
+			return err
+		}
+
+		return (&Repository{db: tx}).syncOptions(ctx, questionID, question.Options)
+	})
+}
+
+func (r *Repository) GetQuestionCampID(ctx context.Context, questionID uint) (uint, error) {
+	type campResult struct {
+		CampID uint `gorm:"column:camp_id"`
+	}
+
+	var result campResult
+
+	err := r.db.WithContext(ctx).
+		Table("questions").
+		Select("question_groups.camp_id").
+		Joins("JOIN question_groups ON question_groups.id = questions.question_group_id").
+		Where("questions.id = ?", questionID).
+		Take(&result).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, repository.ErrQuestionNotFound
+		}
+
+		return 0, err
+	}
+
+	return result.CampID, nil
+}
+
+func (r *Repository) ReorderQuestions(
+	ctx context.Context,
+	questionGroupID uint,
+	questionIDs []uint,
+) error {
+	if _, err := gorm.G[model.QuestionGroup](r.db).
+		Where("id = ?", questionGroupID).
+		First(ctx); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ErrNotFound
+		}
+
 		return err
 	}
 
-	return nil
+	return r.reorder(ctx, "questions", "question_group_id", questionGroupID, questionIDs)
 }
@@ -10,15 +10,41 @@ import (
 	"github.com/traPtitech/rucQ/repository"
 )
 
-func (r *Repository) CreateQuestionGroup(questionGroup *model.QuestionGroup) error {
-	if err := r.db.Create(questionGroup).Error; err != nil {
-		if errors.Is(err, gorm.ErrForeignKeyViolated) {
-			return repository.ErrCampNotFound
+func (r *Repository) CreateQuestionGroup(
+	ctx context.Context,
+	questionGroup *model.QuestionGroup,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sortOrder, err := nextSortOrder(
+			ctx,
+			tx,
+			"camps",
+			"question_groups",
+			"camp_id",
+			questionGroup.CampID,
+		)
+
+		if err != nil {
+			return err
 		}
-		return err
-	}
 
-	return nil
+		questionGroup.SortOrder = sortOrder
+
+		for i := range questionGroup.Questions {
+			questionGroup.Questions[i].SortOrder = i
+
+			setOptionSortOrders(questionGroup.Questions[i].Options)
+		}
+
+		if err := tx.Create(questionGroup).Error; err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return repository.ErrCampNotFound
+			}
+			return err
+		}
+
+		return nil
+	})
 }
 
 func (r *Repository) GetQuestionGroups(
@@ -26,8 +52,11 @@ func (r *Repository) GetQuestionGroups(
 	campID uint,
 ) ([]model.QuestionGroup, error) {
 	questionGroups, err := gorm.G[model.QuestionGroup](r.db).
-		Preload("Questions.Options", nil).
+		Preload("Questions", orderBySortOrder).
+		Preload("Questions.Options", orderActiveOptions).
 		Where("camp_id = ?", campID).
+		Order("sort_order").
+		Order("id").
 		Find(ctx)
 
 	if err != nil {
@@ -52,7 +81,8 @@ func (r *Repository) GetQuestionGroups(
 
 func (r *Repository) GetQuestionGroup(ctx context.Context, ID uint) (*model.QuestionGroup, error) {
 	questionGroup, err := gorm.G[model.QuestionGroup](r.db).
-		Preload("Questions.Options", nil).
+		Preload("Questions", orderBySortOrder).
+		Preload("Questions.Options", orderActiveOptions).
 		Where("id = ?", ID).
 		First(ctx)
 
@@ -92,3 +122,93 @@ func (r *Repository) DeleteQuestionGroup(ID uint) error {
 
 	return nil
 }
+
+func (r *Repository) ReorderQuestionGroups(
+	ctx context.Context,
+	campID uint,
+	questionGroupIDs []uint,
+) error {
+	campExists, err := r.campExists(ctx, campID)
+
+	if err != nil {
+		return err
+	}
+
+	if !campExists {
+		return repository.ErrCampNotFound
+	}
+
+	return r.reorder(ctx, "question_groups", "camp_id", campID, questionGroupIDs)
+}
+
+// unansweredRequiredQuestion はユーザーが回答していない必須の質問の条件
+const unansweredRequiredQuestion = "questions.is_required = ? AND NOT EXISTS (" +
+	"SELECT 1 FROM answers WHERE answers.question_id = questions.id " +
+	"AND answers.user_id = ? AND answers.deleted_at IS NULL)"
+
+func (r *Repository) GetUnansweredRequiredQuestionGroups(
+	ctx context.Context,
+	campID uint,
+	userID string,
+) ([]model.QuestionGroup, error) {
+	questionGroups, err := gorm.G[model.QuestionGroup](r.db).
+		Preload("Questions", func(db gorm.PreloadBuilder) error {
+			db.Where(unansweredRequiredQuestion, true, userID)
+
+			return orderBySortOrder(db)
+		}).
+		Where("camp_id = ?", campID).
+		Where(
+			"EXISTS (SELECT 1 FROM questions WHERE questions.question_group_id = question_groups.id "+
+				"AND questions.deleted_at IS NULL AND "+unansweredRequiredQuestion+")",
+			true,
+			userID,
+		).
+		Order("due").
+		Order("sort_order").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return questionGroups, nil
+}
+
+func (r *Repository) GetQuestionGroupCompletions(
+	ctx context.Context,
+	campID uint,
+) ([]repository.QuestionGroupCompletion, error) {
+	var completions []repository.QuestionGroupCompletion
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.QuestionGroup{}).
+		Select(
+			"question_groups.id AS question_group_id, question_groups.name, question_groups.due, "+
+				"(SELECT COUNT(*) FROM questions "+
+				"WHERE questions.question_group_id = question_groups.id "+
+				"AND questions.is_required = ? AND questions.deleted_at IS NULL) "+
+				"AS required_question_count, "+
+				"(SELECT COUNT(*) FROM camp_participants "+
+				"WHERE camp_participants.camp_id = question_groups.camp_id "+
+				"AND NOT EXISTS (SELECT 1 FROM questions "+
+				"WHERE questions.question_group_id = question_groups.id "+
+				"AND questions.deleted_at IS NULL AND "+
+				// unansweredRequiredQuestionのユーザーを参加者に置き換えたもの
+				"questions.is_required = ? AND NOT EXISTS ("+
+				"SELECT 1 FROM answers WHERE answers.question_id = questions.id "+
+				"AND answers.user_id = camp_participants.user_id "+
+				"AND answers.deleted_at IS NULL))) AS completed_count",
+			true,
+			true,
+		).
+		Where("question_groups.camp_id = ?", campID).
+		Order("question_groups.sort_order").
+		Order("question_groups.id").
+		Scan(&completions).Error; err != nil {
+		return nil, err
+	}
+
+	return completions, nil
+}
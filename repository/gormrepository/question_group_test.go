@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
@@ -134,7 +135,7 @@ func TestCreateQuestionGroup(t *testing.T) {
 			CampID:      camp.ID,
 		}
 
-		err := r.CreateQuestionGroup(&questionGroup)
+		err := r.CreateQuestionGroup(t.Context(), &questionGroup)
 
 		assert.NoError(t, err)
 
@@ -183,7 +184,7 @@ func TestCreateQuestionGroup(t *testing.T) {
 			CampID: uint(random.PositiveInt(t)),
 		}
 
-		err := r.CreateQuestionGroup(&questionGroup)
+		err := r.CreateQuestionGroup(t.Context(), &questionGroup)
 
 		assert.ErrorIs(t, err, repository.ErrCampNotFound)
 	})
@@ -270,3 +271,253 @@ func TestUpdateQuestionGroup(t *testing.T) {
 		assert.Equal(t, camp.ID, updatedQuestionGroup.CampID)
 	})
 }
+
+func TestReorderQuestionGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup1 := mustCreateQuestionGroup(t, r, camp.ID)
+		questionGroup2 := mustCreateQuestionGroup(t, r, camp.ID)
+		questionGroup3 := mustCreateQuestionGroup(t, r, camp.ID)
+
+		err := r.ReorderQuestionGroups(
+			t.Context(),
+			camp.ID,
+			[]uint{questionGroup3.ID, questionGroup1.ID, questionGroup2.ID},
+		)
+
+		assert.NoError(t, err)
+
+		result, err := r.GetQuestionGroups(t.Context(), camp.ID)
+
+		if assert.NoError(t, err) && assert.Len(t, result, 3) {
+			assert.Equal(t, questionGroup3.ID, result[0].ID)
+			assert.Equal(t, questionGroup1.ID, result[1].ID)
+			assert.Equal(t, questionGroup2.ID, result[2].ID)
+		}
+	})
+
+	t.Run("Missing ID", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup1 := mustCreateQuestionGroup(t, r, camp.ID)
+		questionGroup2 := mustCreateQuestionGroup(t, r, camp.ID)
+
+		err := r.ReorderQuestionGroups(t.Context(), camp.ID, []uint{questionGroup2.ID})
+
+		assert.ErrorIs(t, err, repository.ErrInvalidSortOrder)
+
+		// 失敗した場合は並び順が変わらない
+		result, err := r.GetQuestionGroups(t.Context(), camp.ID)
+
+		if assert.NoError(t, err) && assert.Len(t, result, 2) {
+			assert.Equal(t, questionGroup1.ID, result[0].ID)
+			assert.Equal(t, questionGroup2.ID, result[1].ID)
+		}
+	})
+
+	t.Run("Deleted question group", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup1 := mustCreateQuestionGroup(t, r, camp.ID)
+		questionGroup2 := mustCreateQuestionGroup(t, r, camp.ID)
+		questionGroup3 := mustCreateQuestionGroup(t, r, camp.ID)
+
+		require.NoError(t, r.DeleteQuestionGroup(questionGroup2.ID))
+
+		// 削除された質問グループは並べ替えの対象に含めない
+		err := r.ReorderQuestionGroups(
+			t.Context(),
+			camp.ID,
+			[]uint{questionGroup3.ID, questionGroup1.ID},
+		)
+
+		assert.NoError(t, err)
+
+		result, err := r.GetQuestionGroups(t.Context(), camp.ID)
+
+		if assert.NoError(t, err) && assert.Len(t, result, 2) {
+			assert.Equal(t, questionGroup3.ID, result[0].ID)
+			assert.Equal(t, questionGroup1.ID, result[1].ID)
+		}
+	})
+
+	t.Run("Duplicated ID", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup1 := mustCreateQuestionGroup(t, r, camp.ID)
+		_ = mustCreateQuestionGroup(t, r, camp.ID)
+
+		err := r.ReorderQuestionGroups(
+			t.Context(),
+			camp.ID,
+			[]uint{questionGroup1.ID, questionGroup1.ID},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrInvalidSortOrder)
+	})
+
+	t.Run("Other camp's question group", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		otherQuestionGroup := mustCreateQuestionGroup(t, r, otherCamp.ID)
+
+		err := r.ReorderQuestionGroups(
+			t.Context(),
+			camp.ID,
+			[]uint{otherQuestionGroup.ID, questionGroup.ID},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrInvalidSortOrder)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.ReorderQuestionGroups(t.Context(), uint(random.PositiveInt(t)), []uint{})
+
+		assert.ErrorIs(t, err, repository.ErrCampNotFound)
+	})
+}
+
+func TestRepository_GetUnansweredRequiredQuestionGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		answeredGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		_ = mustCreateQuestionGroup(t, r, camp.ID)
+
+		createQuestion := func(questionGroupID uint, isRequired bool) model.Question {
+			question := model.Question{
+				Type:            model.FreeTextQuestion,
+				Title:           random.AlphaNumericString(t, 20),
+				IsRequired:      isRequired,
+				QuestionGroupID: questionGroupID,
+			}
+
+			require.NoError(t, r.CreateQuestion(t.Context(), &question))
+
+			return question
+		}
+		answer := func(questionID uint) {
+			content := random.AlphaNumericString(t, 20)
+
+			require.NoError(t, r.CreateAnswer(t.Context(), &model.Answer{
+				QuestionID:      questionID,
+				UserID:          user.ID,
+				Type:            model.FreeTextQuestion,
+				FreeTextContent: &content,
+			}, user.ID))
+		}
+
+		unanswered := createQuestion(questionGroup.ID, true)
+		answer(createQuestion(questionGroup.ID, true).ID)
+		_ = createQuestion(questionGroup.ID, false)
+		answer(createQuestion(answeredGroup.ID, true).ID)
+
+		questionGroups, err := r.GetUnansweredRequiredQuestionGroups(t.Context(), camp.ID, user.ID)
+
+		require.NoError(t, err)
+		require.Len(t, questionGroups, 1)
+		assert.Equal(t, questionGroup.ID, questionGroups[0].ID)
+		require.Len(t, questionGroups[0].Questions, 1)
+		assert.Equal(t, unanswered.ID, questionGroups[0].Questions[0].ID)
+
+		// 他のユーザーはまだ回答していない
+		otherUser := mustCreateUser(t, r)
+
+		questionGroups, err = r.GetUnansweredRequiredQuestionGroups(
+			t.Context(),
+			camp.ID,
+			otherUser.ID,
+		)
+
+		require.NoError(t, err)
+		assert.Len(t, questionGroups, 2)
+	})
+}
+
+func TestRepository_GetQuestionGroupCompletions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		optionalGroup := mustCreateQuestionGroup(t, r, camp.ID)
+
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &user1))
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &user2))
+
+		createQuestion := func(questionGroupID uint, isRequired bool) model.Question {
+			question := model.Question{
+				Type:            model.FreeTextQuestion,
+				Title:           random.AlphaNumericString(t, 20),
+				IsRequired:      isRequired,
+				QuestionGroupID: questionGroupID,
+			}
+
+			require.NoError(t, r.CreateQuestion(t.Context(), &question))
+
+			return question
+		}
+		answer := func(questionID uint, userID string) {
+			content := random.AlphaNumericString(t, 20)
+
+			require.NoError(t, r.CreateAnswer(t.Context(), &model.Answer{
+				QuestionID:      questionID,
+				UserID:          userID,
+				Type:            model.FreeTextQuestion,
+				FreeTextContent: &content,
+			}, userID))
+		}
+
+		question1 := createQuestion(questionGroup.ID, true)
+		question2 := createQuestion(questionGroup.ID, true)
+		_ = createQuestion(questionGroup.ID, false)
+		_ = createQuestion(optionalGroup.ID, false)
+
+		answer(question1.ID, user1.ID)
+		answer(question2.ID, user1.ID)
+		answer(question1.ID, user2.ID)
+
+		completions, err := r.GetQuestionGroupCompletions(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		require.Len(t, completions, 2)
+		assert.Equal(t, questionGroup.ID, completions[0].QuestionGroupID)
+		assert.Equal(t, questionGroup.Name, completions[0].Name)
+		assert.Equal(t, 2, completions[0].RequiredQuestionCount)
+		assert.Equal(t, 1, completions[0].CompletedCount)
+		// 必須の質問がない場合は全員が回答済み
+		assert.Equal(t, optionalGroup.ID, completions[1].QuestionGroupID)
+		assert.Equal(t, 0, completions[1].RequiredQuestionCount)
+		assert.Equal(t, 2, completions[1].CompletedCount)
+	})
+}
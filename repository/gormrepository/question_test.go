@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
 	"github.com/traPtitech/rucQ/testutil/random"
 )
 
@@ -41,7 +42,7 @@ func TestCreateQuestion(t *testing.T) {
 				},
 			},
 		}
-		err := r.CreateQuestion(&question)
+		err := r.CreateQuestion(t.Context(), &question)
 
 		assert.NoError(t, err)
 		assert.NotZero(t, question.ID)
@@ -123,4 +124,434 @@ func TestUpdateQuestion(t *testing.T) {
 		// CreatedAtが変わっていないことを確認
 		assert.WithinDuration(t, question.CreatedAt, retrievedQuestion.CreatedAt, time.Second)
 	})
+
+	t.Run("Renaming option keeps answers", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+		answer := model.Answer{
+			QuestionID:      question.ID,
+			UserID:          user.ID,
+			Type:            model.SingleChoiceQuestion,
+			SelectedOptions: []model.Option{question.Options[0]},
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		newContent := random.AlphaNumericString(t, 15)
+		question.Options[0].Content = newContent
+
+		err := r.UpdateQuestion(t.Context(), question.ID, &question)
+
+		require.NoError(t, err)
+
+		retrievedAnswer, err := r.GetAnswerByID(t.Context(), answer.ID)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, retrievedAnswer.SelectedOptions, 1) {
+			assert.Equal(t, question.Options[0].ID, retrievedAnswer.SelectedOptions[0].ID)
+			assert.Equal(t, newContent, retrievedAnswer.SelectedOptions[0].Content)
+		}
+
+		flags, err := r.GetAnswerFlags(t.Context(), question.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, flags)
+	})
+
+	t.Run("Removing option flags answers", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+		removedOption := question.Options[0]
+		answer := model.Answer{
+			QuestionID:      question.ID,
+			UserID:          user.ID,
+			Type:            model.SingleChoiceQuestion,
+			SelectedOptions: []model.Option{removedOption},
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		newQuestion := question
+		newQuestion.Options = question.Options[1:]
+
+		err := r.UpdateQuestion(t.Context(), question.ID, &newQuestion)
+
+		require.NoError(t, err)
+
+		retrievedQuestion, err := r.GetQuestionByID(question.ID)
+
+		require.NoError(t, err)
+		assert.Len(t, retrievedQuestion.Options, len(question.Options)-1)
+
+		for _, option := range retrievedQuestion.Options {
+			assert.NotEqual(t, removedOption.ID, option.ID)
+		}
+
+		// 取り除かれた選択肢も過去の回答からは参照できる
+		retrievedAnswer, err := r.GetAnswerByID(t.Context(), answer.ID)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, retrievedAnswer.SelectedOptions, 1) {
+			assert.Equal(t, removedOption.ID, retrievedAnswer.SelectedOptions[0].ID)
+			assert.Equal(t, removedOption.Content, retrievedAnswer.SelectedOptions[0].Content)
+		}
+
+		flags, err := r.GetAnswerFlags(t.Context(), question.ID)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, flags, 1) {
+			assert.Equal(t, answer.ID, flags[0].Answer.ID)
+			assert.Equal(t, removedOption.ID, flags[0].Option.ID)
+		}
+
+		// 選択肢を戻すと印が外れる
+		err = r.UpdateQuestion(t.Context(), question.ID, &question)
+
+		require.NoError(t, err)
+
+		flags, err = r.GetAnswerFlags(t.Context(), question.ID)
+
+		require.NoError(t, err)
+		assert.Empty(t, flags)
+	})
+
+	t.Run("Option of another question", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+		otherQuestion := mustCreateQuestion(
+			t,
+			r,
+			questionGroup.ID,
+			model.SingleChoiceQuestion,
+			nil,
+		)
+
+		question.Options = append(question.Options, otherQuestion.Options[0])
+
+		err := r.UpdateQuestion(t.Context(), question.ID, &question)
+
+		assert.ErrorIs(t, err, repository.ErrOptionNotFound)
+
+		// 他の質問の選択肢は変更されない
+		retrievedQuestion, err := r.GetQuestionByID(otherQuestion.ID)
+
+		require.NoError(t, err)
+		assert.Len(t, retrievedQuestion.Options, len(otherQuestion.Options))
+	})
+}
+
+func TestRepository_GetQuestionCampID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.FreeTextQuestion, nil)
+
+		campID, err := r.GetQuestionCampID(t.Context(), question.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, camp.ID, campID)
+	})
+
+	t.Run("質問が存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		_, err := r.GetQuestionCampID(t.Context(), uint(random.PositiveInt(t)))
+		assert.ErrorIs(t, err, repository.ErrQuestionNotFound)
+	})
+}
+
+func TestReorderQuestions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question1 := mustCreateQuestion(t, r, questionGroup.ID, model.FreeTextQuestion, nil)
+		question2 := mustCreateQuestion(t, r, questionGroup.ID, model.FreeNumberQuestion, nil)
+
+		err := r.ReorderQuestions(
+			t.Context(),
+			questionGroup.ID,
+			[]uint{question2.ID, question1.ID},
+		)
+
+		assert.NoError(t, err)
+
+		result, err := r.GetQuestionGroup(t.Context(), questionGroup.ID)
+
+		if assert.NoError(t, err) && assert.Len(t, result.Questions, 2) {
+			assert.Equal(t, question2.ID, result.Questions[0].ID)
+			assert.Equal(t, question1.ID, result.Questions[1].ID)
+		}
+	})
+
+	t.Run("Invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.FreeTextQuestion, nil)
+
+		err := r.ReorderQuestions(
+			t.Context(),
+			questionGroup.ID,
+			[]uint{question.ID, uint(random.PositiveInt(t))},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrInvalidSortOrder)
+	})
+
+	t.Run("Question group not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.ReorderQuestions(t.Context(), uint(random.PositiveInt(t)), []uint{})
+
+		assert.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestReorderOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+
+		// 作成時の順序を逆にする
+		optionIDs := make([]uint, len(question.Options))
+
+		for i, option := range question.Options {
+			optionIDs[len(optionIDs)-1-i] = option.ID
+		}
+
+		err := r.ReorderOptions(t.Context(), question.ID, optionIDs)
+
+		assert.NoError(t, err)
+
+		result, err := r.GetQuestionByID(question.ID)
+
+		if assert.NoError(t, err) && assert.Len(t, result.Options, len(optionIDs)) {
+			for i, option := range result.Options {
+				assert.Equal(t, optionIDs[i], option.ID)
+			}
+		}
+
+		options, err := r.GetOptions(&repository.GetOptionsQuery{QuestionID: &question.ID})
+
+		if assert.NoError(t, err) && assert.Len(t, options, len(optionIDs)) {
+			for i, option := range options {
+				assert.Equal(t, optionIDs[i], option.ID)
+			}
+		}
+	})
+
+	t.Run("Invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+
+		err := r.ReorderOptions(t.Context(), question.ID, []uint{question.Options[0].ID})
+
+		assert.ErrorIs(t, err, repository.ErrInvalidSortOrder)
+	})
+
+	t.Run("Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.ReorderOptions(t.Context(), uint(random.PositiveInt(t)), []uint{})
+
+		assert.ErrorIs(t, err, repository.ErrQuestionNotFound)
+	})
+}
+
+func TestMergeOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		editor := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.MultipleChoiceQuestion, nil)
+		sourceOption := question.Options[0]
+		targetOption := question.Options[1]
+		answers := []model.Answer{
+			{
+				QuestionID:      question.ID,
+				UserID:          user1.ID,
+				Type:            model.MultipleChoiceQuestion,
+				SelectedOptions: []model.Option{sourceOption},
+			},
+			{
+				QuestionID:      question.ID,
+				UserID:          user2.ID,
+				Type:            model.MultipleChoiceQuestion,
+				SelectedOptions: []model.Option{sourceOption, targetOption},
+			},
+		}
+
+		require.NoError(t, r.CreateAnswers(t.Context(), &answers, user1.ID))
+
+		err := r.MergeOptions(t.Context(), question.ID, sourceOption.ID, targetOption.ID, editor.ID)
+
+		require.NoError(t, err)
+
+		for _, answer := range answers {
+			retrievedAnswer, err := r.GetAnswerByID(t.Context(), answer.ID)
+
+			require.NoError(t, err)
+
+			if assert.Len(t, retrievedAnswer.SelectedOptions, 1) {
+				assert.Equal(t, targetOption.ID, retrievedAnswer.SelectedOptions[0].ID)
+			}
+
+			revisions, err := r.GetAnswerRevisions(t.Context(), question.ID, answer.UserID)
+
+			require.NoError(t, err)
+
+			if assert.Len(t, revisions, 2) {
+				assert.Equal(t, editor.ID, revisions[1].EditorID)
+			}
+		}
+
+		retrievedQuestion, err := r.GetQuestionByID(question.ID)
+
+		require.NoError(t, err)
+		assert.Len(t, retrievedQuestion.Options, len(question.Options)-1)
+
+		for _, option := range retrievedQuestion.Options {
+			assert.NotEqual(t, sourceOption.ID, option.ID)
+		}
+	})
+
+	t.Run("Ranking question", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.RankingQuestion, nil)
+		rankedOptions := make([]model.AnswerRankedOption, len(question.Options))
+
+		for i, option := range question.Options {
+			rankedOptions[i] = model.AnswerRankedOption{Position: i + 1, OptionID: option.ID}
+		}
+
+		answer := model.Answer{
+			QuestionID:    question.ID,
+			UserID:        user.ID,
+			Type:          model.RankingQuestion,
+			RankedOptions: rankedOptions,
+		}
+
+		require.NoError(t, r.CreateAnswer(t.Context(), &answer, user.ID))
+
+		err := r.MergeOptions(
+			t.Context(),
+			question.ID,
+			question.Options[0].ID,
+			question.Options[1].ID,
+			user.ID,
+		)
+
+		require.NoError(t, err)
+
+		retrievedAnswer, err := r.GetAnswerByID(t.Context(), answer.ID)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, retrievedAnswer.RankedOptions, len(question.Options)-1) {
+			for i, rankedOption := range retrievedAnswer.RankedOptions {
+				assert.Equal(t, question.Options[i+1].ID, rankedOption.OptionID)
+				// 付け替え元の順位を削除した後も順位は1から連続する
+				assert.Equal(t, i+1, rankedOption.Position)
+			}
+		}
+	})
+
+	t.Run("Option of another question", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		questionGroup := mustCreateQuestionGroup(t, r, camp.ID)
+		question := mustCreateQuestion(t, r, questionGroup.ID, model.SingleChoiceQuestion, nil)
+		otherQuestion := mustCreateQuestion(
+			t,
+			r,
+			questionGroup.ID,
+			model.SingleChoiceQuestion,
+			nil,
+		)
+
+		err := r.MergeOptions(
+			t.Context(),
+			question.ID,
+			question.Options[0].ID,
+			otherQuestion.Options[0].ID,
+			user.ID,
+		)
+
+		assert.ErrorIs(t, err, repository.ErrOptionNotFound)
+	})
+
+	t.Run("Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		user := mustCreateUser(t, r)
+
+		err := r.MergeOptions(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			uint(random.PositiveInt(t)),
+			uint(random.PositiveInt(t)),
+			user.ID,
+		)
+
+		assert.ErrorIs(t, err, repository.ErrQuestionNotFound)
+	})
 }
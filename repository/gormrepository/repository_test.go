@@ -176,14 +176,19 @@ func mustCreateCamp(t *testing.T, r *Repository) model.Camp {
 	dateStart := random.Time(t)
 	dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 	camp := &model.Camp{
-		DisplayID:          random.AlphaNumericString(t, 10),
-		Name:               random.AlphaNumericString(t, 20),
-		Guidebook:          random.AlphaNumericString(t, 100),
-		IsDraft:            random.Bool(t),
-		IsPaymentOpen:      random.Bool(t),
-		IsRegistrationOpen: random.Bool(t),
-		DateStart:          dateStart,
-		DateEnd:            dateEnd,
+		DisplayID: random.AlphaNumericString(t, 10),
+		Name:      random.AlphaNumericString(t, 20),
+		Guidebook: random.AlphaNumericString(t, 100),
+		Status: random.SelectFrom(
+			t,
+			model.CampStatusDraft,
+			model.CampStatusRegistration,
+			model.CampStatusConfirmed,
+			model.CampStatusOngoing,
+			model.CampStatusFinished,
+		),
+		DateStart: dateStart,
+		DateEnd:   dateEnd,
 	}
 	err := r.CreateCamp(camp)
 
@@ -273,7 +278,7 @@ func mustCreateQuestionGroup(t *testing.T, r *Repository, campID uint) model.Que
 		CampID:      campID,
 	}
 
-	err := r.CreateQuestionGroup(questionGroup)
+	err := r.CreateQuestionGroup(t.Context(), questionGroup)
 
 	require.NoError(t, err)
 
@@ -313,7 +318,7 @@ func mustCreateQuestion(
 	}
 
 	switch questionType {
-	case model.SingleChoiceQuestion, model.MultipleChoiceQuestion:
+	case model.SingleChoiceQuestion, model.MultipleChoiceQuestion, model.RankingQuestion:
 		// 2つ以上の選択肢を作成する
 		question.Options = make([]model.Option, random.PositiveIntN(t, maxOptions)+1)
 
@@ -322,9 +327,16 @@ func mustCreateQuestion(
 				Content: random.AlphaNumericString(t, 20),
 			}
 		}
+
+	case model.ScaleQuestion:
+		scaleMin := 1
+		scaleMax := scaleMin + random.PositiveIntN(t, 9)
+
+		question.ScaleMin = &scaleMin
+		question.ScaleMax = &scaleMax
 	}
 
-	err := r.CreateQuestion(question)
+	err := r.CreateQuestion(t.Context(), question)
 
 	require.NoError(t, err)
 
@@ -3,6 +3,7 @@ package gormrepository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -75,3 +76,234 @@ func (r *Repository) rollCallExists(ctx context.Context, rollCallID uint) (bool,
 
 	return count > 0, nil
 }
+
+func (r *Repository) GetRollCallByID(
+	ctx context.Context,
+	rollCallID uint,
+) (*model.RollCall, error) {
+	rollCall, err := gorm.G[model.RollCall](r.db).
+		Preload("Subjects", nil).
+		Where("id = ?", rollCallID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrRollCallNotFound
+		}
+
+		return nil, err
+	}
+
+	return &rollCall, nil
+}
+
+func (r *Repository) GetRollCallByTraqMessageID(
+	ctx context.Context,
+	messageID string,
+) (*model.RollCall, error) {
+	rollCall, err := gorm.G[model.RollCall](r.db).
+		Preload("Subjects", nil).
+		Where("traq_message_id = ?", messageID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrRollCallNotFound
+		}
+
+		return nil, err
+	}
+
+	return &rollCall, nil
+}
+
+func (r *Repository) UpdateRollCallTraqMessageID(
+	ctx context.Context,
+	rollCallID uint,
+	messageID string,
+) error {
+	rowsAffected, err := gorm.G[model.RollCall](r.db).
+		Where("id = ?", rollCallID).
+		Update(ctx, "traq_message_id", messageID)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrRollCallNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateRollCall(
+	ctx context.Context,
+	rollCallID uint,
+	rollCall *model.RollCall,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rollCall.ID = rollCallID
+
+		// 締め切りを解除できるようにDeadlineとClosedAtはnilでも、RestrictResponsesはfalseでも更新する
+		rowsAffected, err := gorm.G[*model.RollCall](tx).
+			Where("id = ?", rollCallID).
+			Select(
+				"name",
+				"description",
+				"options",
+				"deadline",
+				"closed_at",
+				"restrict_responses",
+			).
+			Updates(ctx, rollCall)
+
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			// 値が変わらなかった場合も0になるので存在確認を行う
+			exists, err := r.rollCallExists(ctx, rollCallID)
+
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return repository.ErrRollCallNotFound
+			}
+		}
+
+		if err := tx.WithContext(ctx).
+			Model(rollCall).
+			Omit("Subjects.*"). // ユーザーの新規作成はされないようにする
+			Association("Subjects").
+			Replace(rollCall.Subjects); err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return repository.ErrUserNotFound
+			}
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) DeleteRollCall(ctx context.Context, rollCallID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := gorm.G[model.RollCallReaction](tx).
+			Where("roll_call_id = ?", rollCallID).
+			Delete(ctx); err != nil {
+			return err
+		}
+
+		rowsAffected, err := gorm.G[model.RollCall](tx).
+			Where("id = ?", rollCallID).
+			Delete(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return repository.ErrRollCallNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) MarkRollCallNudged(
+	ctx context.Context,
+	rollCallID uint,
+	nudgedAt time.Time,
+	interval time.Duration,
+) error {
+	// 条件付きで更新することで、同時に再通知されても1回だけ成功するようにする
+	rowsAffected, err := gorm.G[model.RollCall](r.db).
+		Where("id = ?", rollCallID).
+		Where("last_nudged_at IS NULL OR last_nudged_at <= ?", nudgedAt.Add(-interval)).
+		Update(ctx, "last_nudged_at", nudgedAt)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	if _, err := r.GetRollCallByID(ctx, rollCallID); err != nil {
+		return err
+	}
+
+	return repository.ErrRollCallNudgedRecently
+}
+
+func (r *Repository) GetPendingRollCalls(
+	ctx context.Context,
+	campID uint,
+	userID string,
+	now time.Time,
+) ([]model.RollCall, error) {
+	rollCalls, err := gorm.G[model.RollCall](r.db).
+		Where("camp_id = ?", campID).
+		Where("closed_at IS NULL").
+		Where("deadline IS NULL OR deadline > ?", now).
+		Where(
+			"EXISTS (SELECT 1 FROM roll_call_subjects "+
+				"WHERE roll_call_subjects.roll_call_id = roll_calls.id "+
+				"AND roll_call_subjects.user_id = ?)",
+			userID,
+		).
+		Where(
+			"NOT EXISTS (SELECT 1 FROM roll_call_reactions "+
+				"WHERE roll_call_reactions.roll_call_id = roll_calls.id "+
+				"AND roll_call_reactions.user_id = ? AND roll_call_reactions.deleted_at IS NULL)",
+			userID,
+		).
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rollCalls, nil
+}
+
+func (r *Repository) GetOpenRollCallResponseCounts(
+	ctx context.Context,
+	campID uint,
+	now time.Time,
+) ([]repository.RollCallResponseCount, error) {
+	var counts []repository.RollCallResponseCount
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.RollCall{}).
+		Select(
+			"roll_calls.id AS roll_call_id, roll_calls.name, roll_calls.deadline, "+
+				"(SELECT COUNT(*) FROM roll_call_subjects "+
+				"WHERE roll_call_subjects.roll_call_id = roll_calls.id) AS subject_count, "+
+				"(SELECT COUNT(DISTINCT roll_call_reactions.user_id) FROM roll_call_reactions "+
+				"WHERE roll_call_reactions.roll_call_id = roll_calls.id "+
+				"AND roll_call_reactions.deleted_at IS NULL "+
+				// 対象者が指定されている場合は対象者のリアクションだけを数える
+				"AND (NOT EXISTS (SELECT 1 FROM roll_call_subjects "+
+				"WHERE roll_call_subjects.roll_call_id = roll_calls.id) "+
+				"OR EXISTS (SELECT 1 FROM roll_call_subjects "+
+				"WHERE roll_call_subjects.roll_call_id = roll_calls.id "+
+				"AND roll_call_subjects.user_id = roll_call_reactions.user_id))) AS responded_count",
+		).
+		Where("roll_calls.camp_id = ?", campID).
+		Where("roll_calls.closed_at IS NULL").
+		Where("roll_calls.deadline IS NULL OR roll_calls.deadline > ?", now).
+		Order("roll_calls.id").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
@@ -3,8 +3,10 @@ package gormrepository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
@@ -43,6 +45,49 @@ func (r *Repository) CreateRollCallReaction(
 	return nil
 }
 
+func (r *Repository) CreateUniqueRollCallReaction(
+	ctx context.Context,
+	reaction *model.RollCallReaction,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 同じユーザーのリアクションが同時に作成されないよう、点呼の行をロックする
+		if _, err := gorm.G[model.RollCall](
+			tx,
+			clause.Locking{Strength: clause.LockingStrengthUpdate},
+		).
+			Where("id = ?", reaction.RollCallID).
+			First(ctx); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrRollCallNotFound
+			}
+
+			return err
+		}
+
+		count, err := gorm.G[model.RollCallReaction](tx).
+			Where("roll_call_id = ? AND user_id = ?", reaction.RollCallID, reaction.UserID).
+			Count(ctx, "*")
+
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return repository.ErrRollCallReactionAlreadyExists
+		}
+
+		if err := gorm.G[model.RollCallReaction](tx).Create(ctx, reaction); err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return repository.ErrUserNotFound
+			}
+
+			return err
+		}
+
+		return nil
+	})
+}
+
 func (r *Repository) GetRollCallReactions(
 	ctx context.Context,
 	rollCallID uint,
@@ -95,8 +140,10 @@ func (r *Repository) UpdateRollCallReaction(
 	reactionID uint,
 	reaction *model.RollCallReaction,
 ) error {
+	// スタンプ以外で回答し直した場合にfrom_traq_stampをfalseに戻せるよう、ゼロ値も更新する
 	rowsAffected, err := gorm.G[*model.RollCallReaction](r.db).
 		Where("id = ?", reactionID).
+		Select("content", "from_traq_stamp").
 		Updates(ctx, reaction)
 
 	if err != nil {
@@ -125,3 +172,30 @@ func (r *Repository) DeleteRollCallReaction(ctx context.Context, reactionID uint
 
 	return nil
 }
+
+func (r *Repository) GetRollCallReactionChanges(
+	ctx context.Context,
+	rollCallID uint,
+	since time.Time,
+	afterReactionID uint,
+) ([]model.RollCallReaction, error) {
+	const changedAt = "COALESCE(deleted_at, updated_at)"
+
+	reactions, err := gorm.G[model.RollCallReaction](r.db.Unscoped()).
+		Where("roll_call_id = ?", rollCallID).
+		Where(
+			changedAt+" > ? OR ("+changedAt+" = ? AND id > ?)",
+			since,
+			since,
+			afterReactionID,
+		).
+		Order(changedAt).
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reactions, nil
+}
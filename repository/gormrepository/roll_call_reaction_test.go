@@ -1,7 +1,9 @@
 package gormrepository
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +56,89 @@ func TestRepository_CreateRollCallReaction(t *testing.T) {
 	})
 }
 
+func TestRepository_CreateUniqueRollCallReaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+
+		reaction := model.RollCallReaction{
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     user.ID,
+			RollCallID: rollCall.ID,
+		}
+
+		err := r.CreateUniqueRollCallReaction(t.Context(), &reaction)
+
+		assert.NoError(t, err)
+		assert.NotZero(t, reaction.ID)
+	})
+
+	t.Run("Already exists", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+		_ = mustCreateRollCallReaction(t, r, rollCall.ID, user.ID)
+
+		reaction := model.RollCallReaction{
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     user.ID,
+			RollCallID: rollCall.ID,
+		}
+
+		err := r.CreateUniqueRollCallReaction(t.Context(), &reaction)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallReactionAlreadyExists)
+	})
+
+	t.Run("削除したリアクションは数えない", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+		deleted := mustCreateRollCallReaction(t, r, rollCall.ID, user.ID)
+
+		require.NoError(t, r.DeleteRollCallReaction(t.Context(), deleted.ID))
+
+		reaction := model.RollCallReaction{
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     user.ID,
+			RollCallID: rollCall.ID,
+		}
+
+		err := r.CreateUniqueRollCallReaction(t.Context(), &reaction)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("RollCall not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		user := mustCreateUser(t, r)
+
+		reaction := model.RollCallReaction{
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     user.ID,
+			RollCallID: uint(random.PositiveInt(t)),
+		}
+
+		err := r.CreateUniqueRollCallReaction(t.Context(), &reaction)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+}
+
 func TestRepository_GetRollCallReactions(t *testing.T) {
 	t.Parallel()
 
@@ -222,6 +307,71 @@ func TestRepository_DeleteRollCallReaction(t *testing.T) {
 	})
 }
 
+func TestRepository_GetRollCallReactionChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		user3 := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user1, user2, user3})
+		before := mustCreateRollCallReaction(t, r, rollCall.ID, user1.ID)
+		deleted := mustCreateRollCallReaction(t, r, rollCall.ID, user2.ID)
+		otherRollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user1})
+
+		mustCreateRollCallReaction(t, r, otherRollCall.ID, user1.ID)
+
+		// 以降の変更だけが取得される
+		since := before.UpdatedAt.Truncate(time.Millisecond)
+		created := mustCreateRollCallReaction(t, r, rollCall.ID, user3.ID)
+
+		require.NoError(t, r.DeleteRollCallReaction(t.Context(), deleted.ID))
+
+		changes, err := r.GetRollCallReactionChanges(t.Context(), rollCall.ID, since, before.ID)
+
+		require.NoError(t, err)
+
+		ids := make([]uint, len(changes))
+
+		for i, change := range changes {
+			ids[i] = change.ID
+		}
+
+		assert.NotContains(t, ids, before.ID)
+		assert.Contains(t, ids, created.ID)
+
+		// 削除されたリアクションも含まれる
+		if i := slices.Index(ids, deleted.ID); assert.GreaterOrEqual(t, i, 0) {
+			assert.True(t, changes[i].DeletedAt.Valid)
+		}
+	})
+
+	t.Run("No changes", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+
+		mustCreateRollCallReaction(t, r, rollCall.ID, user.ID)
+
+		changes, err := r.GetRollCallReactionChanges(
+			t.Context(),
+			rollCall.ID,
+			time.Now().Add(time.Hour),
+			0,
+		)
+
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
+
 // mustCreateRollCallReaction creates a roll call reaction for testing purposes
 func mustCreateRollCallReaction(
 	t *testing.T,
@@ -2,6 +2,7 @@ package gormrepository
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -142,6 +143,263 @@ func TestRepository_GetRollCalls(t *testing.T) {
 	})
 }
 
+func TestRepository_GetRollCallByID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+
+		got, err := r.GetRollCallByID(t.Context(), rollCall.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, rollCall.ID, got.ID)
+		assert.Equal(t, rollCall.Name, got.Name)
+		require.Len(t, got.Subjects, 1)
+		assert.Equal(t, user.ID, got.Subjects[0].ID)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetRollCallByID(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+}
+
+func TestRepository_UpdateRollCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user1})
+		deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+		closedAt := time.Now().Truncate(time.Second)
+
+		err := r.UpdateRollCall(t.Context(), rollCall.ID, &model.RollCall{
+			Name:              random.AlphaNumericString(t, 20),
+			Description:       random.AlphaNumericString(t, 100),
+			Options:           []string{random.AlphaNumericString(t, 5)},
+			Subjects:          []model.User{user2},
+			Deadline:          &deadline,
+			ClosedAt:          &closedAt,
+			RestrictResponses: true,
+			CampID:            camp.ID,
+		})
+
+		require.NoError(t, err)
+
+		got, err := r.GetRollCallByID(t.Context(), rollCall.ID)
+
+		require.NoError(t, err)
+		require.Len(t, got.Subjects, 1)
+		assert.Equal(t, user2.ID, got.Subjects[0].ID)
+		require.NotNil(t, got.Deadline)
+		assert.WithinDuration(t, deadline, *got.Deadline, time.Second)
+		require.NotNil(t, got.ClosedAt)
+		assert.False(t, got.IsOpen(time.Now()))
+		assert.True(t, got.RestrictResponses)
+
+		// nilやゼロ値を渡すと締め切りや回答制限を解除できる
+		got.Deadline = nil
+		got.ClosedAt = nil
+		got.RestrictResponses = false
+
+		require.NoError(t, r.UpdateRollCall(t.Context(), rollCall.ID, got))
+
+		got, err = r.GetRollCallByID(t.Context(), rollCall.ID)
+
+		require.NoError(t, err)
+		assert.Nil(t, got.Deadline)
+		assert.Nil(t, got.ClosedAt)
+		assert.False(t, got.RestrictResponses)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.UpdateRollCall(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			&model.RollCall{Name: random.AlphaNumericString(t, 20)},
+		)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+
+	t.Run("Subject user not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, nil)
+
+		rollCall.Subjects = []model.User{{ID: random.AlphaNumericString(t, 32)}}
+
+		err := r.UpdateRollCall(t.Context(), rollCall.ID, &rollCall)
+
+		assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	})
+}
+
+func TestRepository_DeleteRollCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+		reaction := mustCreateRollCallReaction(t, r, rollCall.ID, user.ID)
+
+		require.NoError(t, r.DeleteRollCall(t.Context(), rollCall.ID))
+
+		_, err := r.GetRollCallByID(t.Context(), rollCall.ID)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+
+		// リアクションも削除される
+		_, err = r.GetRollCallReactionByID(t.Context(), reaction.ID)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallReactionNotFound)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.DeleteRollCall(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+}
+
+func TestRepository_UpdateRollCallTraqMessageID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+		messageID := random.AlphaNumericString(t, 36)
+
+		require.NoError(t, r.UpdateRollCallTraqMessageID(t.Context(), rollCall.ID, messageID))
+
+		got, err := r.GetRollCallByTraqMessageID(t.Context(), messageID)
+
+		require.NoError(t, err)
+		assert.Equal(t, rollCall.ID, got.ID)
+		require.Len(t, got.Subjects, 1)
+		assert.Equal(t, user.ID, got.Subjects[0].ID)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.UpdateRollCallTraqMessageID(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			random.AlphaNumericString(t, 36),
+		)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+
+	t.Run("Message not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		_, err := r.GetRollCallByTraqMessageID(t.Context(), random.AlphaNumericString(t, 36))
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+}
+
+func TestRepository_MarkRollCallNudged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, nil)
+		nudgedAt := time.Now().Truncate(time.Second)
+
+		require.NoError(t, r.MarkRollCallNudged(t.Context(), rollCall.ID, nudgedAt, time.Minute))
+
+		got, err := r.GetRollCallByID(t.Context(), rollCall.ID)
+
+		require.NoError(t, err)
+		require.NotNil(t, got.LastNudgedAt)
+		assert.WithinDuration(t, nudgedAt, *got.LastNudgedAt, time.Second)
+
+		// 間隔が空いていれば再度記録できる
+		require.NoError(
+			t,
+			r.MarkRollCallNudged(t.Context(), rollCall.ID, nudgedAt.Add(time.Minute), time.Minute),
+		)
+	})
+
+	t.Run("Nudged recently", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		rollCall := mustCreateRollCall(t, r, camp.ID, nil)
+		nudgedAt := time.Now()
+
+		require.NoError(t, r.MarkRollCallNudged(t.Context(), rollCall.ID, nudgedAt, time.Minute))
+
+		err := r.MarkRollCallNudged(
+			t.Context(),
+			rollCall.ID,
+			nudgedAt.Add(30*time.Second),
+			time.Minute,
+		)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNudgedRecently)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+
+		err := r.MarkRollCallNudged(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			time.Now(),
+			time.Minute,
+		)
+
+		assert.ErrorIs(t, err, repository.ErrRollCallNotFound)
+	})
+}
+
 func mustCreateRollCall(
 	t *testing.T,
 	r *Repository,
@@ -168,3 +426,82 @@ func mustCreateRollCall(
 
 	return rollCall
 }
+
+func TestRepository_GetPendingRollCalls(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		otherUser := mustCreateUser(t, r)
+		now := time.Now()
+		pending := mustCreateRollCall(t, r, camp.ID, []model.User{user, otherUser})
+		reacted := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+		_ = mustCreateRollCall(t, r, camp.ID, []model.User{otherUser})
+		closed := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+		expired := mustCreateRollCall(t, r, camp.ID, []model.User{user})
+
+		_ = mustCreateRollCallReaction(t, r, reacted.ID, user.ID)
+
+		closedAt := now.Add(-time.Minute)
+		closed.ClosedAt = &closedAt
+
+		require.NoError(t, r.UpdateRollCall(t.Context(), closed.ID, &closed))
+
+		deadline := now.Add(-time.Minute)
+		expired.Deadline = &deadline
+
+		require.NoError(t, r.UpdateRollCall(t.Context(), expired.ID, &expired))
+
+		rollCalls, err := r.GetPendingRollCalls(t.Context(), camp.ID, user.ID, now)
+
+		require.NoError(t, err)
+		require.Len(t, rollCalls, 1)
+		assert.Equal(t, pending.ID, rollCalls[0].ID)
+	})
+}
+
+func TestRepository_GetOpenRollCallResponseCounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user1 := mustCreateUser(t, r)
+		user2 := mustCreateUser(t, r)
+		outsider := mustCreateUser(t, r)
+		now := time.Now()
+		restricted := mustCreateRollCall(t, r, camp.ID, []model.User{user1, user2})
+		open := mustCreateRollCall(t, r, camp.ID, nil)
+		closed := mustCreateRollCall(t, r, camp.ID, []model.User{user1})
+
+		// 同じユーザーの複数のリアクションは1人として数える
+		_ = mustCreateRollCallReaction(t, r, restricted.ID, user1.ID)
+		_ = mustCreateRollCallReaction(t, r, restricted.ID, user1.ID)
+		_ = mustCreateRollCallReaction(t, r, restricted.ID, outsider.ID)
+		_ = mustCreateRollCallReaction(t, r, open.ID, user1.ID)
+		_ = mustCreateRollCallReaction(t, r, open.ID, outsider.ID)
+
+		closedAt := now.Add(-time.Minute)
+		closed.ClosedAt = &closedAt
+
+		require.NoError(t, r.UpdateRollCall(t.Context(), closed.ID, &closed))
+
+		counts, err := r.GetOpenRollCallResponseCounts(t.Context(), camp.ID, now)
+
+		require.NoError(t, err)
+		require.Len(t, counts, 2)
+		assert.Equal(t, restricted.ID, counts[0].RollCallID)
+		assert.Equal(t, restricted.Name, counts[0].Name)
+		assert.Equal(t, 2, counts[0].SubjectCount)
+		assert.Equal(t, 1, counts[0].RespondedCount)
+		assert.Equal(t, open.ID, counts[1].RollCallID)
+		assert.Equal(t, 0, counts[1].SubjectCount)
+		assert.Equal(t, 2, counts[1].RespondedCount)
+	})
+}
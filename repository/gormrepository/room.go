@@ -210,3 +210,24 @@ func (r *Repository) DeleteRoom(ctx context.Context, roomID uint) error {
 		return nil
 	})
 }
+
+func (r *Repository) CountUnassignedParticipants(ctx context.Context, campID uint) (int, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Table("camp_participants").
+		Where("camp_participants.camp_id = ?", campID).
+		Where(
+			"NOT EXISTS (SELECT 1 FROM room_members " +
+				"JOIN rooms ON rooms.id = room_members.room_id AND rooms.deleted_at IS NULL " +
+				"JOIN room_groups ON room_groups.id = rooms.room_group_id " +
+				"AND room_groups.deleted_at IS NULL " +
+				"WHERE room_groups.camp_id = camp_participants.camp_id " +
+				"AND room_members.user_id = camp_participants.user_id)",
+		).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
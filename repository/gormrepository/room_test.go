@@ -651,3 +651,31 @@ func TestRepository_DeleteRoom(t *testing.T) {
 		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
 	})
 }
+
+func TestRepository_CountUnassignedParticipants(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		assigned := mustCreateUser(t, r)
+		unassigned := mustCreateUser(t, r)
+
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &assigned))
+		require.NoError(t, r.AddCampParticipant(t.Context(), camp.ID, &unassigned))
+
+		roomGroup := mustCreateRoomGroup(t, r, camp.ID)
+		_ = mustCreateRoom(t, r, roomGroup.ID, []model.User{assigned})
+		// 他の合宿の部屋に割り当てられていても未割り当てとして数える
+		otherRoomGroup := mustCreateRoomGroup(t, r, otherCamp.ID)
+		_ = mustCreateRoom(t, r, otherRoomGroup.ID, []model.User{unassigned})
+
+		count, err := r.CountUnassignedParticipants(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
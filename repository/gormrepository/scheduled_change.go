@@ -0,0 +1,118 @@
+package gormrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+func (r *Repository) CreateScheduledChange(
+	ctx context.Context,
+	change *model.ScheduledChange,
+) error {
+	if err := gorm.G[model.ScheduledChange](r.db).Create(ctx, change); err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return repository.ErrCampNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetScheduledChanges(
+	ctx context.Context,
+	campID uint,
+) ([]model.ScheduledChange, error) {
+	changes, err := gorm.G[model.ScheduledChange](r.db).
+		Where("camp_id = ?", campID).
+		Order("scheduled_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (r *Repository) GetScheduledChangeByID(
+	ctx context.Context,
+	changeID uint,
+) (*model.ScheduledChange, error) {
+	change, err := gorm.G[model.ScheduledChange](r.db).
+		Where("id = ?", changeID).
+		First(ctx)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrScheduledChangeNotFound
+		}
+
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+func (r *Repository) UpdateScheduledChange(
+	ctx context.Context,
+	changeID uint,
+	change *model.ScheduledChange,
+) error {
+	rowsAffected, err := gorm.G[*model.ScheduledChange](r.db).
+		Where("id = ?", changeID).
+		Select("processed_at", "failure_reason").
+		Updates(ctx, change)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrScheduledChangeNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteScheduledChange(ctx context.Context, changeID uint) error {
+	rowsAffected, err := gorm.G[model.ScheduledChange](r.db).
+		Where("id = ?", changeID).
+		Delete(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrScheduledChangeNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) GetDueScheduledChanges(
+	ctx context.Context,
+	now time.Time,
+) ([]model.ScheduledChange, error) {
+	changes, err := gorm.G[model.ScheduledChange](r.db).
+		Where("processed_at IS NULL").
+		Where("scheduled_at <= ?", now).
+		Order("scheduled_at").
+		Order("id").
+		Find(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
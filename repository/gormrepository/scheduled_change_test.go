@@ -0,0 +1,125 @@
+package gormrepository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func mustCreateScheduledChange(
+	t *testing.T,
+	r *Repository,
+	campID uint,
+	createdByID string,
+	scheduledAt time.Time,
+) model.ScheduledChange {
+	t.Helper()
+
+	change := model.ScheduledChange{
+		CampID:      campID,
+		TargetType:  model.ScheduledChangeTargetTypeCamp,
+		TargetID:    campID,
+		Field:       model.ScheduledChangeFieldName,
+		Value:       random.AlphaNumericString(t, 20),
+		ScheduledAt: scheduledAt,
+		CreatedByID: createdByID,
+	}
+
+	err := r.CreateScheduledChange(t.Context(), &change)
+
+	require.NoError(t, err)
+
+	return change
+}
+
+func TestRepository_GetScheduledChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		otherCamp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		now := time.Now()
+		later := mustCreateScheduledChange(t, r, camp.ID, user.ID, now.Add(2*time.Hour))
+		earlier := mustCreateScheduledChange(t, r, camp.ID, user.ID, now.Add(time.Hour))
+		_ = mustCreateScheduledChange(t, r, otherCamp.ID, user.ID, now)
+
+		changes, err := r.GetScheduledChanges(t.Context(), camp.ID)
+
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, earlier.ID, changes[0].ID)
+		assert.Equal(t, later.ID, changes[1].ID)
+		assert.Equal(t, later.Value, changes[1].Value)
+	})
+}
+
+func TestRepository_GetDueScheduledChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		now := time.Now()
+		due := mustCreateScheduledChange(t, r, camp.ID, user.ID, now.Add(-time.Hour))
+		processed := mustCreateScheduledChange(t, r, camp.ID, user.ID, now.Add(-time.Hour))
+		_ = mustCreateScheduledChange(t, r, camp.ID, user.ID, now.Add(time.Hour))
+
+		processedAt := now.Add(-time.Minute)
+		processed.ProcessedAt = &processedAt
+
+		err := r.UpdateScheduledChange(t.Context(), processed.ID, &processed)
+
+		require.NoError(t, err)
+
+		changes, err := r.GetDueScheduledChanges(t.Context(), now)
+
+		require.NoError(t, err)
+
+		if assert.Len(t, changes, 1) {
+			assert.Equal(t, due.ID, changes[0].ID)
+		}
+	})
+}
+
+func TestRepository_DeleteScheduledChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		camp := mustCreateCamp(t, r)
+		user := mustCreateUser(t, r)
+		change := mustCreateScheduledChange(t, r, camp.ID, user.ID, time.Now())
+
+		err := r.DeleteScheduledChange(t.Context(), change.ID)
+
+		require.NoError(t, err)
+
+		_, err = r.GetScheduledChangeByID(t.Context(), change.ID)
+
+		assert.ErrorIs(t, err, repository.ErrScheduledChangeNotFound)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := setup(t)
+		err := r.DeleteScheduledChange(t.Context(), uint(random.PositiveInt(t)))
+
+		assert.ErrorIs(t, err, repository.ErrScheduledChangeNotFound)
+	})
+}
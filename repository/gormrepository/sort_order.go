@@ -0,0 +1,107 @@
+package gormrepository
+
+import (
+	"context"
+	"slices"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// orderBySortOrder は表示順に読み込む。表示順が同じ場合は作成順に並べる
+func orderBySortOrder(db gorm.PreloadBuilder) error {
+	db.Order("sort_order").Order("id")
+
+	return nil
+}
+
+// nextSortOrder は親の中で末尾に追加するときの表示順を返す。
+// 同時に追加された行が同じ表示順にならないよう、親の行をロックしてから求めるため、
+// 追加する行の作成と同じトランザクションの中で呼び出す
+func nextSortOrder(
+	ctx context.Context,
+	tx *gorm.DB,
+	parentTable string,
+	table string,
+	parentColumn string,
+	parentID uint,
+) (int, error) {
+	var parentIDs []uint
+
+	// 親が存在しない場合は作成時に外部キー制約で失敗する
+	if err := tx.WithContext(ctx).
+		Table(parentTable).
+		Where("id = ?", parentID).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Pluck("id", &parentIDs).Error; err != nil {
+		return 0, err
+	}
+
+	var maxSortOrder *int
+
+	if err := tx.WithContext(ctx).
+		Table(table).
+		Select("MAX(sort_order)").
+		Where(parentColumn+" = ?", parentID).
+		Scan(&maxSortOrder).Error; err != nil {
+		return 0, err
+	}
+
+	if maxSortOrder == nil {
+		return 0, nil
+	}
+
+	return *maxSortOrder + 1, nil
+}
+
+// reorder は親に属する行の表示順をidsの順に更新する
+// idsが親に属する行のIDの並べ替えになっていない場合はErrInvalidSortOrderを返す
+// scopesで並べ替え対象の行を絞り込める
+func (r *Repository) reorder(
+	ctx context.Context,
+	table string,
+	parentColumn string,
+	parentID uint,
+	ids []uint,
+	scopes ...func(*gorm.DB) *gorm.DB,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existingIDs []uint
+
+		// 論理削除された行はクライアントから見えないため並べ替えの対象にしない
+		if err := tx.Table(table).
+			Where(parentColumn+" = ?", parentID).
+			Where("deleted_at IS NULL").
+			Scopes(scopes...).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Pluck("id", &existingIDs).Error; err != nil {
+			return err
+		}
+
+		if len(existingIDs) != len(ids) {
+			return repository.ErrInvalidSortOrder
+		}
+
+		sortedIDs := slices.Clone(ids)
+
+		slices.Sort(sortedIDs)
+		slices.Sort(existingIDs)
+
+		if !slices.Equal(sortedIDs, existingIDs) {
+			return repository.ErrInvalidSortOrder
+		}
+
+		for i, id := range ids {
+			if err := tx.Table(table).
+				Where("id = ?", id).
+				Where("deleted_at IS NULL").
+				Update("sort_order", i).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
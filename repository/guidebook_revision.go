@@ -0,0 +1,41 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var (
+	ErrGuidebookRevisionNotFound         = errors.New("guidebook revision not found")
+	ErrGuidebookRevisionAlreadyPublished = errors.New("guidebook revision has already been published")
+	ErrGuidebookRevisionOutdated         = errors.New("a newer guidebook revision has been published")
+)
+
+type GuidebookRevisionRepository interface {
+	CreateGuidebookRevision(ctx context.Context, revision *model.GuidebookRevision) error
+	// GetGuidebookRevisions は合宿のしおりの版を作成した順に取得します
+	GetGuidebookRevisions(ctx context.Context, campID uint) ([]model.GuidebookRevision, error)
+	GetGuidebookRevisionByID(
+		ctx context.Context,
+		revisionID uint,
+	) (*model.GuidebookRevision, error)
+	// GetPreviousGuidebookRevision は同じ合宿でrevisionの直前に作成された版を取得します。
+	// 最初の版の場合はErrGuidebookRevisionNotFoundを返します
+	GetPreviousGuidebookRevision(
+		ctx context.Context,
+		revision *model.GuidebookRevision,
+	) (*model.GuidebookRevision, error)
+	DeleteGuidebookRevision(ctx context.Context, revisionID uint) error
+	// PublishGuidebookRevision は版を公開済みにし、合宿のしおりを版の内容で置き換えます。
+	// 公開済みの版の場合はErrGuidebookRevisionAlreadyPublishedを、
+	// 後に作成された版が公開済みの場合はErrGuidebookRevisionOutdatedを返します。
+	// 2つのテーブルを更新するため、Transactionの中で呼び出してください
+	PublishGuidebookRevision(ctx context.Context, revisionID uint, publishedAt time.Time) error
+	// GetDueGuidebookRevisions は公開予定時刻を過ぎた未公開の版を公開予定時刻の順に取得します。
+	// 後に作成された版が公開済みの版は含みません
+	GetDueGuidebookRevisions(ctx context.Context, now time.Time) ([]model.GuidebookRevision, error)
+}
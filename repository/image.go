@@ -0,0 +1,17 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var ErrImageNotFound = errors.New("image not found")
+
+type ImageRepository interface {
+	GetImageByID(ctx context.Context, imageID uint) (*model.Image, error)
+	// CountCampImages はimageIDsのうち合宿の画像であるものの数を返します
+	CountCampImages(ctx context.Context, campID uint, imageIDs []uint) (int, error)
+}
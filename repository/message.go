@@ -13,8 +13,12 @@ var ErrMessageNotFound = errors.New("message not found")
 type MessageRepository interface {
 	// CreateMessage メッセージをデータベースに作成します
 	CreateMessage(ctx context.Context, message *model.Message) error
+	// CreateMessages 複数のメッセージをまとめて作成します
+	CreateMessages(ctx context.Context, messages *[]model.Message) error
 	// GetReadyToSendMessages 送信予定時刻を過ぎた未送信のメッセージを取得します
 	GetReadyToSendMessages(ctx context.Context) ([]model.Message, error)
 	// UpdateMessage メッセージの情報を更新します
 	UpdateMessage(ctx context.Context, messageID uint, message *model.Message) error
+	// CountUndeliveredMessages は合宿の参加者宛ての未送信のメッセージを数えます
+	CountUndeliveredMessages(ctx context.Context, campID uint) (int, error)
 }
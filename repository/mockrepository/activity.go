@@ -69,3 +69,18 @@ func (mr *MockActivityRepositoryMockRecorder) GetActivitiesByCampID(ctx, campID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivitiesByCampID", reflect.TypeOf((*MockActivityRepository)(nil).GetActivitiesByCampID), ctx, campID)
 }
+
+// GetRecentActivities mocks base method.
+func (m *MockActivityRepository) GetRecentActivities(ctx context.Context, campID uint, userID string, beforeID *uint, limit int) ([]model.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentActivities", ctx, campID, userID, beforeID, limit)
+	ret0, _ := ret[0].([]model.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentActivities indicates an expected call of GetRecentActivities.
+func (mr *MockActivityRepositoryMockRecorder) GetRecentActivities(ctx, campID, userID, beforeID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentActivities", reflect.TypeOf((*MockActivityRepository)(nil).GetRecentActivities), ctx, campID, userID, beforeID, limit)
+}
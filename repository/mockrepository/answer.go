@@ -43,31 +43,31 @@ func (m *MockAnswerRepository) EXPECT() *MockAnswerRepositoryMockRecorder {
 }
 
 // CreateAnswer mocks base method.
-func (m *MockAnswerRepository) CreateAnswer(ctx context.Context, answer *model.Answer) error {
+func (m *MockAnswerRepository) CreateAnswer(ctx context.Context, answer *model.Answer, editorID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAnswer", ctx, answer)
+	ret := m.ctrl.Call(m, "CreateAnswer", ctx, answer, editorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAnswer indicates an expected call of CreateAnswer.
-func (mr *MockAnswerRepositoryMockRecorder) CreateAnswer(ctx, answer any) *gomock.Call {
+func (mr *MockAnswerRepositoryMockRecorder) CreateAnswer(ctx, answer, editorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnswer", reflect.TypeOf((*MockAnswerRepository)(nil).CreateAnswer), ctx, answer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnswer", reflect.TypeOf((*MockAnswerRepository)(nil).CreateAnswer), ctx, answer, editorID)
 }
 
 // CreateAnswers mocks base method.
-func (m *MockAnswerRepository) CreateAnswers(ctx context.Context, answers *[]model.Answer) error {
+func (m *MockAnswerRepository) CreateAnswers(ctx context.Context, answers *[]model.Answer, editorID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAnswers", ctx, answers)
+	ret := m.ctrl.Call(m, "CreateAnswers", ctx, answers, editorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAnswers indicates an expected call of CreateAnswers.
-func (mr *MockAnswerRepositoryMockRecorder) CreateAnswers(ctx, answers any) *gomock.Call {
+func (mr *MockAnswerRepositoryMockRecorder) CreateAnswers(ctx, answers, editorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnswers", reflect.TypeOf((*MockAnswerRepository)(nil).CreateAnswers), ctx, answers)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnswers", reflect.TypeOf((*MockAnswerRepository)(nil).CreateAnswers), ctx, answers, editorID)
 }
 
 // GetAnswerByID mocks base method.
@@ -85,6 +85,51 @@ func (mr *MockAnswerRepositoryMockRecorder) GetAnswerByID(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswerByID", reflect.TypeOf((*MockAnswerRepository)(nil).GetAnswerByID), ctx, id)
 }
 
+// GetAnswerFlags mocks base method.
+func (m *MockAnswerRepository) GetAnswerFlags(ctx context.Context, questionID uint) ([]model.AnswerFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnswerFlags", ctx, questionID)
+	ret0, _ := ret[0].([]model.AnswerFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnswerFlags indicates an expected call of GetAnswerFlags.
+func (mr *MockAnswerRepositoryMockRecorder) GetAnswerFlags(ctx, questionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswerFlags", reflect.TypeOf((*MockAnswerRepository)(nil).GetAnswerFlags), ctx, questionID)
+}
+
+// GetAnswerRevisionByID mocks base method.
+func (m *MockAnswerRepository) GetAnswerRevisionByID(ctx context.Context, id uint) (*model.AnswerRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnswerRevisionByID", ctx, id)
+	ret0, _ := ret[0].(*model.AnswerRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnswerRevisionByID indicates an expected call of GetAnswerRevisionByID.
+func (mr *MockAnswerRepositoryMockRecorder) GetAnswerRevisionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswerRevisionByID", reflect.TypeOf((*MockAnswerRepository)(nil).GetAnswerRevisionByID), ctx, id)
+}
+
+// GetAnswerRevisions mocks base method.
+func (m *MockAnswerRepository) GetAnswerRevisions(ctx context.Context, questionID uint, userID string) ([]model.AnswerRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnswerRevisions", ctx, questionID, userID)
+	ret0, _ := ret[0].([]model.AnswerRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnswerRevisions indicates an expected call of GetAnswerRevisions.
+func (mr *MockAnswerRepositoryMockRecorder) GetAnswerRevisions(ctx, questionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswerRevisions", reflect.TypeOf((*MockAnswerRepository)(nil).GetAnswerRevisions), ctx, questionID, userID)
+}
+
 // GetAnswers mocks base method.
 func (m *MockAnswerRepository) GetAnswers(ctx context.Context, query repository.GetAnswersQuery) ([]model.Answer, error) {
 	m.ctrl.T.Helper()
@@ -101,15 +146,15 @@ func (mr *MockAnswerRepositoryMockRecorder) GetAnswers(ctx, query any) *gomock.C
 }
 
 // UpdateAnswer mocks base method.
-func (m *MockAnswerRepository) UpdateAnswer(ctx context.Context, answerID uint, answer *model.Answer) error {
+func (m *MockAnswerRepository) UpdateAnswer(ctx context.Context, answerID uint, answer *model.Answer, editorID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAnswer", ctx, answerID, answer)
+	ret := m.ctrl.Call(m, "UpdateAnswer", ctx, answerID, answer, editorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateAnswer indicates an expected call of UpdateAnswer.
-func (mr *MockAnswerRepositoryMockRecorder) UpdateAnswer(ctx, answerID, answer any) *gomock.Call {
+func (mr *MockAnswerRepositoryMockRecorder) UpdateAnswer(ctx, answerID, answer, editorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnswer", reflect.TypeOf((*MockAnswerRepository)(nil).UpdateAnswer), ctx, answerID, answer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnswer", reflect.TypeOf((*MockAnswerRepository)(nil).UpdateAnswer), ctx, answerID, answer, editorID)
 }
@@ -12,8 +12,10 @@ package mockrepository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/traPtitech/rucQ/model"
+	repository "github.com/traPtitech/rucQ/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -113,6 +115,21 @@ func (mr *MockCampRepositoryMockRecorder) GetCampParticipants(ctx, campID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampParticipants", reflect.TypeOf((*MockCampRepository)(nil).GetCampParticipants), ctx, campID)
 }
 
+// GetCampRegistrationCounts mocks base method.
+func (m *MockCampRepository) GetCampRegistrationCounts(ctx context.Context, campID uint) ([]repository.CampRegistrationCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampRegistrationCounts", ctx, campID)
+	ret0, _ := ret[0].([]repository.CampRegistrationCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampRegistrationCounts indicates an expected call of GetCampRegistrationCounts.
+func (mr *MockCampRepositoryMockRecorder) GetCampRegistrationCounts(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampRegistrationCounts", reflect.TypeOf((*MockCampRepository)(nil).GetCampRegistrationCounts), ctx, campID)
+}
+
 // GetCamps mocks base method.
 func (m *MockCampRepository) GetCamps() ([]model.Camp, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +145,21 @@ func (mr *MockCampRepositoryMockRecorder) GetCamps() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCamps", reflect.TypeOf((*MockCampRepository)(nil).GetCamps))
 }
 
+// GetCampsWithDueSchedule mocks base method.
+func (m *MockCampRepository) GetCampsWithDueSchedule(ctx context.Context, now time.Time) ([]model.Camp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampsWithDueSchedule", ctx, now)
+	ret0, _ := ret[0].([]model.Camp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampsWithDueSchedule indicates an expected call of GetCampsWithDueSchedule.
+func (mr *MockCampRepositoryMockRecorder) GetCampsWithDueSchedule(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampsWithDueSchedule", reflect.TypeOf((*MockCampRepository)(nil).GetCampsWithDueSchedule), ctx, now)
+}
+
 // IsCampParticipant mocks base method.
 func (m *MockCampRepository) IsCampParticipant(ctx context.Context, campID uint, userID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -170,3 +202,17 @@ func (mr *MockCampRepositoryMockRecorder) UpdateCamp(ctx, campID, camp any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCamp", reflect.TypeOf((*MockCampRepository)(nil).UpdateCamp), ctx, campID, camp)
 }
+
+// UpdateCampStatus mocks base method.
+func (m *MockCampRepository) UpdateCampStatus(ctx context.Context, campID uint, previousStatus model.CampStatus, camp *model.Camp) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCampStatus", ctx, campID, previousStatus, camp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCampStatus indicates an expected call of UpdateCampStatus.
+func (mr *MockCampRepositoryMockRecorder) UpdateCampStatus(ctx, campID, previousStatus, camp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCampStatus", reflect.TypeOf((*MockCampRepository)(nil).UpdateCampStatus), ctx, campID, previousStatus, camp)
+}
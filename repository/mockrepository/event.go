@@ -99,6 +99,21 @@ func (mr *MockEventRepositoryMockRecorder) GetEvents(ctx, campID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventRepository)(nil).GetEvents), ctx, campID)
 }
 
+// GetUserEvents mocks base method.
+func (m *MockEventRepository) GetUserEvents(ctx context.Context, campID uint, userID string) ([]model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEvents", ctx, campID, userID)
+	ret0, _ := ret[0].([]model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEvents indicates an expected call of GetUserEvents.
+func (mr *MockEventRepositoryMockRecorder) GetUserEvents(ctx, campID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEvents", reflect.TypeOf((*MockEventRepository)(nil).GetUserEvents), ctx, campID, userID)
+}
+
 // UpdateEvent mocks base method.
 func (m *MockEventRepository) UpdateEvent(ctx context.Context, ID uint, event *model.Event) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event_attendance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=event_attendance.go -destination=mockrepository/event_attendance.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/traPtitech/rucQ/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventAttendanceRepository is a mock of EventAttendanceRepository interface.
+type MockEventAttendanceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventAttendanceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventAttendanceRepositoryMockRecorder is the mock recorder for MockEventAttendanceRepository.
+type MockEventAttendanceRepositoryMockRecorder struct {
+	mock *MockEventAttendanceRepository
+}
+
+// NewMockEventAttendanceRepository creates a new mock instance.
+func NewMockEventAttendanceRepository(ctrl *gomock.Controller) *MockEventAttendanceRepository {
+	mock := &MockEventAttendanceRepository{ctrl: ctrl}
+	mock.recorder = &MockEventAttendanceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventAttendanceRepository) EXPECT() *MockEventAttendanceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteEventAttendance mocks base method.
+func (m *MockEventAttendanceRepository) DeleteEventAttendance(ctx context.Context, eventID uint, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventAttendance", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventAttendance indicates an expected call of DeleteEventAttendance.
+func (mr *MockEventAttendanceRepositoryMockRecorder) DeleteEventAttendance(ctx, eventID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventAttendance", reflect.TypeOf((*MockEventAttendanceRepository)(nil).DeleteEventAttendance), ctx, eventID, userID)
+}
+
+// GetEventAttendances mocks base method.
+func (m *MockEventAttendanceRepository) GetEventAttendances(ctx context.Context, eventID uint) ([]model.EventAttendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventAttendances", ctx, eventID)
+	ret0, _ := ret[0].([]model.EventAttendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventAttendances indicates an expected call of GetEventAttendances.
+func (mr *MockEventAttendanceRepositoryMockRecorder) GetEventAttendances(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventAttendances", reflect.TypeOf((*MockEventAttendanceRepository)(nil).GetEventAttendances), ctx, eventID)
+}
+
+// SaveEventAttendance mocks base method.
+func (m *MockEventAttendanceRepository) SaveEventAttendance(ctx context.Context, attendance *model.EventAttendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEventAttendance", ctx, attendance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEventAttendance indicates an expected call of SaveEventAttendance.
+func (mr *MockEventAttendanceRepositoryMockRecorder) SaveEventAttendance(ctx, attendance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEventAttendance", reflect.TypeOf((*MockEventAttendanceRepository)(nil).SaveEventAttendance), ctx, attendance)
+}
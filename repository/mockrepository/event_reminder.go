@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event_reminder.go
+//
+// Generated by this command:
+//
+//	mockgen -source=event_reminder.go -destination=mockrepository/event_reminder.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/traPtitech/rucQ/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventReminderRepository is a mock of EventReminderRepository interface.
+type MockEventReminderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventReminderRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventReminderRepositoryMockRecorder is the mock recorder for MockEventReminderRepository.
+type MockEventReminderRepositoryMockRecorder struct {
+	mock *MockEventReminderRepository
+}
+
+// NewMockEventReminderRepository creates a new mock instance.
+func NewMockEventReminderRepository(ctrl *gomock.Controller) *MockEventReminderRepository {
+	mock := &MockEventReminderRepository{ctrl: ctrl}
+	mock.recorder = &MockEventReminderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventReminderRepository) EXPECT() *MockEventReminderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEventReminder mocks base method.
+func (m *MockEventReminderRepository) CreateEventReminder(ctx context.Context, reminder *model.EventReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventReminder", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEventReminder indicates an expected call of CreateEventReminder.
+func (mr *MockEventReminderRepositoryMockRecorder) CreateEventReminder(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventReminder", reflect.TypeOf((*MockEventReminderRepository)(nil).CreateEventReminder), ctx, reminder)
+}
+
+// DeleteEventReminder mocks base method.
+func (m *MockEventReminderRepository) DeleteEventReminder(ctx context.Context, reminderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventReminder", ctx, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventReminder indicates an expected call of DeleteEventReminder.
+func (mr *MockEventReminderRepositoryMockRecorder) DeleteEventReminder(ctx, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventReminder", reflect.TypeOf((*MockEventReminderRepository)(nil).DeleteEventReminder), ctx, reminderID)
+}
+
+// DeleteEventReminders mocks base method.
+func (m *MockEventReminderRepository) DeleteEventReminders(ctx context.Context, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventReminders", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventReminders indicates an expected call of DeleteEventReminders.
+func (mr *MockEventReminderRepositoryMockRecorder) DeleteEventReminders(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventReminders", reflect.TypeOf((*MockEventReminderRepository)(nil).DeleteEventReminders), ctx, eventID)
+}
+
+// GetEventReminderByID mocks base method.
+func (m *MockEventReminderRepository) GetEventReminderByID(ctx context.Context, reminderID uint) (*model.EventReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventReminderByID", ctx, reminderID)
+	ret0, _ := ret[0].(*model.EventReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventReminderByID indicates an expected call of GetEventReminderByID.
+func (mr *MockEventReminderRepositoryMockRecorder) GetEventReminderByID(ctx, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventReminderByID", reflect.TypeOf((*MockEventReminderRepository)(nil).GetEventReminderByID), ctx, reminderID)
+}
+
+// GetEventReminders mocks base method.
+func (m *MockEventReminderRepository) GetEventReminders(ctx context.Context, eventID uint) ([]model.EventReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventReminders", ctx, eventID)
+	ret0, _ := ret[0].([]model.EventReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventReminders indicates an expected call of GetEventReminders.
+func (mr *MockEventReminderRepositoryMockRecorder) GetEventReminders(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventReminders", reflect.TypeOf((*MockEventReminderRepository)(nil).GetEventReminders), ctx, eventID)
+}
+
+// GetReadyToSendEventReminders mocks base method.
+func (m *MockEventReminderRepository) GetReadyToSendEventReminders(ctx context.Context) ([]model.EventReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadyToSendEventReminders", ctx)
+	ret0, _ := ret[0].([]model.EventReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadyToSendEventReminders indicates an expected call of GetReadyToSendEventReminders.
+func (mr *MockEventReminderRepositoryMockRecorder) GetReadyToSendEventReminders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadyToSendEventReminders", reflect.TypeOf((*MockEventReminderRepository)(nil).GetReadyToSendEventReminders), ctx)
+}
+
+// UpdateEventReminder mocks base method.
+func (m *MockEventReminderRepository) UpdateEventReminder(ctx context.Context, reminderID uint, reminder *model.EventReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventReminder", ctx, reminderID, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEventReminder indicates an expected call of UpdateEventReminder.
+func (mr *MockEventReminderRepositoryMockRecorder) UpdateEventReminder(ctx, reminderID, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventReminder", reflect.TypeOf((*MockEventReminderRepository)(nil).UpdateEventReminder), ctx, reminderID, reminder)
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fee_rule.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fee_rule.go -destination=mockrepository/fee_rule.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/traPtitech/rucQ/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeeRuleRepository is a mock of FeeRuleRepository interface.
+type MockFeeRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeRuleRepositoryMockRecorder is the mock recorder for MockFeeRuleRepository.
+type MockFeeRuleRepositoryMockRecorder struct {
+	mock *MockFeeRuleRepository
+}
+
+// NewMockFeeRuleRepository creates a new mock instance.
+func NewMockFeeRuleRepository(ctrl *gomock.Controller) *MockFeeRuleRepository {
+	mock := &MockFeeRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockFeeRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeRuleRepository) EXPECT() *MockFeeRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFeeRule mocks base method.
+func (m *MockFeeRuleRepository) CreateFeeRule(ctx context.Context, feeRule *model.FeeRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeRule", ctx, feeRule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFeeRule indicates an expected call of CreateFeeRule.
+func (mr *MockFeeRuleRepositoryMockRecorder) CreateFeeRule(ctx, feeRule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeRule", reflect.TypeOf((*MockFeeRuleRepository)(nil).CreateFeeRule), ctx, feeRule)
+}
+
+// DeleteFeeRule mocks base method.
+func (m *MockFeeRuleRepository) DeleteFeeRule(ctx context.Context, feeRuleID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeeRule", ctx, feeRuleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeeRule indicates an expected call of DeleteFeeRule.
+func (mr *MockFeeRuleRepositoryMockRecorder) DeleteFeeRule(ctx, feeRuleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeRule", reflect.TypeOf((*MockFeeRuleRepository)(nil).DeleteFeeRule), ctx, feeRuleID)
+}
+
+// GetFeeRuleByID mocks base method.
+func (m *MockFeeRuleRepository) GetFeeRuleByID(ctx context.Context, feeRuleID uint) (*model.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRuleByID", ctx, feeRuleID)
+	ret0, _ := ret[0].(*model.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeRuleByID indicates an expected call of GetFeeRuleByID.
+func (mr *MockFeeRuleRepositoryMockRecorder) GetFeeRuleByID(ctx, feeRuleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRuleByID", reflect.TypeOf((*MockFeeRuleRepository)(nil).GetFeeRuleByID), ctx, feeRuleID)
+}
+
+// GetFeeRules mocks base method.
+func (m *MockFeeRuleRepository) GetFeeRules(ctx context.Context, campID uint) ([]model.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRules", ctx, campID)
+	ret0, _ := ret[0].([]model.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeRules indicates an expected call of GetFeeRules.
+func (mr *MockFeeRuleRepositoryMockRecorder) GetFeeRules(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRules", reflect.TypeOf((*MockFeeRuleRepository)(nil).GetFeeRules), ctx, campID)
+}
+
+// UpdateFeeRule mocks base method.
+func (m *MockFeeRuleRepository) UpdateFeeRule(ctx context.Context, feeRuleID uint, feeRule *model.FeeRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeeRule", ctx, feeRuleID, feeRule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFeeRule indicates an expected call of UpdateFeeRule.
+func (mr *MockFeeRuleRepositoryMockRecorder) UpdateFeeRule(ctx, feeRuleID, feeRule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeeRule", reflect.TypeOf((*MockFeeRuleRepository)(nil).UpdateFeeRule), ctx, feeRuleID, feeRule)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: guidebook_revision.go
+//
+// Generated by this command:
+//
+//	mockgen -source=guidebook_revision.go -destination=mockrepository/guidebook_revision.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/traPtitech/rucQ/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGuidebookRevisionRepository is a mock of GuidebookRevisionRepository interface.
+type MockGuidebookRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuidebookRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGuidebookRevisionRepositoryMockRecorder is the mock recorder for MockGuidebookRevisionRepository.
+type MockGuidebookRevisionRepositoryMockRecorder struct {
+	mock *MockGuidebookRevisionRepository
+}
+
+// NewMockGuidebookRevisionRepository creates a new mock instance.
+func NewMockGuidebookRevisionRepository(ctrl *gomock.Controller) *MockGuidebookRevisionRepository {
+	mock := &MockGuidebookRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockGuidebookRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuidebookRevisionRepository) EXPECT() *MockGuidebookRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateGuidebookRevision mocks base method.
+func (m *MockGuidebookRevisionRepository) CreateGuidebookRevision(ctx context.Context, revision *model.GuidebookRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuidebookRevision", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGuidebookRevision indicates an expected call of CreateGuidebookRevision.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) CreateGuidebookRevision(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuidebookRevision", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).CreateGuidebookRevision), ctx, revision)
+}
+
+// DeleteGuidebookRevision mocks base method.
+func (m *MockGuidebookRevisionRepository) DeleteGuidebookRevision(ctx context.Context, revisionID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGuidebookRevision", ctx, revisionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGuidebookRevision indicates an expected call of DeleteGuidebookRevision.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) DeleteGuidebookRevision(ctx, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuidebookRevision", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).DeleteGuidebookRevision), ctx, revisionID)
+}
+
+// GetDueGuidebookRevisions mocks base method.
+func (m *MockGuidebookRevisionRepository) GetDueGuidebookRevisions(ctx context.Context, now time.Time) ([]model.GuidebookRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueGuidebookRevisions", ctx, now)
+	ret0, _ := ret[0].([]model.GuidebookRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueGuidebookRevisions indicates an expected call of GetDueGuidebookRevisions.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) GetDueGuidebookRevisions(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueGuidebookRevisions", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).GetDueGuidebookRevisions), ctx, now)
+}
+
+// GetGuidebookRevisionByID mocks base method.
+func (m *MockGuidebookRevisionRepository) GetGuidebookRevisionByID(ctx context.Context, revisionID uint) (*model.GuidebookRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuidebookRevisionByID", ctx, revisionID)
+	ret0, _ := ret[0].(*model.GuidebookRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuidebookRevisionByID indicates an expected call of GetGuidebookRevisionByID.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) GetGuidebookRevisionByID(ctx, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuidebookRevisionByID", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).GetGuidebookRevisionByID), ctx, revisionID)
+}
+
+// GetGuidebookRevisions mocks base method.
+func (m *MockGuidebookRevisionRepository) GetGuidebookRevisions(ctx context.Context, campID uint) ([]model.GuidebookRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuidebookRevisions", ctx, campID)
+	ret0, _ := ret[0].([]model.GuidebookRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuidebookRevisions indicates an expected call of GetGuidebookRevisions.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) GetGuidebookRevisions(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuidebookRevisions", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).GetGuidebookRevisions), ctx, campID)
+}
+
+// GetPreviousGuidebookRevision mocks base method.
+func (m *MockGuidebookRevisionRepository) GetPreviousGuidebookRevision(ctx context.Context, revision *model.GuidebookRevision) (*model.GuidebookRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousGuidebookRevision", ctx, revision)
+	ret0, _ := ret[0].(*model.GuidebookRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousGuidebookRevision indicates an expected call of GetPreviousGuidebookRevision.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) GetPreviousGuidebookRevision(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousGuidebookRevision", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).GetPreviousGuidebookRevision), ctx, revision)
+}
+
+// PublishGuidebookRevision mocks base method.
+func (m *MockGuidebookRevisionRepository) PublishGuidebookRevision(ctx context.Context, revisionID uint, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishGuidebookRevision", ctx, revisionID, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishGuidebookRevision indicates an expected call of PublishGuidebookRevision.
+func (mr *MockGuidebookRevisionRepositoryMockRecorder) PublishGuidebookRevision(ctx, revisionID, publishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishGuidebookRevision", reflect.TypeOf((*MockGuidebookRevisionRepository)(nil).PublishGuidebookRevision), ctx, revisionID, publishedAt)
+}
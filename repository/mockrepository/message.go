@@ -41,6 +41,21 @@ func (m *MockMessageRepository) EXPECT() *MockMessageRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountUndeliveredMessages mocks base method.
+func (m *MockMessageRepository) CountUndeliveredMessages(ctx context.Context, campID uint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUndeliveredMessages", ctx, campID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUndeliveredMessages indicates an expected call of CountUndeliveredMessages.
+func (mr *MockMessageRepositoryMockRecorder) CountUndeliveredMessages(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUndeliveredMessages", reflect.TypeOf((*MockMessageRepository)(nil).CountUndeliveredMessages), ctx, campID)
+}
+
 // CreateMessage mocks base method.
 func (m *MockMessageRepository) CreateMessage(ctx context.Context, message *model.Message) error {
 	m.ctrl.T.Helper()
@@ -55,6 +70,20 @@ func (mr *MockMessageRepositoryMockRecorder) CreateMessage(ctx, message any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessage), ctx, message)
 }
 
+// CreateMessages mocks base method.
+func (m *MockMessageRepository) CreateMessages(ctx context.Context, messages *[]model.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMessages", ctx, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMessages indicates an expected call of CreateMessages.
+func (mr *MockMessageRepositoryMockRecorder) CreateMessages(ctx, messages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessages", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessages), ctx, messages)
+}
+
 // GetReadyToSendMessages mocks base method.
 func (m *MockMessageRepository) GetReadyToSendMessages(ctx context.Context) ([]model.Message, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@
 package mockrepository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/traPtitech/rucQ/model"
@@ -42,17 +43,17 @@ func (m *MockOptionRepository) EXPECT() *MockOptionRepositoryMockRecorder {
 }
 
 // CreateOption mocks base method.
-func (m *MockOptionRepository) CreateOption(option *model.Option) error {
+func (m *MockOptionRepository) CreateOption(ctx context.Context, option *model.Option) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOption", option)
+	ret := m.ctrl.Call(m, "CreateOption", ctx, option)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOption indicates an expected call of CreateOption.
-func (mr *MockOptionRepositoryMockRecorder) CreateOption(option any) *gomock.Call {
+func (mr *MockOptionRepositoryMockRecorder) CreateOption(ctx, option any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOption", reflect.TypeOf((*MockOptionRepository)(nil).CreateOption), option)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOption", reflect.TypeOf((*MockOptionRepository)(nil).CreateOption), ctx, option)
 }
 
 // GetOptions mocks base method.
@@ -69,3 +70,31 @@ func (mr *MockOptionRepositoryMockRecorder) GetOptions(query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockOptionRepository)(nil).GetOptions), query)
 }
+
+// MergeOptions mocks base method.
+func (m *MockOptionRepository) MergeOptions(ctx context.Context, questionID, sourceOptionID, targetOptionID uint, editorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeOptions", ctx, questionID, sourceOptionID, targetOptionID, editorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeOptions indicates an expected call of MergeOptions.
+func (mr *MockOptionRepositoryMockRecorder) MergeOptions(ctx, questionID, sourceOptionID, targetOptionID, editorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOptions", reflect.TypeOf((*MockOptionRepository)(nil).MergeOptions), ctx, questionID, sourceOptionID, targetOptionID, editorID)
+}
+
+// ReorderOptions mocks base method.
+func (m *MockOptionRepository) ReorderOptions(ctx context.Context, questionID uint, optionIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderOptions", ctx, questionID, optionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderOptions indicates an expected call of ReorderOptions.
+func (mr *MockOptionRepositoryMockRecorder) ReorderOptions(ctx, questionID, optionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderOptions", reflect.TypeOf((*MockOptionRepository)(nil).ReorderOptions), ctx, questionID, optionIDs)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/traPtitech/rucQ/model"
+	repository "github.com/traPtitech/rucQ/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +56,35 @@ func (mr *MockPaymentRepositoryMockRecorder) CreatePayment(ctx, payment any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).CreatePayment), ctx, payment)
 }
 
+// CreatePaymentTransaction mocks base method.
+func (m *MockPaymentRepository) CreatePaymentTransaction(ctx context.Context, transaction *model.PaymentTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentTransaction", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePaymentTransaction indicates an expected call of CreatePaymentTransaction.
+func (mr *MockPaymentRepositoryMockRecorder) CreatePaymentTransaction(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentTransaction", reflect.TypeOf((*MockPaymentRepository)(nil).CreatePaymentTransaction), ctx, transaction)
+}
+
+// GetCampPaymentTransactions mocks base method.
+func (m *MockPaymentRepository) GetCampPaymentTransactions(ctx context.Context, campID uint) ([]model.PaymentTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampPaymentTransactions", ctx, campID)
+	ret0, _ := ret[0].([]model.PaymentTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampPaymentTransactions indicates an expected call of GetCampPaymentTransactions.
+func (mr *MockPaymentRepositoryMockRecorder) GetCampPaymentTransactions(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampPaymentTransactions", reflect.TypeOf((*MockPaymentRepository)(nil).GetCampPaymentTransactions), ctx, campID)
+}
+
 // GetPaymentByID mocks base method.
 func (m *MockPaymentRepository) GetPaymentByID(ctx context.Context, paymentID uint) (*model.Payment, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +115,36 @@ func (mr *MockPaymentRepositoryMockRecorder) GetPaymentByUserID(ctx, campID, use
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByUserID", reflect.TypeOf((*MockPaymentRepository)(nil).GetPaymentByUserID), ctx, campID, userID)
 }
 
+// GetPaymentCollection mocks base method.
+func (m *MockPaymentRepository) GetPaymentCollection(ctx context.Context, campID uint) (*repository.PaymentCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentCollection", ctx, campID)
+	ret0, _ := ret[0].(*repository.PaymentCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentCollection indicates an expected call of GetPaymentCollection.
+func (mr *MockPaymentRepositoryMockRecorder) GetPaymentCollection(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentCollection", reflect.TypeOf((*MockPaymentRepository)(nil).GetPaymentCollection), ctx, campID)
+}
+
+// GetPaymentTransactions mocks base method.
+func (m *MockPaymentRepository) GetPaymentTransactions(ctx context.Context, paymentID uint) ([]model.PaymentTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentTransactions", ctx, paymentID)
+	ret0, _ := ret[0].([]model.PaymentTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentTransactions indicates an expected call of GetPaymentTransactions.
+func (mr *MockPaymentRepositoryMockRecorder) GetPaymentTransactions(ctx, paymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentTransactions", reflect.TypeOf((*MockPaymentRepository)(nil).GetPaymentTransactions), ctx, paymentID)
+}
+
 // GetPayments mocks base method.
 func (m *MockPaymentRepository) GetPayments(ctx context.Context, campID uint) ([]model.Payment, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +160,20 @@ func (mr *MockPaymentRepositoryMockRecorder) GetPayments(ctx, campID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayments", reflect.TypeOf((*MockPaymentRepository)(nil).GetPayments), ctx, campID)
 }
 
+// ReplacePaymentLineItems mocks base method.
+func (m *MockPaymentRepository) ReplacePaymentLineItems(ctx context.Context, paymentID uint, lineItems []model.PaymentLineItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePaymentLineItems", ctx, paymentID, lineItems)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePaymentLineItems indicates an expected call of ReplacePaymentLineItems.
+func (mr *MockPaymentRepositoryMockRecorder) ReplacePaymentLineItems(ctx, paymentID, lineItems any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePaymentLineItems", reflect.TypeOf((*MockPaymentRepository)(nil).ReplacePaymentLineItems), ctx, paymentID, lineItems)
+}
+
 // UpdatePayment mocks base method.
 func (m *MockPaymentRepository) UpdatePayment(ctx context.Context, paymentID uint, payment *model.Payment) error {
 	m.ctrl.T.Helper()
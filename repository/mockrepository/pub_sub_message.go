@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pub_sub_message.go
+//
+// Generated by this command:
+//
+//	mockgen -source=pub_sub_message.go -destination=mockrepository/pub_sub_message.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/traPtitech/rucQ/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPubSubMessageRepository is a mock of PubSubMessageRepository interface.
+type MockPubSubMessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPubSubMessageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPubSubMessageRepositoryMockRecorder is the mock recorder for MockPubSubMessageRepository.
+type MockPubSubMessageRepositoryMockRecorder struct {
+	mock *MockPubSubMessageRepository
+}
+
+// NewMockPubSubMessageRepository creates a new mock instance.
+func NewMockPubSubMessageRepository(ctrl *gomock.Controller) *MockPubSubMessageRepository {
+	mock := &MockPubSubMessageRepository{ctrl: ctrl}
+	mock.recorder = &MockPubSubMessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPubSubMessageRepository) EXPECT() *MockPubSubMessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePubSubMessage mocks base method.
+func (m *MockPubSubMessageRepository) CreatePubSubMessage(ctx context.Context, message *model.PubSubMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePubSubMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePubSubMessage indicates an expected call of CreatePubSubMessage.
+func (mr *MockPubSubMessageRepositoryMockRecorder) CreatePubSubMessage(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePubSubMessage", reflect.TypeOf((*MockPubSubMessageRepository)(nil).CreatePubSubMessage), ctx, message)
+}
+
+// DeletePubSubMessagesBefore mocks base method.
+func (m *MockPubSubMessageRepository) DeletePubSubMessagesBefore(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePubSubMessagesBefore", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePubSubMessagesBefore indicates an expected call of DeletePubSubMessagesBefore.
+func (mr *MockPubSubMessageRepositoryMockRecorder) DeletePubSubMessagesBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePubSubMessagesBefore", reflect.TypeOf((*MockPubSubMessageRepository)(nil).DeletePubSubMessagesBefore), ctx, before)
+}
+
+// GetLatestPubSubMessageID mocks base method.
+func (m *MockPubSubMessageRepository) GetLatestPubSubMessageID(ctx context.Context) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPubSubMessageID", ctx)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPubSubMessageID indicates an expected call of GetLatestPubSubMessageID.
+func (mr *MockPubSubMessageRepositoryMockRecorder) GetLatestPubSubMessageID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPubSubMessageID", reflect.TypeOf((*MockPubSubMessageRepository)(nil).GetLatestPubSubMessageID), ctx)
+}
+
+// GetPubSubMessages mocks base method.
+func (m *MockPubSubMessageRepository) GetPubSubMessages(ctx context.Context, afterID uint, ids []uint) ([]model.PubSubMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPubSubMessages", ctx, afterID, ids)
+	ret0, _ := ret[0].([]model.PubSubMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPubSubMessages indicates an expected call of GetPubSubMessages.
+func (mr *MockPubSubMessageRepositoryMockRecorder) GetPubSubMessages(ctx, afterID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubSubMessages", reflect.TypeOf((*MockPubSubMessageRepository)(nil).GetPubSubMessages), ctx, afterID, ids)
+}
@@ -42,17 +42,17 @@ func (m *MockQuestionRepository) EXPECT() *MockQuestionRepositoryMockRecorder {
 }
 
 // CreateQuestion mocks base method.
-func (m *MockQuestionRepository) CreateQuestion(question *model.Question) error {
+func (m *MockQuestionRepository) CreateQuestion(ctx context.Context, question *model.Question) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateQuestion", question)
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, question)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateQuestion indicates an expected call of CreateQuestion.
-func (mr *MockQuestionRepositoryMockRecorder) CreateQuestion(question any) *gomock.Call {
+func (mr *MockQuestionRepositoryMockRecorder) CreateQuestion(ctx, question any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).CreateQuestion), question)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).CreateQuestion), ctx, question)
 }
 
 // DeleteQuestionByID mocks base method.
@@ -84,6 +84,21 @@ func (mr *MockQuestionRepositoryMockRecorder) GetQuestionByID(id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionByID", reflect.TypeOf((*MockQuestionRepository)(nil).GetQuestionByID), id)
 }
 
+// GetQuestionCampID mocks base method.
+func (m *MockQuestionRepository) GetQuestionCampID(ctx context.Context, questionID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionCampID", ctx, questionID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionCampID indicates an expected call of GetQuestionCampID.
+func (mr *MockQuestionRepositoryMockRecorder) GetQuestionCampID(ctx, questionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionCampID", reflect.TypeOf((*MockQuestionRepository)(nil).GetQuestionCampID), ctx, questionID)
+}
+
 // GetQuestions mocks base method.
 func (m *MockQuestionRepository) GetQuestions() ([]model.Question, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +114,20 @@ func (mr *MockQuestionRepositoryMockRecorder) GetQuestions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockQuestionRepository)(nil).GetQuestions))
 }
 
+// ReorderQuestions mocks base method.
+func (m *MockQuestionRepository) ReorderQuestions(ctx context.Context, questionGroupID uint, questionIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderQuestions", ctx, questionGroupID, questionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderQuestions indicates an expected call of ReorderQuestions.
+func (mr *MockQuestionRepositoryMockRecorder) ReorderQuestions(ctx, questionGroupID, questionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderQuestions", reflect.TypeOf((*MockQuestionRepository)(nil).ReorderQuestions), ctx, questionGroupID, questionIDs)
+}
+
 // UpdateQuestion mocks base method.
 func (m *MockQuestionRepository) UpdateQuestion(ctx context.Context, questionID uint, question *model.Question) error {
 	m.ctrl.T.Helper()
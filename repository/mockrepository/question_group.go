@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/traPtitech/rucQ/model"
+	repository "github.com/traPtitech/rucQ/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,17 +43,17 @@ func (m *MockQuestionGroupRepository) EXPECT() *MockQuestionGroupRepositoryMockR
 }
 
 // CreateQuestionGroup mocks base method.
-func (m *MockQuestionGroupRepository) CreateQuestionGroup(questionGroup *model.QuestionGroup) error {
+func (m *MockQuestionGroupRepository) CreateQuestionGroup(ctx context.Context, questionGroup *model.QuestionGroup) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateQuestionGroup", questionGroup)
+	ret := m.ctrl.Call(m, "CreateQuestionGroup", ctx, questionGroup)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateQuestionGroup indicates an expected call of CreateQuestionGroup.
-func (mr *MockQuestionGroupRepositoryMockRecorder) CreateQuestionGroup(questionGroup any) *gomock.Call {
+func (mr *MockQuestionGroupRepositoryMockRecorder) CreateQuestionGroup(ctx, questionGroup any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestionGroup", reflect.TypeOf((*MockQuestionGroupRepository)(nil).CreateQuestionGroup), questionGroup)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestionGroup", reflect.TypeOf((*MockQuestionGroupRepository)(nil).CreateQuestionGroup), ctx, questionGroup)
 }
 
 // DeleteQuestionGroup mocks base method.
@@ -84,6 +85,21 @@ func (mr *MockQuestionGroupRepositoryMockRecorder) GetQuestionGroup(ctx, ID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionGroup", reflect.TypeOf((*MockQuestionGroupRepository)(nil).GetQuestionGroup), ctx, ID)
 }
 
+// GetQuestionGroupCompletions mocks base method.
+func (m *MockQuestionGroupRepository) GetQuestionGroupCompletions(ctx context.Context, campID uint) ([]repository.QuestionGroupCompletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionGroupCompletions", ctx, campID)
+	ret0, _ := ret[0].([]repository.QuestionGroupCompletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionGroupCompletions indicates an expected call of GetQuestionGroupCompletions.
+func (mr *MockQuestionGroupRepositoryMockRecorder) GetQuestionGroupCompletions(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionGroupCompletions", reflect.TypeOf((*MockQuestionGroupRepository)(nil).GetQuestionGroupCompletions), ctx, campID)
+}
+
 // GetQuestionGroups mocks base method.
 func (m *MockQuestionGroupRepository) GetQuestionGroups(ctx context.Context, campID uint) ([]model.QuestionGroup, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +115,35 @@ func (mr *MockQuestionGroupRepositoryMockRecorder) GetQuestionGroups(ctx, campID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionGroups", reflect.TypeOf((*MockQuestionGroupRepository)(nil).GetQuestionGroups), ctx, campID)
 }
 
+// GetUnansweredRequiredQuestionGroups mocks base method.
+func (m *MockQuestionGroupRepository) GetUnansweredRequiredQuestionGroups(ctx context.Context, campID uint, userID string) ([]model.QuestionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnansweredRequiredQuestionGroups", ctx, campID, userID)
+	ret0, _ := ret[0].([]model.QuestionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnansweredRequiredQuestionGroups indicates an expected call of GetUnansweredRequiredQuestionGroups.
+func (mr *MockQuestionGroupRepositoryMockRecorder) GetUnansweredRequiredQuestionGroups(ctx, campID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnansweredRequiredQuestionGroups", reflect.TypeOf((*MockQuestionGroupRepository)(nil).GetUnansweredRequiredQuestionGroups), ctx, campID, userID)
+}
+
+// ReorderQuestionGroups mocks base method.
+func (m *MockQuestionGroupRepository) ReorderQuestionGroups(ctx context.Context, campID uint, questionGroupIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderQuestionGroups", ctx, campID, questionGroupIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderQuestionGroups indicates an expected call of ReorderQuestionGroups.
+func (mr *MockQuestionGroupRepositoryMockRecorder) ReorderQuestionGroups(ctx, campID, questionGroupIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderQuestionGroups", reflect.TypeOf((*MockQuestionGroupRepository)(nil).ReorderQuestionGroups), ctx, campID, questionGroupIDs)
+}
+
 // UpdateQuestionGroup mocks base method.
 func (m *MockQuestionGroupRepository) UpdateQuestionGroup(ctx context.Context, questionGroupID uint, questionGroup model.QuestionGroup) error {
 	m.ctrl.T.Helper()
@@ -13,9 +13,15 @@ type MockRepository struct {
 	*MockAnswerRepository
 	*MockCampRepository
 	*MockEventRepository
+	*MockEventAttendanceRepository
+	*MockEventReminderRepository
+	*MockFeeRuleRepository
+	*MockGuidebookRevisionRepository
+	*MockImageRepository
 	*MockMessageRepository
 	*MockOptionRepository
 	*MockPaymentRepository
+	*MockPubSubMessageRepository
 	*MockQuestionRepository
 	*MockQuestionGroupRepository
 	*MockRollCallRepository
@@ -23,26 +29,34 @@ type MockRepository struct {
 	*MockRoomRepository
 	*MockRoomGroupRepository
 	*MockRoomStatusRepository
+	*MockScheduledChangeRepository
 	*MockUserRepository
 }
 
 func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
 	return &MockRepository{
-		MockActivityRepository:         NewMockActivityRepository(ctrl),
-		MockAnswerRepository:           NewMockAnswerRepository(ctrl),
-		MockCampRepository:             NewMockCampRepository(ctrl),
-		MockEventRepository:            NewMockEventRepository(ctrl),
-		MockMessageRepository:          NewMockMessageRepository(ctrl),
-		MockOptionRepository:           NewMockOptionRepository(ctrl),
-		MockPaymentRepository:          NewMockPaymentRepository(ctrl),
-		MockQuestionRepository:         NewMockQuestionRepository(ctrl),
-		MockQuestionGroupRepository:    NewMockQuestionGroupRepository(ctrl),
-		MockRollCallRepository:         NewMockRollCallRepository(ctrl),
-		MockRollCallReactionRepository: NewMockRollCallReactionRepository(ctrl),
-		MockRoomRepository:             NewMockRoomRepository(ctrl),
-		MockRoomGroupRepository:        NewMockRoomGroupRepository(ctrl),
-		MockRoomStatusRepository:       NewMockRoomStatusRepository(ctrl),
-		MockUserRepository:             NewMockUserRepository(ctrl),
+		MockActivityRepository:          NewMockActivityRepository(ctrl),
+		MockAnswerRepository:            NewMockAnswerRepository(ctrl),
+		MockCampRepository:              NewMockCampRepository(ctrl),
+		MockEventRepository:             NewMockEventRepository(ctrl),
+		MockEventAttendanceRepository:   NewMockEventAttendanceRepository(ctrl),
+		MockEventReminderRepository:     NewMockEventReminderRepository(ctrl),
+		MockFeeRuleRepository:           NewMockFeeRuleRepository(ctrl),
+		MockGuidebookRevisionRepository: NewMockGuidebookRevisionRepository(ctrl),
+		MockImageRepository:             NewMockImageRepository(ctrl),
+		MockMessageRepository:           NewMockMessageRepository(ctrl),
+		MockOptionRepository:            NewMockOptionRepository(ctrl),
+		MockPaymentRepository:           NewMockPaymentRepository(ctrl),
+		MockPubSubMessageRepository:     NewMockPubSubMessageRepository(ctrl),
+		MockQuestionRepository:          NewMockQuestionRepository(ctrl),
+		MockQuestionGroupRepository:     NewMockQuestionGroupRepository(ctrl),
+		MockRollCallRepository:          NewMockRollCallRepository(ctrl),
+		MockRollCallReactionRepository:  NewMockRollCallReactionRepository(ctrl),
+		MockRoomRepository:              NewMockRoomRepository(ctrl),
+		MockRoomGroupRepository:         NewMockRoomGroupRepository(ctrl),
+		MockRoomStatusRepository:        NewMockRoomStatusRepository(ctrl),
+		MockScheduledChangeRepository:   NewMockScheduledChangeRepository(ctrl),
+		MockUserRepository:              NewMockUserRepository(ctrl),
 	}
 }
 
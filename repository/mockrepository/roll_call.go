@@ -12,8 +12,10 @@ package mockrepository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/traPtitech/rucQ/model"
+	repository "github.com/traPtitech/rucQ/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +57,80 @@ func (mr *MockRollCallRepositoryMockRecorder) CreateRollCall(ctx, rollCall any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRollCall", reflect.TypeOf((*MockRollCallRepository)(nil).CreateRollCall), ctx, rollCall)
 }
 
+// DeleteRollCall mocks base method.
+func (m *MockRollCallRepository) DeleteRollCall(ctx context.Context, rollCallID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRollCall", ctx, rollCallID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRollCall indicates an expected call of DeleteRollCall.
+func (mr *MockRollCallRepositoryMockRecorder) DeleteRollCall(ctx, rollCallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRollCall", reflect.TypeOf((*MockRollCallRepository)(nil).DeleteRollCall), ctx, rollCallID)
+}
+
+// GetOpenRollCallResponseCounts mocks base method.
+func (m *MockRollCallRepository) GetOpenRollCallResponseCounts(ctx context.Context, campID uint, now time.Time) ([]repository.RollCallResponseCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenRollCallResponseCounts", ctx, campID, now)
+	ret0, _ := ret[0].([]repository.RollCallResponseCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenRollCallResponseCounts indicates an expected call of GetOpenRollCallResponseCounts.
+func (mr *MockRollCallRepositoryMockRecorder) GetOpenRollCallResponseCounts(ctx, campID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenRollCallResponseCounts", reflect.TypeOf((*MockRollCallRepository)(nil).GetOpenRollCallResponseCounts), ctx, campID, now)
+}
+
+// GetPendingRollCalls mocks base method.
+func (m *MockRollCallRepository) GetPendingRollCalls(ctx context.Context, campID uint, userID string, now time.Time) ([]model.RollCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRollCalls", ctx, campID, userID, now)
+	ret0, _ := ret[0].([]model.RollCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRollCalls indicates an expected call of GetPendingRollCalls.
+func (mr *MockRollCallRepositoryMockRecorder) GetPendingRollCalls(ctx, campID, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRollCalls", reflect.TypeOf((*MockRollCallRepository)(nil).GetPendingRollCalls), ctx, campID, userID, now)
+}
+
+// GetRollCallByID mocks base method.
+func (m *MockRollCallRepository) GetRollCallByID(ctx context.Context, rollCallID uint) (*model.RollCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollCallByID", ctx, rollCallID)
+	ret0, _ := ret[0].(*model.RollCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRollCallByID indicates an expected call of GetRollCallByID.
+func (mr *MockRollCallRepositoryMockRecorder) GetRollCallByID(ctx, rollCallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollCallByID", reflect.TypeOf((*MockRollCallRepository)(nil).GetRollCallByID), ctx, rollCallID)
+}
+
+// GetRollCallByTraqMessageID mocks base method.
+func (m *MockRollCallRepository) GetRollCallByTraqMessageID(ctx context.Context, messageID string) (*model.RollCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollCallByTraqMessageID", ctx, messageID)
+	ret0, _ := ret[0].(*model.RollCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRollCallByTraqMessageID indicates an expected call of GetRollCallByTraqMessageID.
+func (mr *MockRollCallRepositoryMockRecorder) GetRollCallByTraqMessageID(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollCallByTraqMessageID", reflect.TypeOf((*MockRollCallRepository)(nil).GetRollCallByTraqMessageID), ctx, messageID)
+}
+
 // GetRollCalls mocks base method.
 func (m *MockRollCallRepository) GetRollCalls(ctx context.Context, campID uint) ([]model.RollCall, error) {
 	m.ctrl.T.Helper()
@@ -69,3 +145,45 @@ func (mr *MockRollCallRepositoryMockRecorder) GetRollCalls(ctx, campID any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollCalls", reflect.TypeOf((*MockRollCallRepository)(nil).GetRollCalls), ctx, campID)
 }
+
+// MarkRollCallNudged mocks base method.
+func (m *MockRollCallRepository) MarkRollCallNudged(ctx context.Context, rollCallID uint, nudgedAt time.Time, interval time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRollCallNudged", ctx, rollCallID, nudgedAt, interval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRollCallNudged indicates an expected call of MarkRollCallNudged.
+func (mr *MockRollCallRepositoryMockRecorder) MarkRollCallNudged(ctx, rollCallID, nudgedAt, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRollCallNudged", reflect.TypeOf((*MockRollCallRepository)(nil).MarkRollCallNudged), ctx, rollCallID, nudgedAt, interval)
+}
+
+// UpdateRollCall mocks base method.
+func (m *MockRollCallRepository) UpdateRollCall(ctx context.Context, rollCallID uint, rollCall *model.RollCall) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRollCall", ctx, rollCallID, rollCall)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRollCall indicates an expected call of UpdateRollCall.
+func (mr *MockRollCallRepositoryMockRecorder) UpdateRollCall(ctx, rollCallID, rollCall any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRollCall", reflect.TypeOf((*MockRollCallRepository)(nil).UpdateRollCall), ctx, rollCallID, rollCall)
+}
+
+// UpdateRollCallTraqMessageID mocks base method.
+func (m *MockRollCallRepository) UpdateRollCallTraqMessageID(ctx context.Context, rollCallID uint, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRollCallTraqMessageID", ctx, rollCallID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRollCallTraqMessageID indicates an expected call of UpdateRollCallTraqMessageID.
+func (mr *MockRollCallRepositoryMockRecorder) UpdateRollCallTraqMessageID(ctx, rollCallID, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRollCallTraqMessageID", reflect.TypeOf((*MockRollCallRepository)(nil).UpdateRollCallTraqMessageID), ctx, rollCallID, messageID)
+}
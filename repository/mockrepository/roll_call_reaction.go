@@ -12,6 +12,7 @@ package mockrepository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/traPtitech/rucQ/model"
 	gomock "go.uber.org/mock/gomock"
@@ -55,6 +56,20 @@ func (mr *MockRollCallReactionRepositoryMockRecorder) CreateRollCallReaction(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRollCallReaction", reflect.TypeOf((*MockRollCallReactionRepository)(nil).CreateRollCallReaction), ctx, reaction)
 }
 
+// CreateUniqueRollCallReaction mocks base method.
+func (m *MockRollCallReactionRepository) CreateUniqueRollCallReaction(ctx context.Context, reaction *model.RollCallReaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUniqueRollCallReaction", ctx, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUniqueRollCallReaction indicates an expected call of CreateUniqueRollCallReaction.
+func (mr *MockRollCallReactionRepositoryMockRecorder) CreateUniqueRollCallReaction(ctx, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUniqueRollCallReaction", reflect.TypeOf((*MockRollCallReactionRepository)(nil).CreateUniqueRollCallReaction), ctx, reaction)
+}
+
 // DeleteRollCallReaction mocks base method.
 func (m *MockRollCallReactionRepository) DeleteRollCallReaction(ctx context.Context, reactionID uint) error {
 	m.ctrl.T.Helper()
@@ -84,6 +99,21 @@ func (mr *MockRollCallReactionRepositoryMockRecorder) GetRollCallReactionByID(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollCallReactionByID", reflect.TypeOf((*MockRollCallReactionRepository)(nil).GetRollCallReactionByID), ctx, reactionID)
 }
 
+// GetRollCallReactionChanges mocks base method.
+func (m *MockRollCallReactionRepository) GetRollCallReactionChanges(ctx context.Context, rollCallID uint, since time.Time, afterReactionID uint) ([]model.RollCallReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollCallReactionChanges", ctx, rollCallID, since, afterReactionID)
+	ret0, _ := ret[0].([]model.RollCallReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRollCallReactionChanges indicates an expected call of GetRollCallReactionChanges.
+func (mr *MockRollCallReactionRepositoryMockRecorder) GetRollCallReactionChanges(ctx, rollCallID, since, afterReactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollCallReactionChanges", reflect.TypeOf((*MockRollCallReactionRepository)(nil).GetRollCallReactionChanges), ctx, rollCallID, since, afterReactionID)
+}
+
 // GetRollCallReactions mocks base method.
 func (m *MockRollCallReactionRepository) GetRollCallReactions(ctx context.Context, rollCallID uint) ([]model.RollCallReaction, error) {
 	m.ctrl.T.Helper()
@@ -41,6 +41,21 @@ func (m *MockRoomRepository) EXPECT() *MockRoomRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountUnassignedParticipants mocks base method.
+func (m *MockRoomRepository) CountUnassignedParticipants(ctx context.Context, campID uint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnassignedParticipants", ctx, campID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnassignedParticipants indicates an expected call of CountUnassignedParticipants.
+func (mr *MockRoomRepositoryMockRecorder) CountUnassignedParticipants(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnassignedParticipants", reflect.TypeOf((*MockRoomRepository)(nil).CountUnassignedParticipants), ctx, campID)
+}
+
 // CreateRoom mocks base method.
 func (m *MockRoomRepository) CreateRoom(ctx context.Context, room *model.Room) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: scheduled_change.go
+//
+// Generated by this command:
+//
+//	mockgen -source=scheduled_change.go -destination=mockrepository/scheduled_change.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/traPtitech/rucQ/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduledChangeRepository is a mock of ScheduledChangeRepository interface.
+type MockScheduledChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledChangeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduledChangeRepositoryMockRecorder is the mock recorder for MockScheduledChangeRepository.
+type MockScheduledChangeRepositoryMockRecorder struct {
+	mock *MockScheduledChangeRepository
+}
+
+// NewMockScheduledChangeRepository creates a new mock instance.
+func NewMockScheduledChangeRepository(ctrl *gomock.Controller) *MockScheduledChangeRepository {
+	mock := &MockScheduledChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledChangeRepository) EXPECT() *MockScheduledChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateScheduledChange mocks base method.
+func (m *MockScheduledChangeRepository) CreateScheduledChange(ctx context.Context, change *model.ScheduledChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledChange", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateScheduledChange indicates an expected call of CreateScheduledChange.
+func (mr *MockScheduledChangeRepositoryMockRecorder) CreateScheduledChange(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledChange", reflect.TypeOf((*MockScheduledChangeRepository)(nil).CreateScheduledChange), ctx, change)
+}
+
+// DeleteScheduledChange mocks base method.
+func (m *MockScheduledChangeRepository) DeleteScheduledChange(ctx context.Context, changeID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledChange", ctx, changeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledChange indicates an expected call of DeleteScheduledChange.
+func (mr *MockScheduledChangeRepositoryMockRecorder) DeleteScheduledChange(ctx, changeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledChange", reflect.TypeOf((*MockScheduledChangeRepository)(nil).DeleteScheduledChange), ctx, changeID)
+}
+
+// GetDueScheduledChanges mocks base method.
+func (m *MockScheduledChangeRepository) GetDueScheduledChanges(ctx context.Context, now time.Time) ([]model.ScheduledChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueScheduledChanges", ctx, now)
+	ret0, _ := ret[0].([]model.ScheduledChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueScheduledChanges indicates an expected call of GetDueScheduledChanges.
+func (mr *MockScheduledChangeRepositoryMockRecorder) GetDueScheduledChanges(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueScheduledChanges", reflect.TypeOf((*MockScheduledChangeRepository)(nil).GetDueScheduledChanges), ctx, now)
+}
+
+// GetScheduledChangeByID mocks base method.
+func (m *MockScheduledChangeRepository) GetScheduledChangeByID(ctx context.Context, changeID uint) (*model.ScheduledChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledChangeByID", ctx, changeID)
+	ret0, _ := ret[0].(*model.ScheduledChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledChangeByID indicates an expected call of GetScheduledChangeByID.
+func (mr *MockScheduledChangeRepositoryMockRecorder) GetScheduledChangeByID(ctx, changeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChangeByID", reflect.TypeOf((*MockScheduledChangeRepository)(nil).GetScheduledChangeByID), ctx, changeID)
+}
+
+// GetScheduledChanges mocks base method.
+func (m *MockScheduledChangeRepository) GetScheduledChanges(ctx context.Context, campID uint) ([]model.ScheduledChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledChanges", ctx, campID)
+	ret0, _ := ret[0].([]model.ScheduledChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledChanges indicates an expected call of GetScheduledChanges.
+func (mr *MockScheduledChangeRepositoryMockRecorder) GetScheduledChanges(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledChanges", reflect.TypeOf((*MockScheduledChangeRepository)(nil).GetScheduledChanges), ctx, campID)
+}
+
+// UpdateScheduledChange mocks base method.
+func (m *MockScheduledChangeRepository) UpdateScheduledChange(ctx context.Context, changeID uint, change *model.ScheduledChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledChange", ctx, changeID, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScheduledChange indicates an expected call of UpdateScheduledChange.
+func (mr *MockScheduledChangeRepositoryMockRecorder) UpdateScheduledChange(ctx, changeID, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledChange", reflect.TypeOf((*MockScheduledChangeRepository)(nil).UpdateScheduledChange), ctx, changeID, change)
+}
@@ -1,13 +1,34 @@
 //go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
 package repository
 
-import "github.com/traPtitech/rucQ/model"
+import (
+	"context"
+	"errors"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var ErrOptionNotFound = errors.New("option not found")
 
 type GetOptionsQuery struct {
 	QuestionID *uint
 }
 
 type OptionRepository interface {
-	CreateOption(option *model.Option) error
+	CreateOption(ctx context.Context, option *model.Option) error
 	GetOptions(query *GetOptionsQuery) ([]model.Option, error)
+	// ReorderOptions は質問内の選択肢をoptionIDsの順に並べ替えます
+	// 質問が存在しない場合はErrQuestionNotFoundを返します
+	ReorderOptions(ctx context.Context, questionID uint, optionIDs []uint) error
+	// MergeOptions はsourceOptionIDの選択肢を選んだ回答をtargetOptionIDの選択肢に付け替え、
+	// sourceOptionIDの選択肢を質問から取り除きます。変更された回答はeditorIDを変更者としてリビジョンを保存します
+	// 質問が存在しない場合はErrQuestionNotFoundを、
+	// どちらかの選択肢が質問の現在の選択肢でない場合はErrOptionNotFoundを返します
+	MergeOptions(
+		ctx context.Context,
+		questionID uint,
+		sourceOptionID uint,
+		targetOptionID uint,
+		editorID string,
+	) error
 }
@@ -10,10 +10,37 @@ import (
 
 var ErrPaymentNotFound = errors.New("payment not found")
 
+// PaymentCollection は合宿の支払いの集金状況
+type PaymentCollection struct {
+	PaymentCount int
+	// 支払済み金額が支払い金額以上の支払いの数
+	PaidCount       int
+	TotalAmount     int
+	TotalAmountPaid int
+}
+
 type PaymentRepository interface {
 	CreatePayment(ctx context.Context, payment *model.Payment) error
 	GetPayments(ctx context.Context, campID uint) ([]model.Payment, error)
 	GetPaymentByUserID(ctx context.Context, campID uint, userID string) (*model.Payment, error)
+	// UpdatePayment は支払い金額を更新します
+	// 支払済み金額は入出金の記録の合計のため、CreatePaymentTransactionで変更します
 	UpdatePayment(ctx context.Context, paymentID uint, payment *model.Payment) error
 	GetPaymentByID(ctx context.Context, paymentID uint) (*model.Payment, error)
+	// ReplacePaymentLineItems は支払いの明細を全てlineItemsに置き換え、金額を明細の合計にします
+	// 支払いが存在しない場合はErrPaymentNotFoundを返します
+	ReplacePaymentLineItems(
+		ctx context.Context,
+		paymentID uint,
+		lineItems []model.PaymentLineItem,
+	) error
+	// CreatePaymentTransaction は入出金を記録し、支払済み金額を記録の合計にします
+	// 支払いが存在しない場合はErrPaymentNotFoundを返します
+	CreatePaymentTransaction(ctx context.Context, transaction *model.PaymentTransaction) error
+	// GetPaymentTransactions は支払いの入出金の記録を受け取った日時の古い順に取得します
+	GetPaymentTransactions(ctx context.Context, paymentID uint) ([]model.PaymentTransaction, error)
+	// GetCampPaymentTransactions は合宿の全ての支払いの入出金の記録を受け取った日時の古い順に取得します
+	GetCampPaymentTransactions(ctx context.Context, campID uint) ([]model.PaymentTransaction, error)
+	// GetPaymentCollection は合宿の現在の参加者の支払いの数と金額を集計します
+	GetPaymentCollection(ctx context.Context, campID uint) (*PaymentCollection, error)
 }
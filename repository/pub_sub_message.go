@@ -0,0 +1,20 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+type PubSubMessageRepository interface {
+	// CreatePubSubMessage 他のインスタンスに配信するメッセージを作成します
+	CreatePubSubMessage(ctx context.Context, message *model.PubSubMessage) error
+	// GetLatestPubSubMessageID 最新のメッセージのIDを取得します。メッセージがない場合は0を返します
+	GetLatestPubSubMessageID(ctx context.Context) (uint, error)
+	// GetPubSubMessages afterIDより後のメッセージと、IDがidsに含まれるメッセージをID順に取得します
+	GetPubSubMessages(ctx context.Context, afterID uint, ids []uint) ([]model.PubSubMessage, error)
+	// DeletePubSubMessagesBefore beforeより前に作成されたメッセージを削除します
+	DeletePubSubMessagesBefore(ctx context.Context, before time.Time) error
+}
@@ -3,14 +3,23 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/traPtitech/rucQ/model"
 )
 
+var ErrQuestionNotFound = errors.New("question not found")
+
 type QuestionRepository interface {
-	CreateQuestion(question *model.Question) error
+	CreateQuestion(ctx context.Context, question *model.Question) error
 	GetQuestions() ([]model.Question, error)
 	GetQuestionByID(id uint) (*model.Question, error)
 	DeleteQuestionByID(id uint) error
+	// UpdateQuestion は質問を更新します。リクエストに含まれない選択肢は削除せずに質問から取り除き、
+	// その選択肢を選んでいた回答に印を付けます。他の質問の選択肢が含まれる場合はErrOptionNotFoundを返します
 	UpdateQuestion(ctx context.Context, questionID uint, question *model.Question) error
+	GetQuestionCampID(ctx context.Context, questionID uint) (uint, error)
+	// ReorderQuestions は質問グループ内の質問をquestionIDsの順に並べ替えます
+	// 質問グループが存在しない場合はmodel.ErrNotFoundを返します
+	ReorderQuestions(ctx context.Context, questionGroupID uint, questionIDs []uint) error
 }
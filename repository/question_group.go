@@ -3,12 +3,26 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/traPtitech/rucQ/model"
 )
 
+// ErrInvalidSortOrder は並べ替え対象のIDが過不足なく指定されていない場合のエラー
+var ErrInvalidSortOrder = errors.New("ids must be a permutation of all existing ids")
+
+// QuestionGroupCompletion は質問グループの必須の質問に全て回答した参加者の数
+type QuestionGroupCompletion struct {
+	QuestionGroupID       uint
+	Name                  string
+	Due                   time.Time
+	RequiredQuestionCount int
+	CompletedCount        int
+}
+
 type QuestionGroupRepository interface {
-	CreateQuestionGroup(questionGroup *model.QuestionGroup) error
+	CreateQuestionGroup(ctx context.Context, questionGroup *model.QuestionGroup) error
 	GetQuestionGroups(ctx context.Context, campID uint) ([]model.QuestionGroup, error)
 	GetQuestionGroup(ctx context.Context, ID uint) (*model.QuestionGroup, error)
 	UpdateQuestionGroup(
@@ -17,4 +31,17 @@ type QuestionGroupRepository interface {
 		questionGroup model.QuestionGroup,
 	) error
 	DeleteQuestionGroup(ID uint) error
+	// ReorderQuestionGroups は合宿内の質問グループをquestionGroupIDsの順に並べ替えます
+	// 合宿が存在しない場合はErrCampNotFoundを返します
+	ReorderQuestionGroups(ctx context.Context, campID uint, questionGroupIDs []uint) error
+	// GetUnansweredRequiredQuestionGroups はユーザーが回答していない必須の質問を含む質問グループを、
+	// それらの質問だけを読み込んで取得します
+	GetUnansweredRequiredQuestionGroups(
+		ctx context.Context,
+		campID uint,
+		userID string,
+	) ([]model.QuestionGroup, error)
+	// GetQuestionGroupCompletions は合宿の質問グループごとに回答を終えた参加者を数えます。
+	// 必須の質問がない質問グループでは全ての参加者を回答済みとします
+	GetQuestionGroupCompletions(ctx context.Context, campID uint) ([]QuestionGroupCompletion, error)
 }
@@ -7,9 +7,15 @@ type Repository interface {
 	AnswerRepository
 	CampRepository
 	EventRepository
+	EventAttendanceRepository
+	EventReminderRepository
+	FeeRuleRepository
+	GuidebookRevisionRepository
+	ImageRepository
 	MessageRepository
 	OptionRepository
 	PaymentRepository
+	PubSubMessageRepository
 	QuestionRepository
 	QuestionGroupRepository
 	RollCallRepository
@@ -17,6 +23,7 @@ type Repository interface {
 	RoomGroupRepository
 	RoomRepository
 	RoomStatusRepository
+	ScheduledChangeRepository
 	UserRepository
 	Transaction(ctx context.Context, fn func(tx Repository) error) error
 }
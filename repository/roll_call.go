@@ -4,13 +4,58 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/traPtitech/rucQ/model"
 )
 
-var ErrRollCallNotFound = errors.New("roll call not found")
+var (
+	ErrRollCallNotFound       = errors.New("roll call not found")
+	ErrRollCallNudgedRecently = errors.New("roll call was nudged recently")
+)
+
+// RollCallResponseCount は点呼の対象者と回答した人の数
+type RollCallResponseCount struct {
+	RollCallID uint
+	Name       string
+	Deadline   *time.Time
+	// 対象者が指定されていない場合は0
+	SubjectCount int
+	// リアクションしたユーザーの数。対象者が指定されている場合は対象者だけを数える
+	RespondedCount int
+}
 
 type RollCallRepository interface {
 	CreateRollCall(ctx context.Context, rollCall *model.RollCall) error
 	GetRollCalls(ctx context.Context, campID uint) ([]model.RollCall, error)
+	GetRollCallByID(ctx context.Context, rollCallID uint) (*model.RollCall, error)
+	// GetRollCallByTraqMessageID はtraQに投稿したメッセージのUUIDから点呼を取得します
+	GetRollCallByTraqMessageID(ctx context.Context, messageID string) (*model.RollCall, error)
+	// UpdateRollCall は点呼の内容と対象者を更新します。ゼロ値のフィールドも上書きします
+	UpdateRollCall(ctx context.Context, rollCallID uint, rollCall *model.RollCall) error
+	// DeleteRollCall は点呼とそのリアクションを削除します
+	DeleteRollCall(ctx context.Context, rollCallID uint) error
+	// UpdateRollCallTraqMessageID はtraQに投稿したメッセージのUUIDを記録します
+	UpdateRollCallTraqMessageID(ctx context.Context, rollCallID uint, messageID string) error
+	// MarkRollCallNudged は前回の再通知からinterval以上経っていれば再通知した時刻を記録します。
+	// 経っていない場合はErrRollCallNudgedRecentlyを返します
+	MarkRollCallNudged(
+		ctx context.Context,
+		rollCallID uint,
+		nudgedAt time.Time,
+		interval time.Duration,
+	) error
+	// GetPendingRollCalls はユーザーが対象で、まだリアクションしていない受付中の点呼を取得します
+	GetPendingRollCalls(
+		ctx context.Context,
+		campID uint,
+		userID string,
+		now time.Time,
+	) ([]model.RollCall, error)
+	// GetOpenRollCallResponseCounts は受付中の点呼ごとに対象者と回答した人を数えます
+	GetOpenRollCallResponseCounts(
+		ctx context.Context,
+		campID uint,
+		now time.Time,
+	) ([]RollCallResponseCount, error)
 }
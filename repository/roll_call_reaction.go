@@ -4,14 +4,22 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/traPtitech/rucQ/model"
 )
 
-var ErrRollCallReactionNotFound = errors.New("roll call reaction not found")
+var (
+	ErrRollCallReactionNotFound      = errors.New("roll call reaction not found")
+	ErrRollCallReactionAlreadyExists = errors.New("roll call reaction already exists")
+)
 
 type RollCallReactionRepository interface {
 	CreateRollCallReaction(ctx context.Context, reaction *model.RollCallReaction) error
+	// CreateUniqueRollCallReaction はユーザーがまだリアクションしていない場合だけリアクションを作成する。
+	// 同時に呼ばれても1人1つになるよう点呼をロックして確認し、
+	// 既にリアクションしている場合はErrRollCallReactionAlreadyExistsを返す
+	CreateUniqueRollCallReaction(ctx context.Context, reaction *model.RollCallReaction) error
 	GetRollCallReactions(ctx context.Context, rollCallID uint) ([]model.RollCallReaction, error)
 	GetRollCallReactionByID(ctx context.Context, reactionID uint) (*model.RollCallReaction, error)
 	UpdateRollCallReaction(
@@ -20,4 +28,13 @@ type RollCallReactionRepository interface {
 		reaction *model.RollCallReaction,
 	) error
 	DeleteRollCallReaction(ctx context.Context, reactionID uint) error
+	// GetRollCallReactionChanges は指定した位置より後に変更されたリアクションを、
+	// 削除されたものも含めて変更された順に取得する。
+	// 変更時刻は削除されたものはDeletedAt、それ以外はUpdatedAtで、同じ時刻の場合はIDで順序を決める
+	GetRollCallReactionChanges(
+		ctx context.Context,
+		rollCallID uint,
+		since time.Time,
+		afterReactionID uint,
+	) ([]model.RollCallReaction, error)
 }
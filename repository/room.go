@@ -24,4 +24,6 @@ type RoomRepository interface {
 	CreateRoom(ctx context.Context, room *model.Room) error
 	UpdateRoom(ctx context.Context, roomID uint, room *model.Room) error
 	DeleteRoom(ctx context.Context, roomID uint) error
+	// CountUnassignedParticipants は合宿のどの部屋にも割り当てられていない参加者を数えます
+	CountUnassignedParticipants(ctx context.Context, campID uint) (int, error)
 }
@@ -0,0 +1,24 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockrepository/$GOFILE -package=mockrepository
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var ErrScheduledChangeNotFound = errors.New("scheduled change not found")
+
+type ScheduledChangeRepository interface {
+	CreateScheduledChange(ctx context.Context, change *model.ScheduledChange) error
+	// GetScheduledChanges は合宿の予約した変更を予定時刻の順に取得します
+	GetScheduledChanges(ctx context.Context, campID uint) ([]model.ScheduledChange, error)
+	GetScheduledChangeByID(ctx context.Context, changeID uint) (*model.ScheduledChange, error)
+	// UpdateScheduledChange は処理時刻と失敗理由を更新します
+	UpdateScheduledChange(ctx context.Context, changeID uint, change *model.ScheduledChange) error
+	DeleteScheduledChange(ctx context.Context, changeID uint) error
+	// GetDueScheduledChanges は予定時刻を過ぎた未処理の変更を予定時刻の順に取得します
+	GetDueScheduledChanges(ctx context.Context, now time.Time) ([]model.ScheduledChange, error)
+}
@@ -48,6 +48,12 @@ func TestServer_GetActivities(t *testing.T) {
 		rollCallName := random.AlphaNumericString(t, 20)
 		questionGroupID := uint(random.PositiveInt(t))
 		questionGroupName := random.AlphaNumericString(t, 20)
+		scheduledChangeAppliedID := uint(random.PositiveInt(t))
+		scheduledChangeTime := random.Time(t)
+		guidebookPublishedID := uint(random.PositiveInt(t))
+		guidebookPublishedTime := random.Time(t)
+		guidebookRevisionID := uint(random.PositiveInt(t))
+		scheduledChangeValue := random.AlphaNumericString(t, 20)
 
 		activities := []activityservice.ActivityResponse{
 			{
@@ -101,6 +107,25 @@ func TestServer_GetActivities(t *testing.T) {
 					NeedsResponse:   true,
 				},
 			},
+			{
+				ID:   scheduledChangeAppliedID,
+				Type: model.ActivityTypeScheduledChangeApplied,
+				Time: scheduledChangeTime,
+				ScheduledChangeApplied: &activityservice.ScheduledChangeAppliedDetail{
+					TargetType: model.ScheduledChangeTargetTypeQuestionGroup,
+					TargetID:   questionGroupID,
+					Field:      model.ScheduledChangeFieldName,
+					Value:      scheduledChangeValue,
+				},
+			},
+			{
+				ID:   guidebookPublishedID,
+				Type: model.ActivityTypeGuidebookPublished,
+				Time: guidebookPublishedTime,
+				GuidebookPublished: &activityservice.GuidebookPublishedDetail{
+					RevisionID: guidebookRevisionID,
+				},
+			},
 		}
 
 		h.activityService.EXPECT().
@@ -115,7 +140,7 @@ func TestServer_GetActivities(t *testing.T) {
 			JSON().
 			Array()
 
-		res.Length().IsEqual(6)
+		res.Length().IsEqual(8)
 
 		// Check first activity (RoomCreated)
 		act1 := res.Value(0).Object()
@@ -163,6 +188,23 @@ func TestServer_GetActivities(t *testing.T) {
 		act6.Value("name").String().IsEqual(questionGroupName)
 		act6.Value("due").String().IsEqual(questionTime.Format("2006-01-02"))
 		act6.Value("needsResponse").Boolean().IsTrue()
+
+		// Check seventh activity (ScheduledChangeApplied)
+		act7 := res.Value(6).Object()
+		act7.Value("id").Number().IsEqual(scheduledChangeAppliedID)
+		act7.Value("type").String().IsEqual("scheduled_change_applied")
+		act7.Value("time").String().IsEqual(scheduledChangeTime.Format(time.RFC3339Nano))
+		act7.Value("targetType").String().IsEqual("question_group")
+		act7.Value("targetId").Number().IsEqual(int(questionGroupID))
+		act7.Value("field").String().IsEqual("name")
+		act7.Value("value").String().IsEqual(scheduledChangeValue)
+
+		// Check eighth activity (GuidebookPublished)
+		act8 := res.Value(7).Object()
+		act8.Value("id").Number().IsEqual(guidebookPublishedID)
+		act8.Value("type").String().IsEqual("guidebook_published")
+		act8.Value("time").String().IsEqual(guidebookPublishedTime.Format(time.RFC3339Nano))
+		act8.Value("revisionId").Number().IsEqual(int(guidebookRevisionID))
 	})
 
 	t.Run("activityServiceでエラーが起こった場合", func(t *testing.T) {
@@ -0,0 +1,195 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// GetMyAnswerRevisions ある質問に対する自分の回答の変更履歴を取得
+// (GET /api/me/questions/{questionId}/answer-revisions)
+func (s *Server) GetMyAnswerRevisions(
+	e echo.Context,
+	questionID api.QuestionId,
+	params api.GetMyAnswerRevisionsParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	return s.respondAnswerRevisions(e, uint(questionID), *params.XForwardedUser)
+}
+
+// AdminGetAnswerRevisions ある質問に対するユーザーの回答の変更履歴を取得（管理者用）
+// (GET /api/admin/users/{userId}/questions/{questionId}/answer-revisions)
+func (s *Server) AdminGetAnswerRevisions(
+	e echo.Context,
+	userID api.UserId,
+	questionID api.QuestionId,
+	params api.AdminGetAnswerRevisionsParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	return s.respondAnswerRevisions(e, uint(questionID), userID)
+}
+
+func (s *Server) respondAnswerRevisions(e echo.Context, questionID uint, userID string) error {
+	revisions, err := s.repo.GetAnswerRevisions(e.Request().Context(), questionID, userID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrQuestionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get answer revisions: %w", err))
+	}
+
+	res, err := converter.Convert[[]api.AnswerRevisionResponse](revisions)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert response body: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminRestoreAnswerRevision 回答を以前の内容に戻す（管理者用）
+// (POST /api/admin/answer-revisions/{answerRevisionId}/restore)
+func (s *Server) AdminRestoreAnswerRevision(
+	e echo.Context,
+	answerRevisionID api.AnswerRevisionId,
+	params api.AdminRestoreAnswerRevisionParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	revision, err := s.repo.GetAnswerRevisionByID(ctx, uint(answerRevisionID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrAnswerRevisionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Answer revision not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get answer revision: %w", err))
+	}
+
+	// 変更前の回答を取得
+	oldAnswer, err := s.repo.GetAnswerByID(ctx, revision.AnswerID)
+
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Answer not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get answer: %w", err))
+	}
+
+	// 画像は回答の履歴とは別に削除されるため、削除された画像の回答には戻せない
+	if revision.FileImageID != nil {
+		if _, err := s.repo.GetImageByID(ctx, *revision.FileImageID); err != nil {
+			if errors.Is(err, repository.ErrImageNotFound) {
+				return echo.NewHTTPError(
+					http.StatusConflict,
+					"The image in this revision has been deleted",
+				)
+			}
+
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to get image: %w", err))
+		}
+	}
+
+	question, err := s.repo.GetQuestionByID(revision.QuestionID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question: %w", err))
+	}
+
+	// 復元も新しいリビジョンとして保存される
+	answer := revision.AsAnswer()
+
+	// 選択肢の削除や範囲の変更により、以前の回答が現在の質問に合わなくなっていることがある
+	if err := validateAnswer(&answer, question); err != nil {
+		return echo.NewHTTPError(
+			http.StatusConflict,
+			fmt.Sprintf("This revision no longer fits the question: %s", err),
+		)
+	}
+
+	if err := s.repo.UpdateAnswer(ctx, revision.AnswerID, &answer, user.ID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to update answer: %w", err))
+	}
+
+	s.publishAnswerEvents(ctx, eventbus.EventTypeUpdated, answer)
+
+	// 回答者にDMを送信（非同期）
+	go func(oldAnswer *model.Answer, newAnswer model.Answer) {
+		ctx := context.WithoutCancel(ctx)
+
+		if err := s.notificationService.SendAnswerChangeMessage(
+			ctx,
+			user.ID,
+			oldAnswer,
+			newAnswer,
+		); err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to send answer change message",
+				slog.String("error", err.Error()),
+				slog.Int("answerId", int(newAnswer.ID)),
+				slog.String("userId", user.ID),
+			)
+		}
+	}(oldAnswer, answer)
+
+	res, err := converter.Convert[api.AnswerResponse](answer)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert response body: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,398 @@
+package router
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_GetMyAnswerRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		staffID := random.AlphaNumericString(t, 32)
+		questionID := uint(random.PositiveInt(t))
+		answerID := uint(random.PositiveInt(t))
+		oldContent := random.AlphaNumericString(t, 20)
+		newContent := random.AlphaNumericString(t, 20)
+		revisions := []model.AnswerRevision{
+			{
+				Model:           gorm.Model{ID: 1, CreatedAt: time.Now().Add(-time.Hour)},
+				AnswerID:        answerID,
+				QuestionID:      questionID,
+				UserID:          userID,
+				Type:            model.FreeTextQuestion,
+				FreeTextContent: &oldContent,
+				EditorID:        userID,
+			},
+			{
+				Model:           gorm.Model{ID: 2, CreatedAt: time.Now()},
+				AnswerID:        answerID,
+				QuestionID:      questionID,
+				UserID:          userID,
+				Type:            model.FreeTextQuestion,
+				FreeTextContent: &newContent,
+				EditorID:        staffID,
+			},
+		}
+
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisions(gomock.Any(), questionID, userID).
+			Return(revisions, nil).
+			Times(1)
+
+		res := h.expect.GET("/api/me/questions/{questionId}/answer-revisions", questionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(len(revisions))
+
+		for i, revision := range revisions {
+			obj := res.Value(i).Object()
+
+			obj.Value("id").Number().IsEqual(revision.ID)
+			obj.Value("answerId").Number().IsEqual(answerID)
+			obj.Value("editorId").String().IsEqual(revision.EditorID)
+
+			answer := obj.Value("answer").Object()
+
+			answer.Value("id").Number().IsEqual(answerID)
+			answer.Value("type").String().IsEqual("free_text")
+			answer.Value("userId").String().IsEqual(userID)
+			answer.Value("content").String().IsEqual(*revision.FreeTextContent)
+		}
+	})
+
+	t.Run("Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionID := uint(random.PositiveInt(t))
+
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisions(gomock.Any(), questionID, userID).
+			Return(nil, repository.ErrQuestionNotFound).
+			Times(1)
+
+		h.expect.GET("/api/me/questions/{questionId}/answer-revisions", questionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_AdminGetAnswerRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		questionID := uint(random.PositiveInt(t))
+		option := model.Option{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			QuestionID: questionID,
+			Content:    random.AlphaNumericString(t, 20),
+		}
+		revision := model.AnswerRevision{
+			Model:           gorm.Model{ID: uint(random.PositiveInt(t))},
+			AnswerID:        uint(random.PositiveInt(t)),
+			QuestionID:      questionID,
+			UserID:          userID,
+			Type:            model.SingleChoiceQuestion,
+			SelectedOptions: []model.Option{option},
+			EditorID:        userID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisions(gomock.Any(), questionID, userID).
+			Return([]model.AnswerRevision{revision}, nil).
+			Times(1)
+
+		res := h.expect.GET(
+			"/api/admin/users/{userId}/questions/{questionId}/answer-revisions",
+			userID,
+			questionID,
+		).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(1)
+
+		selectedOption := res.Value(0).Object().
+			Value("answer").Object().
+			Value("selectedOption").Object()
+
+		selectedOption.Value("id").Number().IsEqual(option.ID)
+		selectedOption.Value("content").String().IsEqual(option.Content)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+
+		h.expect.GET(
+			"/api/admin/users/{userId}/questions/{questionId}/answer-revisions",
+			random.AlphaNumericString(t, 32),
+			random.PositiveInt(t),
+		).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminRestoreAnswerRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		var wg sync.WaitGroup
+
+		wg.Add(1)
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		answerID := uint(random.PositiveInt(t))
+		questionID := uint(random.PositiveInt(t))
+		oldContent := random.Float64(t)
+		restoredContent := random.Float64(t)
+		revision := model.AnswerRevision{
+			Model:             gorm.Model{ID: uint(random.PositiveInt(t))},
+			AnswerID:          answerID,
+			QuestionID:        questionID,
+			UserID:            userID,
+			Type:              model.FreeNumberQuestion,
+			FreeNumberContent: &restoredContent,
+			EditorID:          userID,
+		}
+		oldAnswer := model.Answer{
+			Model:             gorm.Model{ID: answerID},
+			QuestionID:        questionID,
+			UserID:            userID,
+			Type:              model.FreeNumberQuestion,
+			FreeNumberContent: &oldContent,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerByID(gomock.Any(), answerID).
+			Return(&oldAnswer, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(&model.Question{
+				Model: gorm.Model{ID: questionID},
+				Type:  model.FreeNumberQuestion,
+			}, nil).
+			Times(1)
+		// 復元した操作者をリビジョンの変更者として記録する
+		h.repo.MockAnswerRepository.EXPECT().
+			UpdateAnswer(gomock.Any(), answerID, gomock.Any(), staffID).
+			Return(nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), questionID).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+		h.notificationService.EXPECT().
+			SendAnswerChangeMessage(gomock.Any(), staffID, &oldAnswer, revision.AsAnswer()).
+			DoAndReturn(func(_, _, _, _ any) error {
+				defer wg.Done()
+
+				return nil
+			}).
+			Times(1)
+
+		res := h.expect.POST(
+			"/api/admin/answer-revisions/{answerRevisionId}/restore",
+			revision.ID,
+		).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		waitWithTimeout(t, &wg, 2*time.Second)
+		res.Value("id").Number().IsEqual(answerID)
+		res.Value("type").String().IsEqual("free_number")
+		res.Value("userId").String().IsEqual(userID)
+		res.Value("content").Number().InDelta(restoredContent, 0.001)
+	})
+
+	t.Run("Image deleted", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		answerID := uint(random.PositiveInt(t))
+		imageID := uint(random.PositiveInt(t))
+		revision := model.AnswerRevision{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			AnswerID:    answerID,
+			QuestionID:  uint(random.PositiveInt(t)),
+			UserID:      random.AlphaNumericString(t, 32),
+			Type:        model.FileQuestion,
+			FileImageID: &imageID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerByID(gomock.Any(), answerID).
+			Return(&model.Answer{Model: gorm.Model{ID: answerID}}, nil).
+			Times(1)
+		h.repo.MockImageRepository.EXPECT().
+			GetImageByID(gomock.Any(), imageID).
+			Return(nil, repository.ErrImageNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/answer-revisions/{answerRevisionId}/restore", revision.ID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusConflict).
+			JSON().
+			Object().
+			Value("message").String().IsEqual("The image in this revision has been deleted")
+	})
+
+	t.Run("Option removed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		answerID := uint(random.PositiveInt(t))
+		questionID := uint(random.PositiveInt(t))
+		removedOption := model.Option{Model: gorm.Model{ID: uint(random.PositiveInt(t))}}
+		revision := model.AnswerRevision{
+			Model:           gorm.Model{ID: uint(random.PositiveInt(t))},
+			AnswerID:        answerID,
+			QuestionID:      questionID,
+			UserID:          random.AlphaNumericString(t, 32),
+			Type:            model.SingleChoiceQuestion,
+			SelectedOptions: []model.Option{removedOption},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerByID(gomock.Any(), answerID).
+			Return(&model.Answer{Model: gorm.Model{ID: answerID}}, nil).
+			Times(1)
+		// 取り除かれた選択肢は質問の選択肢に含まれない
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(&model.Question{
+				Model: gorm.Model{ID: questionID},
+				Type:  model.SingleChoiceQuestion,
+				Options: []model.Option{
+					{Model: gorm.Model{ID: removedOption.ID + 1}},
+				},
+			}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/answer-revisions/{answerRevisionId}/restore", revision.ID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusConflict).
+			JSON().
+			Object().
+			Value("message").String().Contains("no longer fits the question")
+	})
+
+	t.Run("Revision not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		revisionID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerRevisionByID(gomock.Any(), revisionID).
+			Return(nil, repository.ErrAnswerRevisionNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/answer-revisions/{answerRevisionId}/restore", revisionID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+
+		h.expect.POST(
+			"/api/admin/answer-revisions/{answerRevisionId}/restore",
+			random.PositiveInt(t),
+		).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -0,0 +1,111 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var (
+	errAnswerTypeMismatch    = errors.New("answer type does not match question type")
+	errAnswerInvalidOption   = errors.New("selected option does not belong to the question")
+	errAnswerDuplicateOption = errors.New("the same option is selected more than once")
+	errAnswerSingleChoice    = errors.New("exactly one option must be selected")
+	errAnswerScaleOutOfRange = errors.New("scale answer is out of range")
+	errAnswerRankingOptions  = errors.New("all options must be ranked exactly once")
+	errAnswerDateRequired    = errors.New("date answer must have a date")
+	errAnswerFileRequired    = errors.New("file answer must have an image")
+	errQuestionScaleRange    = errors.New("scaleMin must be less than scaleMax")
+)
+
+// validateQuestion は質問の設定が正しいかを確認する
+func validateQuestion(question *model.Question) error {
+	if question.Type == model.ScaleQuestion &&
+		(question.ScaleMin == nil || question.ScaleMax == nil ||
+			*question.ScaleMin >= *question.ScaleMax) {
+		return errQuestionScaleRange
+	}
+
+	return nil
+}
+
+// validateAnswer は回答の内容が質問の種類や設定に合っているかを確認する
+func validateAnswer(answer *model.Answer, question *model.Question) error {
+	if answer.Type != question.Type {
+		return fmt.Errorf("%w (question %d)", errAnswerTypeMismatch, question.ID)
+	}
+
+	switch answer.Type {
+	case model.SingleChoiceQuestion:
+		if len(answer.SelectedOptions) != 1 {
+			return errAnswerSingleChoice
+		}
+
+		return validateSelectedOptions(answer.SelectedOptions, question)
+
+	case model.MultipleChoiceQuestion:
+		return validateSelectedOptions(answer.SelectedOptions, question)
+
+	case model.ScaleQuestion:
+		if answer.ScaleContent == nil || question.ScaleMin == nil || question.ScaleMax == nil ||
+			*answer.ScaleContent < *question.ScaleMin || *answer.ScaleContent > *question.ScaleMax {
+			return errAnswerScaleOutOfRange
+		}
+
+	case model.DateQuestion, model.DateTimeQuestion:
+		if answer.DateContent == nil {
+			return errAnswerDateRequired
+		}
+
+	case model.FileQuestion:
+		if answer.FileImageID == nil {
+			return errAnswerFileRequired
+		}
+
+	case model.RankingQuestion:
+		// 全ての選択肢に重複なく順位を付ける必要がある
+		if len(answer.RankedOptions) != len(question.Options) {
+			return errAnswerRankingOptions
+		}
+
+		options := make([]model.Option, len(answer.RankedOptions))
+
+		for i, rankedOption := range answer.RankedOptions {
+			options[i] = model.Option{Model: gorm.Model{ID: rankedOption.OptionID}}
+		}
+
+		if err := validateSelectedOptions(options, question); err != nil {
+			return errAnswerRankingOptions
+		}
+	}
+
+	return nil
+}
+
+// validateSelectedOptions は選択された選択肢が質問のものであり、重複していないことを確認する
+func validateSelectedOptions(selectedOptions []model.Option, question *model.Question) error {
+	optionIDs := make(map[uint]struct{}, len(question.Options))
+
+	for _, option := range question.Options {
+		optionIDs[option.ID] = struct{}{}
+	}
+
+	selected := make(map[uint]struct{}, len(selectedOptions))
+
+	for _, option := range selectedOptions {
+		if _, ok := optionIDs[option.ID]; !ok {
+			return errAnswerInvalidOption
+		}
+
+		if _, ok := selected[option.ID]; ok {
+			return errAnswerDuplicateOption
+		}
+
+		selected[option.ID] = struct{}{}
+	}
+
+	return nil
+}
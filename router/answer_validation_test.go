@@ -0,0 +1,74 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestValidateAnswer(t *testing.T) {
+	t.Parallel()
+
+	date := random.Time(t)
+	imageID := uint(random.PositiveInt(t))
+
+	testCases := []struct {
+		name     string
+		answer   model.Answer
+		question model.Question
+		err      error
+	}{
+		{
+			name:     "Date",
+			answer:   model.Answer{Type: model.DateQuestion, DateContent: &date},
+			question: model.Question{Type: model.DateQuestion},
+		},
+		{
+			name:     "Date without content",
+			answer:   model.Answer{Type: model.DateQuestion},
+			question: model.Question{Type: model.DateQuestion},
+			err:      errAnswerDateRequired,
+		},
+		{
+			name:     "DateTime without content",
+			answer:   model.Answer{Type: model.DateTimeQuestion},
+			question: model.Question{Type: model.DateTimeQuestion},
+			err:      errAnswerDateRequired,
+		},
+		{
+			name:     "File",
+			answer:   model.Answer{Type: model.FileQuestion, FileImageID: &imageID},
+			question: model.Question{Type: model.FileQuestion},
+		},
+		{
+			name:     "File without image",
+			answer:   model.Answer{Type: model.FileQuestion},
+			question: model.Question{Type: model.FileQuestion},
+			err:      errAnswerFileRequired,
+		},
+		{
+			name:     "Type mismatch",
+			answer:   model.Answer{Type: model.DateTimeQuestion, DateContent: &date},
+			question: model.Question{Type: model.DateQuestion},
+			err:      errAnswerTypeMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAnswer(&tc.answer, &tc.question)
+
+			if tc.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+}
@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 func (s *Server) GetMyAnswers(
@@ -83,7 +86,7 @@ func (s *Server) GetAnswers(e echo.Context, questionID api.QuestionId) error {
 
 func (s *Server) PostAnswers(
 	e echo.Context,
-	_ api.QuestionGroupId,
+	questionGroupID api.QuestionGroupId,
 	params api.PostAnswersParams,
 ) error {
 	var req api.PostAnswersJSONRequestBody
@@ -99,15 +102,70 @@ func (s *Server) PostAnswers(
 			SetInternal(fmt.Errorf("failed to convert request body: %w", err))
 	}
 
+	questionGroup, err := s.repo.GetQuestionGroup(e.Request().Context(), uint(questionGroupID))
+
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question group not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question group: %w", err))
+	}
+
+	if err := s.ensureCampAcceptsAnswers(e.Request().Context(), questionGroup.CampID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range answers {
+		answer := &answers[i]
+		question, ok := findQuestion(questionGroup.Questions, answer.QuestionID)
+
+		if !ok {
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				fmt.Sprintf(
+					"Question %d does not belong to question group %d",
+					answer.QuestionID,
+					questionGroupID,
+				),
+			)
+		}
+
+		if questionGroup.IsAnswerLocked(question, now) {
+			return newAnswerLockedError(question.ID, questionGroup.Due)
+		}
+
+		if err := validateAnswer(answer, question); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		if err := s.validateAnswerImage(
+			e.Request().Context(),
+			answer,
+			questionGroup.CampID,
+		); err != nil {
+			return err
+		}
+	}
+
 	for i := range answers {
 		answers[i].UserID = *params.XForwardedUser
 	}
 
-	if err := s.repo.CreateAnswers(e.Request().Context(), &answers); err != nil {
+	if err := s.repo.CreateAnswers(
+		e.Request().Context(),
+		&answers,
+		*params.XForwardedUser,
+	); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to create answers: %w", err))
 	}
 
+	s.publishAnswerEvents(e.Request().Context(), eventbus.EventTypeCreated, answers...)
+
 	res, err := converter.Convert[[]api.AnswerResponse](answers)
 
 	if err != nil {
@@ -147,6 +205,29 @@ func (s *Server) PutAnswer(
 		)
 	}
 
+	// 締切後は、IsOpenがtrueの質問を除いて回答を変更できない
+	question, err := s.repo.GetQuestionByID(oldAnswer.QuestionID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question: %w", err))
+	}
+
+	questionGroup, err := s.repo.GetQuestionGroup(e.Request().Context(), question.QuestionGroupID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question group: %w", err))
+	}
+
+	if err := s.ensureCampAcceptsAnswers(e.Request().Context(), questionGroup.CampID); err != nil {
+		return err
+	}
+
+	if questionGroup.IsAnswerLocked(question, time.Now()) {
+		return newAnswerLockedError(question.ID, questionGroup.Due)
+	}
+
 	var req api.PutAnswerJSONRequestBody
 
 	if err := e.Bind(&req); err != nil {
@@ -160,7 +241,20 @@ func (s *Server) PutAnswer(
 			SetInternal(fmt.Errorf("failed to convert request body: %w", err))
 	}
 
-	if err := s.repo.UpdateAnswer(e.Request().Context(), uint(answerID), &answer); err != nil {
+	if err := validateAnswer(&answer, question); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := s.validateAnswerImage(e.Request().Context(), &answer, questionGroup.CampID); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateAnswer(
+		e.Request().Context(),
+		uint(answerID),
+		&answer,
+		*params.XForwardedUser,
+	); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to update answer: %w", err))
 	}
@@ -168,6 +262,8 @@ func (s *Server) PutAnswer(
 	// レスポンス作成のためにUserIDを設定
 	answer.UserID = oldAnswer.UserID
 
+	s.publishAnswerEvents(e.Request().Context(), eventbus.EventTypeUpdated, *oldAnswer)
+
 	res, err := converter.Convert[api.AnswerResponse](answer)
 
 	if err != nil {
@@ -325,11 +421,26 @@ func (s *Server) AdminPostAnswer(
 			SetInternal(fmt.Errorf("failed to convert request body: %w", err))
 	}
 
+	question, err := s.repo.GetQuestionByID(answer.QuestionID)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question: %w", err))
+	}
+
+	if err := s.validateAdminAnswer(e.Request().Context(), &answer, question); err != nil {
+		return err
+	}
+
 	// 対象ユーザーのIDを設定
 	answer.UserID = targetUser.ID
 
 	// 回答を作成
-	if err := s.repo.CreateAnswer(e.Request().Context(), &answer); err != nil {
+	if err := s.repo.CreateAnswer(e.Request().Context(), &answer, user.ID); err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "Question or option not found")
 		}
@@ -338,6 +449,8 @@ func (s *Server) AdminPostAnswer(
 			SetInternal(fmt.Errorf("failed to create answer: %w", err))
 	}
 
+	s.publishAnswerEvents(e.Request().Context(), eventbus.EventTypeCreated, answer)
+
 	go func(newAnswer model.Answer) {
 		ctx := context.WithoutCancel(e.Request().Context())
 
@@ -415,11 +528,29 @@ func (s *Server) AdminPutAnswer(
 			SetInternal(fmt.Errorf("failed to get answer: %w", err))
 	}
 
-	if err := s.repo.UpdateAnswer(e.Request().Context(), uint(answerID), &answer); err != nil {
+	question, err := s.repo.GetQuestionByID(oldAnswer.QuestionID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question: %w", err))
+	}
+
+	if err := s.validateAdminAnswer(e.Request().Context(), &answer, question); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateAnswer(
+		e.Request().Context(),
+		uint(answerID),
+		&answer,
+		user.ID,
+	); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to update answer: %w", err))
 	}
 
+	s.publishAnswerEvents(e.Request().Context(), eventbus.EventTypeUpdated, *oldAnswer)
+
 	// 回答者にDMを送信（非同期）
 	go func(oldAnswer *model.Answer, newAnswer model.Answer) {
 		ctx := context.WithoutCancel(e.Request().Context())
@@ -449,3 +580,100 @@ func (s *Server) AdminPutAnswer(
 
 	return e.JSON(http.StatusOK, res)
 }
+
+// validateAdminAnswer は管理者が代わりに入力する回答を確認する
+// 締切や合宿の状態による制限は管理者には適用しない
+func (s *Server) validateAdminAnswer(
+	ctx context.Context,
+	answer *model.Answer,
+	question *model.Question,
+) error {
+	if err := validateAnswer(answer, question); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if answer.FileImageID == nil {
+		return nil
+	}
+
+	campID, err := s.repo.GetQuestionCampID(ctx, question.ID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question camp: %w", err))
+	}
+
+	return s.validateAnswerImage(ctx, answer, campID)
+}
+
+// validateAnswerImage はファイルの回答の画像が質問と同じ合宿のものであることを確認する
+func (s *Server) validateAnswerImage(ctx context.Context, answer *model.Answer, campID uint) error {
+	if answer.FileImageID == nil {
+		return nil
+	}
+
+	image, err := s.repo.GetImageByID(ctx, *answer.FileImageID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrImageNotFound) {
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				fmt.Sprintf("Image %d not found", *answer.FileImageID),
+			)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get image: %w", err))
+	}
+
+	if image.CampID != campID {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			fmt.Sprintf("Image %d does not belong to the camp", image.ID),
+		)
+	}
+
+	return nil
+}
+
+// findQuestion は質問の一覧から指定したIDの質問を探す
+func findQuestion(questions []model.Question, questionID uint) (*model.Question, bool) {
+	for i := range questions {
+		if questions[i].ID == questionID {
+			return &questions[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// newAnswerLockedError は締切を過ぎた質問への回答を拒否したことを表すエラーを返す
+func newAnswerLockedError(questionID uint, due time.Time) *echo.HTTPError {
+	return echo.NewHTTPError(
+		http.StatusForbidden,
+		fmt.Sprintf(
+			"Answers to question %d are locked because the due date (%s) has passed",
+			questionID,
+			due.Format(time.DateOnly),
+		),
+	)
+}
+
+// ensureCampAcceptsAnswers は合宿の状態が参加者の回答を受け付けているかを確認する
+func (s *Server) ensureCampAcceptsAnswers(ctx context.Context, campID uint) error {
+	camp, err := s.repo.GetCampByID(ctx, campID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	if !camp.AcceptsAnswers() {
+		return echo.NewHTTPError(
+			http.StatusForbidden,
+			fmt.Sprintf("Answers for this camp are not accepted while it is %s", camp.Status),
+		)
+	}
+
+	return nil
+}
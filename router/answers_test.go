@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
@@ -70,11 +71,51 @@ func TestPostAnswers(t *testing.T) {
 			multipleChoiceReq,
 		}
 
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				Due: time.Now().Add(time.Hour),
+				Questions: []model.Question{
+					{
+						Model: gorm.Model{ID: uint(freeTextAnswer.QuestionId)},
+						Type:  model.FreeTextQuestion,
+					},
+					{
+						Model: gorm.Model{ID: uint(freeNumberAnswer.QuestionId)},
+						Type:  model.FreeNumberQuestion,
+					},
+					{
+						Model: gorm.Model{ID: uint(singleChoiceAnswer.QuestionId)},
+						Type:  model.SingleChoiceQuestion,
+						Options: []model.Option{
+							{Model: gorm.Model{ID: uint(singleChoiceAnswer.OptionId)}},
+						},
+					},
+					{
+						Model: gorm.Model{ID: uint(multipleChoiceAnswer.QuestionId)},
+						Type:  model.MultipleChoiceQuestion,
+						Options: []model.Option{
+							{Model: gorm.Model{ID: uint(multipleChoiceAnswer.OptionIds[0])}},
+							{Model: gorm.Model{ID: uint(multipleChoiceAnswer.OptionIds[1])}},
+						},
+					},
+				},
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswers(gomock.Any(), gomock.Any()).
+			CreateAnswers(gomock.Any(), gomock.Any(), userID).
 			Return(nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		res := h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
 			WithJSON(api.PostAnswersJSONRequestBody(req)).
 			WithHeader("X-Forwarded-User", userID).
@@ -131,6 +172,461 @@ func TestPostAnswers(t *testing.T) {
 	})
 }
 
+func TestPostAnswers_Locked(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(t *testing.T, questionID int) api.PostAnswersJSONRequestBody {
+		t.Helper()
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromFreeTextAnswerRequest(api.FreeTextAnswerRequest{
+			Type:       api.FreeTextAnswerRequestTypeFreeText,
+			QuestionId: questionID,
+			Content:    random.AlphaNumericString(t, 50),
+		}))
+
+		return api.PostAnswersJSONRequestBody{req}
+	}
+
+	t.Run("Forbidden when camp does not accept answers", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := uint(random.PositiveInt(t))
+		questionGroupID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				Due:       time.Now().Add(time.Hour),
+				Questions: []model.Question{{Model: gorm.Model{ID: uint(questionID)}}},
+				CampID:    campID,
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(&model.Camp{Status: model.CampStatusFinished}, nil).
+			Times(1)
+
+		h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(newRequest(t, questionID)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Forbidden after due", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionGroupID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				Due:       time.Now().AddDate(0, 0, -2),
+				Questions: []model.Question{{Model: gorm.Model{ID: uint(questionID)}}},
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
+
+		h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(newRequest(t, questionID)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden).JSON().Object().
+			Value("message").String().Contains("locked")
+	})
+
+	t.Run("Success with open question after due", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionGroupID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				Due: time.Now().AddDate(0, 0, -2),
+				Questions: []model.Question{
+					{
+						Model:  gorm.Model{ID: uint(questionID)},
+						Type:   model.FreeTextQuestion,
+						IsOpen: true,
+					},
+				},
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			CreateAnswers(gomock.Any(), gomock.Any(), userID).
+			Return(nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), uint(questionID)).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
+		h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(newRequest(t, questionID)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusCreated)
+	})
+
+	t.Run("Question not in question group", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionGroupID := random.PositiveInt(t)
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{Due: time.Now().Add(time.Hour)}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
+
+		h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(newRequest(t, random.PositiveInt(t))).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Question group not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		questionGroupID := random.PositiveInt(t)
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(nil, model.ErrNotFound).
+			Times(1)
+
+		h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(newRequest(t, random.PositiveInt(t))).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestPostAnswers_AdditionalQuestionTypes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionGroupID := random.PositiveInt(t)
+		scaleMin := 1
+		scaleMax := 5
+		dateQuestionID := random.PositiveInt(t)
+		dateTimeQuestionID := random.PositiveInt(t)
+		scaleQuestionID := random.PositiveInt(t)
+		rankingQuestionID := random.PositiveInt(t)
+		fileQuestionID := random.PositiveInt(t)
+		rankingOptions := []model.Option{
+			{Model: gorm.Model{ID: 1}, Content: random.AlphaNumericString(t, 20)},
+			{Model: gorm.Model{ID: 2}, Content: random.AlphaNumericString(t, 20)},
+			{Model: gorm.Model{ID: 3}, Content: random.AlphaNumericString(t, 20)},
+		}
+		dateContent := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+		dateTimeContent := time.Date(2025, time.August, 2, 10, 30, 0, 0, time.UTC)
+		imageID := random.PositiveInt(t)
+		campID := uint(random.PositiveInt(t))
+
+		var dateReq, dateTimeReq, scaleReq, rankingReq, fileReq api.AnswerRequest
+
+		require.NoError(t, dateReq.FromDateAnswerRequest(api.DateAnswerRequest{
+			Type:       api.DateAnswerRequestTypeDate,
+			QuestionId: dateQuestionID,
+			Content:    openapi_types.Date{Time: dateContent},
+		}))
+		require.NoError(t, dateTimeReq.FromDateTimeAnswerRequest(api.DateTimeAnswerRequest{
+			Type:       api.DateTimeAnswerRequestTypeDatetime,
+			QuestionId: dateTimeQuestionID,
+			Content:    dateTimeContent,
+		}))
+		require.NoError(t, scaleReq.FromScaleAnswerRequest(api.ScaleAnswerRequest{
+			Type:       api.ScaleAnswerRequestTypeScale,
+			QuestionId: scaleQuestionID,
+			Content:    scaleMax,
+		}))
+		require.NoError(t, rankingReq.FromRankingAnswerRequest(api.RankingAnswerRequest{
+			Type:       api.RankingAnswerRequestTypeRanking,
+			QuestionId: rankingQuestionID,
+			OptionIds:  []int{3, 1, 2},
+		}))
+		require.NoError(t, fileReq.FromFileAnswerRequest(api.FileAnswerRequest{
+			Type:       api.FileAnswerRequestTypeFile,
+			QuestionId: fileQuestionID,
+			ImageId:    imageID,
+		}))
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				CampID: campID,
+				Due:    time.Now().Add(time.Hour),
+				Questions: []model.Question{
+					{Model: gorm.Model{ID: uint(dateQuestionID)}, Type: model.DateQuestion},
+					{
+						Model: gorm.Model{ID: uint(dateTimeQuestionID)},
+						Type:  model.DateTimeQuestion,
+					},
+					{
+						Model:    gorm.Model{ID: uint(scaleQuestionID)},
+						Type:     model.ScaleQuestion,
+						ScaleMin: &scaleMin,
+						ScaleMax: &scaleMax,
+					},
+					{
+						Model:   gorm.Model{ID: uint(rankingQuestionID)},
+						Type:    model.RankingQuestion,
+						Options: rankingOptions,
+					},
+					{Model: gorm.Model{ID: uint(fileQuestionID)}, Type: model.FileQuestion},
+				},
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
+		h.repo.MockImageRepository.EXPECT().
+			GetImageByID(gomock.Any(), uint(imageID)).
+			Return(&model.Image{Model: gorm.Model{ID: uint(imageID)}, CampID: campID}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			CreateAnswers(gomock.Any(), gomock.Any(), userID).
+			DoAndReturn(func(_ any, answers *[]model.Answer, _ string) error {
+				// 保存後に再取得した場合と同様に、選択肢の内容を設定する
+				for i, rankedOption := range (*answers)[3].RankedOptions {
+					(*answers)[3].RankedOptions[i].Option = rankingOptions[rankedOption.OptionID-1]
+				}
+
+				return nil
+			}).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
+		res := h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(api.PostAnswersJSONRequestBody{
+				dateReq,
+				dateTimeReq,
+				scaleReq,
+				rankingReq,
+				fileReq,
+			}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusCreated).JSON().Array()
+
+		res.Length().IsEqual(5)
+
+		res.Value(0).Object().Value("type").String().IsEqual("date")
+		res.Value(0).Object().Value("content").String().IsEqual("2025-08-01")
+		res.Value(1).Object().Value("type").String().IsEqual("datetime")
+		res.Value(1).Object().Value("content").String().AsDateTime().IsEqual(dateTimeContent)
+		res.Value(2).Object().Value("type").String().IsEqual("scale")
+		res.Value(2).Object().Value("content").Number().IsEqual(scaleMax)
+
+		rankingRes := res.Value(3).Object()
+
+		rankingRes.Value("type").String().IsEqual("ranking")
+
+		rankedOptions := rankingRes.Value("rankedOptions").Array()
+
+		rankedOptions.Length().IsEqual(3)
+
+		for i, optionID := range []uint{3, 1, 2} {
+			rankedOption := rankedOptions.Value(i).Object()
+
+			rankedOption.Value("id").Number().IsEqual(optionID)
+			rankedOption.Value("content").String().IsEqual(rankingOptions[optionID-1].Content)
+		}
+
+		res.Value(4).Object().Value("type").String().IsEqual("file")
+		res.Value(4).Object().Value("imageId").Number().IsEqual(imageID)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		t.Parallel()
+
+		scaleMin := 1
+		scaleMax := 5
+		options := []model.Option{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 2}}}
+
+		testCases := []struct {
+			name     string
+			question model.Question
+			request  func(t *testing.T, questionID int) api.AnswerRequest
+		}{
+			{
+				name: "Scale out of range",
+				question: model.Question{
+					Type:     model.ScaleQuestion,
+					ScaleMin: &scaleMin,
+					ScaleMax: &scaleMax,
+				},
+				request: func(t *testing.T, questionID int) api.AnswerRequest {
+					var req api.AnswerRequest
+
+					require.NoError(t, req.FromScaleAnswerRequest(api.ScaleAnswerRequest{
+						Type:       api.ScaleAnswerRequestTypeScale,
+						QuestionId: questionID,
+						Content:    scaleMax + 1,
+					}))
+
+					return req
+				},
+			},
+			{
+				name:     "Ranking without all options",
+				question: model.Question{Type: model.RankingQuestion, Options: options},
+				request: func(t *testing.T, questionID int) api.AnswerRequest {
+					var req api.AnswerRequest
+
+					require.NoError(t, req.FromRankingAnswerRequest(api.RankingAnswerRequest{
+						Type:       api.RankingAnswerRequestTypeRanking,
+						QuestionId: questionID,
+						OptionIds:  []int{2},
+					}))
+
+					return req
+				},
+			},
+			{
+				name:     "Ranking with duplicate options",
+				question: model.Question{Type: model.RankingQuestion, Options: options},
+				request: func(t *testing.T, questionID int) api.AnswerRequest {
+					var req api.AnswerRequest
+
+					require.NoError(t, req.FromRankingAnswerRequest(api.RankingAnswerRequest{
+						Type:       api.RankingAnswerRequestTypeRanking,
+						QuestionId: questionID,
+						OptionIds:  []int{2, 2},
+					}))
+
+					return req
+				},
+			},
+			{
+				name:     "Type mismatch",
+				question: model.Question{Type: model.DateQuestion},
+				request: func(t *testing.T, questionID int) api.AnswerRequest {
+					var req api.AnswerRequest
+
+					require.NoError(t, req.FromFreeTextAnswerRequest(api.FreeTextAnswerRequest{
+						Type:       api.FreeTextAnswerRequestTypeFreeText,
+						QuestionId: questionID,
+						Content:    random.AlphaNumericString(t, 20),
+					}))
+
+					return req
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				h := setup(t)
+				questionGroupID := random.PositiveInt(t)
+				questionID := random.PositiveInt(t)
+				question := tc.question
+
+				question.ID = uint(questionID)
+
+				h.repo.MockQuestionGroupRepository.EXPECT().
+					GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+					Return(&model.QuestionGroup{
+						Due:       time.Now().Add(time.Hour),
+						Questions: []model.Question{question},
+					}, nil).
+					Times(1)
+				h.repo.MockCampRepository.EXPECT().
+					GetCampByID(gomock.Any(), gomock.Any()).
+					Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+					Times(1)
+
+				h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+					WithJSON(api.PostAnswersJSONRequestBody{tc.request(t, questionID)}).
+					WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+					Expect().
+					Status(http.StatusBadRequest)
+			})
+		}
+	})
+
+	t.Run("Image of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		questionGroupID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+		imageID := random.PositiveInt(t)
+		campID := uint(random.PositiveInt(t))
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromFileAnswerRequest(api.FileAnswerRequest{
+			Type:       api.FileAnswerRequestTypeFile,
+			QuestionId: questionID,
+			ImageId:    imageID,
+		}))
+
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				CampID: campID,
+				Due:    time.Now().Add(time.Hour),
+				Questions: []model.Question{
+					{Model: gorm.Model{ID: uint(questionID)}, Type: model.FileQuestion},
+				},
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
+		h.repo.MockImageRepository.EXPECT().
+			GetImageByID(gomock.Any(), uint(imageID)).
+			Return(&model.Image{Model: gorm.Model{ID: uint(imageID)}, CampID: campID + 1}, nil).
+			Times(1)
+
+		h.expect.POST("/api/question-groups/{questionGroupId}/answers", questionGroupID).
+			WithJSON(api.PostAnswersJSONRequestBody{req}).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+}
+
 func TestGetMyAnswers(t *testing.T) {
 	t.Parallel()
 
@@ -217,6 +713,45 @@ func TestGetMyAnswers(t *testing.T) {
 	})
 }
 
+// mockOpenQuestion は締切前の質問グループに属する質問を返すようにモックを設定する
+func mockOpenQuestion(
+	t *testing.T,
+	h *testHandler,
+	questionID uint,
+	questionType model.QuestionType,
+	optionIDs ...int,
+) {
+	t.Helper()
+
+	questionGroupID := uint(random.PositiveInt(t))
+	options := make([]model.Option, len(optionIDs))
+
+	for i, optionID := range optionIDs {
+		options[i] = model.Option{Model: gorm.Model{ID: uint(optionID)}}
+	}
+
+	h.repo.MockQuestionRepository.EXPECT().
+		GetQuestionByID(questionID).
+		Return(&model.Question{
+			Model:           gorm.Model{ID: questionID},
+			Type:            questionType,
+			QuestionGroupID: questionGroupID,
+			Options:         options,
+		}, nil).
+		Times(1)
+	h.repo.MockQuestionGroupRepository.EXPECT().
+		GetQuestionGroup(gomock.Any(), questionGroupID).
+		Return(&model.QuestionGroup{
+			Model: gorm.Model{ID: questionGroupID},
+			Due:   time.Now().Add(time.Hour),
+		}, nil).
+		Times(1)
+	h.repo.MockCampRepository.EXPECT().
+		GetCampByID(gomock.Any(), gomock.Any()).
+		Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+		Times(1)
+}
+
 func TestPutAnswer(t *testing.T) {
 	t.Parallel()
 
@@ -246,16 +781,22 @@ func TestPutAnswer(t *testing.T) {
 			GetAnswerByID(gomock.Any(), answerID).
 			Return(oldAnswer, nil).
 			Times(1)
+		mockOpenQuestion(t, h, oldAnswer.QuestionID, model.FreeTextQuestion)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), answerID, gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), answerID, gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 
 				return nil
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		res := h.expect.PUT("/api/answers/{answerId}", answerID).
 			WithJSON(api.PutAnswerJSONRequestBody(req)).
 			WithHeader("X-Forwarded-User", userID).
@@ -296,16 +837,22 @@ func TestPutAnswer(t *testing.T) {
 			GetAnswerByID(gomock.Any(), answerID).
 			Return(oldAnswer, nil).
 			Times(1)
+		mockOpenQuestion(t, h, oldAnswer.QuestionID, model.FreeNumberQuestion)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), answerID, gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), answerID, gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 
 				return nil
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		res := h.expect.PUT("/api/answers/{answerId}", answerID).
 			WithJSON(api.PutAnswerJSONRequestBody(req)).
 			WithHeader("X-Forwarded-User", userID).
@@ -349,10 +896,11 @@ func TestPutAnswer(t *testing.T) {
 			GetAnswerByID(gomock.Any(), answerID).
 			Return(oldAnswer, nil).
 			Times(1)
+		mockOpenQuestion(t, h, oldAnswer.QuestionID, model.SingleChoiceQuestion, optionID)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), answerID, gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), answerID, gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 				answer.SelectedOptions = []model.Option{
 					{
@@ -367,6 +915,11 @@ func TestPutAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		res := h.expect.PUT("/api/answers/{answerId}", answerID).
 			WithJSON(api.PutAnswerJSONRequestBody(req)).
 			WithHeader("X-Forwarded-User", userID).
@@ -413,10 +966,18 @@ func TestPutAnswer(t *testing.T) {
 			GetAnswerByID(gomock.Any(), answerID).
 			Return(oldAnswer, nil).
 			Times(1)
+		mockOpenQuestion(
+			t,
+			h,
+			oldAnswer.QuestionID,
+			model.MultipleChoiceQuestion,
+			optionID1,
+			optionID2,
+		)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), answerID, gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), answerID, gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 				answer.SelectedOptions = []model.Option{
 					{
@@ -432,6 +993,11 @@ func TestPutAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		res := h.expect.PUT("/api/answers/{answerId}", answerID).
 			WithJSON(api.PutAnswerJSONRequestBody(req)).
 			WithHeader("X-Forwarded-User", userID).
@@ -455,6 +1021,58 @@ func TestPutAnswer(t *testing.T) {
 		}
 	})
 
+	t.Run("Forbidden after due", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		answerID := uint(random.PositiveInt(t))
+		questionID := uint(random.PositiveInt(t))
+		questionGroupID := uint(random.PositiveInt(t))
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromFreeTextAnswerRequest(api.FreeTextAnswerRequest{
+			Type:       api.FreeTextAnswerRequestTypeFreeText,
+			QuestionId: int(questionID),
+			Content:    random.AlphaNumericString(t, 50),
+		}))
+
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerByID(gomock.Any(), answerID).
+			Return(&model.Answer{
+				Model:      gorm.Model{ID: answerID},
+				QuestionID: questionID,
+				UserID:     userID,
+			}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(&model.Question{
+				Model:           gorm.Model{ID: questionID},
+				QuestionGroupID: questionGroupID,
+			}, nil).
+			Times(1)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), questionGroupID).
+			Return(&model.QuestionGroup{
+				Model: gorm.Model{ID: questionGroupID},
+				Due:   time.Now().AddDate(0, 0, -2),
+			}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil).
+			Times(1)
+
+		h.expect.PUT("/api/answers/{answerId}", answerID).
+			WithJSON(api.PutAnswerJSONRequestBody(req)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden).JSON().Object().
+			Value("message").String().Contains("locked")
+	})
+
 	t.Run("Forbidden when updating other user's answer", func(t *testing.T) {
 		t.Parallel()
 
@@ -837,6 +1455,14 @@ func TestAdminPutAnswer(t *testing.T) {
 			Return(oldAnswer, nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FreeTextQuestion,
+			}, nil).
+			Times(1)
+
 		newAnswer := *oldAnswer
 		newAnswer.FreeTextContent = &updatedContent
 
@@ -850,8 +1476,8 @@ func TestAdminPutAnswer(t *testing.T) {
 			Times(1)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 				answer.UserID = oldAnswer.UserID
 				answer.FreeTextContent = &updatedContent
@@ -859,6 +1485,11 @@ func TestAdminPutAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.FreeTextAnswerRequest{
 			Type:       api.FreeTextAnswerRequestTypeFreeText,
 			QuestionId: questionID,
@@ -923,6 +1554,14 @@ func TestAdminPutAnswer(t *testing.T) {
 			Return(oldAnswer, nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FreeNumberQuestion,
+			}, nil).
+			Times(1)
+
 		newAnswer := *oldAnswer
 		newAnswer.FreeNumberContent = &updatedContent
 
@@ -936,8 +1575,8 @@ func TestAdminPutAnswer(t *testing.T) {
 			Times(1)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 				answer.UserID = oldAnswer.UserID
 				answer.FreeNumberContent = &updatedContent
@@ -945,6 +1584,11 @@ func TestAdminPutAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.FreeNumberAnswerRequest{
 			Type:       api.FreeNumberAnswerRequestTypeFreeNumber,
 			QuestionId: questionID,
@@ -1022,6 +1666,15 @@ func TestAdminPutAnswer(t *testing.T) {
 			Return(oldAnswer, nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.SingleChoiceQuestion,
+				Options: []model.Option{option},
+			}, nil).
+			Times(1)
+
 		newAnswer := *oldAnswer
 		newAnswer.SelectedOptions = []model.Option{option}
 
@@ -1035,8 +1688,8 @@ func TestAdminPutAnswer(t *testing.T) {
 			Times(1)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 				answer.UserID = oldAnswer.UserID
 				answer.SelectedOptions = []model.Option{option}
@@ -1044,6 +1697,11 @@ func TestAdminPutAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.SingleChoiceAnswerRequest{
 			Type:       api.SingleChoiceAnswerRequestTypeSingle,
 			QuestionId: questionID,
@@ -1132,6 +1790,15 @@ func TestAdminPutAnswer(t *testing.T) {
 			Return(oldAnswer, nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.MultipleChoiceQuestion,
+				Options: options,
+			}, nil).
+			Times(1)
+
 		newAnswer := *oldAnswer
 		newAnswer.SelectedOptions = options
 
@@ -1145,8 +1812,8 @@ func TestAdminPutAnswer(t *testing.T) {
 			Times(1)
 
 		h.repo.MockAnswerRepository.EXPECT().
-			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any()).
-			DoAndReturn(func(_ any, id uint, answer *model.Answer) error {
+			UpdateAnswer(gomock.Any(), uint(answerID), gomock.Any(), userID).
+			DoAndReturn(func(_ any, id uint, answer *model.Answer, _ string) error {
 				answer.ID = id
 				answer.UserID = oldAnswer.UserID
 				answer.SelectedOptions = options
@@ -1154,6 +1821,11 @@ func TestAdminPutAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.MultipleChoiceAnswerRequest{
 			Type:       api.MultipleChoiceAnswerRequestTypeMultiple,
 			QuestionId: questionID,
@@ -1185,6 +1857,51 @@ func TestAdminPutAnswer(t *testing.T) {
 			option.Value("content").String().NotEmpty()
 		}
 	})
+
+	t.Run("BadRequest - Option of another question", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		answerID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+		optionID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerByID(gomock.Any(), uint(answerID)).
+			Return(&model.Answer{
+				Model:      gorm.Model{ID: uint(answerID)},
+				QuestionID: uint(questionID),
+				Type:       model.SingleChoiceQuestion,
+			}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.SingleChoiceQuestion,
+				Options: []model.Option{{Model: gorm.Model{ID: uint(optionID) + 1}}},
+			}, nil).
+			Times(1)
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromSingleChoiceAnswerRequest(api.SingleChoiceAnswerRequest{
+			Type:       api.SingleChoiceAnswerRequestTypeSingle,
+			QuestionId: questionID,
+			OptionId:   optionID,
+		}))
+
+		h.expect.PUT("/api/admin/answers/{answerId}", answerID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(req).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
 }
 
 func TestAdminPostAnswer(t *testing.T) {
@@ -1242,15 +1959,28 @@ func TestAdminPostAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FreeTextQuestion,
+			}, nil).
+			Times(1)
+
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswer(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ any, answer *model.Answer) error {
+			CreateAnswer(gomock.Any(), gomock.Any(), adminUserID).
+			DoAndReturn(func(_ any, answer *model.Answer, _ string) error {
 				answer.ID = answerID
 
 				return nil
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.FreeTextAnswerRequest{
 			Type:       api.FreeTextAnswerRequestTypeFreeText,
 			QuestionId: questionID,
@@ -1329,9 +2059,17 @@ func TestAdminPostAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FreeNumberQuestion,
+			}, nil).
+			Times(1)
+
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswer(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ any, answer *model.Answer) error {
+			CreateAnswer(gomock.Any(), gomock.Any(), adminUserID).
+			DoAndReturn(func(_ any, answer *model.Answer, _ string) error {
 				answer.ID = answerID
 				// FreeNumberContentを設定
 				answer.FreeNumberContent = &contentFloat64
@@ -1340,6 +2078,11 @@ func TestAdminPostAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.FreeNumberAnswerRequest{
 			Type:       api.FreeNumberAnswerRequestTypeFreeNumber,
 			QuestionId: questionID,
@@ -1425,9 +2168,18 @@ func TestAdminPostAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.SingleChoiceQuestion,
+				Options: selectedOptions,
+			}, nil).
+			Times(1)
+
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswer(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ any, answer *model.Answer) error {
+			CreateAnswer(gomock.Any(), gomock.Any(), adminUserID).
+			DoAndReturn(func(_ any, answer *model.Answer, _ string) error {
 				answer.ID = answerID
 				// SelectedOptionsにオプションを設定
 				answer.SelectedOptions = selectedOptions
@@ -1436,6 +2188,11 @@ func TestAdminPostAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.SingleChoiceAnswerRequest{
 			Type:       api.SingleChoiceAnswerRequestTypeSingle,
 			QuestionId: questionID,
@@ -1524,9 +2281,18 @@ func TestAdminPostAnswer(t *testing.T) {
 				return nil
 			}).Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.MultipleChoiceQuestion,
+				Options: selectedOptions,
+			}, nil).
+			Times(1)
+
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswer(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ any, answer *model.Answer) error {
+			CreateAnswer(gomock.Any(), gomock.Any(), adminUserID).
+			DoAndReturn(func(_ any, answer *model.Answer, _ string) error {
 				answer.ID = answerID
 				// SelectedOptionsにオプションを設定
 				answer.SelectedOptions = selectedOptions
@@ -1535,6 +2301,11 @@ func TestAdminPostAnswer(t *testing.T) {
 			}).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), gomock.Any()).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
+
 		reqBody := api.MultipleChoiceAnswerRequest{
 			Type:       api.MultipleChoiceAnswerRequestTypeMultiple,
 			QuestionId: questionID,
@@ -1722,8 +2493,16 @@ func TestAdminPostAnswer(t *testing.T) {
 			Return(targetUser, nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FreeTextQuestion,
+			}, nil).
+			Times(1)
+
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswer(gomock.Any(), gomock.Any()).
+			CreateAnswer(gomock.Any(), gomock.Any(), adminUserID).
 			Return(errors.New("database error")).
 			Times(1)
 
@@ -1774,8 +2553,16 @@ func TestAdminPostAnswer(t *testing.T) {
 			Return(targetUser, nil).
 			Times(1)
 
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FreeTextQuestion,
+			}, nil).
+			Times(1)
+
 		h.repo.MockAnswerRepository.EXPECT().
-			CreateAnswer(gomock.Any(), gomock.Any()).
+			CreateAnswer(gomock.Any(), gomock.Any(), adminUserID).
 			Return(model.ErrNotFound).
 			Times(1)
 
@@ -1796,6 +2583,132 @@ func TestAdminPostAnswer(t *testing.T) {
 			Status(http.StatusNotFound).JSON().Object().
 			Value("message").String().IsEqual("Question or option not found")
 	})
+
+	t.Run("NotFound - Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		targetUserID := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), targetUserID).
+			Return(&model.User{ID: targetUserID}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(nil, gorm.ErrRecordNotFound).
+			Times(1)
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromFreeTextAnswerRequest(api.FreeTextAnswerRequest{
+			Type:       api.FreeTextAnswerRequestTypeFreeText,
+			QuestionId: questionID,
+			Content:    random.AlphaNumericString(t, 50),
+		}))
+
+		h.expect.POST("/api/admin/users/{userId}/answers", targetUserID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(req).
+			Expect().
+			Status(http.StatusNotFound).JSON().Object().
+			Value("message").String().IsEqual("Question not found")
+	})
+
+	t.Run("BadRequest - Answer type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		targetUserID := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), targetUserID).
+			Return(&model.User{ID: targetUserID}, nil).
+			Times(1)
+		// 管理者による入力でも回答の内容は質問に合っている必要がある
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.DateQuestion,
+			}, nil).
+			Times(1)
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromFreeTextAnswerRequest(api.FreeTextAnswerRequest{
+			Type:       api.FreeTextAnswerRequestTypeFreeText,
+			QuestionId: questionID,
+			Content:    random.AlphaNumericString(t, 50),
+		}))
+
+		h.expect.POST("/api/admin/users/{userId}/answers", targetUserID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(req).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("BadRequest - Image of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		targetUserID := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+		imageID := random.PositiveInt(t)
+		campID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), targetUserID).
+			Return(&model.User{ID: targetUserID}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model: gorm.Model{ID: uint(questionID)},
+				Type:  model.FileQuestion,
+			}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), uint(questionID)).
+			Return(campID, nil).
+			Times(1)
+		h.repo.MockImageRepository.EXPECT().
+			GetImageByID(gomock.Any(), uint(imageID)).
+			Return(&model.Image{Model: gorm.Model{ID: uint(imageID)}, CampID: campID + 1}, nil).
+			Times(1)
+
+		var req api.AnswerRequest
+
+		require.NoError(t, req.FromFileAnswerRequest(api.FileAnswerRequest{
+			Type:       api.FileAnswerRequestTypeFile,
+			QuestionId: questionID,
+			ImageId:    imageID,
+		}))
+
+		h.expect.POST("/api/admin/users/{userId}/answers", targetUserID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(req).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
 }
 
 func TestGetAnswers(t *testing.T) {
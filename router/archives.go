@@ -0,0 +1,105 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/repository"
+	archiveservice "github.com/traPtitech/rucQ/service/archive"
+)
+
+// AdminExportCamp 合宿のデータをアーカイブとしてエクスポート（管理者用）
+// (GET /api/admin/camps/{campId}/archive)
+func (s *Server) AdminExportCamp(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminExportCampParams,
+) error {
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	archive, err := s.archiveService.ExportCamp(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to export camp: %w", err))
+	}
+
+	e.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="rucq-%s.json"`, archive.Camp.DisplayID),
+	)
+
+	return e.JSON(http.StatusOK, archive)
+}
+
+// AdminImportCamp アーカイブから合宿をインポート（管理者用）
+// (POST /api/admin/camp-archives)
+func (s *Server) AdminImportCamp(e echo.Context, params api.AdminImportCampParams) error {
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	// api.CampArchiveはversion以外を持たないため、サービスの型に直接バインドする
+	var req archiveservice.Archive
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if params.DisplayId != nil {
+		req.Camp.DisplayID = *params.DisplayId
+	}
+
+	camp, err := s.archiveService.ImportCamp(e.Request().Context(), req, user.ID)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, archiveservice.ErrUnsupportedVersion):
+			return echo.NewHTTPError(http.StatusBadRequest, "Unsupported archive version")
+		case errors.Is(err, archiveservice.ErrInvalidArchive):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, repository.ErrCampAlreadyExists):
+			return echo.NewHTTPError(
+				http.StatusConflict,
+				"Camp with this display ID already exists",
+			)
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to import camp: %w", err))
+		}
+	}
+
+	response, err := converter.Convert[api.CampResponse](camp)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert camp to response: %w", err))
+	}
+
+	return e.JSON(http.StatusCreated, &response)
+}
@@ -0,0 +1,233 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	archiveservice "github.com/traPtitech/rucQ/service/archive"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestAdminExportCamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+		archive := archiveservice.Archive{
+			Version: archiveservice.CurrentVersion,
+			Camp: archiveservice.Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+				Name:      random.AlphaNumericString(t, 20),
+			},
+			Participants: []string{random.AlphaNumericString(t, 32)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.archiveService.EXPECT().ExportCamp(gomock.Any(), campID).Return(&archive, nil)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/archive", campID).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusOK)
+
+		res.Header("Content-Disposition").Contains(archive.Camp.DisplayID)
+
+		obj := res.JSON().Object()
+
+		obj.Value("version").Number().IsEqual(archiveservice.CurrentVersion)
+		obj.Value("camp").Object().Value("displayId").String().IsEqual(archive.Camp.DisplayID)
+		obj.Value("participants").Array().IsEqual(archive.Participants)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.GET("/api/admin/camps/{campId}/archive", campID).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.archiveService.EXPECT().
+			ExportCamp(gomock.Any(), campID).
+			Return(nil, repository.ErrCampNotFound)
+
+		h.expect.GET("/api/admin/camps/{campId}/archive", campID).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestAdminImportCamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		dateStart := random.Time(t)
+		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
+		archive := archiveservice.Archive{
+			Version: archiveservice.CurrentVersion,
+			Camp: archiveservice.Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+				Name:      random.AlphaNumericString(t, 20),
+				DateStart: dateStart,
+				DateEnd:   dateEnd,
+			},
+		}
+		camp := model.Camp{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			DisplayID: archive.Camp.DisplayID,
+			Name:      archive.Camp.Name,
+			DateStart: dateStart,
+			DateEnd:   dateEnd,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{ID: username, IsStaff: true}, nil)
+		h.archiveService.EXPECT().
+			ImportCamp(gomock.Any(), gomock.Any(), username).
+			Return(&camp, nil)
+
+		res := h.expect.POST("/api/admin/camp-archives").
+			WithJSON(archive).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusCreated).JSON().Object()
+
+		res.Value("id").Number().IsEqual(camp.ID)
+		res.Value("displayId").String().IsEqual(camp.DisplayID)
+		res.Value("name").String().IsEqual(camp.Name)
+	})
+
+	t.Run("displayIdを上書き", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		displayID := random.AlphaNumericString(t, 10)
+		archive := archiveservice.Archive{
+			Version: archiveservice.CurrentVersion,
+			Camp: archiveservice.Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+			},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{ID: username, IsStaff: true}, nil)
+		h.archiveService.EXPECT().
+			ImportCamp(gomock.Any(), gomock.Any(), username).
+			DoAndReturn(func(
+				_ context.Context,
+				archive archiveservice.Archive,
+				_ string,
+			) (*model.Camp, error) {
+				if archive.Camp.DisplayID != displayID {
+					t.Errorf("expected displayId %s, got %s", displayID, archive.Camp.DisplayID)
+				}
+
+				return &model.Camp{DisplayID: displayID}, nil
+			})
+
+		h.expect.POST("/api/admin/camp-archives").
+			WithQuery("displayId", displayID).
+			WithJSON(archive).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().Object().Value("displayId").String().IsEqual(displayID)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.POST("/api/admin/camp-archives").
+			WithJSON(archiveservice.Archive{Version: archiveservice.CurrentVersion}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Unsupported Version", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{ID: username, IsStaff: true}, nil)
+		h.archiveService.EXPECT().
+			ImportCamp(gomock.Any(), gomock.Any(), username).
+			Return(nil, archiveservice.ErrUnsupportedVersion)
+
+		h.expect.POST("/api/admin/camp-archives").
+			WithJSON(archiveservice.Archive{Version: archiveservice.CurrentVersion + 1}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{ID: username, IsStaff: true}, nil)
+		h.archiveService.EXPECT().
+			ImportCamp(gomock.Any(), gomock.Any(), username).
+			Return(nil, repository.ErrCampAlreadyExists)
+
+		h.expect.POST("/api/admin/camp-archives").
+			WithJSON(archiveservice.Archive{Version: archiveservice.CurrentVersion}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusConflict)
+	})
+}
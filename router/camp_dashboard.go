@@ -0,0 +1,173 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// AdminGetCampDashboard 合宿の運営状況を取得（管理者用）
+// (GET /api/admin/camps/{campId}/dashboard)
+func (s *Server) AdminGetCampDashboard(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminGetCampDashboardParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	registrationCounts, err := s.repo.GetCampRegistrationCounts(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp registration counts: %w", err))
+	}
+
+	res := api.CampDashboardResponse{
+		Registrations: make([]api.CampDashboardRegistration, 0, len(registrationCounts)),
+	}
+
+	// 登録日時が記録されていない参加者は累計の初期値として数える
+	for _, registrationCount := range registrationCounts {
+		res.ParticipantCount += registrationCount.Count
+
+		if registrationCount.Date == nil {
+			res.UntrackedRegistrationCount += registrationCount.Count
+
+			continue
+		}
+
+		res.Registrations = append(res.Registrations, api.CampDashboardRegistration{
+			Date:  types.Date{Time: *registrationCount.Date},
+			Count: registrationCount.Count,
+		})
+	}
+
+	total := res.UntrackedRegistrationCount
+
+	for i := range res.Registrations {
+		total += res.Registrations[i].Count
+		res.Registrations[i].Total = total
+	}
+
+	paymentCollection, err := s.repo.GetPaymentCollection(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment collection: %w", err))
+	}
+
+	res.Payment = api.CampDashboardPayment{
+		PaymentCount:    paymentCollection.PaymentCount,
+		PaidCount:       paymentCollection.PaidCount,
+		TotalAmount:     paymentCollection.TotalAmount,
+		TotalAmountPaid: paymentCollection.TotalAmountPaid,
+		CollectionRate: dashboardRate(
+			paymentCollection.TotalAmountPaid,
+			paymentCollection.TotalAmount,
+		),
+	}
+
+	questionGroupCompletions, err := s.repo.GetQuestionGroupCompletions(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question group completions: %w", err))
+	}
+
+	res.QuestionGroups = make([]api.CampDashboardQuestionGroup, len(questionGroupCompletions))
+
+	for i, completion := range questionGroupCompletions {
+		res.QuestionGroups[i] = api.CampDashboardQuestionGroup{
+			Id:                    int(completion.QuestionGroupID),
+			Name:                  completion.Name,
+			Due:                   types.Date{Time: completion.Due},
+			RequiredQuestionCount: completion.RequiredQuestionCount,
+			CompletedCount:        completion.CompletedCount,
+			CompletionRate:        dashboardRate(completion.CompletedCount, res.ParticipantCount),
+		}
+	}
+
+	res.UnassignedParticipantCount, err = s.repo.CountUnassignedParticipants(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to count unassigned participants: %w", err))
+	}
+
+	rollCallResponseCounts, err := s.repo.GetOpenRollCallResponseCounts(
+		ctx,
+		uint(campID),
+		time.Now(),
+	)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get open roll call response counts: %w", err))
+	}
+
+	res.OpenRollCalls = make([]api.CampDashboardRollCall, len(rollCallResponseCounts))
+
+	for i, responseCount := range rollCallResponseCounts {
+		// 対象者が指定されていない点呼は参加者全員を対象とみなす
+		subjectCount := responseCount.SubjectCount
+
+		if subjectCount == 0 {
+			subjectCount = res.ParticipantCount
+		}
+
+		res.OpenRollCalls[i] = api.CampDashboardRollCall{
+			Id:             int(responseCount.RollCallID),
+			Name:           responseCount.Name,
+			Deadline:       responseCount.Deadline,
+			SubjectCount:   responseCount.SubjectCount,
+			RespondedCount: responseCount.RespondedCount,
+			ResponseRate:   dashboardRate(responseCount.RespondedCount, subjectCount),
+		}
+	}
+
+	res.UndeliveredMessageCount, err = s.repo.CountUndeliveredMessages(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to count undelivered messages: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, &res)
+}
+
+// dashboardRate はnumeratorのdenominatorに対する割合を返します。denominatorが0の場合はnil
+func dashboardRate(numerator, denominator int) *float64 {
+	if denominator == 0 {
+		return nil
+	}
+
+	rate := float64(numerator) / float64(denominator)
+
+	return &rate
+}
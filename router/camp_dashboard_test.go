@@ -0,0 +1,253 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_AdminGetCampDashboard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+		day1 := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		day2 := time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC)
+		due := time.Date(2026, 8, 31, 0, 0, 0, 0, time.UTC)
+		deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+		questionGroupID := uint(random.PositiveInt(t))
+		questionGroupName := random.AlphaNumericString(t, 20)
+		restrictedRollCallID := uint(random.PositiveInt(t))
+		openRollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampRegistrationCounts(gomock.Any(), uint(campID)).
+			Return([]repository.CampRegistrationCount{
+				{Date: nil, Count: 2},
+				{Date: &day1, Count: 3},
+				{Date: &day2, Count: 5},
+			}, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentCollection(gomock.Any(), uint(campID)).
+			Return(&repository.PaymentCollection{
+				PaymentCount:    8,
+				PaidCount:       6,
+				TotalAmount:     80000,
+				TotalAmountPaid: 60000,
+			}, nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroupCompletions(gomock.Any(), uint(campID)).
+			Return([]repository.QuestionGroupCompletion{
+				{
+					QuestionGroupID:       questionGroupID,
+					Name:                  questionGroupName,
+					Due:                   due,
+					RequiredQuestionCount: 3,
+					CompletedCount:        5,
+				},
+			}, nil)
+		h.repo.MockRoomRepository.EXPECT().
+			CountUnassignedParticipants(gomock.Any(), uint(campID)).
+			Return(4, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetOpenRollCallResponseCounts(gomock.Any(), uint(campID), gomock.Any()).
+			Return([]repository.RollCallResponseCount{
+				{
+					RollCallID:     restrictedRollCallID,
+					Name:           "restricted",
+					Deadline:       &deadline,
+					SubjectCount:   4,
+					RespondedCount: 1,
+				},
+				{
+					RollCallID:     openRollCallID,
+					Name:           "open",
+					RespondedCount: 5,
+				},
+			}, nil)
+		h.repo.MockMessageRepository.EXPECT().
+			CountUndeliveredMessages(gomock.Any(), uint(campID)).
+			Return(7, nil)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/dashboard", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.HasValue("participantCount", 10)
+		res.HasValue("untrackedRegistrationCount", 2)
+		// 累計には登録日時が記録されていない参加者を含む
+		res.Value("registrations").Array().IsEqual([]map[string]any{
+			{"date": "2026-06-01", "count": 3, "total": 5},
+			{"date": "2026-06-03", "count": 5, "total": 10},
+		})
+		res.Value("payment").Object().IsEqual(map[string]any{
+			"paymentCount":    8,
+			"paidCount":       6,
+			"totalAmount":     80000,
+			"totalAmountPaid": 60000,
+			"collectionRate":  0.75,
+		})
+		res.Value("questionGroups").Array().IsEqual([]map[string]any{
+			{
+				"id":                    questionGroupID,
+				"name":                  questionGroupName,
+				"due":                   "2026-08-31",
+				"requiredQuestionCount": 3,
+				"completedCount":        5,
+				"completionRate":        0.5,
+			},
+		})
+		res.HasValue("unassignedParticipantCount", 4)
+
+		openRollCalls := res.Value("openRollCalls").Array()
+
+		openRollCalls.Length().IsEqual(2)
+		openRollCalls.Value(0).Object().HasValue("id", restrictedRollCallID)
+		openRollCalls.Value(0).Object().HasValue("responseRate", 0.25)
+		openRollCalls.Value(0).Object().
+			Value("deadline").String().AsDateTime(time.RFC3339).IsEqual(deadline)
+		// 対象者が指定されていない点呼は参加者の数に対する割合
+		openRollCalls.Value(1).Object().HasValue("id", openRollCallID)
+		openRollCalls.Value(1).Object().HasValue("subjectCount", 0)
+		openRollCalls.Value(1).Object().HasValue("responseRate", 0.5)
+		openRollCalls.Value(1).Object().NotContainsKey("deadline")
+
+		res.HasValue("undeliveredMessageCount", 7)
+	})
+
+	t.Run("Success - Empty camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampRegistrationCounts(gomock.Any(), uint(campID)).
+			Return(nil, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentCollection(gomock.Any(), uint(campID)).
+			Return(&repository.PaymentCollection{}, nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroupCompletions(gomock.Any(), uint(campID)).
+			Return([]repository.QuestionGroupCompletion{
+				{
+					QuestionGroupID: uint(random.PositiveInt(t)),
+					Name:            random.AlphaNumericString(t, 20),
+					Due:             random.Time(t),
+				},
+			}, nil)
+		h.repo.MockRoomRepository.EXPECT().
+			CountUnassignedParticipants(gomock.Any(), uint(campID)).
+			Return(0, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetOpenRollCallResponseCounts(gomock.Any(), uint(campID), gomock.Any()).
+			Return(nil, nil)
+		h.repo.MockMessageRepository.EXPECT().
+			CountUndeliveredMessages(gomock.Any(), uint(campID)).
+			Return(0, nil)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/dashboard", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		// 分母が0の割合は省略する
+		res.HasValue("participantCount", 0)
+		res.Value("registrations").Array().IsEmpty()
+		res.Value("payment").Object().NotContainsKey("collectionRate")
+		res.Value("questionGroups").Array().Value(0).Object().NotContainsKey("completionRate")
+		res.Value("openRollCalls").Array().IsEmpty()
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil)
+
+		h.expect.GET("/api/admin/camps/{campId}/dashboard", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound)
+
+		h.expect.GET("/api/admin/camps/{campId}/dashboard", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			HasValue("message", "Camp not found")
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampRegistrationCounts(gomock.Any(), uint(campID)).
+			Return(nil, errors.New("database error"))
+
+		h.expect.GET("/api/admin/camps/{campId}/dashboard", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusInternalServerError)
+	})
+}
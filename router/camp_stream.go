@@ -0,0 +1,196 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// StreamCampEvents 合宿内の変更をストリームで取得
+// (GET /api/camps/{campId}/stream)
+func (s *Server) StreamCampEvents(
+	e echo.Context,
+	campID api.CampId,
+	params api.StreamCampEventsParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	var lastEventID *uint64
+
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseUint(*params.LastEventID, 10, 64)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Last-Event-ID header")
+		}
+
+		lastEventID = &id
+	}
+
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	// スタッフ以外は参加者だけが購読できる
+	if !user.IsStaff {
+		isParticipant, err := s.repo.IsCampParticipant(ctx, uint(campID), user.ID)
+
+		if err != nil {
+			if errors.Is(err, repository.ErrCampNotFound) {
+				return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+			}
+
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to check camp participation: %w", err))
+		}
+
+		if !isParticipant {
+			return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+		}
+	}
+
+	events, complete := s.eventBus.Subscribe(ctx, uint(campID), lastEventID)
+	res := e.Response()
+
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	if !complete {
+		if err := writeStreamMessage(res, "event: reset\ndata: {}\n\n"); err != nil {
+			return err
+		}
+	}
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-s.shutdown:
+			return writeStreamShutdown(res)
+
+		case <-keepAlive.C:
+			if err := writeStreamKeepAlive(res); err != nil {
+				return err
+			}
+
+		case event, ok := <-events:
+			// 受信が追いつかずに購読が切れた場合は、クライアントの再接続に任せる
+			if !ok {
+				return nil
+			}
+
+			if params.Topics != nil &&
+				!slices.Contains(*params.Topics, api.CampEventTopic(event.Topic)) {
+				continue
+			}
+
+			if err := writeCampEvent(res, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeCampEvent(res *echo.Response, event eventbus.Event) error {
+	data := api.CampEvent{
+		Id:    int64(event.ID),
+		Topic: api.CampEventTopic(event.Topic),
+		Type:  api.CampEventType(event.Type),
+		Time:  event.Time,
+	}
+
+	if event.ResourceID != 0 {
+		resourceID := int(event.ResourceID)
+		data.ResourceId = &resourceID
+	}
+
+	b, err := json.Marshal(data)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to marshal event data: %w", err))
+	}
+
+	if _, err := fmt.Fprintf(res, "id: %d\ndata: %s\n\n", event.ID, b); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to write event data: %w", err))
+	}
+
+	res.Flush()
+
+	return nil
+}
+
+// publishAnswerEvents は回答の変更を質問が属する合宿に通知する。
+// 同時に変更される回答は同じ合宿のものなので、合宿の取得は1回だけ行う。
+// 回答の変更自体は完了しているので、合宿の取得に失敗してもログに残すだけにする
+func (s *Server) publishAnswerEvents(
+	ctx context.Context,
+	eventType eventbus.EventType,
+	answers ...model.Answer,
+) {
+	if len(answers) == 0 {
+		return
+	}
+
+	campID, err := s.repo.GetQuestionCampID(ctx, answers[0].QuestionID)
+
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get camp ID of question",
+			slog.String("error", err.Error()),
+			slog.Int("questionId", int(answers[0].QuestionID)),
+		)
+
+		return
+	}
+
+	for _, answer := range answers {
+		s.eventBus.Publish(campID, eventbus.TopicAnswer, eventType, answer.ID)
+	}
+}
+
+// roomCampID はイベントの送信先として部屋が属する合宿を取得する。
+// 部屋の変更自体は完了しているので、取得に失敗してもログに残すだけにする
+func (s *Server) roomCampID(ctx context.Context, roomID uint) (uint, bool) {
+	campID, err := s.repo.GetRoomCampID(ctx, roomID)
+
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get camp ID of room",
+			slog.String("error", err.Error()),
+			slog.Int("roomId", int(roomID)),
+		)
+
+		return 0, false
+	}
+
+	return campID, true
+}
@@ -0,0 +1,334 @@
+package router
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/service/activity/mockactivity"
+	"github.com/traPtitech/rucQ/service/archive/mockarchive"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/service/notification/mocknotification"
+	"github.com/traPtitech/rucQ/service/reconciliation/mockreconciliation"
+	"github.com/traPtitech/rucQ/service/traq/mocktraq"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+// openCampStream は合宿のストリームに接続し、1行ずつ読み取るスキャナを返す
+func openCampStream(
+	t *testing.T,
+	h *testHandler,
+	campID uint,
+	userID string,
+	query url.Values,
+	lastEventID string,
+) *bufio.Scanner {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/api/camps/%d/stream?%s", h.testServerURL, campID, query.Encode()),
+		nil,
+	)
+
+	require.NoError(t, err)
+
+	req.Header.Set("X-Forwarded-User", userID)
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, res.Body.Close())
+	})
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+	return bufio.NewScanner(res.Body)
+}
+
+// readStreamLines はストリームから指定した行数を読み取る
+func readStreamLines(t *testing.T, scanner *bufio.Scanner, n int) []string {
+	t.Helper()
+
+	lines := make([]string, 0, n)
+
+	for range n {
+		require.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond)
+
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines
+}
+
+func TestServer_StreamCampEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		roomID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), campID, userID).
+			Return(true, nil).
+			Times(1)
+
+		scanner := openCampStream(
+			t,
+			h,
+			campID,
+			userID,
+			url.Values{"topics": {"room,payment"}},
+			"",
+		)
+
+		// 指定していないトピックや他の合宿のイベントは送信されない
+		h.eventBus.Publish(campID, eventbus.TopicAnswer, eventbus.EventTypeCreated, 1)
+		h.eventBus.Publish(campID+1, eventbus.TopicRoom, eventbus.EventTypeCreated, 1)
+		h.eventBus.Publish(campID, eventbus.TopicRoom, eventbus.EventTypeUpdated, roomID)
+
+		lines := readStreamLines(t, scanner, 3)
+
+		assert.Equal(t, "id: 3", lines[0])
+
+		if assert.True(t, strings.HasPrefix(lines[1], eventStreamDataPrefix), lines[1]) {
+			var event api.CampEvent
+
+			require.NoError(
+				t,
+				json.Unmarshal([]byte(strings.TrimPrefix(lines[1], eventStreamDataPrefix)), &event),
+			)
+
+			assert.Equal(t, int64(3), event.Id)
+			assert.Equal(t, api.CampEventTopicRoom, event.Topic)
+			assert.Equal(t, api.CampEventUpdated, event.Type)
+
+			if assert.NotNil(t, event.ResourceId) {
+				assert.Equal(t, int(roomID), *event.ResourceId)
+			}
+		}
+
+		assert.Empty(t, lines[2])
+	})
+
+	t.Run("Replay from Last-Event-ID", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+
+		h.eventBus.Publish(campID, eventbus.TopicEvent, eventbus.EventTypeCreated, 1)
+		h.eventBus.Publish(campID, eventbus.TopicEvent, eventbus.EventTypeDeleted, 1)
+
+		scanner := openCampStream(t, h, campID, userID, url.Values{}, "1")
+		lines := readStreamLines(t, scanner, 3)
+
+		// 切断中に発生したイベントだけを再送する
+		assert.Equal(t, "id: 2", lines[0])
+		assert.Contains(t, lines[1], `"type":"deleted"`)
+		assert.Empty(t, lines[2])
+	})
+
+	t.Run("Reset when replay is incomplete", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+
+		// テスト用のバッファに収まらない数のイベントを送信する
+		for range 101 {
+			h.eventBus.Publish(campID, eventbus.TopicPayment, eventbus.EventTypeUpdated, 1)
+		}
+
+		scanner := openCampStream(
+			t,
+			h,
+			campID,
+			userID,
+			url.Values{"topics": {"room"}},
+			"0",
+		)
+
+		h.eventBus.Publish(campID, eventbus.TopicRoom, eventbus.EventTypeCreated, 1)
+
+		lines := readStreamLines(t, scanner, 6)
+
+		assert.Equal(t, "event: reset", lines[0])
+		assert.Equal(t, "data: {}", lines[1])
+		assert.Empty(t, lines[2])
+		assert.Equal(t, "id: 102", lines[3])
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(false, nil).
+			Times(1)
+
+		h.expect.GET("/api/camps/{campId}/stream", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(false, repository.ErrCampNotFound).
+			Times(1)
+
+		h.expect.GET("/api/camps/{campId}/stream", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Invalid Last-Event-ID", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.GET("/api/camps/{campId}/stream", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			WithHeader("Last-Event-ID", "invalid").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		// サーバーの終了を再現するため、キャンセルできるコンテキストでサーバーを作成する
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		serverCtx, shutdown := context.WithCancel(t.Context())
+		server := NewServer(
+			serverCtx,
+			repo,
+			mockactivity.NewMockActivityService(ctrl),
+			mockarchive.NewMockArchiveService(ctrl),
+			mockreconciliation.NewMockReconciliationService(ctrl),
+			mocknotification.NewMockNotificationService(ctrl),
+			mocktraq.NewMockTraqService(ctrl),
+			eventbus.NewEventBus(100),
+			TraqBotConfig{},
+			PubSubConfig{},
+			false,
+		)
+		e := echo.New()
+
+		api.RegisterHandlers(e, server)
+
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+
+		httptestServer := httptest.NewServer(e)
+
+		t.Cleanup(httptestServer.Close)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/camps/%d/stream", httptestServer.URL, campID),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		req.Header.Set("X-Forwarded-User", userID)
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		shutdown()
+
+		// shutdownイベントを送信した後にストリームが終了する
+		body, err := io.ReadAll(res.Body)
+
+		require.NoError(t, err)
+		assert.Equal(t, "event: shutdown\ndata: {}\n\n", string(body))
+	})
+}
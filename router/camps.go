@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/labstack/echo/v4"
@@ -14,6 +15,7 @@ import (
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/traq"
 )
 
@@ -62,7 +64,45 @@ func (s *Server) AdminPostCamp(e echo.Context, params api.AdminPostCampParams) e
 			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
 	}
 
-	if err := s.repo.CreateCamp(&campModel); err != nil {
+	if err := campModel.ValidateSchedule(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// 作成する合宿にはまだ画像がないため、しおりから画像を参照できない
+	if imageIDs, ok := guidebookImageIDs(campModel.Guidebook); !ok || len(imageIDs) > 0 {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			"Guidebook refers to an image that does not belong to this camp",
+		)
+	}
+
+	// 合宿は下書きとして作成し、準備ができてから申し込み受付を開始する
+	campModel.Status = model.CampStatusDraft
+
+	if err := s.repo.Transaction(e.Request().Context(), func(tx repository.Repository) error {
+		if err := tx.CreateCamp(&campModel); err != nil {
+			return err
+		}
+
+		if campModel.Guidebook == "" {
+			return nil
+		}
+
+		// 作成時のしおりを最初の版として残す
+		now := time.Now()
+		revision := model.GuidebookRevision{
+			CampID:      campModel.ID,
+			Content:     campModel.Guidebook,
+			AuthorID:    &user.ID,
+			PublishedAt: &now,
+		}
+
+		if err := tx.CreateGuidebookRevision(e.Request().Context(), &revision); err != nil {
+			return fmt.Errorf("failed to create guidebook revision: %w", err)
+		}
+
+		return nil
+	}); err != nil {
 		if errors.Is(err, repository.ErrCampAlreadyExists) {
 			return echo.NewHTTPError(http.StatusConflict, "Camp already exists")
 		}
@@ -112,9 +152,57 @@ func (s *Server) AdminPutCamp(
 			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
 	}
 
-	newCamp.ID = uint(campID)
+	if err := newCamp.ValidateSchedule(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	currentCamp, err := s.repo.GetCampByID(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	guidebookChanged := newCamp.Guidebook != currentCamp.Guidebook
 
-	if err := s.repo.UpdateCamp(e.Request().Context(), uint(campID), &newCamp); err != nil {
+	if guidebookChanged {
+		if err := s.validateGuidebookImages(
+			e.Request().Context(),
+			uint(campID),
+			newCamp.Guidebook,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.Transaction(e.Request().Context(), func(tx repository.Repository) error {
+		if err := tx.UpdateCamp(e.Request().Context(), uint(campID), &newCamp); err != nil {
+			return err
+		}
+
+		if !guidebookChanged {
+			return nil
+		}
+
+		// 合宿の編集で書き換えたしおりも版として残す
+		now := time.Now()
+		revision := model.GuidebookRevision{
+			CampID:      uint(campID),
+			Content:     newCamp.Guidebook,
+			AuthorID:    &user.ID,
+			PublishedAt: &now,
+		}
+
+		if err := tx.CreateGuidebookRevision(e.Request().Context(), &revision); err != nil {
+			return fmt.Errorf("failed to create guidebook revision: %w", err)
+		}
+
+		return s.activityService.RecordGuidebookPublished(e.Request().Context(), tx, revision)
+	}); err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
 		}
@@ -130,7 +218,19 @@ func (s *Server) AdminPutCamp(
 			SetInternal(fmt.Errorf("failed to update camp: %w", err))
 	}
 
-	response, err := converter.Convert[api.CampResponse](newCamp)
+	if guidebookChanged {
+		s.eventBus.Publish(uint(campID), eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+	}
+
+	// 状態は更新しないため、保存された合宿を返す
+	updatedCamp, err := s.repo.GetCampByID(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	response, err := converter.Convert[api.CampResponse](updatedCamp)
 
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
@@ -167,6 +267,82 @@ func (s *Server) AdminDeleteCamp(
 	return e.NoContent(http.StatusNoContent)
 }
 
+// AdminPutCampStatus 合宿の状態を変更（管理者用）
+// (PUT /api/admin/camps/{campId}/status)
+func (s *Server) AdminPutCampStatus(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminPutCampStatusParams,
+) error {
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPutCampStatusJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if !req.Status.Valid() {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid status")
+	}
+
+	camp, err := s.repo.GetCampByID(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	previousStatus := camp.Status
+
+	if err := camp.TransitionTo(model.CampStatus(req.Status), time.Now()); err != nil {
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+
+	if err := s.repo.UpdateCampStatus(
+		e.Request().Context(),
+		camp.ID,
+		previousStatus,
+		camp,
+	); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		// 予定時刻による変更などと同時に変更された
+		if errors.Is(err, repository.ErrCampStatusChanged) {
+			return echo.NewHTTPError(http.StatusConflict, "Camp status has been changed")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to update camp status: %w", err))
+	}
+
+	s.eventBus.Publish(camp.ID, eventbus.TopicCamp, eventbus.EventTypeUpdated, camp.ID)
+
+	response, err := converter.Convert[api.CampResponse](camp)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert camp to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, &response)
+}
+
 // PostCampRegister 合宿に登録
 func (s *Server) PostCampRegister(
 	e echo.Context,
@@ -189,7 +365,7 @@ func (s *Server) PostCampRegister(
 			SetInternal(fmt.Errorf("failed to get camp: %w", err))
 	}
 
-	if !camp.IsRegistrationOpen {
+	if !camp.AcceptsRegistration() {
 		return echo.NewHTTPError(http.StatusForbidden, "Registration for this camp is closed")
 	}
 
@@ -229,7 +405,7 @@ func (s *Server) DeleteCampRegister(
 			SetInternal(fmt.Errorf("failed to get camp: %w", err))
 	}
 
-	if !camp.IsRegistrationOpen {
+	if !camp.AcceptsRegistration() {
 		return echo.NewHTTPError(http.StatusForbidden, "Registration for this camp is closed")
 	}
 
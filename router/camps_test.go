@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,12 +10,14 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/traq"
 	"github.com/traPtitech/rucQ/testutil/random"
 )
@@ -32,14 +35,21 @@ func TestGetCamps(t *testing.T) {
 			Model: gorm.Model{
 				ID: uint(random.PositiveInt(t)),
 			},
-			DisplayID:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          dateStart,
-			DateEnd:            dateEnd,
+			DisplayID: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status: random.SelectFrom(
+				t,
+				model.CampStatusDraft,
+				model.CampStatusRegistration,
+				model.CampStatusConfirmed,
+				model.CampStatusOngoing,
+				model.CampStatusFinished,
+				model.CampStatusArchived,
+			),
+			DateStart:               dateStart,
+			DateEnd:                 dateEnd,
+			RegistrationScheduledAt: random.PtrOrNil(t, random.Time(t)),
 		}
 
 		h.repo.MockCampRepository.EXPECT().GetCamps().Return([]model.Camp{camp}, nil)
@@ -50,18 +60,23 @@ func TestGetCamps(t *testing.T) {
 
 		val := res.Value(0).Object()
 
-		val.Keys().ContainsOnly(
-			"id", "displayId", "name", "guidebook", "isDraft", "isPaymentOpen",
-			"isRegistrationOpen", "dateStart", "dateEnd")
+		val.Keys().ContainsAll("id", "displayId", "name", "guidebook", "status", "dateStart", "dateEnd")
 		val.Value("id").Number().IsEqual(camp.ID)
 		val.Value("displayId").String().IsEqual(camp.DisplayID)
 		val.Value("name").String().IsEqual(camp.Name)
 		val.Value("guidebook").String().IsEqual(camp.Guidebook)
-		val.Value("isDraft").Boolean().IsEqual(camp.IsDraft)
-		val.Value("isPaymentOpen").Boolean().IsEqual(camp.IsPaymentOpen)
-		val.Value("isRegistrationOpen").Boolean().IsEqual(camp.IsRegistrationOpen)
+		val.Value("status").String().IsEqual(string(camp.Status))
 		val.Value("dateStart").String().IsEqual(camp.DateStart.Format(time.DateOnly))
 		val.Value("dateEnd").String().IsEqual(camp.DateEnd.Format(time.DateOnly))
+
+		if camp.RegistrationScheduledAt != nil {
+			val.Value("registrationScheduledAt").
+				String().
+				AsDateTime(time.RFC3339Nano).
+				IsEqual(*camp.RegistrationScheduledAt)
+		} else {
+			val.NotContainsKey("registrationScheduledAt")
+		}
 	})
 }
 
@@ -75,14 +90,11 @@ func TestAdminPostCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPostCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
@@ -90,6 +102,13 @@ func TestAdminPostCamp(t *testing.T) {
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil)
 		h.repo.MockCampRepository.EXPECT().CreateCamp(gomock.Any()).Return(nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			CreateGuidebookRevision(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, revision *model.GuidebookRevision) error {
+				assert.Equal(t, req.Guidebook, revision.Content)
+				assert.NotNil(t, revision.PublishedAt)
+				return nil
+			})
 
 		res := h.expect.POST("/api/admin/camps").
 			WithJSON(req).
@@ -98,14 +117,11 @@ func TestAdminPostCamp(t *testing.T) {
 			Status(http.StatusCreated).JSON().Object()
 
 		res.Keys().ContainsOnly(
-			"id", "displayId", "name", "guidebook", "isDraft", "isPaymentOpen",
-			"isRegistrationOpen", "dateStart", "dateEnd")
+			"id", "displayId", "name", "guidebook", "status", "dateStart", "dateEnd")
 		res.Value("displayId").String().IsEqual(req.DisplayId)
 		res.Value("name").String().IsEqual(req.Name)
 		res.Value("guidebook").String().IsEqual(req.Guidebook)
-		res.Value("isDraft").Boolean().IsEqual(req.IsDraft)
-		res.Value("isPaymentOpen").Boolean().IsEqual(req.IsPaymentOpen)
-		res.Value("isRegistrationOpen").Boolean().IsEqual(req.IsRegistrationOpen)
+		res.Value("status").String().IsEqual("draft")
 		res.Value("dateStart").String().IsEqual(req.DateStart.Format(time.DateOnly))
 		res.Value("dateEnd").String().IsEqual(req.DateEnd.Format(time.DateOnly))
 	})
@@ -117,14 +133,11 @@ func TestAdminPostCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPostCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
@@ -156,24 +169,59 @@ func TestAdminPutCamp(t *testing.T) {
 		campID := api.CampId(random.PositiveInt(t))
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
+		confirmedScheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		ongoingScheduledAt := confirmedScheduledAt.Add(24 * time.Hour)
 		req := api.AdminPutCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId:            random.AlphaNumericString(t, 10),
+			Name:                 random.AlphaNumericString(t, 20),
+			Guidebook:            random.AlphaNumericString(t, 100),
+			DateStart:            types.Date{Time: dateStart},
+			DateEnd:              types.Date{Time: dateEnd},
+			ConfirmedScheduledAt: &confirmedScheduledAt,
+			OngoingScheduledAt:   &ongoingScheduledAt,
 		}
 		username := random.AlphaNumericString(t, 32)
 
+		var savedCamp model.Camp
+
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), username).
-			Return(&model.User{IsStaff: true}, nil)
+			Return(&model.User{ID: username, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{
+				Model:     gorm.Model{ID: uint(campID)},
+				Guidebook: random.AlphaNumericString(t, 100),
+				Status:    model.CampStatusRegistration,
+			}, nil)
 		h.repo.MockCampRepository.EXPECT().
 			UpdateCamp(gomock.Any(), uint(campID), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, camp *model.Camp) error {
+				savedCamp = *camp
+				return nil
+			})
+		// しおりを書き換えたため版として記録する
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			CreateGuidebookRevision(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, revision *model.GuidebookRevision) error {
+				assert.Equal(t, uint(campID), revision.CampID)
+				assert.Equal(t, req.Guidebook, revision.Content)
+				assert.Equal(t, &username, revision.AuthorID)
+				assert.NotNil(t, revision.PublishedAt)
+				return nil
+			})
+		h.activityService.EXPECT().
+			RecordGuidebookPublished(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			DoAndReturn(func(_ context.Context, _ uint) (*model.Camp, error) {
+				camp := savedCamp
+				camp.ID = uint(campID)
+				camp.Status = model.CampStatusRegistration
+
+				return &camp, nil
+			})
 
 		res := h.expect.PUT("/api/admin/camps/{campId}", campID).
 			WithJSON(req).
@@ -182,17 +230,49 @@ func TestAdminPutCamp(t *testing.T) {
 			Status(http.StatusOK).JSON().Object()
 
 		res.Keys().ContainsOnly(
-			"id", "displayId", "name", "guidebook", "isDraft", "isPaymentOpen",
-			"isRegistrationOpen", "dateStart", "dateEnd")
+			"id", "displayId", "name", "guidebook", "status", "dateStart", "dateEnd",
+			"confirmedScheduledAt", "ongoingScheduledAt")
 		res.Value("id").Number().IsEqual(campID)
 		res.Value("displayId").String().IsEqual(req.DisplayId)
 		res.Value("name").String().IsEqual(req.Name)
 		res.Value("guidebook").String().IsEqual(req.Guidebook)
-		res.Value("isDraft").Boolean().IsEqual(req.IsDraft)
-		res.Value("isPaymentOpen").Boolean().IsEqual(req.IsPaymentOpen)
-		res.Value("isRegistrationOpen").Boolean().IsEqual(req.IsRegistrationOpen)
+		res.Value("status").String().IsEqual("registration")
 		res.Value("dateStart").String().IsEqual(req.DateStart.Format(time.DateOnly))
 		res.Value("dateEnd").String().IsEqual(req.DateEnd.Format(time.DateOnly))
+		res.Value("confirmedScheduledAt").
+			String().
+			AsDateTime(time.RFC3339).
+			IsEqual(confirmedScheduledAt)
+		res.Value("ongoingScheduledAt").String().AsDateTime(time.RFC3339).IsEqual(ongoingScheduledAt)
+	})
+
+	t.Run("Invalid schedule", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		dateStart := random.Time(t)
+		confirmedScheduledAt := time.Now().Add(time.Hour)
+		// 参加者の確定より前に開催中にすることはできない
+		ongoingScheduledAt := confirmedScheduledAt.Add(-time.Minute)
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+
+		h.expect.PUT("/api/admin/camps/{campId}", campID).
+			WithJSON(api.AdminPutCampJSONRequestBody{
+				DisplayId:            random.AlphaNumericString(t, 10),
+				Name:                 random.AlphaNumericString(t, 20),
+				DateStart:            types.Date{Time: dateStart},
+				DateEnd:              types.Date{Time: dateStart},
+				ConfirmedScheduledAt: &confirmedScheduledAt,
+				OngoingScheduledAt:   &ongoingScheduledAt,
+			}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusBadRequest)
 	})
 
 	t.Run("Unauthorized", func(t *testing.T) {
@@ -203,14 +283,11 @@ func TestAdminPutCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPutCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
@@ -251,20 +328,23 @@ func TestAdminPutCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPutCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{
+				Model:     gorm.Model{ID: uint(campID)},
+				Guidebook: req.Guidebook,
+			}, nil)
 		h.repo.MockCampRepository.EXPECT().
 			UpdateCamp(gomock.Any(), uint(campID), gomock.Any()).
 			Return(errors.New("update error"))
@@ -284,14 +364,11 @@ func TestAdminPutCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPutCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
@@ -314,14 +391,11 @@ func TestAdminPutCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPutCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
@@ -329,8 +403,8 @@ func TestAdminPutCamp(t *testing.T) {
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil)
 		h.repo.MockCampRepository.EXPECT().
-			UpdateCamp(gomock.Any(), uint(campID), gomock.Any()).
-			Return(model.ErrNotFound)
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound)
 
 		h.expect.PUT("/api/admin/camps/{campId}", campID).
 			WithJSON(req).
@@ -350,20 +424,23 @@ func TestAdminPutCamp(t *testing.T) {
 		dateStart := random.Time(t)
 		dateEnd := dateStart.Add(time.Duration(random.PositiveInt(t)))
 		req := api.AdminPutCampJSONRequestBody{
-			DisplayId:          random.AlphaNumericString(t, 10),
-			Name:               random.AlphaNumericString(t, 20),
-			Guidebook:          random.AlphaNumericString(t, 100),
-			IsDraft:            random.Bool(t),
-			IsPaymentOpen:      random.Bool(t),
-			IsRegistrationOpen: random.Bool(t),
-			DateStart:          types.Date{Time: dateStart},
-			DateEnd:            types.Date{Time: dateEnd},
+			DisplayId: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			DateStart: types.Date{Time: dateStart},
+			DateEnd:   types.Date{Time: dateEnd},
 		}
 		username := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{
+				Model:     gorm.Model{ID: uint(campID)},
+				Guidebook: req.Guidebook,
+			}, nil)
 		h.repo.MockCampRepository.EXPECT().
 			UpdateCamp(gomock.Any(), uint(campID), gomock.Any()).
 			Return(repository.ErrCampAlreadyExists)
@@ -379,6 +456,214 @@ func TestAdminPutCamp(t *testing.T) {
 	})
 }
 
+func TestAdminPutCampStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+		camp := &model.Camp{
+			Model: gorm.Model{
+				ID: uint(campID),
+			},
+			Status: model.CampStatusDraft,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(camp, nil)
+		h.repo.MockCampRepository.EXPECT().
+			UpdateCampStatus(gomock.Any(), uint(campID), model.CampStatusDraft, camp).
+			Return(nil)
+
+		events, _ := h.eventBus.Subscribe(t.Context(), uint(campID), nil)
+
+		h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(api.AdminPutCampStatusJSONRequestBody{Status: api.Registration}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			HasValue("id", campID).
+			HasValue("status", api.Registration)
+
+		// 合宿のストリームに状態の変更を通知する
+		event := <-events
+
+		assert.Equal(t, eventbus.TopicCamp, event.Topic)
+		assert.Equal(t, eventbus.EventTypeUpdated, event.Type)
+		assert.Equal(t, uint(campID), event.ResourceID)
+	})
+
+	t.Run("Status changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+		camp := &model.Camp{
+			Model: gorm.Model{
+				ID: uint(campID),
+			},
+			Status: model.CampStatusDraft,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(camp, nil)
+		// 取得した後に予定時刻によって状態が変更された
+		h.repo.MockCampRepository.EXPECT().
+			UpdateCampStatus(gomock.Any(), uint(campID), model.CampStatusDraft, camp).
+			Return(repository.ErrCampStatusChanged)
+
+		h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(api.AdminPutCampStatusJSONRequestBody{Status: api.Registration}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusConflict).
+			JSON().
+			Object().
+			HasValue("message", "Camp status has been changed")
+	})
+
+	t.Run("Rollback clears past schedule", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+		confirmedScheduledAt := time.Now().Add(-time.Hour)
+		ongoingScheduledAt := time.Now().Add(time.Hour)
+		camp := &model.Camp{
+			Model: gorm.Model{
+				ID: uint(campID),
+			},
+			Status:               model.CampStatusConfirmed,
+			ConfirmedScheduledAt: &confirmedScheduledAt,
+			OngoingScheduledAt:   &ongoingScheduledAt,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(camp, nil)
+		h.repo.MockCampRepository.EXPECT().
+			UpdateCampStatus(gomock.Any(), uint(campID), model.CampStatusConfirmed, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, _ model.CampStatus, camp *model.Camp) error {
+				assert.Equal(t, model.CampStatusRegistration, camp.Status)
+				assert.Nil(t, camp.ConfirmedScheduledAt)
+				assert.NotNil(t, camp.OngoingScheduledAt)
+
+				return nil
+			})
+
+		res := h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(api.AdminPutCampStatusJSONRequestBody{Status: api.Registration}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.HasValue("status", api.Registration)
+		res.NotContainsKey("confirmedScheduledAt")
+		res.ContainsKey("ongoingScheduledAt")
+	})
+
+	t.Run("Invalid transition", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Status: model.CampStatusDraft}, nil)
+
+		h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(api.AdminPutCampStatusJSONRequestBody{Status: api.Ongoing}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("Invalid status", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+
+		h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(map[string]string{"status": "unknown"}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusBadRequest).
+			JSON().
+			Object().
+			HasValue("message", "Invalid status")
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound)
+
+		h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(api.AdminPutCampStatusJSONRequestBody{Status: api.Registration}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := api.CampId(random.PositiveInt(t))
+		username := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.PUT("/api/admin/camps/{campId}/status", campID).
+			WithJSON(api.AdminPutCampStatusJSONRequestBody{Status: api.Registration}).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
 func TestPostCampRegister(t *testing.T) {
 	t.Parallel()
 
@@ -396,7 +681,7 @@ func TestPostCampRegister(t *testing.T) {
 			Model: gorm.Model{
 				ID: uint(campID),
 			},
-			IsRegistrationOpen: true,
+			Status: model.CampStatusRegistration,
 		}
 
 		h.repo.MockUserRepository.EXPECT().
@@ -497,7 +782,7 @@ func TestPostCampRegister(t *testing.T) {
 			Model: gorm.Model{
 				ID: uint(campID),
 			},
-			IsRegistrationOpen: false,
+			Status: model.CampStatusConfirmed,
 		}
 
 		h.repo.MockUserRepository.EXPECT().
@@ -530,7 +815,7 @@ func TestPostCampRegister(t *testing.T) {
 			Model: gorm.Model{
 				ID: uint(campID),
 			},
-			IsRegistrationOpen: true,
+			Status: model.CampStatusRegistration,
 		}
 
 		h.repo.MockUserRepository.EXPECT().
@@ -561,8 +846,8 @@ func TestServer_DeleteCampRegister(t *testing.T) {
 		username := random.AlphaNumericString(t, 32)
 		user := &model.User{ID: username}
 		camp := &model.Camp{
-			Model:              gorm.Model{ID: uint(campID)},
-			IsRegistrationOpen: true,
+			Model:  gorm.Model{ID: uint(campID)},
+			Status: model.CampStatusRegistration,
 		}
 
 		h.repo.MockUserRepository.EXPECT().
@@ -651,8 +936,8 @@ func TestServer_DeleteCampRegister(t *testing.T) {
 		username := random.AlphaNumericString(t, 32)
 		user := &model.User{ID: username}
 		camp := &model.Camp{
-			Model:              gorm.Model{ID: uint(campID)},
-			IsRegistrationOpen: false,
+			Model:  gorm.Model{ID: uint(campID)},
+			Status: model.CampStatusConfirmed,
 		}
 
 		h.repo.MockUserRepository.EXPECT().
@@ -679,8 +964,8 @@ func TestServer_DeleteCampRegister(t *testing.T) {
 		username := random.AlphaNumericString(t, 32)
 		user := &model.User{ID: username}
 		camp := &model.Camp{
-			Model:              gorm.Model{ID: uint(campID)},
-			IsRegistrationOpen: true,
+			Model:  gorm.Model{ID: uint(campID)},
+			Status: model.CampStatusRegistration,
 		}
 
 		h.repo.MockUserRepository.EXPECT().
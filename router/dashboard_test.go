@@ -104,7 +104,7 @@ func TestServer_GetDashboard(t *testing.T) {
 
 		paymentRes := res.Value("payment").Object()
 
-		paymentRes.Keys().ContainsOnly("id", "amount", "amountPaid", "campId", "userId")
+		paymentRes.Keys().ContainsOnly("id", "amount", "amountPaid", "referenceCode", "campId", "userId")
 		paymentRes.Value("id").Number().IsEqual(payment.ID)
 		paymentRes.Value("amount").Number().IsEqual(payment.Amount)
 		paymentRes.Value("amountPaid").Number().IsEqual(payment.AmountPaid)
@@ -174,7 +174,7 @@ func TestServer_GetDashboard(t *testing.T) {
 
 		paymentRes := res.Value("payment").Object()
 
-		paymentRes.Keys().ContainsOnly("id", "amount", "amountPaid", "campId", "userId")
+		paymentRes.Keys().ContainsOnly("id", "amount", "amountPaid", "referenceCode", "campId", "userId")
 		paymentRes.Value("id").Number().IsEqual(payment.ID)
 		paymentRes.Value("amount").Number().IsEqual(payment.Amount)
 		paymentRes.Value("amountPaid").Number().IsEqual(payment.AmountPaid)
@@ -0,0 +1,308 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// PutEventAttendance イベントへの出欠を登録
+// (PUT /api/events/{eventId}/attendance)
+func (s *Server) PutEventAttendance(
+	e echo.Context,
+	eventID api.EventId,
+	params api.PutEventAttendanceParams,
+) error {
+	var req api.PutEventAttendanceJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if !req.Status.Valid() {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid status")
+	}
+
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	event, err := s.repo.GetEventByID(uint(eventID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
+	}
+
+	if event.Type == model.EventTypeMoment {
+		return echo.NewHTTPError(http.StatusBadRequest, "Cannot respond to a moment event")
+	}
+
+	isCampParticipant, err := s.repo.IsCampParticipant(
+		e.Request().Context(),
+		event.CampID,
+		user.ID,
+	)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to check if user is a camp participant: %w", err))
+	}
+
+	if !isCampParticipant {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	attendance := model.EventAttendance{
+		EventID: event.ID,
+		UserID:  user.ID,
+		Status:  model.EventAttendanceStatus(req.Status),
+	}
+
+	if err := s.repo.Transaction(e.Request().Context(), func(tx repository.Repository) error {
+		attendances, err := tx.GetEventAttendances(e.Request().Context(), event.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var existing *model.EventAttendance
+
+		for i := range attendances {
+			if attendances[i].UserID == user.ID {
+				existing = &attendances[i]
+				break
+			}
+		}
+
+		// 既にgoingの場合はキャンセル待ちの順番を保つため何もしない
+		if existing != nil && existing.Status == model.EventAttendanceStatusGoing &&
+			attendance.Status == model.EventAttendanceStatusGoing {
+			attendance = *existing
+
+			return nil
+		}
+
+		if attendance.Status == model.EventAttendanceStatusGoing && event.Capacity != nil {
+			attendance.IsWaitlisted = countConfirmedAttendees(attendances) >= *event.Capacity
+		}
+
+		if err := tx.SaveEventAttendance(e.Request().Context(), &attendance); err != nil {
+			return err
+		}
+
+		if existing != nil && existing.Status == model.EventAttendanceStatusGoing &&
+			!existing.IsWaitlisted {
+			if _, err := promoteWaitlistedAttendees(e.Request().Context(), tx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to save event attendance: %w", err))
+	}
+
+	response, err := converter.Convert[api.EventAttendanceResponse](attendance)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert event attendance: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, &response)
+}
+
+// DeleteEventAttendance イベントへの出欠を取り消す
+// (DELETE /api/events/{eventId}/attendance)
+func (s *Server) DeleteEventAttendance(
+	e echo.Context,
+	eventID api.EventId,
+	params api.DeleteEventAttendanceParams,
+) error {
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	event, err := s.repo.GetEventByID(uint(eventID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
+	}
+
+	if err := s.repo.Transaction(e.Request().Context(), func(tx repository.Repository) error {
+		attendances, err := tx.GetEventAttendances(e.Request().Context(), event.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var existing *model.EventAttendance
+
+		for i := range attendances {
+			if attendances[i].UserID == user.ID {
+				existing = &attendances[i]
+				break
+			}
+		}
+
+		if existing == nil {
+			return repository.ErrEventAttendanceNotFound
+		}
+
+		if err := tx.DeleteEventAttendance(
+			e.Request().Context(),
+			event.ID,
+			user.ID,
+		); err != nil {
+			return err
+		}
+
+		if existing.Status == model.EventAttendanceStatusGoing && !existing.IsWaitlisted {
+			if _, err := promoteWaitlistedAttendees(e.Request().Context(), tx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, repository.ErrEventAttendanceNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event attendance not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to delete event attendance: %w", err))
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// GetEventAttendees イベントの出欠一覧を取得
+// (GET /api/events/{eventId}/attendees)
+func (s *Server) GetEventAttendees(
+	e echo.Context,
+	eventID api.EventId,
+	params api.GetEventAttendeesParams,
+) error {
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	event, err := s.repo.GetEventByID(uint(eventID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
+	}
+
+	isOrganizer := event.OrganizerID != nil && *event.OrganizerID == user.ID
+
+	if !user.IsStaff && !isOrganizer {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	attendances, err := s.repo.GetEventAttendances(e.Request().Context(), event.ID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event attendances: %w", err))
+	}
+
+	response, err := converter.Convert[[]api.EventAttendanceResponse](attendances)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert event attendances: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, response)
+}
+
+// countConfirmedAttendees はキャンセル待ちを除いたgoingの人数を返す
+func countConfirmedAttendees(attendances []model.EventAttendance) int {
+	count := 0
+
+	for _, attendance := range attendances {
+		if attendance.Status == model.EventAttendanceStatusGoing && !attendance.IsWaitlisted {
+			count++
+		}
+	}
+
+	return count
+}
+
+// promoteWaitlistedAttendees は定員に空きがある分だけキャンセル待ちを登録順に繰り上げ、
+// 繰り上げ後の出欠を返す
+func promoteWaitlistedAttendees(
+	ctx context.Context,
+	repo repository.Repository,
+	event *model.Event,
+) ([]model.EventAttendance, error) {
+	attendances, err := repo.GetEventAttendances(ctx, event.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed := countConfirmedAttendees(attendances)
+
+	for i := range attendances {
+		if !attendances[i].IsWaitlisted {
+			continue
+		}
+
+		if event.Capacity != nil && confirmed >= *event.Capacity {
+			break
+		}
+
+		attendances[i].IsWaitlisted = false
+
+		if err := repo.SaveEventAttendance(ctx, &attendances[i]); err != nil {
+			return nil, err
+		}
+
+		confirmed++
+	}
+
+	return attendances, nil
+}
+
+// isCapacityIncreased は定員が増えたか、定員が無くなったかを返す
+func isCapacityIncreased(before, after *int) bool {
+	if before == nil {
+		return false
+	}
+
+	return after == nil || *after > *before
+}
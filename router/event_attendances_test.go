@@ -0,0 +1,414 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_PutEventAttendance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:   model.EventTypeDuration,
+			CampID: uint(random.PositiveInt(t)),
+		}
+		status := random.SelectFrom(t, api.Going, api.Maybe, api.NotGoing)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), event.CampID, userID).
+			Return(true, nil)
+		h.repo.MockEventAttendanceRepository.EXPECT().
+			GetEventAttendances(gomock.Any(), event.ID).
+			Return(nil, nil)
+		h.repo.MockEventAttendanceRepository.EXPECT().
+			SaveEventAttendance(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, attendance *model.EventAttendance) error {
+				assert.Equal(t, event.ID, attendance.EventID)
+				assert.Equal(t, userID, attendance.UserID)
+				assert.Equal(t, model.EventAttendanceStatus(status), attendance.Status)
+				assert.False(t, attendance.IsWaitlisted)
+				return nil
+			})
+
+		res := h.expect.PUT("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventAttendanceRequest{Status: status}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("userId", "status", "isWaitlisted")
+		res.Value("userId").String().IsEqual(userID)
+		res.Value("status").String().IsEqual(string(status))
+		res.Value("isWaitlisted").Boolean().IsFalse()
+	})
+
+	t.Run("定員に達している場合はキャンセル待ち", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		capacity := 1
+		event := model.Event{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:     model.EventTypeOfficial,
+			Capacity: &capacity,
+			CampID:   uint(random.PositiveInt(t)),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), event.CampID, userID).
+			Return(true, nil)
+		h.repo.MockEventAttendanceRepository.EXPECT().
+			GetEventAttendances(gomock.Any(), event.ID).
+			Return([]model.EventAttendance{
+				{
+					EventID: event.ID,
+					UserID:  random.AlphaNumericString(t, 32),
+					Status:  model.EventAttendanceStatusGoing,
+				},
+			}, nil)
+		h.repo.MockEventAttendanceRepository.EXPECT().
+			SaveEventAttendance(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, attendance *model.EventAttendance) error {
+				assert.True(t, attendance.IsWaitlisted)
+				return nil
+			})
+
+		h.expect.PUT("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventAttendanceRequest{Status: api.Going}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("isWaitlisted").Boolean().IsTrue()
+	})
+
+	t.Run("参加を取りやめた場合はキャンセル待ちが繰り上がる", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		waitlistedUserID := random.AlphaNumericString(t, 32)
+		capacity := 1
+		event := model.Event{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:     model.EventTypeDuration,
+			Capacity: &capacity,
+			CampID:   uint(random.PositiveInt(t)),
+		}
+		waitlisted := model.EventAttendance{
+			EventID:      event.ID,
+			UserID:       waitlistedUserID,
+			Status:       model.EventAttendanceStatusGoing,
+			IsWaitlisted: true,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), event.CampID, userID).
+			Return(true, nil)
+		gomock.InOrder(
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				GetEventAttendances(gomock.Any(), event.ID).
+				Return([]model.EventAttendance{
+					{
+						EventID: event.ID,
+						UserID:  userID,
+						Status:  model.EventAttendanceStatusGoing,
+					},
+					waitlisted,
+				}, nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				SaveEventAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, attendance *model.EventAttendance) error {
+					assert.Equal(t, userID, attendance.UserID)
+					assert.Equal(t, model.EventAttendanceStatusNotGoing, attendance.Status)
+					return nil
+				}),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				GetEventAttendances(gomock.Any(), event.ID).
+				Return([]model.EventAttendance{
+					{
+						EventID: event.ID,
+						UserID:  userID,
+						Status:  model.EventAttendanceStatusNotGoing,
+					},
+					waitlisted,
+				}, nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				SaveEventAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, attendance *model.EventAttendance) error {
+					assert.Equal(t, waitlistedUserID, attendance.UserID)
+					assert.False(t, attendance.IsWaitlisted)
+					return nil
+				}),
+		)
+
+		h.expect.PUT("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventAttendanceRequest{Status: api.NotGoing}).
+			Expect().
+			Status(http.StatusOK)
+	})
+
+	t.Run("Moment Event", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:  model.EventTypeMoment,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+
+		h.expect.PUT("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventAttendanceRequest{Status: api.Going}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:   model.EventTypeDuration,
+			CampID: uint(random.PositiveInt(t)),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), event.CampID, userID).
+			Return(false, nil)
+
+		h.expect.PUT("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventAttendanceRequest{Status: api.Going}).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		eventID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEventByID(eventID).
+			Return(nil, repository.ErrEventNotFound)
+
+		h.expect.PUT("/api/events/{eventId}/attendance", eventID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventAttendanceRequest{Status: api.Going}).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Invalid Status", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.PUT("/api/events/{eventId}/attendance", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			WithJSON(map[string]string{"status": random.AlphaNumericString(t, 10)}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+}
+
+func TestServer_DeleteEventAttendance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		capacity := 1
+		event := model.Event{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:     model.EventTypeDuration,
+			Capacity: &capacity,
+		}
+		waitlisted := model.EventAttendance{
+			EventID:      event.ID,
+			UserID:       random.AlphaNumericString(t, 32),
+			Status:       model.EventAttendanceStatusGoing,
+			IsWaitlisted: true,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		gomock.InOrder(
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				GetEventAttendances(gomock.Any(), event.ID).
+				Return([]model.EventAttendance{
+					{
+						EventID: event.ID,
+						UserID:  userID,
+						Status:  model.EventAttendanceStatusGoing,
+					},
+					waitlisted,
+				}, nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				DeleteEventAttendance(gomock.Any(), event.ID, userID).
+				Return(nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				GetEventAttendances(gomock.Any(), event.ID).
+				Return([]model.EventAttendance{waitlisted}, nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				SaveEventAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, attendance *model.EventAttendance) error {
+					assert.Equal(t, waitlisted.UserID, attendance.UserID)
+					assert.False(t, attendance.IsWaitlisted)
+					return nil
+				}),
+		)
+
+		h.expect.DELETE("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:  model.EventTypeDuration,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockEventAttendanceRepository.EXPECT().
+			GetEventAttendances(gomock.Any(), event.ID).
+			Return(nil, nil)
+
+		h.expect.DELETE("/api/events/{eventId}/attendance", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_GetEventAttendees(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success (organizer)", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			OrganizerID: &userID,
+		}
+		attendance := model.EventAttendance{
+			EventID:      event.ID,
+			UserID:       random.AlphaNumericString(t, 32),
+			Status:       model.EventAttendanceStatusGoing,
+			IsWaitlisted: random.Bool(t),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockEventAttendanceRepository.EXPECT().
+			GetEventAttendances(gomock.Any(), event.ID).
+			Return([]model.EventAttendance{attendance}, nil)
+
+		res := h.expect.GET("/api/events/{eventId}/attendees", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(1)
+
+		val := res.Value(0).Object()
+
+		val.Value("userId").String().IsEqual(attendance.UserID)
+		val.Value("status").String().IsEqual(string(attendance.Status))
+		val.Value("isWaitlisted").Boolean().IsEqual(attendance.IsWaitlisted)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		organizerID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			OrganizerID: &organizerID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+
+		h.expect.GET("/api/events/{eventId}/attendees", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -0,0 +1,189 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+)
+
+var (
+	errEventTimeEndBeforeStart = errors.New("timeEnd must be after timeStart")
+	errEventOutOfCampPeriod    = errors.New("event must be within the camp period")
+	errEventCapacityTooSmall   = errors.New("capacity must be at least 1")
+)
+
+// GetEventConflicts イベントの重複を取得
+// (GET /api/camps/{campId}/event-conflicts)
+func (s *Server) GetEventConflicts(e echo.Context, campID api.CampId) error {
+	events, err := s.repo.GetEvents(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get events: %w", err))
+	}
+
+	conflicts := findEventConflicts(events)
+	response := make([]api.EventConflict, len(conflicts))
+
+	for i, conflict := range conflicts {
+		response[i] = api.EventConflict{
+			Type:     api.EventConflictType(conflict.conflictType),
+			Value:    conflict.value,
+			EventIds: []int{int(conflict.eventIDs[0]), int(conflict.eventIDs[1])},
+		}
+	}
+
+	return e.JSON(http.StatusOK, response)
+}
+
+// validateEvent はイベントの定員が1以上で、時刻が合宿期間内にあり、終了時刻が開始時刻より後であることを確認する
+func validateEvent(event *model.Event, camp *model.Camp) error {
+	// リクエストはOpenAPIのスキーマで検証されないため、定員の下限もここで確認する
+	if event.Capacity != nil && *event.Capacity < 1 {
+		return errEventCapacityTooSmall
+	}
+
+	if event.TimeEnd != nil && !event.TimeEnd.After(event.TimeStart) {
+		return errEventTimeEndBeforeStart
+	}
+
+	y, m, d := camp.DateStart.Date()
+	periodStart := time.Date(y, m, d, 0, 0, 0, 0, model.JST)
+	y, m, d = camp.DateEnd.Date()
+	periodEnd := time.Date(y, m, d, 0, 0, 0, 0, model.JST).AddDate(0, 0, 1)
+
+	timeEnd := event.TimeStart
+
+	if event.TimeEnd != nil {
+		timeEnd = *event.TimeEnd
+	}
+
+	if event.TimeStart.Before(periodStart) || timeEnd.After(periodEnd) {
+		return errEventOutOfCampPeriod
+	}
+
+	return nil
+}
+
+type eventConflictType string
+
+const (
+	eventConflictTypeLocation  eventConflictType = "location"
+	eventConflictTypeOrganizer eventConflictType = "organizer"
+)
+
+type eventConflict struct {
+	conflictType eventConflictType
+	value        string
+	eventIDs     [2]uint
+}
+
+// findEventConflicts は時間が重なっていて場所または主催者が同じイベントの組を返す。
+// 終了時刻のないmomentイベントは対象外
+func findEventConflicts(events []model.Event) []eventConflict {
+	conflicts := make([]eventConflict, 0)
+
+	for i := range events {
+		for j := i + 1; j < len(events); j++ {
+			a, b := &events[i], &events[j]
+
+			if a.TimeEnd == nil || b.TimeEnd == nil {
+				continue
+			}
+
+			if !a.TimeStart.Before(*b.TimeEnd) || !b.TimeStart.Before(*a.TimeEnd) {
+				continue
+			}
+
+			eventIDs := [2]uint{a.ID, b.ID}
+
+			if location := strings.TrimSpace(a.Location); location != "" &&
+				location == strings.TrimSpace(b.Location) {
+				conflicts = append(conflicts, eventConflict{
+					conflictType: eventConflictTypeLocation,
+					value:        location,
+					eventIDs:     eventIDs,
+				})
+			}
+
+			if a.OrganizerID != nil && b.OrganizerID != nil && *a.OrganizerID == *b.OrganizerID {
+				conflicts = append(conflicts, eventConflict{
+					conflictType: eventConflictTypeOrganizer,
+					value:        *a.OrganizerID,
+					eventIDs:     eventIDs,
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// eventWarnings はconflictsのうちeventIDのイベントに関するものを警告として返す
+func eventWarnings(conflicts []eventConflict, eventID uint) []api.EventWarning {
+	var warnings []api.EventWarning
+
+	for _, conflict := range conflicts {
+		var conflictingEventID uint
+
+		switch eventID {
+		case conflict.eventIDs[0]:
+			conflictingEventID = conflict.eventIDs[1]
+		case conflict.eventIDs[1]:
+			conflictingEventID = conflict.eventIDs[0]
+		default:
+			continue
+		}
+
+		warnings = append(warnings, api.EventWarning{
+			Type:               api.EventWarningType(conflict.conflictType),
+			ConflictingEventId: int(conflictingEventID),
+		})
+	}
+
+	return warnings
+}
+
+// setEventWarnings は変換済みのレスポンスに警告を追加する。momentイベントには警告を付けない
+func setEventWarnings(
+	response *api.EventResponse,
+	eventType model.EventType,
+	warnings []api.EventWarning,
+) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	switch eventType {
+	case model.EventTypeDuration:
+		durationEvent, err := response.AsDurationEventResponse()
+
+		if err != nil {
+			return err
+		}
+
+		durationEvent.Warnings = &warnings
+
+		return response.FromDurationEventResponse(durationEvent)
+
+	case model.EventTypeOfficial:
+		officialEvent, err := response.AsOfficialEventResponse()
+
+		if err != nil {
+			return err
+		}
+
+		officialEvent.Warnings = &warnings
+
+		return response.FromOfficialEventResponse(officialEvent)
+	}
+
+	return nil
+}
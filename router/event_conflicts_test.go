@@ -0,0 +1,233 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_GetEventConflicts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		location := random.AlphaNumericString(t, 20)
+		organizerID := random.AlphaNumericString(t, 32)
+		timeStart := random.Time(t)
+		timeEnd := timeStart.Add(time.Hour)
+		overlapStart := timeStart.Add(30 * time.Minute)
+		overlapEnd := overlapStart.Add(time.Hour)
+		laterStart := overlapEnd
+		laterEnd := laterStart.Add(time.Hour)
+		event1 := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			Location:    location,
+			TimeStart:   timeStart,
+			TimeEnd:     &timeEnd,
+			OrganizerID: &organizerID,
+		}
+		// event1と時間・場所・主催者が重なる
+		event2 := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			Location:    location,
+			TimeStart:   overlapStart,
+			TimeEnd:     &overlapEnd,
+			OrganizerID: &organizerID,
+		}
+		// event2の終了と同時に始まるので重ならない
+		event3 := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeOfficial,
+			Location:  location,
+			TimeStart: laterStart,
+			TimeEnd:   &laterEnd,
+		}
+		// 終了時刻がないので対象外
+		event4 := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeMoment,
+			Location:  location,
+			TimeStart: overlapStart,
+		}
+
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return([]model.Event{event1, event2, event3, event4}, nil)
+
+		res := h.expect.GET("/api/camps/{campId}/event-conflicts", campID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(2)
+
+		locationConflict := res.Value(0).Object()
+
+		locationConflict.Value("type").String().IsEqual(string(api.EventConflictTypeLocation))
+		locationConflict.Value("value").String().IsEqual(location)
+		locationConflict.Value("eventIds").Array().IsEqual([]uint{event1.ID, event2.ID})
+
+		organizerConflict := res.Value(1).Object()
+
+		organizerConflict.Value("type").String().IsEqual(string(api.EventConflictTypeOrganizer))
+		organizerConflict.Value("value").String().IsEqual(organizerID)
+		organizerConflict.Value("eventIds").Array().IsEqual([]uint{event1.ID, event2.ID})
+	})
+}
+
+func TestServer_PostEvent_Schedule(t *testing.T) {
+	t.Parallel()
+
+	postOfficialEvent := func(
+		t *testing.T,
+		h *testHandler,
+		campID uint,
+		timeStart, timeEnd time.Time,
+	) *httpexpect.Response {
+		t.Helper()
+
+		var eventRequest api.EventRequest
+
+		err := eventRequest.FromOfficialEventRequest(api.OfficialEventRequest{
+			Type:        api.OfficialEventRequestTypeOfficial,
+			Name:        random.AlphaNumericString(t, 20),
+			Description: random.AlphaNumericString(t, 100),
+			Location:    random.AlphaNumericString(t, 50),
+			TimeStart:   timeStart,
+			TimeEnd:     timeEnd,
+		})
+
+		require.NoError(t, err)
+
+		return h.expect.POST("/api/camps/{campId}/events", campID).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			WithJSON(eventRequest).
+			Expect()
+	}
+
+	t.Run("終了時刻が開始時刻より前", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		timeStart := random.Time(t)
+		timeEnd := timeStart.Add(-time.Hour)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), gomock.Any()).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeEnd, timeStart), nil)
+
+		postOfficialEvent(t, h, campID, timeStart, timeEnd).Status(http.StatusBadRequest)
+	})
+
+	t.Run("合宿期間外", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		timeStart := random.Time(t)
+		timeEnd := timeStart.Add(time.Hour)
+		camp := campCovering(t, campID, timeStart, timeEnd)
+
+		// 合宿の最終日の翌日に終わるイベント
+		camp.DateEnd = timeEnd.AddDate(0, 0, -2)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), gomock.Any()).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(camp, nil)
+
+		postOfficialEvent(t, h, campID, timeStart, timeEnd).Status(http.StatusBadRequest)
+	})
+
+	t.Run("重複がある場合は警告を返す", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		timeStart := random.Time(t)
+		timeEnd := timeStart.Add(time.Hour)
+		existingEvent := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeOfficial,
+			TimeStart: timeStart,
+			TimeEnd:   &timeEnd,
+			CampID:    campID,
+		}
+		organizerID := random.AlphaNumericString(t, 32)
+		existingEvent.OrganizerID = &organizerID
+
+		var eventRequest api.EventRequest
+
+		err := eventRequest.FromDurationEventRequest(api.DurationEventRequest{
+			Type:         api.DurationEventRequestTypeDuration,
+			Name:         random.AlphaNumericString(t, 20),
+			Description:  random.AlphaNumericString(t, 100),
+			Location:     random.AlphaNumericString(t, 50),
+			TimeStart:    timeStart,
+			TimeEnd:      timeEnd,
+			OrganizerId:  organizerID,
+			DisplayColor: api.DurationEventRequestDisplayColorBlue,
+		})
+
+		require.NoError(t, err)
+
+		userID := random.AlphaNumericString(t, 32)
+		createdEventID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), campID, organizerID).
+			Return(true, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return([]model.Event{existingEvent}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			CreateEvent(gomock.Any()).
+			DoAndReturn(func(event *model.Event) error {
+				event.ID = createdEventID
+				return nil
+			})
+
+		res := h.expect.POST("/api/camps/{campId}/events", campID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(eventRequest).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		warnings := res.Value("warnings").Array()
+
+		warnings.Length().IsEqual(1)
+		warnings.Value(0).Object().Value("type").
+			String().IsEqual(string(api.EventWarningTypeOrganizer))
+		warnings.Value(0).Object().Value("conflictingEventId").
+			Number().IsEqual(existingEvent.ID)
+	})
+}
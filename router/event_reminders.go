@@ -0,0 +1,200 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// 1週間より前のリマインダーは作成できない
+const maxReminderMinutesBefore = 7 * 24 * 60
+
+// GetEventReminders イベントのリマインダー一覧を取得
+// (GET /api/events/{eventId}/reminders)
+func (s *Server) GetEventReminders(
+	e echo.Context,
+	eventID api.EventId,
+	params api.GetEventRemindersParams,
+) error {
+	event, err := s.getManageableEvent(e, uint(eventID), *params.XForwardedUser)
+
+	if err != nil {
+		return err
+	}
+
+	reminders, err := s.repo.GetEventReminders(e.Request().Context(), event.ID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event reminders: %w", err))
+	}
+
+	response, err := converter.Convert[[]api.EventReminderResponse](reminders)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert event reminders: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, response)
+}
+
+// PostEventReminder イベントのリマインダーを作成
+// (POST /api/events/{eventId}/reminders)
+func (s *Server) PostEventReminder(
+	e echo.Context,
+	eventID api.EventId,
+	params api.PostEventReminderParams,
+) error {
+	var req api.PostEventReminderJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if req.MinutesBefore < 0 || req.MinutesBefore > maxReminderMinutesBefore {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid minutesBefore")
+	}
+
+	event, err := s.getManageableEvent(e, uint(eventID), *params.XForwardedUser)
+
+	if err != nil {
+		return err
+	}
+
+	reminder := model.EventReminder{
+		EventID:       event.ID,
+		MinutesBefore: req.MinutesBefore,
+		SendAt:        reminderSendAt(event.TimeStart, req.MinutesBefore),
+	}
+
+	if err := s.repo.CreateEventReminder(e.Request().Context(), &reminder); err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to create event reminder: %w", err))
+	}
+
+	response, err := converter.Convert[api.EventReminderResponse](reminder)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert event reminder: %w", err))
+	}
+
+	return e.JSON(http.StatusCreated, &response)
+}
+
+// DeleteEventReminder イベントのリマインダーを削除
+// (DELETE /api/event-reminders/{reminderId})
+func (s *Server) DeleteEventReminder(
+	e echo.Context,
+	reminderID api.ReminderId,
+	params api.DeleteEventReminderParams,
+) error {
+	reminder, err := s.repo.GetEventReminderByID(e.Request().Context(), uint(reminderID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrEventReminderNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event reminder not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event reminder (reminderId: %d): %w", reminderID, err))
+	}
+
+	if _, err := s.getManageableEvent(e, reminder.EventID, *params.XForwardedUser); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteEventReminder(e.Request().Context(), reminder.ID); err != nil {
+		if errors.Is(err, repository.ErrEventReminderNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event reminder not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to delete event reminder: %w", err))
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// getManageableEvent はイベントを取得し、ユーザーがその主催者または管理者であることを確認する
+func (s *Server) getManageableEvent(
+	e echo.Context,
+	eventID uint,
+	userID string,
+) (*model.Event, error) {
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), userID)
+
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	event, err := s.repo.GetEventByID(eventID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
+		return nil, echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
+	}
+
+	isOrganizer := event.OrganizerID != nil && *event.OrganizerID == user.ID
+
+	if !user.IsStaff && !isOrganizer {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	return event, nil
+}
+
+func reminderSendAt(timeStart time.Time, minutesBefore int) time.Time {
+	return timeStart.Add(-time.Duration(minutesBefore) * time.Minute)
+}
+
+// rescheduleEventReminders はイベントの開始時刻の変更に合わせてリマインダーの送信予定時刻を更新する。
+// 送信済みでも新しい送信予定時刻が未来であれば再度送信する
+func rescheduleEventReminders(
+	ctx context.Context,
+	repo repository.Repository,
+	eventID uint,
+	timeStart time.Time,
+) error {
+	reminders, err := repo.GetEventReminders(ctx, eventID)
+
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range reminders {
+		reminder := &reminders[i]
+		reminder.SendAt = reminderSendAt(timeStart, reminder.MinutesBefore)
+
+		if reminder.SendAt.After(now) {
+			reminder.SentAt = nil
+		}
+
+		if err := repo.UpdateEventReminder(ctx, reminder.ID, reminder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
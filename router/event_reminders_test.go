@@ -0,0 +1,271 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_GetEventReminders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success (organizer)", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			OrganizerID: &userID,
+			TimeStart:   random.Time(t),
+		}
+		sentAt := random.Time(t)
+		reminders := []model.EventReminder{
+			{
+				Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+				EventID:       event.ID,
+				MinutesBefore: 60,
+				SendAt:        event.TimeStart.Add(-time.Hour),
+				SentAt:        &sentAt,
+			},
+			{
+				Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+				EventID:       event.ID,
+				MinutesBefore: 15,
+				SendAt:        event.TimeStart.Add(-15 * time.Minute),
+			},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminders(gomock.Any(), event.ID).
+			Return(reminders, nil)
+
+		res := h.expect.GET("/api/events/{eventId}/reminders", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(len(reminders))
+
+		sentReminder := res.Value(0).Object()
+
+		sentReminder.Keys().ContainsOnly("id", "minutesBefore", "sendAt", "sentAt")
+		sentReminder.Value("id").Number().IsEqual(reminders[0].ID)
+		sentReminder.Value("minutesBefore").Number().IsEqual(reminders[0].MinutesBefore)
+
+		pendingReminder := res.Value(1).Object()
+
+		pendingReminder.Keys().ContainsOnly("id", "minutesBefore", "sendAt")
+		pendingReminder.Value("id").Number().IsEqual(reminders[1].ID)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		organizerID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			OrganizerID: &organizerID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+
+		h.expect.GET("/api/events/{eventId}/reminders", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_PostEventReminder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeOfficial,
+			TimeStart: random.Time(t),
+		}
+		reminderID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockEventReminderRepository.EXPECT().
+			CreateEventReminder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reminder *model.EventReminder) error {
+				assert.Equal(t, event.ID, reminder.EventID)
+				assert.Equal(t, 15, reminder.MinutesBefore)
+				assert.True(t, event.TimeStart.Add(-15*time.Minute).Equal(reminder.SendAt))
+				assert.Nil(t, reminder.SentAt)
+
+				reminder.ID = reminderID
+
+				return nil
+			})
+
+		res := h.expect.POST("/api/events/{eventId}/reminders", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventReminderRequest{MinutesBefore: 15}).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("id", "minutesBefore", "sendAt")
+		res.Value("id").Number().IsEqual(reminderID)
+		res.Value("minutesBefore").Number().IsEqual(15)
+	})
+
+	t.Run("Invalid MinutesBefore", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.POST("/api/events/{eventId}/reminders", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			WithJSON(api.EventReminderRequest{MinutesBefore: -1}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		eventID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEventByID(eventID).
+			Return(nil, repository.ErrEventNotFound)
+
+		h.expect.POST("/api/events/{eventId}/reminders", eventID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.EventReminderRequest{MinutesBefore: 15}).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_DeleteEventReminder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			OrganizerID: &userID,
+		}
+		reminder := model.EventReminder{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			EventID: event.ID,
+		}
+
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminderByID(gomock.Any(), reminder.ID).
+			Return(&reminder, nil)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		h.repo.MockEventReminderRepository.EXPECT().
+			DeleteEventReminder(gomock.Any(), reminder.ID).
+			Return(nil)
+
+		h.expect.DELETE("/api/event-reminders/{reminderId}", reminder.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		reminderID := uint(random.PositiveInt(t))
+
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminderByID(gomock.Any(), reminderID).
+			Return(nil, repository.ErrEventReminderNotFound)
+
+		h.expect.DELETE("/api/event-reminders/{reminderId}", reminderID).
+			WithHeader("X-Forwarded-User", random.AlphaNumericString(t, 32)).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestRescheduleEventReminders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("送信済みでも新しい送信予定時刻が未来なら再送する", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		eventID := uint(random.PositiveInt(t))
+		timeStart := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		sentAt := time.Now().Add(-time.Hour)
+		reminders := []model.EventReminder{
+			{
+				Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+				EventID:       eventID,
+				MinutesBefore: 30,
+				SendAt:        time.Now().Add(-time.Hour),
+				SentAt:        &sentAt,
+			},
+		}
+
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminders(gomock.Any(), eventID).
+			Return(reminders, nil)
+		h.repo.MockEventReminderRepository.EXPECT().
+			UpdateEventReminder(gomock.Any(), reminders[0].ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, reminder *model.EventReminder) error {
+				assert.True(t, timeStart.Add(-30*time.Minute).Equal(reminder.SendAt))
+				// 新しい送信予定時刻が未来なので再送する
+				assert.Nil(t, reminder.SentAt)
+
+				return nil
+			})
+
+		err := rescheduleEventReminders(t.Context(), h.repo, eventID, timeStart)
+
+		assert.NoError(t, err)
+	})
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 func (s *Server) GetEvents(e echo.Context, campID api.CampId) error {
@@ -26,6 +29,19 @@ func (s *Server) GetEvents(e echo.Context, campID api.CampId) error {
 			SetInternal(fmt.Errorf("failed to convert events to response: %w", err))
 	}
 
+	conflicts := findEventConflicts(events)
+
+	for i, event := range events {
+		if err := setEventWarnings(
+			&response[i],
+			event.Type,
+			eventWarnings(conflicts, event.ID),
+		); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to set event warnings: %w", err))
+		}
+	}
+
 	return e.JSON(http.StatusOK, response)
 }
 
@@ -76,11 +92,35 @@ func (s *Server) PostEvent(e echo.Context, campID api.CampId, params api.PostEve
 		}
 	}
 
+	camp, err := s.repo.GetCampByID(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	if err := validateEvent(&eventModel, camp); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	existingEvents, err := s.repo.GetEvents(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get events: %w", err))
+	}
+
 	if err := s.repo.CreateEvent(&eventModel); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to create event: %w", err))
 	}
 
+	s.eventBus.Publish(eventModel.CampID, eventbus.TopicEvent, eventbus.EventTypeCreated, eventModel.ID)
+
 	response, err := converter.Convert[api.EventResponse](eventModel)
 
 	if err != nil {
@@ -88,12 +128,27 @@ func (s *Server) PostEvent(e echo.Context, campID api.CampId, params api.PostEve
 			SetInternal(fmt.Errorf("failed to convert event to response: %w", err))
 	}
 
+	conflicts := findEventConflicts(append(existingEvents, eventModel))
+
+	if err := setEventWarnings(
+		&response,
+		eventModel.Type,
+		eventWarnings(conflicts, eventModel.ID),
+	); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to set event warnings: %w", err))
+	}
+
 	return e.JSON(http.StatusCreated, &response)
 }
 
 func (s *Server) GetEvent(e echo.Context, eventID api.EventId) error {
 	event, err := s.repo.GetEventByID(uint(eventID))
 	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
 	}
@@ -117,6 +172,10 @@ func (s *Server) PutEvent(e echo.Context, eventID api.EventId, params api.PutEve
 
 	existingEvent, err := s.repo.GetEventByID(uint(eventID))
 	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
 	}
@@ -166,11 +225,65 @@ func (s *Server) PutEvent(e echo.Context, eventID api.EventId, params api.PutEve
 		}
 	}
 
-	if err := s.repo.UpdateEvent(e.Request().Context(), uint(eventID), &newEvent); err != nil {
+	camp, err := s.repo.GetCampByID(e.Request().Context(), existingEvent.CampID)
+
+	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
-			SetInternal(fmt.Errorf("failed to update event (eventId: %d): %w", eventID, err))
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	if err := validateEvent(&newEvent, camp); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	campEvents, err := s.repo.GetEvents(e.Request().Context(), existingEvent.CampID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get events: %w", err))
+	}
+
+	newEvent.Attendances = existingEvent.Attendances
+
+	ctx := e.Request().Context()
+
+	// 同時に出欠が変更されても定員を超えないよう、繰り上げは更新と同じトランザクションで行う
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		if err := tx.UpdateEvent(ctx, uint(eventID), &newEvent); err != nil {
+			return fmt.Errorf("failed to update event (eventId: %d): %w", eventID, err)
+		}
+
+		if !newEvent.TimeStart.Equal(existingEvent.TimeStart) {
+			if err := rescheduleEventReminders(
+				ctx,
+				tx,
+				newEvent.ID,
+				newEvent.TimeStart,
+			); err != nil {
+				return fmt.Errorf("failed to reschedule event reminders: %w", err)
+			}
+		}
+
+		// 定員が増えた場合や無くなった場合はキャンセル待ちを繰り上げる
+		if !isCapacityIncreased(existingEvent.Capacity, newEvent.Capacity) {
+			return nil
+		}
+
+		attendances, err := promoteWaitlistedAttendees(ctx, tx, &newEvent)
+
+		if err != nil {
+			return fmt.Errorf("failed to promote waitlisted attendees: %w", err)
+		}
+
+		newEvent.Attendances = attendances
+
+		return nil
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	s.eventBus.Publish(existingEvent.CampID, eventbus.TopicEvent, eventbus.EventTypeUpdated, newEvent.ID)
+
 	response, err := converter.Convert[api.EventResponse](newEvent)
 
 	if err != nil {
@@ -178,6 +291,23 @@ func (s *Server) PutEvent(e echo.Context, eventID api.EventId, params api.PutEve
 			SetInternal(fmt.Errorf("failed to convert event to response: %w", err))
 	}
 
+	for i := range campEvents {
+		if campEvents[i].ID == newEvent.ID {
+			campEvents[i] = newEvent
+		}
+	}
+
+	conflicts := findEventConflicts(campEvents)
+
+	if err := setEventWarnings(
+		&response,
+		newEvent.Type,
+		eventWarnings(conflicts, newEvent.ID),
+	); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to set event warnings: %w", err))
+	}
+
 	return e.JSON(http.StatusOK, &response)
 }
 
@@ -194,6 +324,10 @@ func (s *Server) DeleteEvent(
 
 	deleteEvent, err := s.repo.GetEventByID(uint(eventID))
 	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+		}
+
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to get event (eventId: %d): %w", eventID, err))
 	}
@@ -203,10 +337,19 @@ func (s *Server) DeleteEvent(
 		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
 	}
 
-	if err := s.repo.DeleteEvent(uint(eventID)); err != nil {
+	// 未送信のリマインダーが送られないようにイベントと一緒に削除する
+	if err := s.repo.Transaction(e.Request().Context(), func(tx repository.Repository) error {
+		if err := tx.DeleteEventReminders(e.Request().Context(), uint(eventID)); err != nil {
+			return err
+		}
+
+		return tx.DeleteEvent(uint(eventID))
+	}); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to delete event: %w", err))
 	}
 
+	s.eventBus.Publish(deleteEvent.CampID, eventbus.TopicEvent, eventbus.EventTypeDeleted, uint(eventID))
+
 	return e.NoContent(http.StatusNoContent)
 }
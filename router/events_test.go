@@ -8,10 +8,12 @@ import (
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
 	"github.com/traPtitech/rucQ/testutil/random"
 )
 
@@ -48,6 +50,13 @@ func TestGetEvents(t *testing.T) {
 			TimeEnd:      &timeEnd1,
 			OrganizerID:  &userID,
 			DisplayColor: &color,
+			Attendances: []model.EventAttendance{
+				{Status: model.EventAttendanceStatusGoing},
+				{Status: model.EventAttendanceStatusGoing},
+				{Status: model.EventAttendanceStatusGoing, IsWaitlisted: true},
+				{Status: model.EventAttendanceStatusMaybe},
+				{Status: model.EventAttendanceStatusNotGoing},
+			},
 		}
 
 		timeStart2 := random.Time(t)
@@ -95,7 +104,7 @@ func TestGetEvents(t *testing.T) {
 		res1 := res.Value(0).Object()
 
 		res1.Keys().ContainsOnly("id", "type", "name", "description", "location", "timeStart",
-			"timeEnd", "organizerId", "displayColor")
+			"timeEnd", "organizerId", "displayColor", "attendance")
 		res1.Value("id").Number().IsEqual(durationEvent.ID)
 		res1.Value("type").String().IsEqual(string(model.EventTypeDuration))
 		res1.Value("name").String().IsEqual(durationEvent.Name)
@@ -106,6 +115,13 @@ func TestGetEvents(t *testing.T) {
 		res1.Value("organizerId").String().IsEqual(*durationEvent.OrganizerID)
 		res1.Value("displayColor").String().IsEqual(*durationEvent.DisplayColor)
 
+		attendance := res1.Value("attendance").Object()
+
+		attendance.Value("going").Number().IsEqual(2)
+		attendance.Value("maybe").Number().IsEqual(1)
+		attendance.Value("notGoing").Number().IsEqual(1)
+		attendance.Value("waitlisted").Number().IsEqual(1)
+
 		res2 := res.Value(1).Object()
 
 		res2.Keys().ContainsOnly("id", "type", "name", "description", "location", "time")
@@ -118,7 +134,7 @@ func TestGetEvents(t *testing.T) {
 
 		res3 := res.Value(2).Object()
 		res3.Keys().
-			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd")
+			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "attendance")
 		res3.Value("id").Number().IsEqual(officialEvent.ID)
 		res3.Value("type").String().IsEqual(string(model.EventTypeOfficial))
 		res3.Value("name").String().IsEqual(officialEvent.Name)
@@ -183,6 +199,12 @@ func TestServer_PostEvent(t *testing.T) {
 		h.repo.MockCampRepository.EXPECT().
 			IsCampParticipant(gomock.Any(), campID, organizerID).
 			Return(true, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return(nil, nil)
 		h.repo.MockEventRepository.EXPECT().
 			CreateEvent(gomock.Any()).
 			DoAndReturn(func(event *model.Event) error {
@@ -205,7 +227,7 @@ func TestServer_PostEvent(t *testing.T) {
 			Object()
 
 		res.Keys().
-			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "organizerId", "displayColor")
+			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "organizerId", "displayColor", "attendance")
 		res.Value("id").Number().IsEqual(createdEvent.ID)
 		res.Value("type").String().IsEqual(string(model.EventTypeDuration))
 		res.Value("name").String().IsEqual(reqBody.Name)
@@ -298,6 +320,12 @@ func TestServer_PostEvent(t *testing.T) {
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), userID).
 			Return(&user, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return(nil, nil)
 		h.repo.MockEventRepository.EXPECT().
 			CreateEvent(gomock.Any()).
 			DoAndReturn(func(event *model.Event) error {
@@ -320,7 +348,7 @@ func TestServer_PostEvent(t *testing.T) {
 			Object()
 
 		res.Keys().
-			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd")
+			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "attendance")
 		res.Value("id").Number().IsEqual(createdEvent.ID)
 		res.Value("type").String().IsEqual(string(model.EventTypeOfficial))
 		res.Value("name").String().IsEqual(reqBody.Name)
@@ -417,9 +445,18 @@ func TestServer_PutEvent(t *testing.T) {
 		h.repo.MockCampRepository.EXPECT().
 			IsCampParticipant(gomock.Any(), campID, organizerID).
 			Return(true, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return(nil, nil)
 		h.repo.MockEventRepository.EXPECT().
 			UpdateEvent(gomock.Any(), eventID, gomock.Any()).
 			Return(nil)
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminders(gomock.Any(), eventID).
+			Return(nil, nil)
 
 		var eventRequest api.EventRequest
 
@@ -436,7 +473,7 @@ func TestServer_PutEvent(t *testing.T) {
 			Object()
 
 		res.Keys().
-			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "organizerId", "displayColor")
+			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "organizerId", "displayColor", "attendance")
 		res.Value("id").Number().IsEqual(existingEvent.ID)
 		res.Value("type").String().IsEqual(string(model.EventTypeDuration))
 		res.Value("name").String().IsEqual(reqBody.Name)
@@ -538,9 +575,18 @@ func TestServer_PutEvent(t *testing.T) {
 		h.repo.MockEventRepository.EXPECT().
 			GetEventByID(eventID).
 			Return(&existingEvent, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return(nil, nil)
 		h.repo.MockEventRepository.EXPECT().
 			UpdateEvent(gomock.Any(), eventID, gomock.Any()).
 			Return(nil)
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminders(gomock.Any(), eventID).
+			Return(nil, nil)
 
 		var eventRequest api.EventRequest
 
@@ -557,7 +603,7 @@ func TestServer_PutEvent(t *testing.T) {
 			Object()
 
 		res.Keys().
-			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd")
+			ContainsOnly("id", "type", "name", "description", "location", "timeStart", "timeEnd", "attendance")
 		res.Value("id").Number().IsEqual(existingEvent.ID)
 		res.Value("type").String().IsEqual(string(model.EventTypeOfficial))
 		res.Value("name").String().IsEqual(reqBody.Name)
@@ -608,4 +654,229 @@ func TestServer_PutEvent(t *testing.T) {
 			Expect().
 			Status(http.StatusForbidden)
 	})
+
+	t.Run("定員が無くなったときキャンセル待ちを繰り上げる", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		eventID := uint(random.PositiveInt(t))
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		timeStart := random.Time(t)
+		timeEnd := timeStart.Add(time.Duration(random.PositiveInt(t)))
+		capacity := 1
+		existingEvent := model.Event{
+			Model:    gorm.Model{ID: eventID},
+			Type:     model.EventTypeOfficial,
+			Name:     random.AlphaNumericString(t, 20),
+			CampID:   campID,
+			Capacity: &capacity,
+		}
+		attendances := []model.EventAttendance{
+			{
+				EventID: eventID,
+				UserID:  random.AlphaNumericString(t, 32),
+				Status:  model.EventAttendanceStatusGoing,
+			},
+			{
+				EventID:      eventID,
+				UserID:       random.AlphaNumericString(t, 32),
+				Status:       model.EventAttendanceStatusGoing,
+				IsWaitlisted: true,
+			},
+		}
+		reqBody := api.OfficialEventRequest{
+			Type:        api.OfficialEventRequestTypeOfficial,
+			Name:        existingEvent.Name,
+			Description: random.AlphaNumericString(t, 100),
+			Location:    random.AlphaNumericString(t, 50),
+			TimeStart:   timeStart,
+			TimeEnd:     timeEnd,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEventByID(eventID).
+			Return(&existingEvent, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEvents(gomock.Any(), campID).
+			Return(nil, nil)
+		gomock.InOrder(
+			h.repo.MockEventRepository.EXPECT().
+				UpdateEvent(gomock.Any(), eventID, gomock.Any()).
+				Return(nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				GetEventAttendances(gomock.Any(), eventID).
+				Return(attendances, nil),
+			h.repo.MockEventAttendanceRepository.EXPECT().
+				SaveEventAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, attendance *model.EventAttendance) error {
+					assert.Equal(t, attendances[1].UserID, attendance.UserID)
+					assert.False(t, attendance.IsWaitlisted)
+
+					return nil
+				}),
+		)
+		h.repo.MockEventReminderRepository.EXPECT().
+			GetEventReminders(gomock.Any(), eventID).
+			Return(nil, nil)
+
+		var eventRequest api.EventRequest
+
+		require.NoError(t, eventRequest.FromOfficialEventRequest(reqBody))
+
+		h.expect.PUT("/api/events/{eventId}", eventID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(eventRequest).
+			Expect().
+			Status(http.StatusOK)
+	})
+
+	t.Run("定員が1未満の場合は400を返す", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		eventID := uint(random.PositiveInt(t))
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		timeStart := random.Time(t)
+		timeEnd := timeStart.Add(time.Duration(random.PositiveInt(t)))
+		capacity := 0
+		existingEvent := model.Event{
+			Model:  gorm.Model{ID: eventID},
+			Type:   model.EventTypeOfficial,
+			Name:   random.AlphaNumericString(t, 20),
+			CampID: campID,
+		}
+		reqBody := api.OfficialEventRequest{
+			Type:      api.OfficialEventRequestTypeOfficial,
+			Name:      existingEvent.Name,
+			TimeStart: timeStart,
+			TimeEnd:   timeEnd,
+			Capacity:  &capacity,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEventByID(eventID).
+			Return(&existingEvent, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(campCovering(t, campID, timeStart, timeEnd), nil)
+
+		var eventRequest api.EventRequest
+
+		require.NoError(t, eventRequest.FromOfficialEventRequest(reqBody))
+
+		h.expect.PUT("/api/events/{eventId}", eventID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(eventRequest).
+			Expect().
+			Status(http.StatusBadRequest).
+			JSON().
+			Object().
+			Value("message").
+			String().
+			IsEqual("capacity must be at least 1")
+	})
+
+	t.Run("Event not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		eventID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEventByID(eventID).
+			Return(nil, repository.ErrEventNotFound)
+
+		var eventRequest api.EventRequest
+
+		require.NoError(t, eventRequest.FromOfficialEventRequest(api.OfficialEventRequest{
+			Type: api.OfficialEventRequestTypeOfficial,
+			Name: random.AlphaNumericString(t, 20),
+		}))
+
+		h.expect.PUT("/api/events/{eventId}", eventID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(eventRequest).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+// campCovering はtimeStartからtimeEndまでを期間に含む合宿を返す
+func campCovering(t *testing.T, campID uint, timeStart, timeEnd time.Time) *model.Camp {
+	t.Helper()
+
+	return &model.Camp{
+		Model:     gorm.Model{ID: campID},
+		DisplayID: random.AlphaNumericString(t, 10),
+		Name:      random.AlphaNumericString(t, 20),
+		DateStart: timeStart.AddDate(0, 0, -1),
+		DateEnd:   timeEnd.AddDate(0, 0, 1),
+	}
+}
+
+func TestServer_DeleteEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("リマインダーも削除する", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		event := model.Event{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:        model.EventTypeDuration,
+			OrganizerID: &userID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().GetEventByID(event.ID).Return(&event, nil)
+		gomock.InOrder(
+			h.repo.MockEventReminderRepository.EXPECT().
+				DeleteEventReminders(gomock.Any(), event.ID).
+				Return(nil),
+			h.repo.MockEventRepository.EXPECT().DeleteEvent(event.ID).Return(nil),
+		)
+
+		h.expect.DELETE("/api/events/{eventId}", event.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Event not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		eventID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetEventByID(eventID).
+			Return(nil, repository.ErrEventNotFound)
+
+		h.expect.DELETE("/api/events/{eventId}", eventID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
 }
@@ -0,0 +1,586 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+var (
+	errInvalidLineItemAmount = errors.New(
+		"discount amount must not be positive and other amounts except other must not be negative",
+	)
+	errIncompleteFeeRuleCondition = errors.New("questionId and optionId must be specified together")
+	errInvalidFeeRuleCondition    = errors.New("optionId must be an option of the question in the camp")
+	// 料金ルールは選んだ選択肢で判定するため、順位付けなど選ぶ以外の質問には使えない
+	errFeeRuleQuestionType = errors.New("questionId must be a single or multiple choice question")
+)
+
+// AdminGetFeeRules 料金ルールの一覧を取得（管理者用）
+func (s *Server) AdminGetFeeRules(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminGetFeeRulesParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	feeRules, err := s.repo.GetFeeRules(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get fee rules: %w", err))
+	}
+
+	res, err := converter.Convert[[]api.FeeRuleResponse](feeRules)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert fee rules to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminPostFeeRule 料金ルールを作成（管理者用）
+func (s *Server) AdminPostFeeRule(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminPostFeeRuleParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	var req api.AdminPostFeeRuleJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	feeRule, err := converter.Convert[model.FeeRule](req)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
+	}
+
+	feeRule.CampID = uint(campID)
+
+	if err := s.validateFeeRule(ctx, &feeRule); err != nil {
+		return err
+	}
+
+	if err := s.repo.CreateFeeRule(ctx, &feeRule); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to create fee rule: %w", err))
+	}
+
+	res, err := converter.Convert[api.FeeRuleResponse](feeRule)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusCreated, res)
+}
+
+// AdminPutFeeRule 料金ルールを更新（管理者用）
+func (s *Server) AdminPutFeeRule(
+	e echo.Context,
+	feeRuleID api.FeeRuleId,
+	params api.AdminPutFeeRuleParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPutFeeRuleJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	feeRule, err := converter.Convert[model.FeeRule](req)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
+	}
+
+	existingFeeRule, err := s.repo.GetFeeRuleByID(ctx, uint(feeRuleID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrFeeRuleNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Fee rule not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get fee rule: %w", err))
+	}
+
+	feeRule.CampID = existingFeeRule.CampID
+
+	if err := s.validateFeeRule(ctx, &feeRule); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateFeeRule(ctx, uint(feeRuleID), &feeRule); err != nil {
+		if errors.Is(err, repository.ErrFeeRuleNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Fee rule not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to update fee rule: %w", err))
+	}
+
+	updatedFeeRule, err := s.repo.GetFeeRuleByID(ctx, uint(feeRuleID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get fee rule: %w", err))
+	}
+
+	res, err := converter.Convert[api.FeeRuleResponse](updatedFeeRule)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminDeleteFeeRule 料金ルールを削除（管理者用）
+func (s *Server) AdminDeleteFeeRule(
+	e echo.Context,
+	feeRuleID api.FeeRuleId,
+	params api.AdminDeleteFeeRuleParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	if err := s.repo.DeleteFeeRule(ctx, uint(feeRuleID)); err != nil {
+		if errors.Is(err, repository.ErrFeeRuleNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Fee rule not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to delete fee rule: %w", err))
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// AdminPreviewFeeRules 料金ルールの適用結果をプレビュー（管理者用）
+func (s *Server) AdminPreviewFeeRules(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminPreviewFeeRulesParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	plans, err := buildFeeRulePlans(ctx, s.repo, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	res := make([]api.FeeRulePreviewResponse, len(plans))
+
+	for i, plan := range plans {
+		lineItems, err := converter.Convert[[]api.PaymentLineItemResponse](plan.lineItems)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to convert line items to response: %w", err))
+		}
+
+		res[i] = api.FeeRulePreviewResponse{
+			UserId:        plan.userID,
+			CurrentAmount: plan.currentAmount(),
+			NewAmount:     plan.newAmount(),
+			LineItems:     lineItems,
+		}
+
+		if plan.payment != nil {
+			paymentID := int(plan.payment.ID)
+			res[i].PaymentId = &paymentID
+		}
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminApplyFeeRules 料金ルールを適用（管理者用）
+func (s *Server) AdminApplyFeeRules(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminApplyFeeRulesParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	if err := s.ensureCampAcceptsPayments(ctx, uint(campID)); err != nil {
+		return err
+	}
+
+	var (
+		createdPayments []model.Payment
+		updatedPayments []model.Payment
+	)
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		plans, err := buildFeeRulePlans(ctx, tx, uint(campID))
+
+		if err != nil {
+			return err
+		}
+
+		for _, plan := range plans {
+			if !plan.needsApply() {
+				continue
+			}
+
+			if plan.payment == nil {
+				payment := model.Payment{
+					Amount:    plan.newAmount(),
+					UserID:    plan.userID,
+					CampID:    uint(campID),
+					LineItems: plan.lineItems,
+				}
+
+				if err := tx.CreatePayment(ctx, &payment); err != nil {
+					return fmt.Errorf("failed to create payment: %w", err)
+				}
+
+				if err := s.activityService.RecordPaymentCreated(ctx, tx, payment); err != nil {
+					return err
+				}
+
+				createdPayments = append(createdPayments, payment)
+
+				continue
+			}
+
+			if err := tx.ReplacePaymentLineItems(ctx, plan.payment.ID, plan.lineItems); err != nil {
+				return fmt.Errorf("failed to replace payment line items: %w", err)
+			}
+
+			payment, err := tx.GetPaymentByID(ctx, plan.payment.ID)
+
+			if err != nil {
+				return fmt.Errorf("failed to get payment: %w", err)
+			}
+
+			if payment.Amount != plan.payment.Amount {
+				if err := s.activityService.RecordPaymentAmountChanged(ctx, tx, *payment); err != nil {
+					return err
+				}
+			}
+
+			updatedPayments = append(updatedPayments, *payment)
+		}
+
+		return nil
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	for _, payment := range createdPayments {
+		s.eventBus.Publish(payment.CampID, eventbus.TopicPayment, eventbus.EventTypeCreated, payment.ID)
+	}
+
+	for _, payment := range updatedPayments {
+		s.eventBus.Publish(payment.CampID, eventbus.TopicPayment, eventbus.EventTypeUpdated, payment.ID)
+	}
+
+	if len(createdPayments) > 0 || len(updatedPayments) > 0 {
+		s.eventBus.Publish(uint(campID), eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+	}
+
+	res, err := converter.Convert[[]api.PaymentResponse](
+		slices.Concat(createdPayments, updatedPayments),
+	)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert payments to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// validateFeeRule は料金ルールの金額と適用条件を検証する
+func (s *Server) validateFeeRule(ctx context.Context, feeRule *model.FeeRule) error {
+	if !feeRule.Category.IsValidAmount(feeRule.Amount) {
+		return echo.NewHTTPError(http.StatusBadRequest, errInvalidLineItemAmount.Error())
+	}
+
+	if (feeRule.QuestionID == nil) != (feeRule.OptionID == nil) {
+		return echo.NewHTTPError(http.StatusBadRequest, errIncompleteFeeRuleCondition.Error())
+	}
+
+	if feeRule.QuestionID == nil {
+		return nil
+	}
+
+	questionCampID, err := s.repo.GetQuestionCampID(ctx, *feeRule.QuestionID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrQuestionNotFound) {
+			return echo.NewHTTPError(http.StatusBadRequest, errInvalidFeeRuleCondition.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp ID of question: %w", err))
+	}
+
+	if questionCampID != feeRule.CampID {
+		return echo.NewHTTPError(http.StatusBadRequest, errInvalidFeeRuleCondition.Error())
+	}
+
+	question, err := s.repo.GetQuestionByID(*feeRule.QuestionID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question: %w", err))
+	}
+
+	if question.Type != model.SingleChoiceQuestion &&
+		question.Type != model.MultipleChoiceQuestion {
+		return echo.NewHTTPError(http.StatusBadRequest, errFeeRuleQuestionType.Error())
+	}
+
+	if !slices.ContainsFunc(question.Options, func(option model.Option) bool {
+		return option.ID == *feeRule.OptionID
+	}) {
+		return echo.NewHTTPError(http.StatusBadRequest, errInvalidFeeRuleCondition.Error())
+	}
+
+	return nil
+}
+
+// feeRulePlan は料金ルールを参加者の回答に適用した結果
+type feeRulePlan struct {
+	userID string
+	// 支払い情報がまだない場合はnil
+	payment *model.Payment
+	// 手動で追加された明細と料金ルールから作られた明細を合わせた、適用後の明細
+	lineItems []model.PaymentLineItem
+}
+
+func (p *feeRulePlan) currentAmount() int {
+	if p.payment == nil {
+		return 0
+	}
+
+	return p.payment.Amount
+}
+
+// newAmount は適用後の金額を返す
+// 適用前も適用後も明細がない場合は、手動で設定された金額をそのまま使う
+func (p *feeRulePlan) newAmount() int {
+	if len(p.lineItems) == 0 && (p.payment == nil || len(p.payment.LineItems) == 0) {
+		return p.currentAmount()
+	}
+
+	payment := model.Payment{LineItems: p.lineItems}
+
+	return payment.LineItemsTotal()
+}
+
+// needsApply は適用によって明細が変わるかを返す
+func (p *feeRulePlan) needsApply() bool {
+	if p.payment == nil {
+		return len(p.lineItems) > 0
+	}
+
+	return !slices.EqualFunc(
+		p.payment.LineItems,
+		p.lineItems,
+		func(a, b model.PaymentLineItem) bool {
+			return a.Category == b.Category &&
+				a.Description == b.Description &&
+				a.Amount == b.Amount &&
+				equalUintPtr(a.FeeRuleID, b.FeeRuleID)
+		},
+	)
+}
+
+// buildFeeRulePlans は合宿の全ての参加者について料金ルールを適用した結果を求める
+func buildFeeRulePlans(
+	ctx context.Context,
+	repo repository.Repository,
+	campID uint,
+) ([]feeRulePlan, error) {
+	feeRules, err := repo.GetFeeRules(ctx, campID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee rules: %w", err)
+	}
+
+	participants, err := repo.GetCampParticipants(ctx, campID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get camp participants: %w", err)
+	}
+
+	payments, err := repo.GetPayments(ctx, campID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payments: %w", err)
+	}
+
+	paymentMap := make(map[string]*model.Payment, len(payments))
+
+	for i := range payments {
+		paymentMap[payments[i].UserID] = &payments[i]
+	}
+
+	answerMap := make(map[string][]model.Answer)
+	fetchedQuestionIDs := make(map[uint]struct{})
+
+	for _, feeRule := range feeRules {
+		if feeRule.QuestionID == nil {
+			continue
+		}
+
+		if _, ok := fetchedQuestionIDs[*feeRule.QuestionID]; ok {
+			continue
+		}
+
+		fetchedQuestionIDs[*feeRule.QuestionID] = struct{}{}
+
+		answers, err := repo.GetAnswers(ctx, repository.GetAnswersQuery{
+			QuestionID:            feeRule.QuestionID,
+			IncludePrivateAnswers: true,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get answers: %w", err)
+		}
+
+		for _, answer := range answers {
+			answerMap[answer.UserID] = append(answerMap[answer.UserID], answer)
+		}
+	}
+
+	plans := make([]feeRulePlan, len(participants))
+
+	for i, participant := range participants {
+		plan := feeRulePlan{
+			userID:    participant.ID,
+			payment:   paymentMap[participant.ID],
+			lineItems: []model.PaymentLineItem{},
+		}
+
+		if plan.payment != nil {
+			for _, lineItem := range plan.payment.LineItems {
+				if lineItem.FeeRuleID == nil {
+					plan.lineItems = append(plan.lineItems, lineItem)
+				}
+			}
+		}
+
+		for _, feeRule := range feeRules {
+			if feeRule.AppliesTo(answerMap[participant.ID]) {
+				plan.lineItems = append(plan.lineItems, feeRule.LineItem())
+			}
+		}
+
+		plans[i] = plan
+	}
+
+	return plans, nil
+}
+
+func equalUintPtr(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,714 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestAdminPostFeeRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		req := api.AdminPostFeeRuleJSONRequestBody{
+			Category:    api.PaymentLineItemCategoryLodging,
+			Description: random.AlphaNumericString(t, 20),
+			Amount:      random.PositiveInt(t),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			CreateFeeRule(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, feeRule *model.FeeRule) error {
+				feeRule.ID = uint(random.PositiveInt(t))
+
+				return nil
+			}).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/fee-rules", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("id", "campId", "category", "description", "amount")
+		res.Value("campId").Number().IsEqual(campID)
+		res.Value("category").String().IsEqual(string(req.Category))
+		res.Value("description").String().IsEqual(req.Description)
+		res.Value("amount").Number().IsEqual(req.Amount)
+	})
+
+	t.Run("Success with condition", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+		optionID := random.PositiveInt(t)
+		req := api.AdminPostFeeRuleJSONRequestBody{
+			Category:    api.PaymentLineItemCategoryBus,
+			Description: random.AlphaNumericString(t, 20),
+			Amount:      2000,
+			QuestionId:  &questionID,
+			OptionId:    &optionID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), uint(questionID)).
+			Return(uint(campID), nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.SingleChoiceQuestion,
+				Options: []model.Option{{Model: gorm.Model{ID: uint(optionID)}}},
+			}, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			CreateFeeRule(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/fee-rules", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.Value("questionId").Number().IsEqual(questionID)
+		res.Value("optionId").Number().IsEqual(optionID)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		t.Parallel()
+
+		questionID := random.PositiveInt(t)
+
+		testCases := []struct {
+			name string
+			req  api.AdminPostFeeRuleJSONRequestBody
+		}{
+			{
+				name: "Positive discount",
+				req: api.AdminPostFeeRuleJSONRequestBody{
+					Category: api.PaymentLineItemCategoryDiscount,
+					Amount:   random.PositiveInt(t),
+				},
+			},
+			{
+				name: "Negative lodging",
+				req: api.AdminPostFeeRuleJSONRequestBody{
+					Category: api.PaymentLineItemCategoryLodging,
+					Amount:   -random.PositiveInt(t),
+				},
+			},
+			{
+				name: "Question without option",
+				req: api.AdminPostFeeRuleJSONRequestBody{
+					Category:   api.PaymentLineItemCategoryBus,
+					Amount:     random.PositiveInt(t),
+					QuestionId: &questionID,
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				h := setup(t)
+				userID := random.AlphaNumericString(t, 32)
+				campID := random.PositiveInt(t)
+
+				h.repo.MockUserRepository.EXPECT().
+					GetOrCreateUser(gomock.Any(), userID).
+					Return(&model.User{ID: userID, IsStaff: true}, nil).
+					Times(1)
+				h.repo.MockCampRepository.EXPECT().
+					GetCampByID(gomock.Any(), uint(campID)).
+					Return(&model.Camp{}, nil).
+					Times(1)
+
+				h.expect.POST("/api/admin/camps/{campId}/fee-rules", campID).
+					WithJSON(tc.req).
+					WithHeader("X-Forwarded-User", userID).
+					Expect().
+					Status(http.StatusBadRequest)
+			})
+		}
+	})
+
+	t.Run("Question of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+		optionID := random.PositiveInt(t)
+		req := api.AdminPostFeeRuleJSONRequestBody{
+			Category:   api.PaymentLineItemCategoryBus,
+			Amount:     random.PositiveInt(t),
+			QuestionId: &questionID,
+			OptionId:   &optionID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), uint(questionID)).
+			Return(uint(campID)+1, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Ranking question", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		questionID := random.PositiveInt(t)
+		optionID := random.PositiveInt(t)
+		req := api.AdminPostFeeRuleJSONRequestBody{
+			Category:   api.PaymentLineItemCategoryBus,
+			Amount:     random.PositiveInt(t),
+			QuestionId: &questionID,
+			OptionId:   &optionID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionCampID(gomock.Any(), uint(questionID)).
+			Return(uint(campID), nil).
+			Times(1)
+		// 順位付けの回答は選んだ選択肢を持たないため、料金ルールを適用できない
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(uint(questionID)).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: uint(questionID)},
+				Type:    model.RankingQuestion,
+				Options: []model.Option{{Model: gorm.Model{ID: uint(optionID)}}},
+			}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules", campID).
+			WithJSON(api.AdminPostFeeRuleJSONRequestBody{Category: api.PaymentLineItemCategoryMeal}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules", random.PositiveInt(t)).
+			WithJSON(api.AdminPostFeeRuleJSONRequestBody{Category: api.PaymentLineItemCategoryMeal}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestAdminPutFeeRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		feeRule := model.FeeRule{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:   uint(random.PositiveInt(t)),
+			Category: model.PaymentLineItemCategoryMeal,
+			Amount:   random.PositiveInt(t),
+		}
+		req := api.AdminPutFeeRuleJSONRequestBody{
+			Category:    api.PaymentLineItemCategoryDiscount,
+			Description: random.AlphaNumericString(t, 20),
+			Amount:      -random.PositiveInt(t),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			GetFeeRuleByID(gomock.Any(), feeRule.ID).
+			Return(&feeRule, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			UpdateFeeRule(gomock.Any(), feeRule.ID, gomock.Any()).
+			Return(nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			GetFeeRuleByID(gomock.Any(), feeRule.ID).
+			Return(&model.FeeRule{
+				Model:       feeRule.Model,
+				CampID:      feeRule.CampID,
+				Category:    model.PaymentLineItemCategoryDiscount,
+				Description: req.Description,
+				Amount:      req.Amount,
+			}, nil).
+			Times(1)
+
+		res := h.expect.PUT("/api/admin/fee-rules/{feeRuleId}", feeRule.ID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Value("id").Number().IsEqual(feeRule.ID)
+		res.Value("category").String().IsEqual(string(req.Category))
+		res.Value("amount").Number().IsEqual(req.Amount)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		feeRuleID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			GetFeeRuleByID(gomock.Any(), uint(feeRuleID)).
+			Return(nil, repository.ErrFeeRuleNotFound).
+			Times(1)
+
+		h.expect.PUT("/api/admin/fee-rules/{feeRuleId}", feeRuleID).
+			WithJSON(api.AdminPutFeeRuleJSONRequestBody{Category: api.PaymentLineItemCategoryMeal}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestAdminDeleteFeeRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		feeRuleID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			DeleteFeeRule(gomock.Any(), uint(feeRuleID)).
+			Return(nil).
+			Times(1)
+
+		h.expect.DELETE("/api/admin/fee-rules/{feeRuleId}", feeRuleID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		feeRuleID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockFeeRuleRepository.EXPECT().
+			DeleteFeeRule(gomock.Any(), uint(feeRuleID)).
+			Return(repository.ErrFeeRuleNotFound).
+			Times(1)
+
+		h.expect.DELETE("/api/admin/fee-rules/{feeRuleId}", feeRuleID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+// feeRuleFixture は料金ルールのプレビューと適用のテストで使うデータ
+type feeRuleFixture struct {
+	campID         uint
+	lodgingRule    model.FeeRule
+	busRule        model.FeeRule
+	busQuestionID  uint
+	busOption      model.Option
+	busUser        model.User
+	busUserPayment model.Payment
+	newUser        model.User
+	upToDateUser   model.User
+	upToDatePay    model.Payment
+}
+
+// mockFeeRuleFixture は次の参加者について料金ルールを適用する状況を作る
+// busUser: バスに乗ると回答しており、手動の割引の明細がある支払い情報を持つ
+// newUser: 支払い情報がない
+// upToDateUser: 既に料金ルールが適用済み
+func mockFeeRuleFixture(t *testing.T, h *testHandler) feeRuleFixture {
+	t.Helper()
+
+	f := feeRuleFixture{
+		campID:        uint(random.PositiveInt(t)),
+		busQuestionID: uint(random.PositiveInt(t)),
+		busOption:     model.Option{Model: gorm.Model{ID: uint(random.PositiveInt(t))}},
+		busUser:       model.User{ID: random.AlphaNumericString(t, 32)},
+		newUser:       model.User{ID: random.AlphaNumericString(t, 32)},
+		upToDateUser:  model.User{ID: random.AlphaNumericString(t, 32)},
+	}
+
+	f.lodgingRule = model.FeeRule{
+		Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+		CampID:      f.campID,
+		Category:    model.PaymentLineItemCategoryLodging,
+		Description: "宿泊費",
+		Amount:      10000,
+	}
+	f.busRule = model.FeeRule{
+		Model:       gorm.Model{ID: f.lodgingRule.ID + 1},
+		CampID:      f.campID,
+		Category:    model.PaymentLineItemCategoryBus,
+		Description: "バス代",
+		Amount:      2000,
+		QuestionID:  &f.busQuestionID,
+		OptionID:    &f.busOption.ID,
+	}
+	f.busUserPayment = model.Payment{
+		Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+		Amount: -500,
+		UserID: f.busUser.ID,
+		CampID: f.campID,
+		LineItems: []model.PaymentLineItem{
+			{
+				ID:          uint(random.PositiveInt(t)),
+				Category:    model.PaymentLineItemCategoryDiscount,
+				Description: "早期割引",
+				Amount:      -500,
+			},
+		},
+	}
+	f.upToDatePay = model.Payment{
+		Model:     gorm.Model{ID: f.busUserPayment.ID + 1},
+		Amount:    10000,
+		UserID:    f.upToDateUser.ID,
+		CampID:    f.campID,
+		LineItems: []model.PaymentLineItem{f.lodgingRule.LineItem()},
+	}
+
+	h.repo.MockFeeRuleRepository.EXPECT().
+		GetFeeRules(gomock.Any(), f.campID).
+		Return([]model.FeeRule{f.lodgingRule, f.busRule}, nil).
+		Times(1)
+	h.repo.MockCampRepository.EXPECT().
+		GetCampParticipants(gomock.Any(), f.campID).
+		Return([]model.User{f.busUser, f.newUser, f.upToDateUser}, nil).
+		Times(1)
+	h.repo.MockPaymentRepository.EXPECT().
+		GetPayments(gomock.Any(), f.campID).
+		Return([]model.Payment{f.busUserPayment, f.upToDatePay}, nil).
+		Times(1)
+	h.repo.MockAnswerRepository.EXPECT().
+		GetAnswers(gomock.Any(), repository.GetAnswersQuery{
+			QuestionID:            &f.busQuestionID,
+			IncludePrivateAnswers: true,
+		}).
+		Return([]model.Answer{
+			{
+				QuestionID:      f.busQuestionID,
+				UserID:          f.busUser.ID,
+				Type:            model.SingleChoiceQuestion,
+				SelectedOptions: []model.Option{f.busOption},
+			},
+		}, nil).
+		Times(1)
+
+	return f
+}
+
+func TestAdminPreviewFeeRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+
+		f := mockFeeRuleFixture(t, h)
+
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), f.campID).
+			Return(&model.Camp{}, nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/fee-rules/preview", f.campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(3)
+
+		busUser := res.Value(0).Object()
+
+		busUser.Value("userId").String().IsEqual(f.busUser.ID)
+		busUser.Value("paymentId").Number().IsEqual(f.busUserPayment.ID)
+		busUser.Value("currentAmount").Number().IsEqual(-500)
+		busUser.Value("newAmount").Number().IsEqual(11500)
+
+		lineItems := busUser.Value("lineItems").Array()
+
+		lineItems.Length().IsEqual(3)
+		lineItems.Value(0).Object().Value("category").String().IsEqual("discount")
+		lineItems.Value(1).Object().Value("feeRuleId").Number().IsEqual(f.lodgingRule.ID)
+		lineItems.Value(2).Object().Value("feeRuleId").Number().IsEqual(f.busRule.ID)
+
+		newUser := res.Value(1).Object()
+
+		newUser.Value("userId").String().IsEqual(f.newUser.ID)
+		newUser.Keys().NotContainsAny("paymentId")
+		newUser.Value("currentAmount").Number().IsEqual(0)
+		newUser.Value("newAmount").Number().IsEqual(10000)
+
+		upToDateUser := res.Value(2).Object()
+
+		upToDateUser.Value("currentAmount").Number().IsEqual(10000)
+		upToDateUser.Value("newAmount").Number().IsEqual(10000)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules/preview", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestAdminApplyFeeRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+
+		f := mockFeeRuleFixture(t, h)
+
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), f.campID).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
+			Times(1)
+
+		appliedBusUserPayment := f.busUserPayment
+		appliedBusUserPayment.Amount = 11500
+		appliedBusUserPayment.LineItems = []model.PaymentLineItem{
+			f.busUserPayment.LineItems[0],
+			f.lodgingRule.LineItem(),
+			f.busRule.LineItem(),
+		}
+
+		h.repo.MockPaymentRepository.EXPECT().
+			ReplacePaymentLineItems(gomock.Any(), f.busUserPayment.ID, gomock.Len(3)).
+			Return(nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), f.busUserPayment.ID).
+			Return(&appliedBusUserPayment, nil).
+			Times(1)
+		h.activityService.EXPECT().
+			RecordPaymentAmountChanged(gomock.Any(), gomock.Any(), appliedBusUserPayment).
+			Return(nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			CreatePayment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, payment *model.Payment) error {
+				payment.ID = f.upToDatePay.ID + 1
+
+				return nil
+			}).
+			Times(1)
+		h.activityService.EXPECT().
+			RecordPaymentCreated(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/fee-rules/apply", f.campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		// 既に適用済みの参加者は含まれない
+		res.Length().IsEqual(2)
+
+		created := res.Value(0).Object()
+
+		created.Value("userId").String().IsEqual(f.newUser.ID)
+		created.Value("amount").Number().IsEqual(10000)
+		created.Value("amountPaid").Number().IsEqual(0)
+		created.Value("lineItems").Array().Length().IsEqual(1)
+
+		updated := res.Value(1).Object()
+
+		updated.Value("userId").String().IsEqual(f.busUser.ID)
+		updated.Value("amount").Number().IsEqual(11500)
+		updated.Value("lineItems").Array().Length().IsEqual(3)
+	})
+
+	t.Run("Camp not accepting payments", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		// アーカイブされた合宿の支払いは変更しない
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Status: model.CampStatusArchived}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules/apply", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/fee-rules/apply", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -0,0 +1,377 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// GetGuidebook 合宿のしおりを取得
+func (s *Server) GetGuidebook(e echo.Context, campID api.CampId) error {
+	camp, err := s.repo.GetCampByID(e.Request().Context(), uint(campID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, api.GuidebookResponse{
+		Content: resolveGuidebookImages(camp.Guidebook),
+		Toc:     guidebookHeadings(camp.Guidebook),
+	})
+}
+
+// AdminGetGuidebookRevisions しおりの版の一覧を取得（管理者用）
+func (s *Server) AdminGetGuidebookRevisions(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminGetGuidebookRevisionsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	revisions, err := s.repo.GetGuidebookRevisions(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get guidebook revisions: %w", err))
+	}
+
+	res, err := converter.Convert[[]api.GuidebookRevisionResponse](revisions)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert guidebook revisions to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminPostGuidebookRevision しおりの版を作成（管理者用）
+func (s *Server) AdminPostGuidebookRevision(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminPostGuidebookRevisionParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPostGuidebookRevisionJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	if err := s.validateGuidebookImages(ctx, uint(campID), req.Content); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	revision := model.GuidebookRevision{
+		CampID:    uint(campID),
+		Content:   req.Content,
+		AuthorID:  &user.ID,
+		PublishAt: req.PublishAt,
+	}
+	// 公開予定時刻を過ぎている場合は予約せずにすぐ公開する
+	publishNow := revision.PublishAt == nil || !revision.PublishAt.After(now)
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		if err := tx.CreateGuidebookRevision(ctx, &revision); err != nil {
+			return fmt.Errorf("failed to create guidebook revision: %w", err)
+		}
+
+		if !publishNow {
+			return nil
+		}
+
+		return s.publishGuidebookRevision(ctx, tx, &revision, now)
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	if publishNow {
+		s.eventBus.Publish(revision.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+	}
+
+	res, err := converter.Convert[api.GuidebookRevisionResponse](revision)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusCreated, res)
+}
+
+// AdminDeleteGuidebookRevision 公開していないしおりの版を削除（管理者用）
+func (s *Server) AdminDeleteGuidebookRevision(
+	e echo.Context,
+	guidebookRevisionID api.GuidebookRevisionId,
+	params api.AdminDeleteGuidebookRevisionParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	revision, err := s.repo.GetGuidebookRevisionByID(ctx, uint(guidebookRevisionID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrGuidebookRevisionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Guidebook revision not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get guidebook revision: %w", err))
+	}
+
+	// 公開したことがある版は履歴として残す
+	if revision.IsPublished() {
+		return echo.NewHTTPError(
+			http.StatusConflict,
+			"Guidebook revision has already been published",
+		)
+	}
+
+	if err := s.repo.DeleteGuidebookRevision(ctx, revision.ID); err != nil {
+		if errors.Is(err, repository.ErrGuidebookRevisionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Guidebook revision not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to delete guidebook revision: %w", err))
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// AdminGetGuidebookRevisionDiff しおりの版の差分を取得（管理者用）
+func (s *Server) AdminGetGuidebookRevisionDiff(
+	e echo.Context,
+	guidebookRevisionID api.GuidebookRevisionId,
+	params api.AdminGetGuidebookRevisionDiffParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	revision, err := s.repo.GetGuidebookRevisionByID(ctx, uint(guidebookRevisionID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrGuidebookRevisionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Guidebook revision not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get guidebook revision: %w", err))
+	}
+
+	var base *model.GuidebookRevision
+
+	if params.BaseRevisionId != nil {
+		base, err = s.repo.GetGuidebookRevisionByID(ctx, uint(*params.BaseRevisionId))
+
+		if err != nil && !errors.Is(err, repository.ErrGuidebookRevisionNotFound) {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to get base guidebook revision: %w", err))
+		}
+
+		// 他の合宿の版とは比較できない
+		if err != nil || base.CampID != revision.CampID {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid base revision")
+		}
+	} else {
+		base, err = s.repo.GetPreviousGuidebookRevision(ctx, revision)
+
+		if err != nil {
+			if !errors.Is(err, repository.ErrGuidebookRevisionNotFound) {
+				return echo.NewHTTPError(http.StatusInternalServerError).
+					SetInternal(fmt.Errorf("failed to get previous guidebook revision: %w", err))
+			}
+
+			// 最初の版は空のしおりと比較する
+			base = nil
+		}
+	}
+
+	res := api.GuidebookDiffResponse{
+		RevisionId: int(revision.ID),
+	}
+
+	var baseContent string
+
+	if base != nil {
+		baseRevisionID := int(base.ID)
+		res.BaseRevisionId = &baseRevisionID
+		baseContent = base.Content
+	}
+
+	res.Lines = diffGuidebookLines(baseContent, revision.Content)
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminPublishGuidebookRevision しおりの版をすぐに公開（管理者用）
+func (s *Server) AdminPublishGuidebookRevision(
+	e echo.Context,
+	guidebookRevisionID api.GuidebookRevisionId,
+	params api.AdminPublishGuidebookRevisionParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	revision, err := s.repo.GetGuidebookRevisionByID(ctx, uint(guidebookRevisionID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrGuidebookRevisionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Guidebook revision not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get guidebook revision: %w", err))
+	}
+
+	// 公開済みの版や、後に作成された版が公開済みの版は公開できない。
+	// 以前の版に戻す場合は同じ内容で新しい版を作成する
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		return s.publishGuidebookRevision(ctx, tx, revision, time.Now())
+	}); err != nil {
+		if errors.Is(err, repository.ErrGuidebookRevisionAlreadyPublished) {
+			return echo.NewHTTPError(
+				http.StatusConflict,
+				"Guidebook revision has already been published",
+			)
+		}
+
+		if errors.Is(err, repository.ErrGuidebookRevisionOutdated) {
+			return echo.NewHTTPError(
+				http.StatusConflict,
+				"A newer guidebook revision has already been published",
+			)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	s.eventBus.Publish(revision.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
+	res, err := converter.Convert[api.GuidebookRevisionResponse](*revision)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// publishGuidebookRevision は版を公開し、Activityに記録します。Transactionの中で呼び出してください
+func (s *Server) publishGuidebookRevision(
+	ctx context.Context,
+	tx repository.Repository,
+	revision *model.GuidebookRevision,
+	now time.Time,
+) error {
+	if err := tx.PublishGuidebookRevision(ctx, revision.ID, now); err != nil {
+		return fmt.Errorf("failed to publish guidebook revision: %w", err)
+	}
+
+	revision.PublishedAt = &now
+
+	return s.activityService.RecordGuidebookPublished(ctx, tx, *revision)
+}
+
+// validateGuidebookImages はしおりが合宿の画像だけを参照しているかを確認します
+func (s *Server) validateGuidebookImages(ctx context.Context, campID uint, content string) error {
+	imageIDs, ok := guidebookImageIDs(content)
+
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid image reference in guidebook")
+	}
+
+	if len(imageIDs) == 0 {
+		return nil
+	}
+
+	count, err := s.repo.CountCampImages(ctx, campID, imageIDs)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to count camp images: %w", err))
+	}
+
+	if count != len(imageIDs) {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			"Guidebook refers to an image that does not belong to this camp",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package router
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/traPtitech/rucQ/api"
+)
+
+// guidebookImagePattern はしおりの中の`![説明](image:{imageId})`の形の画像への参照
+var guidebookImagePattern = regexp.MustCompile(`(!\[[^\]]*\])\(image:(\d+)\)`)
+
+// resolveGuidebookImages は画像への参照を画像を取得するAPIのURLに置き換えます
+func resolveGuidebookImages(content string) string {
+	return guidebookImagePattern.ReplaceAllString(content, "$1(/api/images/$2)")
+}
+
+// guidebookImageIDs はしおりが参照している画像のIDを重複を除いて返します。
+// IDとして読めない参照がある場合はfalseを返します
+func guidebookImageIDs(content string) ([]uint, bool) {
+	var imageIDs []uint
+
+	for _, match := range guidebookImagePattern.FindAllStringSubmatch(content, -1) {
+		imageID, err := strconv.ParseUint(match[2], 10, 0)
+
+		if err != nil {
+			return nil, false
+		}
+
+		if !slices.Contains(imageIDs, uint(imageID)) {
+			imageIDs = append(imageIDs, uint(imageID))
+		}
+	}
+
+	return imageIDs, true
+}
+
+// guidebookHeadings はATX形式（`#`で始まる行）の見出しから目次を作ります。
+// コードブロックの中の行は見出しとして扱いません
+func guidebookHeadings(content string) []api.GuidebookHeading {
+	headings := []api.GuidebookHeading{}
+	anchorCounts := make(map[string]int)
+
+	// 開いているコードブロックの区切り文字。空の場合はコードブロックの外
+	var fence string
+
+	for line := range strings.SplitSeq(content, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		trimmed, ok := trimMarkdownIndent(line)
+
+		if !ok {
+			continue
+		}
+
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]+" \t") == "" {
+				fence = ""
+			}
+
+			continue
+		}
+
+		if marker := markdownFence(trimmed); marker != "" {
+			fence = marker
+
+			continue
+		}
+
+		level, title, ok := parseMarkdownHeading(trimmed)
+
+		if !ok {
+			continue
+		}
+
+		anchor := markdownAnchor(title)
+
+		// GitHubと同じく、重複するアンカーには-1、-2…を付ける
+		if count := anchorCounts[anchor]; count > 0 {
+			anchorCounts[anchor] = count + 1
+			anchor = fmt.Sprintf("%s-%d", anchor, count)
+		} else {
+			anchorCounts[anchor] = 1
+		}
+
+		headings = append(headings, api.GuidebookHeading{
+			Level:  level,
+			Title:  title,
+			Anchor: anchor,
+		})
+	}
+
+	return headings
+}
+
+// trimMarkdownIndent は3つまでの先頭の空白を取り除きます。
+// 4つ以上の場合はインデントされたコードブロックのためfalseを返します
+func trimMarkdownIndent(line string) (string, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+
+	if len(line)-len(trimmed) > 3 {
+		return "", false
+	}
+
+	return trimmed, true
+}
+
+// markdownFence はコードブロックを開始する行であれば区切り文字を返します
+func markdownFence(line string) string {
+	for _, char := range []string{"`", "~"} {
+		marker := strings.Repeat(char, 3)
+
+		if !strings.HasPrefix(line, marker) {
+			continue
+		}
+
+		// 閉じるときは開いたときと同じ長さ以上の区切り文字が必要
+		length := len(line) - len(strings.TrimLeft(line, char))
+
+		return strings.Repeat(char, length)
+	}
+
+	return ""
+}
+
+// parseMarkdownHeading は見出しの行であればレベルと見出しの文字列を返します
+func parseMarkdownHeading(line string) (int, string, bool) {
+	level := len(line) - len(strings.TrimLeft(line, "#"))
+
+	if level < 1 || level > 6 {
+		return 0, "", false
+	}
+
+	rest := line[level:]
+
+	// `#`の後には空白が必要
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return 0, "", false
+	}
+
+	title := strings.TrimSpace(rest)
+
+	// 末尾の閉じる`#`を取り除く
+	if closing := strings.TrimRight(title, "#"); closing == "" {
+		title = ""
+	} else if closing != title && strings.HasSuffix(closing, " ") {
+		title = strings.TrimSpace(closing)
+	}
+
+	if title == "" {
+		return 0, "", false
+	}
+
+	return level, title, true
+}
+
+// markdownAnchor は見出しの文字列からGitHubと同じ形式のアンカーを作ります
+func markdownAnchor(title string) string {
+	var sb strings.Builder
+
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsNumber(r), r == '-', r == '_':
+			sb.WriteRune(r)
+		case r == ' ':
+			sb.WriteRune('-')
+		}
+	}
+
+	return sb.String()
+}
+
+// guidebookDiffMaxCells は差分を求めるときの最長共通部分列の表の大きさの上限。
+// 超える場合は共通部分列を求めず、異なる範囲の行を全て削除と追加として扱う
+const guidebookDiffMaxCells = 1 << 20
+
+// diffGuidebookLines はしおりの2つの版を行ごとに比較します。
+// 最長共通部分列に含まれる行を変更なし、それ以外を追加または削除とします
+func diffGuidebookLines(base, target string) []api.GuidebookDiffLine {
+	baseLines := splitGuidebookLines(base)
+	targetLines := splitGuidebookLines(target)
+	lines := make([]api.GuidebookDiffLine, 0, len(baseLines)+len(targetLines))
+
+	// 先頭と末尾の共通する行は表に含めない
+	prefix := 0
+
+	for prefix < len(baseLines) && prefix < len(targetLines) &&
+		baseLines[prefix] == targetLines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+
+	for suffix < len(baseLines)-prefix && suffix < len(targetLines)-prefix &&
+		baseLines[len(baseLines)-1-suffix] == targetLines[len(targetLines)-1-suffix] {
+		suffix++
+	}
+
+	lines = appendGuidebookDiffLines(lines, api.Unchanged, baseLines[:prefix])
+	lines = append(lines, diffGuidebookLineRange(
+		baseLines[prefix:len(baseLines)-suffix],
+		targetLines[prefix:len(targetLines)-suffix],
+	)...)
+	lines = appendGuidebookDiffLines(lines, api.Unchanged, baseLines[len(baseLines)-suffix:])
+
+	return lines
+}
+
+// diffGuidebookLineRange は最長共通部分列を使って行の範囲を比較します
+func diffGuidebookLineRange(baseLines, targetLines []string) []api.GuidebookDiffLine {
+	lines := make([]api.GuidebookDiffLine, 0, len(baseLines)+len(targetLines))
+
+	if (len(baseLines)+1)*(len(targetLines)+1) > guidebookDiffMaxCells {
+		lines = appendGuidebookDiffLines(lines, api.Removed, baseLines)
+
+		return appendGuidebookDiffLines(lines, api.Added, targetLines)
+	}
+
+	// lcs[i][j]はbaseLines[i:]とtargetLines[j:]の最長共通部分列の長さ
+	lcs := make([][]int, len(baseLines)+1)
+
+	for i := range lcs {
+		lcs[i] = make([]int, len(targetLines)+1)
+	}
+
+	for i := len(baseLines) - 1; i >= 0; i-- {
+		for j := len(targetLines) - 1; j >= 0; j-- {
+			if baseLines[i] == targetLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+
+	for i < len(baseLines) || j < len(targetLines) {
+		switch {
+		case i < len(baseLines) && j < len(targetLines) && baseLines[i] == targetLines[j]:
+			lines = append(lines, api.GuidebookDiffLine{
+				Type:    api.Unchanged,
+				Content: baseLines[i],
+			})
+			i++
+			j++
+		// 置き換えた行は削除した行を先に並べる
+		case i < len(baseLines) && (j == len(targetLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, api.GuidebookDiffLine{
+				Type:    api.Removed,
+				Content: baseLines[i],
+			})
+			i++
+		default:
+			lines = append(lines, api.GuidebookDiffLine{
+				Type:    api.Added,
+				Content: targetLines[j],
+			})
+			j++
+		}
+	}
+
+	return lines
+}
+
+// appendGuidebookDiffLines は同じ種類の差分の行をまとめて追加します
+func appendGuidebookDiffLines(
+	lines []api.GuidebookDiffLine,
+	lineType api.GuidebookDiffLineType,
+	contents []string,
+) []api.GuidebookDiffLine {
+	for _, content := range contents {
+		lines = append(lines, api.GuidebookDiffLine{
+			Type:    lineType,
+			Content: content,
+		})
+	}
+
+	return lines
+}
+
+// splitGuidebookLines はしおりを行に分けます。空のしおりは0行として扱います
+func splitGuidebookLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
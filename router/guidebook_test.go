@@ -0,0 +1,648 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_GetGuidebook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		guidebook := "# 持ち物 #\n" +
+			"![地図](image:12)\n" +
+			"## 着替え\n" +
+			"```\n" +
+			"# コードブロックの中\n" +
+			"```\n" +
+			"  ## Day 1: 集合\n" +
+			"## 着替え\n" +
+			"#見出しではない\n" +
+			"    # インデントされたコード\n"
+
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{
+				Model:     gorm.Model{ID: uint(campID)},
+				Guidebook: guidebook,
+			}, nil)
+
+		res := h.expect.GET("/api/camps/{campId}/guidebook", campID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Value("content").String().Contains("![地図](/api/images/12)")
+		res.Value("content").String().NotContains("image:12")
+
+		toc := res.Value("toc").Array()
+
+		toc.Length().IsEqual(4)
+		toc.Value(0).Object().IsEqual(map[string]any{
+			"level": 1, "title": "持ち物", "anchor": "持ち物",
+		})
+		toc.Value(1).Object().IsEqual(map[string]any{
+			"level": 2, "title": "着替え", "anchor": "着替え",
+		})
+		toc.Value(2).Object().IsEqual(map[string]any{
+			"level": 2, "title": "Day 1: 集合", "anchor": "day-1-集合",
+		})
+		toc.Value(3).Object().IsEqual(map[string]any{
+			"level": 2, "title": "着替え", "anchor": "着替え-1",
+		})
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound)
+
+		h.expect.GET("/api/camps/{campId}/guidebook", campID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_AdminGetGuidebookRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		publishedAt := random.Time(t)
+		revisions := []model.GuidebookRevision{
+			{
+				Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+				CampID:      uint(campID),
+				Content:     random.AlphaNumericString(t, 100),
+				PublishedAt: &publishedAt,
+			},
+			{
+				Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+				CampID:   uint(campID),
+				Content:  random.AlphaNumericString(t, 100),
+				AuthorID: &adminUserID,
+			},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisions(gomock.Any(), uint(campID)).
+			Return(revisions, nil)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(len(revisions))
+
+		// 移行した版には作成者がいない
+		first := res.Value(0).Object()
+
+		first.HasValue("id", revisions[0].ID)
+		first.HasValue("campId", campID)
+		first.HasValue("content", revisions[0].Content)
+		first.NotContainsKey("author")
+		first.Value("publishedAt").String().AsDateTime(time.RFC3339).InRange(
+			publishedAt.Add(-time.Second),
+			publishedAt.Add(time.Second),
+		)
+
+		second := res.Value(1).Object()
+
+		second.HasValue("author", adminUserID)
+		second.NotContainsKey("publishedAt")
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.GET("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminPostGuidebookRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Publish now", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		revisionID := uint(random.PositiveInt(t))
+		content := random.AlphaNumericString(t, 100)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			CreateGuidebookRevision(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, revision *model.GuidebookRevision) error {
+				assert.Equal(t, uint(campID), revision.CampID)
+				assert.Equal(t, content, revision.Content)
+				assert.Equal(t, &adminUserID, revision.AuthorID)
+				revision.ID = revisionID
+				return nil
+			})
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			PublishGuidebookRevision(gomock.Any(), revisionID, gomock.Any()).
+			Return(nil)
+		h.activityService.EXPECT().
+			RecordGuidebookPublished(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ repository.Repository, revision model.GuidebookRevision) error {
+				assert.Equal(t, revisionID, revision.ID)
+				return nil
+			})
+
+		res := h.expect.POST("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(api.GuidebookRevisionRequest{Content: content}).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.HasValue("id", revisionID)
+		res.HasValue("author", adminUserID)
+		res.ContainsKey("publishedAt")
+	})
+
+	t.Run("Schedule", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+		// 公開予定時刻までは公開しない
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			CreateGuidebookRevision(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(api.GuidebookRevisionRequest{
+				Content:   random.AlphaNumericString(t, 100),
+				PublishAt: &publishAt,
+			}).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.Value("publishAt").String().AsDateTime(time.RFC3339).IsEqual(publishAt)
+		res.NotContainsKey("publishedAt")
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound)
+
+		h.expect.POST("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(api.GuidebookRevisionRequest{Content: random.AlphaNumericString(t, 100)}).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.GuidebookRevisionRequest{Content: random.AlphaNumericString(t, 100)}).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminPostGuidebookRevision_Images(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Image of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		imageID := uint(random.PositiveInt(t))
+		content := fmt.Sprintf("![map](image:%d)\n![map again](image:%d)", imageID, imageID)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+		h.repo.MockImageRepository.EXPECT().
+			CountCampImages(gomock.Any(), uint(campID), []uint{imageID}).
+			Return(0, nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(api.GuidebookRevisionRequest{Content: content}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Invalid image reference", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/guidebook/revisions", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			WithJSON(api.GuidebookRevisionRequest{
+				Content: "![map](image:99999999999999999999999)",
+			}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+}
+
+func TestDiffGuidebookLines(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Too large", func(t *testing.T) {
+		t.Parallel()
+
+		// 表が大きすぎる場合は異なる範囲を全て削除と追加として扱う
+		baseLines := []string{"# title"}
+		targetLines := []string{"# title"}
+
+		for i := range 1100 {
+			baseLines = append(baseLines, fmt.Sprintf("base %d", i))
+			targetLines = append(targetLines, fmt.Sprintf("target %d", i))
+		}
+
+		baseLines = append(baseLines, "end")
+		targetLines = append(targetLines, "end")
+
+		lines := diffGuidebookLines(
+			strings.Join(baseLines, "\n"),
+			strings.Join(targetLines, "\n"),
+		)
+
+		require.Len(t, lines, 2202)
+		assert.Equal(t, api.GuidebookDiffLine{Type: api.Unchanged, Content: "# title"}, lines[0])
+		assert.Equal(t, api.GuidebookDiffLine{Type: api.Removed, Content: "base 0"}, lines[1])
+		assert.Equal(t, api.GuidebookDiffLine{Type: api.Added, Content: "target 0"}, lines[1101])
+		assert.Equal(t, api.GuidebookDiffLine{Type: api.Unchanged, Content: "end"}, lines[2201])
+	})
+}
+
+func TestServer_AdminDeleteGuidebookRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		revisionID := uint(random.PositiveInt(t))
+		adminUserID := random.AlphaNumericString(t, 32)
+		publishAt := time.Now().Add(time.Hour)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revisionID).
+			Return(&model.GuidebookRevision{
+				Model:     gorm.Model{ID: revisionID},
+				PublishAt: &publishAt,
+			}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			DeleteGuidebookRevision(gomock.Any(), revisionID).
+			Return(nil)
+
+		h.expect.DELETE("/api/admin/guidebook-revisions/{guidebookRevisionId}", revisionID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Already published", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		revisionID := uint(random.PositiveInt(t))
+		adminUserID := random.AlphaNumericString(t, 32)
+		publishedAt := random.Time(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revisionID).
+			Return(&model.GuidebookRevision{
+				Model:       gorm.Model{ID: revisionID},
+				PublishedAt: &publishedAt,
+			}, nil)
+
+		h.expect.DELETE("/api/admin/guidebook-revisions/{guidebookRevisionId}", revisionID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		revisionID := uint(random.PositiveInt(t))
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revisionID).
+			Return(nil, repository.ErrGuidebookRevisionNotFound)
+
+		h.expect.DELETE("/api/admin/guidebook-revisions/{guidebookRevisionId}", revisionID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_AdminGetGuidebookRevisionDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Previous revision", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := uint(random.PositiveInt(t))
+		adminUserID := random.AlphaNumericString(t, 32)
+		revision := model.GuidebookRevision{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:  campID,
+			Content: "# 持ち物\n着替え\n洗面用具\n",
+		}
+		previous := model.GuidebookRevision{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:  campID,
+			Content: "# 持ち物\nタオル\n洗面用具\n",
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetPreviousGuidebookRevision(gomock.Any(), gomock.Any()).
+			Return(&previous, nil)
+
+		res := h.expect.GET("/api/admin/guidebook-revisions/{guidebookRevisionId}/diff", revision.ID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.HasValue("revisionId", revision.ID)
+		res.HasValue("baseRevisionId", previous.ID)
+		res.Value("lines").IsEqual([]map[string]any{
+			{"type": api.Unchanged, "content": "# 持ち物"},
+			{"type": api.Removed, "content": "タオル"},
+			{"type": api.Added, "content": "着替え"},
+			{"type": api.Unchanged, "content": "洗面用具"},
+		})
+	})
+
+	t.Run("First revision", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		revision := model.GuidebookRevision{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:  uint(random.PositiveInt(t)),
+			Content: "# 持ち物",
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetPreviousGuidebookRevision(gomock.Any(), gomock.Any()).
+			Return(nil, repository.ErrGuidebookRevisionNotFound)
+
+		res := h.expect.GET("/api/admin/guidebook-revisions/{guidebookRevisionId}/diff", revision.ID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.NotContainsKey("baseRevisionId")
+		res.Value("lines").IsEqual([]map[string]any{
+			{"type": api.Added, "content": "# 持ち物"},
+		})
+	})
+
+	t.Run("Base revision of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		campID := uint(random.PositiveInt(t))
+		revision := model.GuidebookRevision{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID: campID,
+		}
+		base := model.GuidebookRevision{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID: campID + 1,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), base.ID).
+			Return(&base, nil)
+
+		h.expect.GET("/api/admin/guidebook-revisions/{guidebookRevisionId}/diff", revision.ID).
+			WithQuery("baseRevisionId", base.ID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+}
+
+func TestServer_AdminPublishGuidebookRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		publishAt := time.Now().Add(time.Hour)
+		revision := model.GuidebookRevision{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:    uint(random.PositiveInt(t)),
+			Content:   random.AlphaNumericString(t, 100),
+			PublishAt: &publishAt,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			PublishGuidebookRevision(gomock.Any(), revision.ID, gomock.Any()).
+			Return(nil)
+		h.activityService.EXPECT().
+			RecordGuidebookPublished(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		h.expect.POST("/api/admin/guidebook-revisions/{guidebookRevisionId}/publish", revision.ID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ContainsKey("publishedAt")
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		revisionID := uint(random.PositiveInt(t))
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revisionID).
+			Return(nil, repository.ErrGuidebookRevisionNotFound)
+
+		h.expect.POST("/api/admin/guidebook-revisions/{guidebookRevisionId}/publish", revisionID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Outdated", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		revision := model.GuidebookRevision{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:  uint(random.PositiveInt(t)),
+			Content: random.AlphaNumericString(t, 100),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisionByID(gomock.Any(), revision.ID).
+			Return(&revision, nil)
+		h.repo.MockGuidebookRevisionRepository.EXPECT().
+			PublishGuidebookRevision(gomock.Any(), revision.ID, gomock.Any()).
+			Return(repository.ErrGuidebookRevisionOutdated)
+
+		h.expect.POST("/api/admin/guidebook-revisions/{guidebookRevisionId}/publish", revision.ID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusConflict)
+	})
+}
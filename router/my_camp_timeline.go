@@ -0,0 +1,179 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// myCampRecentActivityLimit は自分の合宿のタイムラインに含める最近のアクティビティの件数
+const myCampRecentActivityLimit = 20
+
+// GetMyCampTimeline 自分の合宿の予定とやることをまとめて取得
+// (GET /api/camps/{campId}/me/timeline)
+func (s *Server) GetMyCampTimeline(
+	e echo.Context,
+	campID api.CampId,
+	params api.GetMyCampTimelineParams,
+) error {
+	ctx := e.Request().Context()
+	userID := *params.XForwardedUser
+
+	isParticipant, err := s.repo.IsCampParticipant(ctx, uint(campID), userID)
+
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to check camp participation: %w", err))
+	}
+
+	if !isParticipant {
+		return echo.NewHTTPError(http.StatusNotFound, "User is not a participant of this camp")
+	}
+
+	res := api.MyCampTimelineResponse{
+		Id: userID,
+	}
+
+	room, err := s.repo.GetRoomByUserID(ctx, uint(campID), userID)
+
+	if err != nil && !errors.Is(err, repository.ErrRoomNotFound) {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get room: %w", err))
+	}
+
+	// 同室のメンバーは部屋のmembersに含まれる
+	if room != nil {
+		apiRoom, err := converter.Convert[api.RoomResponse](room)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to convert room: %w", err))
+		}
+
+		res.Room = &apiRoom
+	}
+
+	payment, err := s.repo.GetPaymentByUserID(ctx, uint(campID), userID)
+
+	if err != nil && !errors.Is(err, repository.ErrPaymentNotFound) {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment: %w", err))
+	}
+
+	if payment != nil {
+		apiPayment, err := converter.Convert[api.PaymentResponse](payment)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to convert payment: %w", err))
+		}
+
+		balance := apiPayment.Amount - apiPayment.AmountPaid
+		res.Payment = &apiPayment
+		res.PaymentBalance = &balance
+	}
+
+	questionGroups, err := s.repo.GetUnansweredRequiredQuestionGroups(ctx, uint(campID), userID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get unanswered question groups: %w", err))
+	}
+
+	res.PendingQuestionGroups = pendingQuestionGroups(questionGroups)
+
+	rollCalls, err := s.repo.GetPendingRollCalls(ctx, uint(campID), userID, time.Now())
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get pending roll calls: %w", err))
+	}
+
+	res.PendingRollCalls = pendingRollCalls(rollCalls)
+
+	events, err := s.repo.GetUserEvents(ctx, uint(campID), userID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get events: %w", err))
+	}
+
+	res.Events, err = converter.Convert[[]api.EventResponse](events)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert events: %w", err))
+	}
+
+	activities, err := s.activityService.GetRecentActivities(
+		ctx,
+		uint(campID),
+		userID,
+		myCampRecentActivityLimit,
+	)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get activities: %w", err))
+	}
+
+	res.RecentActivities, err = converter.Convert[[]api.ActivityResponse](activities)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert activities: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, &res)
+}
+
+func pendingQuestionGroups(questionGroups []model.QuestionGroup) []api.PendingQuestionGroup {
+	res := make([]api.PendingQuestionGroup, len(questionGroups))
+
+	for i, questionGroup := range questionGroups {
+		questions := make([]api.PendingQuestion, len(questionGroup.Questions))
+
+		for j, question := range questionGroup.Questions {
+			questions[j] = api.PendingQuestion{
+				Id:    int(question.ID),
+				Title: question.Title,
+			}
+		}
+
+		res[i] = api.PendingQuestionGroup{
+			Id:        int(questionGroup.ID),
+			Name:      questionGroup.Name,
+			Due:       types.Date{Time: questionGroup.Due},
+			Questions: questions,
+		}
+	}
+
+	return res
+}
+
+func pendingRollCalls(rollCalls []model.RollCall) []api.PendingRollCall {
+	res := make([]api.PendingRollCall, len(rollCalls))
+
+	for i, rollCall := range rollCalls {
+		res[i] = api.PendingRollCall{
+			Id:       int(rollCall.ID),
+			Name:     rollCall.Name,
+			Deadline: rollCall.Deadline,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,255 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	activityservice "github.com/traPtitech/rucQ/service/activity"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_GetMyCampTimeline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+		roommateID := random.AlphaNumericString(t, 32)
+		room := &model.Room{
+			Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:  random.AlphaNumericString(t, 20),
+			Members: []model.User{
+				{ID: userID},
+				{ID: roommateID},
+			},
+		}
+		payment := &model.Payment{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			Amount:     10000,
+			AmountPaid: 3000,
+			UserID:     userID,
+			CampID:     uint(campID),
+		}
+		due := time.Date(2026, 8, 31, 0, 0, 0, 0, time.UTC)
+		questionGroup := model.QuestionGroup{
+			Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:  random.AlphaNumericString(t, 20),
+			Due:   due,
+			Questions: []model.Question{
+				{
+					Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+					Title:      random.AlphaNumericString(t, 20),
+					IsRequired: true,
+				},
+			},
+		}
+		deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:     random.AlphaNumericString(t, 20),
+			Deadline: &deadline,
+		}
+		timeEnd := random.Time(t)
+		event := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeOfficial,
+			Name:      random.AlphaNumericString(t, 20),
+			TimeStart: timeEnd.Add(-time.Hour),
+			TimeEnd:   &timeEnd,
+		}
+		// 最近のアクティビティは20件まで返す
+		activities := make([]activityservice.ActivityResponse, myCampRecentActivityLimit)
+
+		for i := range activities {
+			activities[i] = activityservice.ActivityResponse{
+				ID:          uint(i + 1),
+				Type:        model.ActivityTypeRoomCreated,
+				Time:        random.Time(t),
+				RoomCreated: &activityservice.RoomCreatedDetail{},
+			}
+		}
+
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(true, nil)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomByUserID(gomock.Any(), uint(campID), userID).
+			Return(room, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByUserID(gomock.Any(), uint(campID), userID).
+			Return(payment, nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetUnansweredRequiredQuestionGroups(gomock.Any(), uint(campID), userID).
+			Return([]model.QuestionGroup{questionGroup}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetPendingRollCalls(gomock.Any(), uint(campID), userID, gomock.Any()).
+			Return([]model.RollCall{rollCall}, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetUserEvents(gomock.Any(), uint(campID), userID).
+			Return([]model.Event{event}, nil)
+		h.activityService.EXPECT().
+			GetRecentActivities(gomock.Any(), uint(campID), userID, myCampRecentActivityLimit).
+			Return(activities, nil)
+
+		res := h.expect.GET("/api/camps/{campId}/me/timeline", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.HasValue("id", userID)
+		res.Value("room").Object().HasValue("id", room.ID)
+		res.Value("room").Object().Value("members").Array().Length().IsEqual(2)
+		res.Value("payment").Object().HasValue("id", payment.ID)
+		res.HasValue("paymentBalance", 7000)
+
+		pendingQuestionGroups := res.Value("pendingQuestionGroups").Array()
+
+		pendingQuestionGroups.Length().IsEqual(1)
+		pendingQuestionGroups.Value(0).Object().IsEqual(map[string]any{
+			"id":   questionGroup.ID,
+			"name": questionGroup.Name,
+			"due":  "2026-08-31",
+			"questions": []map[string]any{
+				{"id": questionGroup.Questions[0].ID, "title": questionGroup.Questions[0].Title},
+			},
+		})
+
+		pendingRollCalls := res.Value("pendingRollCalls").Array()
+
+		pendingRollCalls.Length().IsEqual(1)
+		pendingRollCalls.Value(0).Object().HasValue("id", rollCall.ID)
+		pendingRollCalls.Value(0).Object().HasValue("name", rollCall.Name)
+		pendingRollCalls.Value(0).Object().
+			Value("deadline").String().AsDateTime(time.RFC3339).IsEqual(deadline)
+
+		events := res.Value("events").Array()
+
+		events.Length().IsEqual(1)
+		events.Value(0).Object().HasValue("id", event.ID)
+		events.Value(0).Object().HasValue("type", "official")
+
+		recentActivities := res.Value("recentActivities").Array()
+
+		recentActivities.Length().IsEqual(20)
+		recentActivities.Value(0).Object().HasValue("id", 1)
+	})
+
+	t.Run("Success - Nothing to do", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(true, nil)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomByUserID(gomock.Any(), uint(campID), userID).
+			Return(nil, repository.ErrRoomNotFound)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByUserID(gomock.Any(), uint(campID), userID).
+			Return(nil, repository.ErrPaymentNotFound)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetUnansweredRequiredQuestionGroups(gomock.Any(), uint(campID), userID).
+			Return(nil, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetPendingRollCalls(gomock.Any(), uint(campID), userID, gomock.Any()).
+			Return(nil, nil)
+		h.repo.MockEventRepository.EXPECT().
+			GetUserEvents(gomock.Any(), uint(campID), userID).
+			Return(nil, nil)
+		h.activityService.EXPECT().
+			GetRecentActivities(gomock.Any(), uint(campID), userID, myCampRecentActivityLimit).
+			Return([]activityservice.ActivityResponse{}, nil)
+
+		res := h.expect.GET("/api/camps/{campId}/me/timeline", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly(
+			"id", "pendingQuestionGroups", "pendingRollCalls", "events", "recentActivities")
+		res.Value("pendingQuestionGroups").Array().IsEmpty()
+		res.Value("pendingRollCalls").Array().IsEmpty()
+		res.Value("events").Array().IsEmpty()
+		res.Value("recentActivities").Array().IsEmpty()
+	})
+
+	t.Run("Not participant", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(false, nil)
+
+		h.expect.GET("/api/camps/{campId}/me/timeline", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(false, model.ErrNotFound)
+
+		h.expect.GET("/api/camps/{campId}/me/timeline", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			HasValue("message", "Camp not found")
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockCampRepository.EXPECT().
+			IsCampParticipant(gomock.Any(), uint(campID), userID).
+			Return(true, nil)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomByUserID(gomock.Any(), uint(campID), userID).
+			Return(nil, repository.ErrRoomNotFound)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByUserID(gomock.Any(), uint(campID), userID).
+			Return(nil, repository.ErrPaymentNotFound)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetUnansweredRequiredQuestionGroups(gomock.Any(), uint(campID), userID).
+			Return(nil, errors.New("database error"))
+
+		h.expect.GET("/api/camps/{campId}/me/timeline", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusInternalServerError)
+	})
+}
@@ -0,0 +1,294 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/service/reconciliation"
+)
+
+var errBankTransferAlreadyRecorded = errors.New("bank transfer is already recorded")
+
+// AdminPostPaymentReconciliation 銀行の入出金明細と支払い情報を照合（管理者用）
+func (s *Server) AdminPostPaymentReconciliation(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminPostPaymentReconciliationParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPostPaymentReconciliationJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	mapping := reconciliation.ColumnMapping{
+		Date:   req.DateColumn,
+		Amount: req.AmountColumn,
+		Name:   req.NameColumn,
+		Memo:   req.MemoColumn,
+	}
+
+	if req.HeaderRows != nil {
+		mapping.HeaderRows = *req.HeaderRows
+	}
+
+	proposals, err := s.reconciliationService.ProposeMatches(
+		ctx,
+		uint(campID),
+		strings.NewReader(req.Statement),
+		mapping,
+	)
+
+	if err != nil {
+		if errors.Is(err, reconciliation.ErrInvalidColumnMapping) ||
+			errors.Is(err, reconciliation.ErrInvalidStatement) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to propose matches: %w", err))
+	}
+
+	res := make([]api.PaymentReconciliationProposalResponse, len(proposals))
+
+	for i, proposal := range proposals {
+		res[i] = reconciliationProposalToSchema(proposal)
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminConfirmPaymentReconciliation 照合した銀行振込を入金として記録（管理者用）
+func (s *Server) AdminConfirmPaymentReconciliation(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminConfirmPaymentReconciliationParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminConfirmPaymentReconciliationJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if len(req) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "at least one match must be specified")
+	}
+
+	for _, match := range req {
+		if match.Amount <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "amount must be positive")
+		}
+	}
+
+	if err := s.ensureCampAcceptsPayments(ctx, uint(campID)); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	transactions := make([]model.PaymentTransaction, len(req))
+
+	for i, match := range req {
+		payment, err := s.repo.GetPaymentByID(ctx, uint(match.PaymentId))
+
+		if err != nil && !errors.Is(err, repository.ErrPaymentNotFound) {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to get payment: %w", err))
+		}
+
+		if err != nil || payment.CampID != uint(campID) {
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				fmt.Sprintf("payment %d does not belong to the camp", match.PaymentId),
+			)
+		}
+
+		transactions[i] = model.PaymentTransaction{
+			PaymentID:    payment.ID,
+			Amount:       match.Amount,
+			Method:       model.PaymentMethodBankTransfer,
+			ReceivedByID: &user.ID,
+			ReceivedAt:   now,
+			Memo:         "銀行の入出金明細との照合",
+		}
+
+		// 明細の日付で記録しないと、同じ明細を読み込み直したときに記録済みと判定できない
+		if match.ReceivedAt != nil {
+			transactions[i].ReceivedAt = *match.ReceivedAt
+		} else if match.Date != nil {
+			year, month, day := match.Date.Date()
+			transactions[i].ReceivedAt = time.Date(year, month, day, 0, 0, 0, 0, model.JST)
+		}
+
+		if match.Memo != nil {
+			transactions[i].Memo = *match.Memo
+		}
+	}
+
+	// 同じ支払いに複数の入金があった場合は最後の状態を返す
+	var updatedPayments []model.Payment
+
+	updatedIndexes := make(map[uint]int, len(transactions))
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		for i := range transactions {
+			if err := tx.CreatePaymentTransaction(ctx, &transactions[i]); err != nil {
+				return fmt.Errorf("failed to create payment transaction: %w", err)
+			}
+
+			// 記録すると支払いの行がロックされるため、その後に確認すれば
+			// 同じ明細を同時に確定しても二重に記録されない
+			if err := checkBankTransferNotRecorded(ctx, tx, &transactions[i]); err != nil {
+				return err
+			}
+
+			payment, err := tx.GetPaymentByID(ctx, transactions[i].PaymentID)
+
+			if err != nil {
+				return fmt.Errorf("failed to get payment: %w", err)
+			}
+
+			if err := s.activityService.RecordPaymentPaidChanged(ctx, tx, *payment); err != nil {
+				return err
+			}
+
+			if index, ok := updatedIndexes[payment.ID]; ok {
+				updatedPayments[index] = *payment
+			} else {
+				updatedIndexes[payment.ID] = len(updatedPayments)
+				updatedPayments = append(updatedPayments, *payment)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, errBankTransferAlreadyRecorded) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	for _, payment := range updatedPayments {
+		s.eventBus.Publish(
+			payment.CampID,
+			eventbus.TopicPayment,
+			eventbus.EventTypeUpdated,
+			payment.ID,
+		)
+	}
+
+	s.eventBus.Publish(uint(campID), eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
+	res, err := converter.Convert[[]api.PaymentResponse](updatedPayments)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert payments to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// checkBankTransferNotRecorded は記録した銀行振込と同じ日付と金額の銀行振込が
+// その支払いに他に記録されていないかを確認する
+func checkBankTransferNotRecorded(
+	ctx context.Context,
+	tx repository.Repository,
+	transaction *model.PaymentTransaction,
+) error {
+	recorded, err := tx.GetPaymentTransactions(ctx, transaction.PaymentID)
+
+	if err != nil {
+		return fmt.Errorf("failed to get payment transactions: %w", err)
+	}
+
+	for _, other := range recorded {
+		if other.ID == transaction.ID {
+			continue
+		}
+
+		if other.IsBankTransferOn(transaction.Amount, transaction.ReceivedAt) {
+			return fmt.Errorf(
+				"%w: payment %d already has a bank transfer of %d on %s",
+				errBankTransferAlreadyRecorded,
+				transaction.PaymentID,
+				transaction.Amount,
+				transaction.ReceivedAt.In(model.JST).Format(time.DateOnly),
+			)
+		}
+	}
+
+	return nil
+}
+
+func reconciliationProposalToSchema(
+	proposal reconciliation.Proposal,
+) api.PaymentReconciliationProposalResponse {
+	res := api.PaymentReconciliationProposalResponse{
+		Line:            proposal.Row.Line,
+		Amount:          proposal.Row.Amount,
+		Name:            proposal.Row.Name,
+		Memo:            proposal.Row.Memo,
+		AlreadyRecorded: proposal.AlreadyRecorded,
+	}
+
+	if proposal.Row.Date != nil {
+		res.Date = &openapi_types.Date{Time: *proposal.Row.Date}
+	}
+
+	if proposal.Payment != nil {
+		paymentID := int(proposal.Payment.ID)
+		matchMethod := api.PaymentReconciliationProposalResponseMatchMethod(proposal.Method)
+
+		res.PaymentId = &paymentID
+		res.UserId = &proposal.Payment.UserID
+		res.MatchMethod = &matchMethod
+	}
+
+	return res
+}
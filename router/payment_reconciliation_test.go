@@ -0,0 +1,493 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/reconciliation"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_AdminPostPaymentReconciliation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		headerRows := 1
+		dateColumn := 0
+		req := api.AdminPostPaymentReconciliationJSONRequestBody{
+			Statement:    random.AlphaNumericString(t, 100),
+			HeaderRows:   &headerRows,
+			DateColumn:   &dateColumn,
+			AmountColumn: 2,
+			NameColumn:   1,
+		}
+		date := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+		payment := model.Payment{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Amount: 10000,
+			UserID: random.AlphaNumericString(t, 32),
+			CampID: uint(campID),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil).
+			Times(1)
+		h.reconciliationService.EXPECT().
+			ProposeMatches(
+				gomock.Any(),
+				uint(campID),
+				gomock.Any(),
+				reconciliation.ColumnMapping{
+					HeaderRows: headerRows,
+					Date:       &dateColumn,
+					Amount:     req.AmountColumn,
+					Name:       req.NameColumn,
+				},
+			).
+			Return([]reconciliation.Proposal{
+				{
+					Row: reconciliation.StatementRow{
+						Line:   2,
+						Date:   &date,
+						Amount: 10000,
+						Name:   "ﾀﾅｶ ﾊﾅｺ " + payment.ReferenceCode(),
+					},
+					Payment:         &payment,
+					Method:          reconciliation.MatchMethodReferenceCode,
+					AlreadyRecorded: true,
+				},
+				{
+					Row: reconciliation.StatementRow{
+						Line:   3,
+						Amount: 5000,
+						Name:   "ｽｽﾞｷ ｲﾁﾛｳ",
+					},
+				},
+			}, nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(2)
+
+		matched := res.Value(0).Object()
+
+		matched.Value("line").Number().IsEqual(2)
+		matched.Value("date").String().IsEqual("2026-08-01")
+		matched.Value("amount").Number().IsEqual(10000)
+		matched.Value("paymentId").Number().IsEqual(payment.ID)
+		matched.Value("userId").String().IsEqual(payment.UserID)
+		matched.Value("matchMethod").String().IsEqual("reference_code")
+		matched.Value("alreadyRecorded").Boolean().IsTrue()
+
+		unmatched := res.Value(1).Object()
+
+		unmatched.Keys().ContainsOnly("line", "amount", "name", "memo", "alreadyRecorded")
+		unmatched.Value("alreadyRecorded").Boolean().IsFalse()
+	})
+
+	t.Run("Invalid statement", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{}, nil).
+			Times(1)
+		h.reconciliationService.EXPECT().
+			ProposeMatches(gomock.Any(), uint(campID), gomock.Any(), gomock.Any()).
+			Return(nil, reconciliation.ErrInvalidStatement).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation", campID).
+			WithJSON(api.AdminPostPaymentReconciliationJSONRequestBody{
+				Statement:    random.AlphaNumericString(t, 100),
+				AmountColumn: 0,
+				NameColumn:   1,
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation", campID).
+			WithJSON(api.AdminPostPaymentReconciliationJSONRequestBody{}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation", random.PositiveInt(t)).
+			WithJSON(api.AdminPostPaymentReconciliationJSONRequestBody{}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminConfirmPaymentReconciliation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		receivedAt := random.Time(t)
+		payment := model.Payment{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Amount: 10000,
+			UserID: random.AlphaNumericString(t, 32),
+			CampID: uint(campID),
+		}
+		req := api.AdminConfirmPaymentReconciliationJSONRequestBody{
+			{PaymentId: int(payment.ID), Amount: 4000, ReceivedAt: &receivedAt},
+			{PaymentId: int(payment.ID), Amount: 6000},
+		}
+		partlyPaid := payment
+		partlyPaid.AmountPaid = 4000
+		fullyPaid := payment
+		fullyPaid.AmountPaid = 10000
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusFinished}, nil).
+			Times(1)
+		gomock.InOrder(
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentByID(gomock.Any(), payment.ID).
+				Return(&payment, nil).
+				Times(2),
+			h.repo.MockPaymentRepository.EXPECT().
+				CreatePaymentTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, transaction *model.PaymentTransaction) error {
+					assert.Equal(t, payment.ID, transaction.PaymentID)
+					assert.Equal(t, 4000, transaction.Amount)
+					assert.Equal(t, model.PaymentMethodBankTransfer, transaction.Method)
+					assert.Equal(t, &adminUserID, transaction.ReceivedByID)
+					assert.WithinDuration(t, receivedAt, transaction.ReceivedAt, time.Second)
+
+					return nil
+				}),
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentTransactions(gomock.Any(), payment.ID).
+				Return([]model.PaymentTransaction{
+					// 同じ日付と金額でも現金の入金は別の記録として扱う
+					{
+						PaymentID:  payment.ID,
+						Amount:     4000,
+						Method:     model.PaymentMethodCash,
+						ReceivedAt: receivedAt,
+					},
+				}, nil),
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentByID(gomock.Any(), payment.ID).
+				Return(&partlyPaid, nil),
+			h.repo.MockPaymentRepository.EXPECT().
+				CreatePaymentTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, transaction *model.PaymentTransaction) error {
+					assert.Equal(t, 6000, transaction.Amount)
+					assert.WithinDuration(t, time.Now(), transaction.ReceivedAt, time.Minute)
+
+					return nil
+				}),
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentTransactions(gomock.Any(), payment.ID).
+				Return(nil, nil),
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentByID(gomock.Any(), payment.ID).
+				Return(&fullyPaid, nil),
+		)
+		h.activityService.EXPECT().
+			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), partlyPaid).
+			Return(nil).
+			Times(1)
+		h.activityService.EXPECT().
+			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), fullyPaid).
+			Return(nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation/confirm", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(1)
+		res.Value(0).Object().Value("id").Number().IsEqual(payment.ID)
+		res.Value(0).Object().Value("amountPaid").Number().IsEqual(10000)
+		res.Value(0).Object().Value("referenceCode").String().IsEqual(payment.ReferenceCode())
+	})
+
+	t.Run("Statement date", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		payment := model.Payment{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Amount: 10000,
+			CampID: uint(campID),
+		}
+		date := openapi_types.Date{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusFinished}, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), payment.ID).
+			Return(&payment, nil).
+			Times(2)
+		h.repo.MockPaymentRepository.EXPECT().
+			CreatePaymentTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, transaction *model.PaymentTransaction) error {
+				// receivedAtを省略した場合は明細の日付の入金として記録する
+				assert.True(
+					t,
+					time.Date(2026, 10, 1, 0, 0, 0, 0, model.JST).Equal(transaction.ReceivedAt),
+				)
+
+				return nil
+			})
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentTransactions(gomock.Any(), payment.ID).
+			Return(nil, nil)
+		h.activityService.EXPECT().
+			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation/confirm", campID).
+			WithJSON(api.AdminConfirmPaymentReconciliationJSONRequestBody{
+				{PaymentId: int(payment.ID), Amount: 10000, Date: &date},
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK)
+	})
+
+	t.Run("Already recorded", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		payment := model.Payment{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Amount: 10000,
+			CampID: uint(campID),
+		}
+		date := openapi_types.Date{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+		transactionID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusFinished}, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), payment.ID).
+			Return(&payment, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			CreatePaymentTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, transaction *model.PaymentTransaction) error {
+				transaction.ID = transactionID
+
+				return nil
+			})
+		// 同じ明細を確定し直したため、日本時間で同じ日の同じ金額の銀行振込が既にある
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentTransactions(gomock.Any(), payment.ID).
+			Return([]model.PaymentTransaction{
+				{
+					Model:      gorm.Model{ID: transactionID - 1},
+					PaymentID:  payment.ID,
+					Amount:     10000,
+					Method:     model.PaymentMethodBankTransfer,
+					ReceivedAt: time.Date(2026, 10, 1, 15, 0, 0, 0, model.JST),
+				},
+				{
+					Model:      gorm.Model{ID: transactionID},
+					PaymentID:  payment.ID,
+					Amount:     10000,
+					Method:     model.PaymentMethodBankTransfer,
+					ReceivedAt: time.Date(2026, 10, 1, 0, 0, 0, 0, model.JST),
+				},
+			}, nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation/confirm", campID).
+			WithJSON(api.AdminConfirmPaymentReconciliationJSONRequestBody{
+				{PaymentId: int(payment.ID), Amount: 10000, Date: &date},
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("Payment of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		paymentID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusFinished}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&model.Payment{
+				Model:  gorm.Model{ID: uint(paymentID)},
+				CampID: uint(campID) + 1,
+			}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/camps/{campId}/payments/reconciliation/confirm", campID).
+			WithJSON(api.AdminConfirmPaymentReconciliationJSONRequestBody{
+				{PaymentId: paymentID, Amount: random.PositiveInt(t)},
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			req  api.AdminConfirmPaymentReconciliationJSONRequestBody
+		}{
+			{
+				name: "Empty",
+				req:  api.AdminConfirmPaymentReconciliationJSONRequestBody{},
+			},
+			{
+				name: "Non-positive amount",
+				req: api.AdminConfirmPaymentReconciliationJSONRequestBody{
+					{PaymentId: random.PositiveInt(t), Amount: -random.PositiveInt(t)},
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				h := setup(t)
+				adminUserID := random.AlphaNumericString(t, 32)
+
+				h.repo.MockUserRepository.EXPECT().
+					GetOrCreateUser(gomock.Any(), adminUserID).
+					Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+					Times(1)
+
+				h.expect.POST(
+					"/api/admin/camps/{campId}/payments/reconciliation/confirm",
+					random.PositiveInt(t),
+				).
+					WithJSON(tc.req).
+					WithHeader("X-Forwarded-User", adminUserID).
+					Expect().
+					Status(http.StatusBadRequest)
+			})
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST(
+			"/api/admin/camps/{campId}/payments/reconciliation/confirm",
+			random.PositiveInt(t),
+		).
+			WithJSON(api.AdminConfirmPaymentReconciliationJSONRequestBody{}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
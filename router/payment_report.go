@@ -0,0 +1,341 @@
+package router
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// paymentReportCategories は集計に含める明細の種類の順番
+var paymentReportCategories = []model.PaymentLineItemCategory{
+	model.PaymentLineItemCategoryLodging,
+	model.PaymentLineItemCategoryMeal,
+	model.PaymentLineItemCategoryBus,
+	model.PaymentLineItemCategoryLateRegistration,
+	model.PaymentLineItemCategoryDiscount,
+	model.PaymentLineItemCategoryOther,
+}
+
+// paymentReportMethods は集計に含める支払い方法と、CSVの列名
+var paymentReportMethods = []struct {
+	method model.PaymentMethod
+	label  string
+}{
+	{method: model.PaymentMethodCash, label: "現金"},
+	{method: model.PaymentMethodBankTransfer, label: "銀行振込"},
+	{method: model.PaymentMethodOther, label: "その他"},
+}
+
+// paymentReportEntry は参加者1人分の支払いの状況
+type paymentReportEntry struct {
+	userID string
+	// 支払い情報が作成されていない場合はnil
+	payment           *model.Payment
+	collectedByMethod map[model.PaymentMethod]int
+}
+
+func (e *paymentReportEntry) billed() int {
+	if e.payment == nil {
+		return 0
+	}
+
+	return e.payment.Amount
+}
+
+func (e *paymentReportEntry) collected() int {
+	if e.payment == nil {
+		return 0
+	}
+
+	return e.payment.AmountPaid
+}
+
+// outstanding は未収額を返す。払い過ぎている場合は0
+func (e *paymentReportEntry) outstanding() int {
+	return max(e.billed()-e.collected(), 0)
+}
+
+type paymentStatus int
+
+const (
+	paymentStatusWithoutPayment paymentStatus = iota
+	paymentStatusPaid
+	paymentStatusPartiallyPaid
+	paymentStatusUnpaid
+)
+
+// paymentStatusLabels はCSVに書き込む支払いの状況
+var paymentStatusLabels = map[paymentStatus]string{
+	paymentStatusWithoutPayment: "支払い情報なし",
+	paymentStatusPaid:           "支払済",
+	paymentStatusPartiallyPaid:  "一部支払済",
+	paymentStatusUnpaid:         "未払い",
+}
+
+func (e *paymentReportEntry) status() paymentStatus {
+	switch {
+	case e.payment == nil:
+		return paymentStatusWithoutPayment
+	case e.outstanding() == 0:
+		return paymentStatusPaid
+	case e.collected() > 0:
+		return paymentStatusPartiallyPaid
+	default:
+		return paymentStatusUnpaid
+	}
+}
+
+// AdminGetPaymentReport 支払いの集計を取得（管理者用）
+func (s *Server) AdminGetPaymentReport(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminGetPaymentReportParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	format := api.Json
+
+	if params.Format != nil {
+		if !params.Format.Valid() {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid format")
+		}
+
+		format = *params.Format
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	participants, err := s.repo.GetCampParticipants(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp participants: %w", err))
+	}
+
+	payments, err := s.repo.GetPayments(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payments: %w", err))
+	}
+
+	transactions, err := s.repo.GetCampPaymentTransactions(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment transactions: %w", err))
+	}
+
+	entries := newPaymentReportEntries(participants, payments, transactions)
+
+	if format == api.Csv {
+		b, err := paymentReportCSV(entries)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to write payment report: %w", err))
+		}
+
+		e.Response().Header().Set(
+			echo.HeaderContentDisposition,
+			fmt.Sprintf(`attachment; filename="payments-%d.csv"`, campID),
+		)
+
+		return e.Blob(http.StatusOK, "text/csv; charset=utf-8", b)
+	}
+
+	return e.JSON(http.StatusOK, summarizePayments(entries))
+}
+
+// newPaymentReportEntries は現在の参加者ごとに支払いと入出金の記録をまとめる。
+// 参加を取り消したユーザーの支払いは含めない
+func newPaymentReportEntries(
+	participants []model.User,
+	payments []model.Payment,
+	transactions []model.PaymentTransaction,
+) []paymentReportEntry {
+	paymentsByUserID := make(map[string]*model.Payment, len(payments))
+
+	for i := range payments {
+		paymentsByUserID[payments[i].UserID] = &payments[i]
+	}
+
+	collectedByPaymentID := make(map[uint]map[model.PaymentMethod]int, len(payments))
+
+	for _, transaction := range transactions {
+		if collectedByPaymentID[transaction.PaymentID] == nil {
+			collectedByPaymentID[transaction.PaymentID] = make(map[model.PaymentMethod]int)
+		}
+
+		collectedByPaymentID[transaction.PaymentID][transaction.Method] += transaction.Amount
+	}
+
+	entries := make([]paymentReportEntry, len(participants))
+
+	for i, participant := range participants {
+		entries[i] = paymentReportEntry{userID: participant.ID}
+
+		if payment, ok := paymentsByUserID[participant.ID]; ok {
+			entries[i].payment = payment
+			entries[i].collectedByMethod = collectedByPaymentID[payment.ID]
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b paymentReportEntry) int {
+		return strings.Compare(a.userID, b.userID)
+	})
+
+	return entries
+}
+
+// summarizePayments は請求額と入金額の合計、支払いの状況ごとの人数、種類ごとの内訳を集計する
+func summarizePayments(entries []paymentReportEntry) api.PaymentReportResponse {
+	res := api.PaymentReportResponse{
+		ParticipantCount: len(entries),
+		ByCategory:       make([]api.PaymentReportCategoryAmount, len(paymentReportCategories)),
+		ByMethod:         make([]api.PaymentReportMethodAmount, len(paymentReportMethods)),
+	}
+
+	for i, category := range paymentReportCategories {
+		res.ByCategory[i].Category = api.PaymentLineItemCategory(category)
+	}
+
+	for i, method := range paymentReportMethods {
+		res.ByMethod[i].Method = api.PaymentMethod(method.method)
+	}
+
+	for _, entry := range entries {
+		switch entry.status() {
+		case paymentStatusWithoutPayment:
+			res.WithoutPaymentCount++
+			continue
+		case paymentStatusPaid:
+			res.PaidCount++
+		case paymentStatusPartiallyPaid:
+			res.PartiallyPaidCount++
+		case paymentStatusUnpaid:
+			res.UnpaidCount++
+		}
+
+		res.TotalBilled += entry.billed()
+		res.TotalCollected += entry.collected()
+		res.Outstanding += entry.outstanding()
+
+		if len(entry.payment.LineItems) == 0 {
+			res.UncategorizedAmount += entry.payment.Amount
+		}
+
+		for _, lineItem := range entry.payment.LineItems {
+			i := slices.Index(paymentReportCategories, lineItem.Category)
+
+			if i >= 0 {
+				res.ByCategory[i].Amount += lineItem.Amount
+			}
+		}
+
+		for i, method := range paymentReportMethods {
+			res.ByMethod[i].Amount += entry.collectedByMethod[method.method]
+		}
+	}
+
+	return res
+}
+
+// paymentReportCSV は参加者ごとの請求額と入金額の一覧を、最後に合計の行を付けてCSVにする。
+// Excelで開いても文字化けしないようにBOMを付ける
+func paymentReportCSV(entries []paymentReportEntry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("\ufeff")
+
+	w := csv.NewWriter(&buf)
+	header := []string{"ユーザーID", "照合コード", "請求額", "入金額", "未収額", "状況"}
+
+	for _, method := range paymentReportMethods {
+		header = append(header, method.label)
+	}
+
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	summary := summarizePayments(entries)
+
+	for _, entry := range entries {
+		referenceCode := ""
+
+		if entry.payment != nil {
+			referenceCode = entry.payment.ReferenceCode()
+		}
+
+		record := []string{
+			entry.userID,
+			referenceCode,
+			strconv.Itoa(entry.billed()),
+			strconv.Itoa(entry.collected()),
+			strconv.Itoa(entry.outstanding()),
+			paymentStatusLabels[entry.status()],
+		}
+
+		for _, method := range paymentReportMethods {
+			record = append(record, strconv.Itoa(entry.collectedByMethod[method.method]))
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	total := []string{
+		"合計",
+		"",
+		strconv.Itoa(summary.TotalBilled),
+		strconv.Itoa(summary.TotalCollected),
+		strconv.Itoa(summary.Outstanding),
+		"",
+	}
+
+	for _, method := range summary.ByMethod {
+		total = append(total, strconv.Itoa(method.Amount))
+	}
+
+	if err := w.Write(total); err != nil {
+		return nil, err
+	}
+
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
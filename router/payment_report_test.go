@@ -0,0 +1,234 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+// paymentReportFixture は支払済み・一部支払済・未払い・支払い情報なしの参加者と、
+// 参加を取り消したユーザーの支払いを含む合宿の支払い情報
+type paymentReportFixture struct {
+	campID       uint
+	participants []model.User
+	payments     []model.Payment
+	transactions []model.PaymentTransaction
+}
+
+func newPaymentReportFixture(t *testing.T) paymentReportFixture {
+	t.Helper()
+
+	campID := uint(random.PositiveInt(t))
+	participants := []model.User{
+		{ID: "alice"},
+		{ID: "bob"},
+		{ID: "carol"},
+		{ID: "dave"},
+	}
+	payments := []model.Payment{
+		{
+			Model:      gorm.Model{ID: 1},
+			Amount:     12000,
+			AmountPaid: 13000,
+			UserID:     "alice",
+			CampID:     campID,
+			LineItems: []model.PaymentLineItem{
+				{Category: model.PaymentLineItemCategoryLodging, Amount: 10000},
+				{Category: model.PaymentLineItemCategoryBus, Amount: 3000},
+				{Category: model.PaymentLineItemCategoryDiscount, Amount: -1000},
+			},
+		},
+		{
+			Model:      gorm.Model{ID: 2},
+			Amount:     10000,
+			AmountPaid: 4000,
+			UserID:     "bob",
+			CampID:     campID,
+			LineItems: []model.PaymentLineItem{
+				{Category: model.PaymentLineItemCategoryLodging, Amount: 10000},
+			},
+		},
+		{
+			Model:  gorm.Model{ID: 3},
+			Amount: 8000,
+			UserID: "carol",
+			CampID: campID,
+		},
+		{
+			Model:      gorm.Model{ID: 4},
+			Amount:     10000,
+			AmountPaid: 10000,
+			UserID:     "eve",
+			CampID:     campID,
+		},
+	}
+	transactions := []model.PaymentTransaction{
+		{PaymentID: 1, Amount: 10000, Method: model.PaymentMethodBankTransfer},
+		{PaymentID: 1, Amount: 3000, Method: model.PaymentMethodCash},
+		{PaymentID: 2, Amount: 4000, Method: model.PaymentMethodCash},
+		{PaymentID: 4, Amount: 10000, Method: model.PaymentMethodCash},
+	}
+
+	return paymentReportFixture{
+		campID:       campID,
+		participants: participants,
+		payments:     payments,
+		transactions: transactions,
+	}
+}
+
+func (f paymentReportFixture) expectRepository(h *testHandler, adminUserID string) {
+	h.repo.MockUserRepository.EXPECT().
+		GetOrCreateUser(gomock.Any(), adminUserID).
+		Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+		Times(1)
+	h.repo.MockCampRepository.EXPECT().
+		GetCampByID(gomock.Any(), f.campID).
+		Return(&model.Camp{}, nil).
+		Times(1)
+	h.repo.MockCampRepository.EXPECT().
+		GetCampParticipants(gomock.Any(), f.campID).
+		Return(f.participants, nil).
+		Times(1)
+	h.repo.MockPaymentRepository.EXPECT().
+		GetPayments(gomock.Any(), f.campID).
+		Return(f.payments, nil).
+		Times(1)
+	h.repo.MockPaymentRepository.EXPECT().
+		GetCampPaymentTransactions(gomock.Any(), f.campID).
+		Return(f.transactions, nil).
+		Times(1)
+}
+
+func TestServer_AdminGetPaymentReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		f := newPaymentReportFixture(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		f.expectRepository(h, adminUserID)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/payments/report", f.campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		// 参加を取り消したeveの支払いは含まない
+		res.Value("participantCount").Number().IsEqual(4)
+		res.Value("totalBilled").Number().IsEqual(30000)
+		res.Value("totalCollected").Number().IsEqual(17000)
+		res.Value("outstanding").Number().IsEqual(14000)
+		res.Value("paidCount").Number().IsEqual(1)
+		res.Value("partiallyPaidCount").Number().IsEqual(1)
+		res.Value("unpaidCount").Number().IsEqual(1)
+		res.Value("withoutPaymentCount").Number().IsEqual(1)
+		res.Value("uncategorizedAmount").Number().IsEqual(8000)
+		res.Value("byCategory").Array().IsEqual([]map[string]any{
+			{"category": "lodging", "amount": 20000},
+			{"category": "meal", "amount": 0},
+			{"category": "bus", "amount": 3000},
+			{"category": "late_registration", "amount": 0},
+			{"category": "discount", "amount": -1000},
+			{"category": "other", "amount": 0},
+		})
+		res.Value("byMethod").Array().IsEqual([]map[string]any{
+			{"method": "cash", "amount": 7000},
+			{"method": "bank_transfer", "amount": 10000},
+			{"method": "other", "amount": 0},
+		})
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		f := newPaymentReportFixture(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		f.expectRepository(h, adminUserID)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/payments/report", f.campID).
+			WithQuery("format", "csv").
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK)
+
+		res.Header("Content-Type").HasPrefix("text/csv")
+		res.Body().IsEqual("\ufeff" +
+			"ユーザーID,照合コード,請求額,入金額,未収額,状況,現金,銀行振込,その他\n" +
+			"alice,RQ00001,12000,13000,0,支払済,3000,10000,0\n" +
+			"bob,RQ00002,10000,4000,6000,一部支払済,4000,0,0\n" +
+			"carol,RQ00003,8000,0,8000,未払い,0,0,0\n" +
+			"dave,,0,0,0,支払い情報なし,0,0,0\n" +
+			"合計,,30000,17000,14000,,7000,10000,0\n")
+	})
+
+	t.Run("Invalid format", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+
+		h.expect.GET("/api/admin/camps/{campId}/payments/report", random.PositiveInt(t)).
+			WithQuery("format", "xlsx").
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound).
+			Times(1)
+
+		h.expect.GET("/api/admin/camps/{campId}/payments/report", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.GET("/api/admin/camps/{campId}/payments/report", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -0,0 +1,183 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// AdminGetPaymentTransactions 支払いの入出金の記録を取得（管理者用）
+func (s *Server) AdminGetPaymentTransactions(
+	e echo.Context,
+	paymentID api.PaymentId,
+	params api.AdminGetPaymentTransactionsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	if _, err := s.repo.GetPaymentByID(ctx, uint(paymentID)); err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Payment not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment: %w", err))
+	}
+
+	transactions, err := s.repo.GetPaymentTransactions(ctx, uint(paymentID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment transactions: %w", err))
+	}
+
+	res, err := converter.Convert[[]api.PaymentTransactionResponse](transactions)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert payment transactions: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminPostPaymentTransaction 支払いの入出金を記録（管理者用）
+func (s *Server) AdminPostPaymentTransaction(
+	e echo.Context,
+	paymentID api.PaymentId,
+	params api.AdminPostPaymentTransactionParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPostPaymentTransactionJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if !req.Method.Valid() {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid method")
+	}
+
+	if req.Amount == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "amount must not be zero")
+	}
+
+	transaction, err := converter.Convert[model.PaymentTransaction](req)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
+	}
+
+	if transaction.ReceivedByID == nil {
+		transaction.ReceivedByID = &user.ID
+	} else if *transaction.ReceivedByID != user.ID {
+		receivedBy, err := s.repo.GetOrCreateUser(ctx, *transaction.ReceivedByID)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+		}
+
+		if !receivedBy.IsStaff {
+			return echo.NewHTTPError(http.StatusBadRequest, "receivedBy must be a staff")
+		}
+	}
+
+	if transaction.ReceivedAt.IsZero() {
+		transaction.ReceivedAt = time.Now()
+	}
+
+	payment, err := s.repo.GetPaymentByID(ctx, uint(paymentID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Payment not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment: %w", err))
+	}
+
+	if err := s.ensureCampAcceptsPayments(ctx, payment.CampID); err != nil {
+		return err
+	}
+
+	transaction.PaymentID = uint(paymentID)
+
+	var updatedPayment *model.Payment
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		if err := tx.CreatePaymentTransaction(ctx, &transaction); err != nil {
+			return fmt.Errorf("failed to create payment transaction: %w", err)
+		}
+
+		var err error
+		updatedPayment, err = tx.GetPaymentByID(ctx, uint(paymentID))
+
+		if err != nil {
+			return fmt.Errorf("failed to get payment: %w", err)
+		}
+
+		return s.activityService.RecordPaymentPaidChanged(ctx, tx, *updatedPayment)
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	s.eventBus.Publish(
+		updatedPayment.CampID,
+		eventbus.TopicPayment,
+		eventbus.EventTypeUpdated,
+		updatedPayment.ID,
+	)
+	s.eventBus.Publish(updatedPayment.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
+	res, err := converter.Convert[api.PaymentTransactionResponse](transaction)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusCreated, res)
+}
+
+// newAdjustmentTransaction は支払済み金額が直接入力されたときに、その差額を表す記録を作る
+func newAdjustmentTransaction(staffID string, amount int, memo string) model.PaymentTransaction {
+	return model.PaymentTransaction{
+		Amount:       amount,
+		Method:       model.PaymentMethodOther,
+		ReceivedByID: &staffID,
+		ReceivedAt:   time.Now(),
+		Memo:         memo,
+	}
+}
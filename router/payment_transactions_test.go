@@ -0,0 +1,374 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_AdminGetPaymentTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		receivedAt := random.Time(t)
+		transactions := []model.PaymentTransaction{
+			{
+				Model:        gorm.Model{ID: uint(random.PositiveInt(t))},
+				PaymentID:    uint(paymentID),
+				Amount:       5000,
+				Method:       model.PaymentMethodCash,
+				ReceivedByID: &adminUserID,
+				ReceivedAt:   receivedAt,
+				Memo:         random.AlphaNumericString(t, 20),
+			},
+			{
+				Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+				PaymentID:  uint(paymentID),
+				Amount:     -1000,
+				Method:     model.PaymentMethodBankTransfer,
+				ReceivedAt: receivedAt.Add(time.Hour),
+			},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&model.Payment{Model: gorm.Model{ID: uint(paymentID)}}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentTransactions(gomock.Any(), uint(paymentID)).
+			Return(transactions, nil).
+			Times(1)
+
+		res := h.expect.GET("/api/admin/payments/{paymentId}/transactions", paymentID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(2)
+
+		first := res.Value(0).Object()
+
+		first.Keys().ContainsOnly("id", "amount", "method", "receivedBy", "receivedAt", "memo")
+		first.Value("id").Number().IsEqual(transactions[0].ID)
+		first.Value("amount").Number().IsEqual(5000)
+		first.Value("method").String().IsEqual(string(api.PaymentMethodCash))
+		first.Value("receivedBy").String().IsEqual(adminUserID)
+		first.Value("receivedAt").String().AsDateTime(time.RFC3339).IsEqual(receivedAt)
+		first.Value("memo").String().IsEqual(transactions[0].Memo)
+
+		second := res.Value(1).Object()
+
+		second.Keys().NotContainsAny("receivedBy")
+		second.Value("amount").Number().IsEqual(-1000)
+		second.Value("method").String().IsEqual(string(api.PaymentMethodBankTransfer))
+	})
+
+	t.Run("Payment Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(nil, repository.ErrPaymentNotFound).
+			Times(1)
+
+		h.expect.GET("/api/admin/payments/{paymentId}/transactions", paymentID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.GET("/api/admin/payments/{paymentId}/transactions", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminPostPaymentTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		memo := random.AlphaNumericString(t, 20)
+		req := api.AdminPostPaymentTransactionJSONRequestBody{
+			Amount: 3000,
+			Method: api.PaymentMethodCash,
+			Memo:   &memo,
+		}
+		beforePayment := model.Payment{
+			Model:      gorm.Model{ID: uint(paymentID)},
+			Amount:     10000,
+			AmountPaid: 5000,
+			UserID:     random.AlphaNumericString(t, 32),
+			CampID:     uint(campID),
+		}
+		updatedPayment := beforePayment
+		updatedPayment.AmountPaid = 8000
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusOngoing}, nil).
+			Times(1)
+		gomock.InOrder(
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentByID(gomock.Any(), uint(paymentID)).
+				Return(&beforePayment, nil),
+			h.repo.MockPaymentRepository.EXPECT().
+				CreatePaymentTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, transaction *model.PaymentTransaction) error {
+					assert.Equal(t, uint(paymentID), transaction.PaymentID)
+					assert.Equal(t, req.Amount, transaction.Amount)
+					assert.Equal(t, model.PaymentMethodCash, transaction.Method)
+					assert.Equal(t, &adminUserID, transaction.ReceivedByID)
+					assert.WithinDuration(t, time.Now(), transaction.ReceivedAt, time.Minute)
+
+					transaction.ID = uint(random.PositiveInt(t))
+
+					return nil
+				}),
+			h.repo.MockPaymentRepository.EXPECT().
+				GetPaymentByID(gomock.Any(), uint(paymentID)).
+				Return(&updatedPayment, nil),
+		)
+		h.activityService.EXPECT().
+			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), updatedPayment).
+			Return(nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/payments/{paymentId}/transactions", paymentID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.Value("amount").Number().IsEqual(req.Amount)
+		res.Value("method").String().IsEqual(string(req.Method))
+		res.Value("receivedBy").String().IsEqual(adminUserID)
+		res.Value("memo").String().IsEqual(memo)
+	})
+
+	t.Run("Refund received by another staff", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		staffID := random.AlphaNumericString(t, 32)
+		receivedAt := random.Time(t)
+		req := api.AdminPostPaymentTransactionJSONRequestBody{
+			Amount:     -2000,
+			Method:     api.PaymentMethodBankTransfer,
+			ReceivedBy: &staffID,
+			ReceivedAt: &receivedAt,
+		}
+		payment := model.Payment{
+			Model:      gorm.Model{ID: uint(paymentID)},
+			AmountPaid: 3000,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusOngoing}, nil).
+			Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&payment, nil).
+			Times(2)
+		h.repo.MockPaymentRepository.EXPECT().
+			CreatePaymentTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, transaction *model.PaymentTransaction) error {
+				assert.Equal(t, -2000, transaction.Amount)
+				assert.Equal(t, &staffID, transaction.ReceivedByID)
+				assert.WithinDuration(t, receivedAt, transaction.ReceivedAt, time.Second)
+
+				return nil
+			}).
+			Times(1)
+		h.activityService.EXPECT().
+			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/payments/{paymentId}/transactions", paymentID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusCreated)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			req  api.AdminPostPaymentTransactionJSONRequestBody
+		}{
+			{
+				name: "Zero amount",
+				req: api.AdminPostPaymentTransactionJSONRequestBody{
+					Amount: 0,
+					Method: api.PaymentMethodCash,
+				},
+			},
+			{
+				name: "Invalid method",
+				req: api.AdminPostPaymentTransactionJSONRequestBody{
+					Amount: random.PositiveInt(t),
+					Method: api.PaymentMethod("credit_card"),
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				h := setup(t)
+				adminUserID := random.AlphaNumericString(t, 32)
+
+				h.repo.MockUserRepository.EXPECT().
+					GetOrCreateUser(gomock.Any(), adminUserID).
+					Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+					Times(1)
+
+				h.expect.POST("/api/admin/payments/{paymentId}/transactions", random.PositiveInt(t)).
+					WithJSON(tc.req).
+					WithHeader("X-Forwarded-User", adminUserID).
+					Expect().
+					Status(http.StatusBadRequest)
+			})
+		}
+	})
+
+	t.Run("Received by non-staff", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/payments/{paymentId}/transactions", random.PositiveInt(t)).
+			WithJSON(api.AdminPostPaymentTransactionJSONRequestBody{
+				Amount:     random.PositiveInt(t),
+				Method:     api.PaymentMethodCash,
+				ReceivedBy: &userID,
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Payment Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(nil, repository.ErrPaymentNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/payments/{paymentId}/transactions", paymentID).
+			WithJSON(api.AdminPostPaymentTransactionJSONRequestBody{
+				Amount: random.PositiveInt(t),
+				Method: api.PaymentMethodCash,
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/payments/{paymentId}/transactions", random.PositiveInt(t)).
+			WithJSON(api.AdminPostPaymentTransactionJSONRequestBody{
+				Amount: random.PositiveInt(t),
+				Method: api.PaymentMethodCash,
+			}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 // AdminGetPayments 支払い情報の一覧を取得（管理者用）
@@ -64,13 +66,8 @@ func (s *Server) AdminPostPayment(
 		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
 	}
 
-	if _, err := s.repo.GetCampByID(e.Request().Context(), uint(campID)); err != nil {
-		if errors.Is(err, repository.ErrCampNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
-		}
-
-		return echo.NewHTTPError(http.StatusInternalServerError).
-			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	if err := s.ensureCampAcceptsPayments(e.Request().Context(), uint(campID)); err != nil {
+		return err
 	}
 
 	var req api.AdminPostPaymentJSONRequestBody
@@ -88,6 +85,13 @@ func (s *Server) AdminPostPayment(
 
 	payment.CampID = uint(campID)
 
+	// 支払済み金額は入出金の記録の合計になるため、指定された金額を記録として作成する
+	if payment.AmountPaid != 0 {
+		payment.Transactions = []model.PaymentTransaction{
+			newAdjustmentTransaction(user.ID, payment.AmountPaid, "支払い情報の作成時に入力された支払済み金額"),
+		}
+	}
+
 	ctx := e.Request().Context()
 
 	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
@@ -101,6 +105,9 @@ func (s *Server) AdminPostPayment(
 			SetInternal(err)
 	}
 
+	s.eventBus.Publish(payment.CampID, eventbus.TopicPayment, eventbus.EventTypeCreated, payment.ID)
+	s.eventBus.Publish(payment.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
 	res, err := converter.Convert[api.PaymentResponse](payment)
 
 	if err != nil {
@@ -152,6 +159,33 @@ func (s *Server) AdminPutPayment(
 			SetInternal(fmt.Errorf("failed to get payment: %w", err))
 	}
 
+	if err := s.ensureCampAcceptsPayments(e.Request().Context(), beforePayment.CampID); err != nil {
+		return err
+	}
+
+	// 明細がある場合、金額は明細の合計から変えられない
+	if len(beforePayment.LineItems) > 0 && payment.Amount != beforePayment.LineItemsTotal() {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			fmt.Sprintf(
+				"amount must be equal to the total of line items (%d)",
+				beforePayment.LineItemsTotal(),
+			),
+		)
+	}
+
+	// 支払済み金額は入出金の記録からのみ変更する。
+	// 古い支払い情報を元にした更新で入出金が打ち消されないよう、現在の値と異なる場合は拒否する
+	if req.AmountPaid != nil && *req.AmountPaid != beforePayment.AmountPaid {
+		return echo.NewHTTPError(
+			http.StatusConflict,
+			fmt.Sprintf(
+				"amountPaid does not match the current amount paid (%d); record a payment transaction instead",
+				beforePayment.AmountPaid,
+			),
+		)
+	}
+
 	ctx := e.Request().Context()
 
 	var updatedPayment *model.Payment
@@ -168,7 +202,7 @@ func (s *Server) AdminPutPayment(
 			return fmt.Errorf("failed to get payment: %w", err)
 		}
 
-		// Amount/AmountPaid が変更された場合にアクティビティを記録
+		// Amount が変更された場合にアクティビティを記録
 		if updatedPayment.Amount != beforePayment.Amount {
 			if err := s.activityService.RecordPaymentAmountChanged(
 				ctx,
@@ -179,22 +213,23 @@ func (s *Server) AdminPutPayment(
 			}
 		}
 
-		if updatedPayment.AmountPaid != beforePayment.AmountPaid {
-			if err := s.activityService.RecordPaymentPaidChanged(
-				ctx,
-				tx,
-				*updatedPayment,
-			); err != nil {
-				return err
-			}
-		}
-
 		return nil
 	}); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(err)
 	}
 
+	s.eventBus.Publish(
+		updatedPayment.CampID,
+		eventbus.TopicPayment,
+		eventbus.EventTypeUpdated,
+		updatedPayment.ID,
+	)
+
+	if updatedPayment.Amount != beforePayment.Amount {
+		s.eventBus.Publish(updatedPayment.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+	}
+
 	res, err := converter.Convert[api.PaymentResponse](updatedPayment)
 
 	if err != nil {
@@ -204,3 +239,122 @@ func (s *Server) AdminPutPayment(
 
 	return e.JSON(http.StatusOK, res)
 }
+
+// AdminPutPaymentLineItems 支払いの明細を更新（管理者用）
+func (s *Server) AdminPutPaymentLineItems(
+	e echo.Context,
+	paymentID api.PaymentId,
+	params api.AdminPutPaymentLineItemsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPutPaymentLineItemsJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	lineItems, err := converter.Convert[[]model.PaymentLineItem](req)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
+	}
+
+	for _, lineItem := range lineItems {
+		if !lineItem.Category.IsValidAmount(lineItem.Amount) {
+			return echo.NewHTTPError(http.StatusBadRequest, errInvalidLineItemAmount.Error())
+		}
+	}
+
+	beforePayment, err := s.repo.GetPaymentByID(ctx, uint(paymentID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Payment not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get payment: %w", err))
+	}
+
+	if err := s.ensureCampAcceptsPayments(ctx, beforePayment.CampID); err != nil {
+		return err
+	}
+
+	var updatedPayment *model.Payment
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		if err := tx.ReplacePaymentLineItems(ctx, uint(paymentID), lineItems); err != nil {
+			return fmt.Errorf("failed to replace payment line items: %w", err)
+		}
+
+		var err error
+		updatedPayment, err = tx.GetPaymentByID(ctx, uint(paymentID))
+
+		if err != nil {
+			return fmt.Errorf("failed to get payment: %w", err)
+		}
+
+		if updatedPayment.Amount != beforePayment.Amount {
+			return s.activityService.RecordPaymentAmountChanged(ctx, tx, *updatedPayment)
+		}
+
+		return nil
+	}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+
+	s.eventBus.Publish(
+		updatedPayment.CampID,
+		eventbus.TopicPayment,
+		eventbus.EventTypeUpdated,
+		updatedPayment.ID,
+	)
+
+	if updatedPayment.Amount != beforePayment.Amount {
+		s.eventBus.Publish(updatedPayment.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+	}
+
+	res, err := converter.Convert[api.PaymentResponse](updatedPayment)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// ensureCampAcceptsPayments は合宿の状態が支払い情報の作成・更新や入出金の記録を受け付けているかを確認する
+func (s *Server) ensureCampAcceptsPayments(ctx context.Context, campID uint) error {
+	camp, err := s.repo.GetCampByID(ctx, campID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	if !camp.AcceptsPayments() {
+		return echo.NewHTTPError(
+			http.StatusForbidden,
+			fmt.Sprintf("Payments for this camp are not accepted while it is %s", camp.Status),
+		)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
@@ -22,9 +23,10 @@ func TestAdminPostPayment(t *testing.T) {
 
 		h := setup(t)
 		campID := random.PositiveInt(t)
+		amountPaid := random.PositiveInt(t)
 		req := api.AdminPostPaymentJSONRequestBody{
 			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
+			AmountPaid: &amountPaid,
 			UserId:     random.AlphaNumericString(t, 32),
 		}
 		adminUserID := random.AlphaNumericString(t, 32)
@@ -36,8 +38,17 @@ func TestAdminPostPayment(t *testing.T) {
 			}, nil)
 		h.repo.MockCampRepository.EXPECT().
 			GetCampByID(gomock.Any(), uint(campID)).
-			Return(&model.Camp{}, nil)
-		h.repo.MockPaymentRepository.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).Return(nil)
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil)
+		h.repo.MockPaymentRepository.EXPECT().
+			CreatePayment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, payment *model.Payment) error {
+				// 支払済み金額は入出金の記録としても作成される
+				if assert.Len(t, payment.Transactions, 1) {
+					assert.Equal(t, amountPaid, payment.Transactions[0].Amount)
+				}
+
+				return nil
+			})
 		h.activityService.EXPECT().
 			RecordPaymentCreated(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
@@ -49,9 +60,9 @@ func TestAdminPostPayment(t *testing.T) {
 			Expect().Status(http.StatusCreated).JSON().Object()
 
 		res.Keys().ContainsOnly(
-			"id", "amount", "amountPaid", "userId", "campId")
+			"id", "amount", "amountPaid", "referenceCode", "userId", "campId")
 		res.Value("amount").Number().IsEqual(req.Amount)
-		res.Value("amountPaid").Number().IsEqual(req.AmountPaid)
+		res.Value("amountPaid").Number().IsEqual(amountPaid)
 		res.Value("userId").String().IsEqual(req.UserId)
 		res.Value("campId").Number().IsEqual(campID)
 	})
@@ -62,9 +73,8 @@ func TestAdminPostPayment(t *testing.T) {
 		h := setup(t)
 		campID := random.PositiveInt(t)
 		req := api.AdminPostPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
+			Amount: random.PositiveInt(t),
+			UserId: random.AlphaNumericString(t, 32),
 		}
 		adminUserID := random.AlphaNumericString(t, 32)
 
@@ -75,7 +85,7 @@ func TestAdminPostPayment(t *testing.T) {
 			}, nil)
 		h.repo.MockCampRepository.EXPECT().
 			GetCampByID(gomock.Any(), uint(campID)).
-			Return(&model.Camp{}, nil)
+			Return(&model.Camp{Status: model.CampStatusRegistration}, nil)
 		h.repo.MockPaymentRepository.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).Return(nil)
 		h.activityService.EXPECT().
 			RecordPaymentCreated(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -94,9 +104,8 @@ func TestAdminPostPayment(t *testing.T) {
 		h := setup(t)
 		campID := random.PositiveInt(t)
 		req := api.AdminPostPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
+			Amount: random.PositiveInt(t),
+			UserId: random.AlphaNumericString(t, 32),
 		}
 		adminUserID := random.AlphaNumericString(t, 32)
 
@@ -114,6 +123,37 @@ func TestAdminPostPayment(t *testing.T) {
 			WithHeader("X-Forwarded-User", adminUserID).
 			Expect().Status(http.StatusNotFound)
 	})
+
+	t.Run("Camp does not accept payments", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []model.CampStatus{model.CampStatusDraft, model.CampStatusArchived}
+
+		for _, status := range testCases {
+			t.Run(string(status), func(t *testing.T) {
+				t.Parallel()
+
+				h := setup(t)
+				campID := random.PositiveInt(t)
+				adminUserID := random.AlphaNumericString(t, 32)
+
+				h.repo.MockUserRepository.EXPECT().
+					GetOrCreateUser(gomock.Any(), adminUserID).
+					Return(&model.User{IsStaff: true}, nil)
+				h.repo.MockCampRepository.EXPECT().
+					GetCampByID(gomock.Any(), uint(campID)).
+					Return(&model.Camp{Status: status}, nil)
+
+				h.expect.POST("/api/admin/camps/{campId}/payments", campID).
+					WithJSON(api.AdminPostPaymentJSONRequestBody{
+						Amount: random.PositiveInt(t),
+						UserId: random.AlphaNumericString(t, 32),
+					}).
+					WithHeader("X-Forwarded-User", adminUserID).
+					Expect().Status(http.StatusForbidden)
+			})
+		}
+	})
 }
 
 func TestAdminGetPayments(t *testing.T) {
@@ -161,7 +201,7 @@ func TestAdminGetPayments(t *testing.T) {
 
 		// 最初のpaymentをチェック
 		payment1 := res.Value(0).Object()
-		payment1.Keys().ContainsOnly("id", "amount", "amountPaid", "userId", "campId")
+		payment1.Keys().ContainsOnly("id", "amount", "amountPaid", "referenceCode", "userId", "campId")
 		payment1.Value("id").Number().IsEqual(1)
 		payment1.Value("amount").Number().IsEqual(1000)
 		payment1.Value("amountPaid").Number().IsEqual(500)
@@ -170,7 +210,7 @@ func TestAdminGetPayments(t *testing.T) {
 
 		// 2番目のpaymentをチェック
 		payment2 := res.Value(1).Object()
-		payment2.Keys().ContainsOnly("id", "amount", "amountPaid", "userId", "campId")
+		payment2.Keys().ContainsOnly("id", "amount", "amountPaid", "referenceCode", "userId", "campId")
 		payment2.Value("id").Number().IsEqual(2)
 		payment2.Value("amount").Number().IsEqual(2000)
 		payment2.Value("amountPaid").Number().IsEqual(1500)
@@ -229,22 +269,23 @@ func TestServer_AdminPutPayment(t *testing.T) {
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
 		campID := random.PositiveInt(t)
+		amountPaid := random.PositiveInt(t)
 		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     2000,
-			AmountPaid: 1500,
+			Amount:     random.PositiveInt(t),
+			AmountPaid: &amountPaid,
 			UserId:     random.AlphaNumericString(t, 32),
 		}
 		beforePayment := &model.Payment{
 			Model:      gorm.Model{ID: uint(paymentID)},
 			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
+			AmountPaid: amountPaid,
 			UserID:     req.UserId,
 			CampID:     uint(campID),
 		}
 		updatedPayment := &model.Payment{
 			Model:      gorm.Model{ID: uint(paymentID)},
 			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
+			AmountPaid: amountPaid,
 			UserID:     req.UserId,
 			CampID:     uint(campID),
 		}
@@ -255,13 +296,21 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			Return(&model.User{
 				IsStaff: true,
 			}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
+			Times(1)
 		gomock.InOrder(
 			h.repo.MockPaymentRepository.EXPECT().
 				GetPaymentByID(gomock.Any(), uint(paymentID)).
 				Return(beforePayment, nil),
 			h.repo.MockPaymentRepository.EXPECT().
 				UpdatePayment(gomock.Any(), uint(paymentID), gomock.Any()).
-				Return(nil),
+				DoAndReturn(func(_ any, _ uint, payment *model.Payment) error {
+					assert.Equal(t, req.Amount, payment.Amount)
+
+					return nil
+				}),
 			h.repo.MockPaymentRepository.EXPECT().
 				GetPaymentByID(gomock.Any(), uint(paymentID)).
 				Return(updatedPayment, nil),
@@ -272,21 +321,16 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			Return(nil).
 			Times(1)
 
-		h.activityService.EXPECT().
-			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(nil).
-			Times(1)
-
 		res := h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
 			WithJSON(req).
 			WithHeader("X-Forwarded-User", adminUserID).
 			Expect().Status(http.StatusOK).JSON().Object()
 
 		res.Keys().ContainsOnly(
-			"id", "amount", "amountPaid", "userId", "campId")
+			"id", "amount", "amountPaid", "referenceCode", "userId", "campId")
 		res.Value("id").Number().IsEqual(paymentID)
 		res.Value("amount").Number().IsEqual(req.Amount)
-		res.Value("amountPaid").Number().IsEqual(req.AmountPaid)
+		res.Value("amountPaid").Number().IsEqual(amountPaid)
 		res.Value("userId").String().IsEqual(req.UserId)
 		res.Value("campId").Number().IsEqual(campID)
 	})
@@ -298,21 +342,13 @@ func TestServer_AdminPutPayment(t *testing.T) {
 		paymentID := random.PositiveInt(t)
 		campID := random.PositiveInt(t)
 		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
-		}
-		beforePayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
+			Amount: random.PositiveInt(t),
+			UserId: random.AlphaNumericString(t, 32),
 		}
-		updatedPayment := &model.Payment{
+		payment := &model.Payment{
 			Model:      gorm.Model{ID: uint(paymentID)},
 			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
+			AmountPaid: random.PositiveInt(t),
 			UserID:     req.UserId,
 			CampID:     uint(campID),
 		}
@@ -323,16 +359,20 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			Return(&model.User{
 				IsStaff: true,
 			}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
+			Times(1)
 		gomock.InOrder(
 			h.repo.MockPaymentRepository.EXPECT().
 				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(beforePayment, nil),
+				Return(payment, nil),
 			h.repo.MockPaymentRepository.EXPECT().
 				UpdatePayment(gomock.Any(), uint(paymentID), gomock.Any()).
 				Return(nil),
 			h.repo.MockPaymentRepository.EXPECT().
 				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(updatedPayment, nil),
+				Return(payment, nil),
 		)
 
 		res := h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
@@ -340,40 +380,22 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			WithHeader("X-Forwarded-User", adminUserID).
 			Expect().Status(http.StatusOK).JSON().Object()
 
-		res.Keys().ContainsOnly(
-			"id", "amount", "amountPaid", "userId", "campId")
-		res.Value("id").Number().IsEqual(paymentID)
 		res.Value("amount").Number().IsEqual(req.Amount)
-		res.Value("amountPaid").Number().IsEqual(req.AmountPaid)
-		res.Value("userId").String().IsEqual(req.UserId)
-		res.Value("campId").Number().IsEqual(campID)
+		// amountPaidを省略した場合は支払済み金額を変えない
+		res.Value("amountPaid").Number().IsEqual(payment.AmountPaid)
 	})
 
-	t.Run("amountのみ変更されたとき", func(t *testing.T) {
+	t.Run("支払済み金額が現在の値と異なるとき", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
-		campID := random.PositiveInt(t)
+		amountPaid := random.PositiveInt(t)
 		req := api.AdminPutPaymentJSONRequestBody{
 			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
+			AmountPaid: &amountPaid,
 			UserId:     random.AlphaNumericString(t, 32),
 		}
-		beforePayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     random.PositiveInt(t),
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
-		}
-		updatedPayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
-		}
 		adminUserID := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
@@ -381,61 +403,47 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			Return(&model.User{
 				IsStaff: true,
 			}, nil)
-		gomock.InOrder(
-			h.repo.MockPaymentRepository.EXPECT().
-				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(beforePayment, nil),
-			h.repo.MockPaymentRepository.EXPECT().
-				UpdatePayment(gomock.Any(), uint(paymentID), gomock.Any()).
-				Return(nil),
-			h.repo.MockPaymentRepository.EXPECT().
-				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(updatedPayment, nil),
-		)
-
-		h.activityService.EXPECT().
-			RecordPaymentAmountChanged(gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(nil).
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
 			Times(1)
+		// 古い支払い情報を元にした更新で入出金の記録が打ち消されないようにする
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&model.Payment{
+				Model:      gorm.Model{ID: uint(paymentID)},
+				Amount:     req.Amount,
+				AmountPaid: amountPaid + 1000,
+				UserID:     req.UserId,
+			}, nil)
 
-		res := h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
 			WithJSON(req).
 			WithHeader("X-Forwarded-User", adminUserID).
-			Expect().Status(http.StatusOK).JSON().Object()
-
-		res.Keys().ContainsOnly(
-			"id", "amount", "amountPaid", "userId", "campId")
-		res.Value("id").Number().IsEqual(paymentID)
-		res.Value("amount").Number().IsEqual(req.Amount)
-		res.Value("amountPaid").Number().IsEqual(req.AmountPaid)
-		res.Value("userId").String().IsEqual(req.UserId)
-		res.Value("campId").Number().IsEqual(campID)
+			Expect().Status(http.StatusConflict)
 	})
 
-	t.Run("amountPaidのみ変更されたとき", func(t *testing.T) {
+	t.Run("RecordPaymentAmountChangedでエラーが起きたとき", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
 		campID := random.PositiveInt(t)
 		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
+			Amount: random.PositiveInt(t),
+			UserId: random.AlphaNumericString(t, 32),
 		}
 		beforePayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: random.PositiveInt(t),
-			UserID:     req.UserId,
-			CampID:     uint(campID),
+			Model:  gorm.Model{ID: uint(paymentID)},
+			Amount: random.PositiveInt(t),
+			UserID: req.UserId,
+			CampID: uint(campID),
 		}
 		updatedPayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
+			Model:  gorm.Model{ID: uint(paymentID)},
+			Amount: req.Amount,
+			UserID: req.UserId,
+			CampID: uint(campID),
 		}
 		adminUserID := random.AlphaNumericString(t, 32)
 
@@ -444,6 +452,10 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			Return(&model.User{
 				IsStaff: true,
 			}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
+			Times(1)
 		gomock.InOrder(
 			h.repo.MockPaymentRepository.EXPECT().
 				GetPaymentByID(gomock.Any(), uint(paymentID)).
@@ -457,156 +469,256 @@ func TestServer_AdminPutPayment(t *testing.T) {
 		)
 
 		h.activityService.EXPECT().
-			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(nil).
+			RecordPaymentAmountChanged(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("activity error")).
 			Times(1)
 
-		res := h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
 			WithJSON(req).
 			WithHeader("X-Forwarded-User", adminUserID).
-			Expect().Status(http.StatusOK).JSON().Object()
-
-		res.Keys().ContainsOnly(
-			"id", "amount", "amountPaid", "userId", "campId")
-		res.Value("id").Number().IsEqual(paymentID)
-		res.Value("amount").Number().IsEqual(req.Amount)
-		res.Value("amountPaid").Number().IsEqual(req.AmountPaid)
-		res.Value("userId").String().IsEqual(req.UserId)
-		res.Value("campId").Number().IsEqual(campID)
+			Expect().Status(http.StatusInternalServerError)
 	})
 
-	t.Run("RecordPaymentAmountChangedでエラーが起きたとき", func(t *testing.T) {
+	t.Run("Forbidden", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
-		campID := random.PositiveInt(t)
 		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
+			Amount: 2000,
+			UserId: random.AlphaNumericString(t, 32),
 		}
-		beforePayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     random.PositiveInt(t),
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
-		}
-		updatedPayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{
+				IsStaff: false, // 管理者ではないユーザー
+			}, nil)
+
+		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().Status(http.StatusForbidden)
+	})
+
+	t.Run("Payment Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		req := api.AdminPutPaymentJSONRequestBody{
+			Amount: random.PositiveInt(t),
+			UserId: random.AlphaNumericString(t, 32),
 		}
 		adminUserID := random.AlphaNumericString(t, 32)
 
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{
+				IsStaff: true,
+			}, nil).Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(nil, repository.ErrPaymentNotFound).
+			Times(1)
+
+		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().Status(http.StatusNotFound)
+	})
+
+	t.Run("Bad Request", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), adminUserID).
 			Return(&model.User{
 				IsStaff: true,
 			}, nil)
-		gomock.InOrder(
-			h.repo.MockPaymentRepository.EXPECT().
-				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(beforePayment, nil),
-			h.repo.MockPaymentRepository.EXPECT().
-				UpdatePayment(gomock.Any(), uint(paymentID), gomock.Any()).
-				Return(nil),
-			h.repo.MockPaymentRepository.EXPECT().
-				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(updatedPayment, nil),
-		)
 
-		h.activityService.EXPECT().
-			RecordPaymentAmountChanged(gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(errors.New("activity error")).
+		// 不正なJSONリクエスト
+		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+			WithHeader("Content-Type", "application/json").
+			WithText("invalid json").
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().Status(http.StatusBadRequest)
+	})
+
+	t.Run("明細の合計と異なる金額に変更しようとしたとき", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		paymentID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		req := api.AdminPutPaymentJSONRequestBody{
+			Amount: 5000,
+			UserId: random.AlphaNumericString(t, 32),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{
+				IsStaff: true,
+			}, nil).Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), gomock.Any()).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&model.Payment{
+				Model:  gorm.Model{ID: uint(paymentID)},
+				Amount: 10000,
+				UserID: req.UserId,
+				LineItems: []model.PaymentLineItem{
+					{Category: model.PaymentLineItemCategoryLodging, Amount: 10000},
+				},
+			}, nil).
 			Times(1)
 
 		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
 			WithJSON(req).
 			WithHeader("X-Forwarded-User", adminUserID).
-			Expect().Status(http.StatusInternalServerError)
+			Expect().Status(http.StatusBadRequest)
 	})
+}
 
-	t.Run("RecordPaymentPaidChangedでエラーが起きたとき", func(t *testing.T) {
+func TestServer_AdminPutPaymentLineItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
 		campID := random.PositiveInt(t)
-		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
+		adminUserID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		req := api.AdminPutPaymentLineItemsJSONRequestBody{
+			{
+				Category:    api.PaymentLineItemCategoryLodging,
+				Description: "宿泊費",
+				Amount:      10000,
+			},
+			{
+				Category:    api.PaymentLineItemCategoryDiscount,
+				Description: "早期割引",
+				Amount:      -1000,
+			},
 		}
-		beforePayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: random.PositiveInt(t),
-			UserID:     req.UserId,
-			CampID:     uint(campID),
+		beforePayment := model.Payment{
+			Model:  gorm.Model{ID: uint(paymentID)},
+			Amount: 8000,
+			UserID: userID,
+			CampID: uint(campID),
 		}
-		updatedPayment := &model.Payment{
-			Model:      gorm.Model{ID: uint(paymentID)},
-			Amount:     req.Amount,
-			AmountPaid: req.AmountPaid,
-			UserID:     req.UserId,
-			CampID:     uint(campID),
+		updatedPayment := model.Payment{
+			Model:  beforePayment.Model,
+			Amount: 9000,
+			UserID: userID,
+			CampID: uint(campID),
+			LineItems: []model.PaymentLineItem{
+				{
+					ID:          uint(random.PositiveInt(t)),
+					PaymentID:   uint(paymentID),
+					Category:    model.PaymentLineItemCategoryLodging,
+					Description: "宿泊費",
+					Amount:      10000,
+				},
+				{
+					ID:          uint(random.PositiveInt(t)),
+					PaymentID:   uint(paymentID),
+					Category:    model.PaymentLineItemCategoryDiscount,
+					Description: "早期割引",
+					Amount:      -1000,
+				},
+			},
 		}
-		adminUserID := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), adminUserID).
 			Return(&model.User{
 				IsStaff: true,
-			}, nil)
-		gomock.InOrder(
-			h.repo.MockPaymentRepository.EXPECT().
-				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(beforePayment, nil),
-			h.repo.MockPaymentRepository.EXPECT().
-				UpdatePayment(gomock.Any(), uint(paymentID), gomock.Any()).
-				Return(nil),
-			h.repo.MockPaymentRepository.EXPECT().
-				GetPaymentByID(gomock.Any(), uint(paymentID)).
-				Return(updatedPayment, nil),
-		)
-
+			}, nil).Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&beforePayment, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), beforePayment.CampID).
+			Return(&model.Camp{Status: model.CampStatusConfirmed}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			ReplacePaymentLineItems(gomock.Any(), uint(paymentID), []model.PaymentLineItem{
+				{
+					Category:    model.PaymentLineItemCategoryLodging,
+					Description: "宿泊費",
+					Amount:      10000,
+				},
+				{
+					Category:    model.PaymentLineItemCategoryDiscount,
+					Description: "早期割引",
+					Amount:      -1000,
+				},
+			}).
+			Return(nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&updatedPayment, nil).
+			Times(1)
 		h.activityService.EXPECT().
-			RecordPaymentPaidChanged(gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(errors.New("activity error")).
+			RecordPaymentAmountChanged(gomock.Any(), gomock.Any(), updatedPayment).
+			Return(nil).
 			Times(1)
 
-		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+		res := h.expect.PUT("/api/admin/payments/{paymentId}/line-items", paymentID).
 			WithJSON(req).
 			WithHeader("X-Forwarded-User", adminUserID).
-			Expect().Status(http.StatusInternalServerError)
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("id", "amount", "amountPaid", "referenceCode", "userId", "campId", "lineItems")
+		res.Value("amount").Number().IsEqual(9000)
+
+		lineItems := res.Value("lineItems").Array()
+
+		lineItems.Length().IsEqual(2)
+		lineItems.Value(0).Object().Value("category").String().IsEqual(string(api.PaymentLineItemCategoryLodging))
+		lineItems.Value(1).Object().Value("amount").Number().IsEqual(-1000)
 	})
 
-	t.Run("Forbidden", func(t *testing.T) {
+	t.Run("Invalid amount", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
-		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     2000,
-			AmountPaid: 1500,
-			UserId:     random.AlphaNumericString(t, 32),
+		adminUserID := random.AlphaNumericString(t, 32)
+		req := api.AdminPutPaymentLineItemsJSONRequestBody{
+			{
+				Category: api.PaymentLineItemCategoryDiscount,
+				Amount:   random.PositiveInt(t),
+			},
 		}
-		userID := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
-			GetOrCreateUser(gomock.Any(), userID).
+			GetOrCreateUser(gomock.Any(), adminUserID).
 			Return(&model.User{
-				IsStaff: false, // 管理者ではないユーザー
-			}, nil)
+				IsStaff: true,
+			}, nil).Times(1)
 
-		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
+		h.expect.PUT("/api/admin/payments/{paymentId}/line-items", paymentID).
 			WithJSON(req).
-			WithHeader("X-Forwarded-User", userID).
-			Expect().Status(http.StatusForbidden)
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().Status(http.StatusBadRequest)
 	})
 
 	t.Run("Payment Not Found", func(t *testing.T) {
@@ -614,11 +726,6 @@ func TestServer_AdminPutPayment(t *testing.T) {
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
-		req := api.AdminPutPaymentJSONRequestBody{
-			Amount:     random.PositiveInt(t),
-			AmountPaid: random.PositiveInt(t),
-			UserId:     random.AlphaNumericString(t, 32),
-		}
 		adminUserID := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
@@ -631,30 +738,55 @@ func TestServer_AdminPutPayment(t *testing.T) {
 			Return(nil, repository.ErrPaymentNotFound).
 			Times(1)
 
-		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
-			WithJSON(req).
+		h.expect.PUT("/api/admin/payments/{paymentId}/line-items", paymentID).
+			WithJSON(api.AdminPutPaymentLineItemsJSONRequestBody{}).
 			WithHeader("X-Forwarded-User", adminUserID).
 			Expect().Status(http.StatusNotFound)
 	})
 
-	t.Run("Bad Request", func(t *testing.T) {
+	t.Run("Camp not accepting payments", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		paymentID := random.PositiveInt(t)
+		campID := uint(random.PositiveInt(t))
 		adminUserID := random.AlphaNumericString(t, 32)
 
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockPaymentRepository.EXPECT().
+			GetPaymentByID(gomock.Any(), uint(paymentID)).
+			Return(&model.Payment{Model: gorm.Model{ID: uint(paymentID)}, CampID: campID}, nil).
+			Times(1)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(&model.Camp{Status: model.CampStatusArchived}, nil).
+			Times(1)
+
+		h.expect.PUT("/api/admin/payments/{paymentId}/line-items", paymentID).
+			WithJSON(api.AdminPutPaymentLineItemsJSONRequestBody{}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
 			Return(&model.User{
-				IsStaff: true,
+				IsStaff: false,
 			}, nil)
 
-		// 不正なJSONリクエスト
-		h.expect.PUT("/api/admin/payments/{paymentId}", paymentID).
-			WithHeader("Content-Type", "application/json").
-			WithText("invalid json").
-			WithHeader("X-Forwarded-User", adminUserID).
-			Expect().Status(http.StatusBadRequest)
+		h.expect.PUT("/api/admin/payments/{paymentId}/line-items", random.PositiveInt(t)).
+			WithJSON(api.AdminPutPaymentLineItemsJSONRequestBody{}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().Status(http.StatusForbidden)
 	})
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 func (s *Server) GetQuestionGroups(e echo.Context, campID api.CampId) error {
@@ -68,7 +69,7 @@ func (s *Server) AdminPostQuestionGroup(
 	ctx := e.Request().Context()
 
 	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
-		if err := tx.CreateQuestionGroup(&questionGroup); err != nil {
+		if err := tx.CreateQuestionGroup(ctx, &questionGroup); err != nil {
 			return fmt.Errorf("failed to create question group: %w", err)
 		}
 
@@ -81,6 +82,8 @@ func (s *Server) AdminPostQuestionGroup(
 			SetInternal(err)
 	}
 
+	s.eventBus.Publish(questionGroup.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
 	res, err := converter.Convert[api.QuestionGroupResponse](questionGroup)
 
 	if err != nil {
@@ -172,3 +175,67 @@ func (s *Server) AdminDeleteQuestionGroup(
 
 	return e.NoContent(http.StatusNoContent)
 }
+
+func (s *Server) AdminReorderQuestionGroups(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminReorderQuestionGroupsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminReorderQuestionGroupsJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := s.repo.ReorderQuestionGroups(ctx, uint(campID), toUintIDs(req.Ids)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		if errors.Is(err, repository.ErrInvalidSortOrder) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to reorder question groups: %w", err))
+	}
+
+	questionGroups, err := s.repo.GetQuestionGroups(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question groups: %w", err))
+	}
+
+	res, err := converter.Convert[[]api.QuestionGroupResponse](questionGroups)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert response body: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// toUintIDs はリクエストのIDをリポジトリで扱う型に変換する
+func toUintIDs(ids []int) []uint {
+	uintIDs := make([]uint, len(ids))
+
+	for i, id := range ids {
+		uintIDs[i] = uint(id)
+	}
+
+	return uintIDs
+}
@@ -253,7 +253,7 @@ func TestAdminPostQuestionGroup(t *testing.T) {
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil)
-		h.repo.MockQuestionGroupRepository.EXPECT().CreateQuestionGroup(gomock.Any()).Return(nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().CreateQuestionGroup(gomock.Any(), gomock.Any()).Return(nil)
 		h.activityService.EXPECT().
 			RecordQuestionCreated(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
@@ -404,7 +404,7 @@ func TestAdminPostQuestionGroup(t *testing.T) {
 			Times(1)
 
 		h.repo.MockQuestionGroupRepository.EXPECT().
-			CreateQuestionGroup(gomock.Any()).
+			CreateQuestionGroup(gomock.Any(), gomock.Any()).
 			Return(repository.ErrCampNotFound)
 
 		h.expect.POST("/api/admin/camps/{campId}/question-groups", campID).
@@ -442,7 +442,7 @@ func TestAdminPostQuestionGroup(t *testing.T) {
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil)
-		h.repo.MockQuestionGroupRepository.EXPECT().CreateQuestionGroup(gomock.Any()).Return(nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().CreateQuestionGroup(gomock.Any(), gomock.Any()).Return(nil)
 		h.activityService.EXPECT().
 			RecordQuestionCreated(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(errors.New("activity error")).
@@ -511,3 +511,131 @@ func TestAdminPutQuestionGroupMetadata(t *testing.T) {
 		res.Value("due").String().IsEqual(updateQuestionGroup.Due.Format(time.DateOnly))
 	})
 }
+
+func TestAdminReorderQuestionGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		questionGroup1 := model.QuestionGroup{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:   random.AlphaNumericString(t, 20),
+			Due:    random.Time(t),
+			CampID: uint(campID),
+		}
+		questionGroup2 := model.QuestionGroup{
+			Model:  gorm.Model{ID: questionGroup1.ID + 1},
+			Name:   random.AlphaNumericString(t, 20),
+			Due:    random.Time(t),
+			CampID: uint(campID),
+		}
+		req := api.AdminReorderQuestionGroupsJSONRequestBody{
+			Ids: []int{int(questionGroup2.ID), int(questionGroup1.ID)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			ReorderQuestionGroups(
+				gomock.Any(),
+				uint(campID),
+				[]uint{questionGroup2.ID, questionGroup1.ID},
+			).
+			Return(nil).
+			Times(1)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroups(gomock.Any(), uint(campID)).
+			Return([]model.QuestionGroup{questionGroup2, questionGroup1}, nil).
+			Times(1)
+
+		res := h.expect.PUT("/api/admin/camps/{campId}/question-groups/order", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusOK).JSON().Array()
+
+		res.Length().IsEqual(2)
+		res.Value(0).Object().Value("id").Number().IsEqual(questionGroup2.ID)
+		res.Value(1).Object().Value("id").Number().IsEqual(questionGroup1.ID)
+	})
+
+	t.Run("Invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		req := api.AdminReorderQuestionGroupsJSONRequestBody{
+			Ids: []int{random.PositiveInt(t)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			ReorderQuestionGroups(gomock.Any(), uint(campID), gomock.Any()).
+			Return(repository.ErrInvalidSortOrder).
+			Times(1)
+
+		h.expect.PUT("/api/admin/camps/{campId}/question-groups/order", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		campID := random.PositiveInt(t)
+		req := api.AdminReorderQuestionGroupsJSONRequestBody{
+			Ids: []int{},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			ReorderQuestionGroups(gomock.Any(), uint(campID), gomock.Any()).
+			Return(repository.ErrCampNotFound).
+			Times(1)
+
+		h.expect.PUT("/api/admin/camps/{campId}/question-groups/order", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusNotFound).JSON().Object().
+			Value("message").String().IsEqual("Camp not found")
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		req := api.AdminReorderQuestionGroupsJSONRequestBody{
+			Ids: []int{random.PositiveInt(t)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.PUT("/api/admin/camps/{campId}/question-groups/order", random.PositiveInt(t)).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
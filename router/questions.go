@@ -10,6 +10,7 @@ import (
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
 )
 
 func (s *Server) AdminDeleteQuestion(
@@ -69,9 +70,13 @@ func (s *Server) AdminPostQuestion(
 			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
 	}
 
+	if err := validateQuestion(&question); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	question.QuestionGroupID = uint(questionGroupID)
 
-	if err := s.repo.CreateQuestion(&question); err != nil {
+	if err := s.repo.CreateQuestion(e.Request().Context(), &question); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to create question (questionGroupId: %d): %w", questionGroupID, err))
 	}
@@ -115,6 +120,10 @@ func (s *Server) AdminPutQuestion(
 			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
 	}
 
+	if err := validateQuestion(&requestQuestion); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// 既存の質問を取得
 	existingQuestion, err := s.repo.GetQuestionByID(uint(questionID))
 
@@ -143,6 +152,13 @@ func (s *Server) AdminPutQuestion(
 			return echo.NewHTTPError(http.StatusNotFound, "Question not found")
 		}
 
+		if errors.Is(err, repository.ErrOptionNotFound) {
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				"options must belong to the question",
+			)
+		}
+
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to update question (questionId: %d): %w", questionID, err))
 	}
@@ -156,3 +172,218 @@ func (s *Server) AdminPutQuestion(
 
 	return e.JSON(http.StatusOK, &res)
 }
+
+func (s *Server) AdminReorderQuestions(
+	e echo.Context,
+	questionGroupID api.QuestionGroupId,
+	params api.AdminReorderQuestionsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user (userId: %s): %w", *params.XForwardedUser, err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminReorderQuestionsJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := s.repo.ReorderQuestions(
+		ctx,
+		uint(questionGroupID),
+		toUintIDs(req.Ids),
+	); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question group not found")
+		}
+
+		if errors.Is(err, repository.ErrInvalidSortOrder) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to reorder questions (questionGroupId: %d): %w", questionGroupID, err))
+	}
+
+	questionGroup, err := s.repo.GetQuestionGroup(ctx, uint(questionGroupID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question group (questionGroupId: %d): %w", questionGroupID, err))
+	}
+
+	res, err := converter.Convert[[]api.QuestionResponse](questionGroup.Questions)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+func (s *Server) AdminReorderOptions(
+	e echo.Context,
+	questionID api.QuestionId,
+	params api.AdminReorderOptionsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user (userId: %s): %w", *params.XForwardedUser, err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminReorderOptionsJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := s.repo.ReorderOptions(ctx, uint(questionID), toUintIDs(req.Ids)); err != nil {
+		if errors.Is(err, repository.ErrQuestionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question not found")
+		}
+
+		if errors.Is(err, repository.ErrInvalidSortOrder) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to reorder options (questionId: %d): %w", questionID, err))
+	}
+
+	questionIDUint := uint(questionID)
+	options, err := s.repo.GetOptions(&repository.GetOptionsQuery{QuestionID: &questionIDUint})
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get options (questionId: %d): %w", questionID, err))
+	}
+
+	res, err := converter.Convert[[]api.OptionResponse](options)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+func (s *Server) AdminMergeOptions(
+	e echo.Context,
+	questionID api.QuestionId,
+	params api.AdminMergeOptionsParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user (userId: %s): %w", *params.XForwardedUser, err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminMergeOptionsJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if req.SourceOptionId == req.TargetOptionId {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			"sourceOptionId and targetOptionId must be different",
+		)
+	}
+
+	if err := s.repo.MergeOptions(
+		ctx,
+		uint(questionID),
+		uint(req.SourceOptionId),
+		uint(req.TargetOptionId),
+		user.ID,
+	); err != nil {
+		if errors.Is(err, repository.ErrQuestionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question not found")
+		}
+
+		if errors.Is(err, repository.ErrOptionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Option not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to merge options (questionId: %d): %w", questionID, err))
+	}
+
+	question, err := s.repo.GetQuestionByID(uint(questionID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get question (questionId: %d): %w", questionID, err))
+	}
+
+	res, err := converter.Convert[api.QuestionResponse](question)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, &res)
+}
+
+func (s *Server) AdminGetFlaggedAnswers(
+	e echo.Context,
+	questionID api.QuestionId,
+	params api.AdminGetFlaggedAnswersParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user (userId: %s): %w", *params.XForwardedUser, err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	flags, err := s.repo.GetAnswerFlags(ctx, uint(questionID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrQuestionNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get flagged answers (questionId: %d): %w", questionID, err))
+	}
+
+	res, err := converter.Convert[[]api.FlaggedAnswerResponse](flags)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
@@ -6,9 +6,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
 	"github.com/traPtitech/rucQ/testutil/random"
 )
 
@@ -46,7 +48,7 @@ func TestAdminPostQuestion(t *testing.T) {
 			IsStaff: true,
 		}, nil).Times(1)
 		h.repo.MockQuestionRepository.EXPECT().
-			CreateQuestion(gomock.Any()).
+			CreateQuestion(gomock.Any(), gomock.Any()).
 			Return(nil).
 			Times(1)
 
@@ -89,6 +91,91 @@ func TestAdminPostQuestion(t *testing.T) {
 	})
 }
 
+func TestAdminPostQuestion_Scale(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(t *testing.T, scaleMin, scaleMax int) api.PostQuestionRequest {
+		t.Helper()
+
+		var req api.PostQuestionRequest
+
+		require.NoError(t, req.FromScaleQuestionRequest(api.ScaleQuestionRequest{
+			Type:          api.ScaleQuestionRequestTypeScale,
+			Title:         random.AlphaNumericString(t, 10),
+			IsPublic:      random.Bool(t),
+			IsOpen:        random.Bool(t),
+			ScaleMin:      scaleMin,
+			ScaleMax:      scaleMax,
+			ScaleMinLabel: random.PtrOrNil(t, random.AlphaNumericString(t, 10)),
+			ScaleMaxLabel: random.PtrOrNil(t, random.AlphaNumericString(t, 10)),
+		}))
+
+		return req
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		req := newRequest(t, 1, 5)
+		scaleQuestion, err := req.AsScaleQuestionRequest()
+
+		require.NoError(t, err)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			CreateQuestion(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		res := h.expect.POST(
+			"/api/admin/question-groups/{questionGroupID}/questions",
+			random.PositiveInt(t),
+		).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object()
+
+		res.Value("type").IsEqual(api.ScaleQuestionRequestTypeScale)
+		res.Value("scaleMin").Number().IsEqual(1)
+		res.Value("scaleMax").Number().IsEqual(5)
+
+		if scaleQuestion.ScaleMinLabel != nil {
+			res.Value("scaleMinLabel").IsEqual(*scaleQuestion.ScaleMinLabel)
+		} else {
+			res.Keys().NotContainsAny("scaleMinLabel")
+		}
+	})
+
+	t.Run("BadRequest - Invalid range", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+
+		h.expect.POST(
+			"/api/admin/question-groups/{questionGroupID}/questions",
+			random.PositiveInt(t),
+		).
+			WithJSON(newRequest(t, 5, 5)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+}
+
 func TestAdminPutQuestion(t *testing.T) {
 	t.Parallel()
 
@@ -209,4 +296,525 @@ func TestAdminPutQuestion(t *testing.T) {
 			String().
 			IsEqual("question type cannot be changed")
 	})
+	t.Run("Failure - Option of another question", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		questionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		req := api.PutSingleChoiceQuestionRequest{
+			Type:     api.PutSingleChoiceQuestionRequestTypeSingle,
+			Title:    random.AlphaNumericString(t, 15),
+			IsPublic: random.Bool(t),
+			IsOpen:   random.Bool(t),
+			Options: []api.PutOptionRequest{
+				{
+					Id:      random.PositiveInt(t),
+					Content: random.AlphaNumericString(t, 10),
+				},
+			},
+		}
+
+		h.repo.MockUserRepository.EXPECT().GetOrCreateUser(gomock.Any(), userID).Return(&model.User{
+			IsStaff: true,
+		}, nil).Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(&model.Question{
+				Type: model.SingleChoiceQuestion,
+			}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			UpdateQuestion(gomock.Any(), questionID, gomock.Any()).
+			Return(repository.ErrOptionNotFound).
+			Times(1)
+
+		h.expect.PUT("/api/admin/questions/{questionID}", questionID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusBadRequest).
+			JSON().
+			Object().
+			Value("message").
+			String().
+			IsEqual("options must belong to the question")
+	})
+}
+
+func TestAdminReorderQuestions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		questionGroupID := uint(random.PositiveInt(t))
+		question1 := model.Question{
+			Model:           gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:            model.FreeTextQuestion,
+			Title:           random.AlphaNumericString(t, 20),
+			QuestionGroupID: questionGroupID,
+		}
+		question2 := model.Question{
+			Model:           gorm.Model{ID: question1.ID + 1},
+			Type:            model.FreeNumberQuestion,
+			Title:           random.AlphaNumericString(t, 20),
+			QuestionGroupID: questionGroupID,
+		}
+		req := api.AdminReorderQuestionsJSONRequestBody{
+			Ids: []int{int(question2.ID), int(question1.ID)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			ReorderQuestions(
+				gomock.Any(),
+				questionGroupID,
+				[]uint{question2.ID, question1.ID},
+			).
+			Return(nil).
+			Times(1)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), questionGroupID).
+			Return(&model.QuestionGroup{
+				Model:     gorm.Model{ID: questionGroupID},
+				Questions: []model.Question{question2, question1},
+			}, nil).
+			Times(1)
+
+		res := h.expect.PUT(
+			"/api/admin/question-groups/{questionGroupId}/questions/order",
+			questionGroupID,
+		).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusOK).JSON().Array()
+
+		res.Length().IsEqual(2)
+		res.Value(0).Object().Value("id").Number().IsEqual(question2.ID)
+		res.Value(0).Object().Value("type").String().IsEqual(string(question2.Type))
+		res.Value(1).Object().Value("id").Number().IsEqual(question1.ID)
+	})
+
+	t.Run("Invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		questionGroupID := random.PositiveInt(t)
+		req := api.AdminReorderQuestionsJSONRequestBody{
+			Ids: []int{random.PositiveInt(t), random.PositiveInt(t)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			ReorderQuestions(gomock.Any(), uint(questionGroupID), gomock.Any()).
+			Return(repository.ErrInvalidSortOrder).
+			Times(1)
+
+		h.expect.PUT(
+			"/api/admin/question-groups/{questionGroupId}/questions/order",
+			questionGroupID,
+		).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Question group not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		questionGroupID := random.PositiveInt(t)
+		req := api.AdminReorderQuestionsJSONRequestBody{
+			Ids: []int{},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			ReorderQuestions(gomock.Any(), uint(questionGroupID), gomock.Any()).
+			Return(model.ErrNotFound).
+			Times(1)
+
+		h.expect.PUT(
+			"/api/admin/question-groups/{questionGroupId}/questions/order",
+			questionGroupID,
+		).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		req := api.AdminReorderQuestionsJSONRequestBody{
+			Ids: []int{random.PositiveInt(t)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.PUT(
+			"/api/admin/question-groups/{questionGroupId}/questions/order",
+			random.PositiveInt(t),
+		).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestAdminReorderOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		questionID := uint(random.PositiveInt(t))
+		option1 := model.Option{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			QuestionID: questionID,
+			Content:    random.AlphaNumericString(t, 20),
+		}
+		option2 := model.Option{
+			Model:      gorm.Model{ID: option1.ID + 1},
+			QuestionID: questionID,
+			Content:    random.AlphaNumericString(t, 20),
+		}
+		req := api.AdminReorderOptionsJSONRequestBody{
+			Ids: []int{int(option2.ID), int(option1.ID)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockOptionRepository.EXPECT().
+			ReorderOptions(gomock.Any(), questionID, []uint{option2.ID, option1.ID}).
+			Return(nil).
+			Times(1)
+		h.repo.MockOptionRepository.EXPECT().
+			GetOptions(&repository.GetOptionsQuery{QuestionID: &questionID}).
+			Return([]model.Option{option2, option1}, nil).
+			Times(1)
+
+		res := h.expect.PUT("/api/admin/questions/{questionId}/options/order", questionID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusOK).JSON().Array()
+
+		res.Length().IsEqual(2)
+		res.Value(0).Object().Value("id").Number().IsEqual(option2.ID)
+		res.Value(0).Object().Value("content").String().IsEqual(option2.Content)
+		res.Value(1).Object().Value("id").Number().IsEqual(option1.ID)
+	})
+
+	t.Run("Invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+		req := api.AdminReorderOptionsJSONRequestBody{
+			Ids: []int{random.PositiveInt(t)},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockOptionRepository.EXPECT().
+			ReorderOptions(gomock.Any(), uint(questionID), gomock.Any()).
+			Return(repository.ErrInvalidSortOrder).
+			Times(1)
+
+		h.expect.PUT("/api/admin/questions/{questionId}/options/order", questionID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		username := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+		req := api.AdminReorderOptionsJSONRequestBody{
+			Ids: []int{},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), username).
+			Return(&model.User{IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockOptionRepository.EXPECT().
+			ReorderOptions(gomock.Any(), uint(questionID), gomock.Any()).
+			Return(repository.ErrQuestionNotFound).
+			Times(1)
+
+		h.expect.PUT("/api/admin/questions/{questionId}/options/order", questionID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", username).
+			Expect().
+			Status(http.StatusNotFound).JSON().Object().
+			Value("message").String().IsEqual("Question not found")
+	})
+}
+
+func TestAdminMergeOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionID := uint(random.PositiveInt(t))
+		targetOption := model.Option{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			QuestionID: questionID,
+			Content:    random.AlphaNumericString(t, 10),
+		}
+		req := api.AdminMergeOptionsJSONRequestBody{
+			SourceOptionId: int(targetOption.ID) + 1,
+			TargetOptionId: int(targetOption.ID),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockOptionRepository.EXPECT().
+			MergeOptions(
+				gomock.Any(),
+				questionID,
+				uint(req.SourceOptionId),
+				uint(req.TargetOptionId),
+				userID,
+			).
+			Return(nil).
+			Times(1)
+		h.repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(&model.Question{
+				Model:   gorm.Model{ID: questionID},
+				Type:    model.SingleChoiceQuestion,
+				Title:   random.AlphaNumericString(t, 15),
+				Options: []model.Option{targetOption},
+			}, nil).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/questions/{questionId}/options/merge", questionID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Value("id").Number().IsEqual(questionID)
+
+		options := res.Value("options").Array()
+
+		options.Length().IsEqual(1)
+		options.Value(0).Object().Value("id").Number().IsEqual(targetOption.ID)
+	})
+
+	t.Run("Same option", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		optionID := random.PositiveInt(t)
+		req := api.AdminMergeOptionsJSONRequestBody{
+			SourceOptionId: optionID,
+			TargetOptionId: optionID,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/questions/{questionId}/options/merge", random.PositiveInt(t)).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Option not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+		req := api.AdminMergeOptionsJSONRequestBody{
+			SourceOptionId: random.PositiveInt(t),
+			TargetOptionId: 0,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockOptionRepository.EXPECT().
+			MergeOptions(gomock.Any(), uint(questionID), gomock.Any(), gomock.Any(), userID).
+			Return(repository.ErrOptionNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/questions/{questionId}/options/merge", questionID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			Value("message").
+			String().
+			IsEqual("Option not found")
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		req := api.AdminMergeOptionsJSONRequestBody{
+			SourceOptionId: random.PositiveInt(t),
+			TargetOptionId: 0,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/questions/{questionId}/options/merge", random.PositiveInt(t)).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestAdminGetFlaggedAnswers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionID := uint(random.PositiveInt(t))
+		removedOption := model.Option{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			QuestionID: questionID,
+			Content:    random.AlphaNumericString(t, 10),
+		}
+		flag := model.AnswerFlag{
+			ID: uint(random.PositiveInt(t)),
+			Answer: model.Answer{
+				Model:           gorm.Model{ID: uint(random.PositiveInt(t))},
+				QuestionID:      questionID,
+				UserID:          random.AlphaNumericString(t, 32),
+				Type:            model.SingleChoiceQuestion,
+				SelectedOptions: []model.Option{removedOption},
+			},
+			Option:    removedOption,
+			CreatedAt: random.Time(t),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerFlags(gomock.Any(), questionID).
+			Return([]model.AnswerFlag{flag}, nil).
+			Times(1)
+
+		res := h.expect.GET("/api/admin/questions/{questionId}/flagged-answers", questionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(1)
+
+		flagged := res.Value(0).Object()
+
+		flagged.Value("answer").Object().Value("id").Number().IsEqual(flag.Answer.ID)
+		flagged.Value("answer").Object().Value("userId").String().IsEqual(flag.Answer.UserID)
+		flagged.Value("removedOption").Object().Value("id").Number().IsEqual(removedOption.ID)
+		flagged.Value("removedOption").Object().
+			Value("content").String().IsEqual(removedOption.Content)
+		flagged.Value("flaggedAt").String().AsDateTime().IsEqual(flag.CreatedAt)
+	})
+
+	t.Run("Question not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		questionID := random.PositiveInt(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockAnswerRepository.EXPECT().
+			GetAnswerFlags(gomock.Any(), uint(questionID)).
+			Return(nil, repository.ErrQuestionNotFound).
+			Times(1)
+
+		h.expect.GET("/api/admin/questions/{questionId}/flagged-answers", questionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: false}, nil).
+			Times(1)
+
+		h.expect.GET("/api/admin/questions/{questionId}/flagged-answers", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
 }
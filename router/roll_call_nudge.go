@@ -0,0 +1,143 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// 同じ点呼に再通知できる間隔
+const rollCallNudgeInterval = 10 * time.Minute
+
+func (s *Server) AdminNudgeRollCall(
+	e echo.Context,
+	rollCallID api.RollCallId,
+	params api.AdminNudgeRollCallParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	rollCall, err := s.repo.GetRollCallByID(ctx, uint(rollCallID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
+	now := time.Now()
+
+	if !rollCall.IsOpen(now) {
+		return echo.NewHTTPError(http.StatusConflict, "Roll call is closed")
+	}
+
+	reactions, err := s.repo.GetRollCallReactions(ctx, rollCall.ID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call reactions: %w", err))
+	}
+
+	notRespondedSubjects := summarizeRollCall(rollCall, reactions).NotRespondedSubjects
+
+	// 全員が回答済みの場合は再通知の回数制限を消費しない
+	if len(notRespondedSubjects) == 0 {
+		return e.JSON(http.StatusOK, api.RollCallNudgeResponse{NudgedSubjects: notRespondedSubjects})
+	}
+
+	messages := rollCallMessages(
+		notRespondedSubjects,
+		rollCallMessageContent(rollCall, fmt.Sprintf("点呼「%s」にまだ回答していません", rollCall.Name)),
+		now,
+	)
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		if err := tx.MarkRollCallNudged(ctx, rollCall.ID, now, rollCallNudgeInterval); err != nil {
+			return err
+		}
+
+		return tx.CreateMessages(ctx, &messages)
+	}); err != nil {
+		if errors.Is(err, repository.ErrRollCallNudgedRecently) {
+			if rollCall.LastNudgedAt != nil {
+				retryAfter := rollCall.LastNudgedAt.Add(rollCallNudgeInterval).Sub(now)
+
+				e.Response().Header().Set(
+					"Retry-After",
+					strconv.Itoa(int(math.Ceil(max(retryAfter, 0).Seconds()))),
+				)
+			}
+
+			return echo.NewHTTPError(http.StatusTooManyRequests, "Roll call was nudged recently")
+		}
+
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to nudge roll call: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, api.RollCallNudgeResponse{NudgedSubjects: notRespondedSubjects})
+}
+
+// rollCallMessageContent は点呼の内容を伝えるDMの本文を作成する
+func rollCallMessageContent(rollCall *model.RollCall, heading string) string {
+	lines := []string{heading}
+
+	if rollCall.Description != "" {
+		lines = append(lines, rollCall.Description)
+	}
+
+	if len(rollCall.Options) > 0 {
+		lines = append(lines, "選択肢: "+strings.Join(rollCall.Options, " / "))
+	}
+
+	if rollCall.Deadline != nil {
+		lines = append(lines, "締め切り: "+rollCall.Deadline.In(model.JST).Format("1/2 15:04"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// rollCallMessages は対象者それぞれに送るDMを作成する。送信はスケジューラーが行う
+func rollCallMessages(userIDs []string, content string, sendAt time.Time) []model.Message {
+	messages := make([]model.Message, len(userIDs))
+
+	for i, userID := range userIDs {
+		messages[i] = model.Message{
+			TargetUserID: userID,
+			Content:      content,
+			SendAt:       sendAt,
+		}
+	}
+
+	return messages
+}
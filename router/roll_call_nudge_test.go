@@ -0,0 +1,210 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_AdminNudgeRollCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		respondedUserID := random.AlphaNumericString(t, 32)
+		notRespondedUserID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:     random.AlphaNumericString(t, 20),
+			Options:  []string{random.AlphaNumericString(t, 5)},
+			Subjects: []model.User{{ID: respondedUserID}, {ID: notRespondedUserID}},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{
+				{UserID: respondedUserID, RollCallID: rollCall.ID, Content: rollCall.Options[0]},
+			}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			MarkRollCallNudged(gomock.Any(), rollCall.ID, gomock.Any(), rollCallNudgeInterval).
+			Return(nil).
+			Times(1)
+		h.repo.MockMessageRepository.EXPECT().
+			CreateMessages(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, messages *[]model.Message) error {
+				if assert.Len(t, *messages, 1) {
+					assert.Equal(t, notRespondedUserID, (*messages)[0].TargetUserID)
+					assert.Contains(t, (*messages)[0].Content, rollCall.Name)
+				}
+
+				return nil
+			}).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/roll-calls/{rollCallId}/nudge", rollCall.ID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("nudgedSubjects")
+		res.Value("nudgedSubjects").Array().IsEqual([]string{notRespondedUserID})
+	})
+
+	t.Run("All subjects responded", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Subjects: []model.User{{ID: userID}},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{{UserID: userID, RollCallID: rollCall.ID}}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/nudge", rollCall.ID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("nudgedSubjects").Array().IsEmpty()
+	})
+
+	t.Run("Nudged recently", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		lastNudgedAt := time.Now().Add(-time.Minute)
+		rollCall := model.RollCall{
+			Model:        gorm.Model{ID: uint(random.PositiveInt(t))},
+			Subjects:     []model.User{{ID: random.AlphaNumericString(t, 32)}},
+			LastNudgedAt: &lastNudgedAt,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			MarkRollCallNudged(gomock.Any(), rollCall.ID, gomock.Any(), rollCallNudgeInterval).
+			Return(repository.ErrRollCallNudgedRecently).
+			Times(1)
+
+		res := h.expect.POST("/api/admin/roll-calls/{rollCallId}/nudge", rollCall.ID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusTooManyRequests)
+
+		res.Header("Retry-After").AsNumber().InRange(1, rollCallNudgeInterval.Seconds())
+	})
+
+	t.Run("Roll call closed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		closedAt := time.Now().Add(-time.Minute)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			ClosedAt: &closedAt,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/nudge", rollCall.ID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		staffID := random.AlphaNumericString(t, 32)
+		rollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), staffID).
+			Return(&model.User{ID: staffID, IsStaff: true}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(nil, repository.ErrRollCallNotFound).
+			Times(1)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/nudge", rollCallID).
+			WithHeader("X-Forwarded-User", staffID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("User not staff", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/nudge", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -0,0 +1,189 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+)
+
+// クライアントが再接続するまでの待ち時間(ミリ秒)
+const reactionStreamRetryMillis = 3000
+
+// reactionCursor はリアクションの変更の位置を表す。
+// 変更時刻はデータベースに合わせてミリ秒単位で扱い、同じ時刻の変更はリアクションのIDで順序を決める
+type reactionCursor struct {
+	changedAt  time.Time
+	reactionID uint
+}
+
+func newReactionCursor(changedAt time.Time, reactionID uint) reactionCursor {
+	return reactionCursor{
+		changedAt:  time.UnixMilli(changedAt.UnixMilli()),
+		reactionID: reactionID,
+	}
+}
+
+// reactionChangeCursor は保存されているリアクションが最後に変更された位置を返す
+func reactionChangeCursor(reaction model.RollCallReaction) reactionCursor {
+	if reaction.DeletedAt.Valid {
+		return newReactionCursor(reaction.DeletedAt.Time, reaction.ID)
+	}
+
+	return newReactionCursor(reaction.UpdatedAt, reaction.ID)
+}
+
+// parseReactionCursor はLast-Event-IDとして送られたイベントIDを読み取る
+func parseReactionCursor(s string) (reactionCursor, error) {
+	// 変更時刻が負の値になる場合も考慮して、最後の区切りで分割する
+	i := strings.LastIndex(s, "-")
+
+	if i <= 0 {
+		return reactionCursor{}, fmt.Errorf("invalid event ID: %q", s)
+	}
+
+	millis, id := s[:i], s[i+1:]
+
+	changedAt, err := strconv.ParseInt(millis, 10, 64)
+
+	if err != nil {
+		return reactionCursor{}, fmt.Errorf("invalid event ID: %q: %w", s, err)
+	}
+
+	reactionID, err := strconv.ParseUint(id, 10, 0)
+
+	if err != nil {
+		return reactionCursor{}, fmt.Errorf("invalid event ID: %q: %w", s, err)
+	}
+
+	return newReactionCursor(time.UnixMilli(changedAt), uint(reactionID)), nil
+}
+
+// String はSSEのidとして送るイベントIDを返す
+func (c reactionCursor) String() string {
+	return fmt.Sprintf("%d-%d", c.changedAt.UnixMilli(), c.reactionID)
+}
+
+// after はcがotherより後の変更を表すかを返す
+func (c reactionCursor) after(other reactionCursor) bool {
+	if !c.changedAt.Equal(other.changedAt) {
+		return c.changedAt.After(other.changedAt)
+	}
+
+	return c.reactionID > other.reactionID
+}
+
+// replayRollCallReactions はsinceより後のリアクションの変更をデータベースから取得して送信し、
+// 最後に送信した変更の位置を返す。送信するものがなかった場合はsinceをそのまま返す
+func (s *Server) replayRollCallReactions(
+	ctx context.Context,
+	res *echo.Response,
+	rollCall *model.RollCall,
+	since reactionCursor,
+	includeSummary bool,
+) (reactionCursor, error) {
+	changes, err := s.repo.GetRollCallReactionChanges(
+		ctx,
+		rollCall.ID,
+		since.changedAt,
+		since.reactionID,
+	)
+
+	if err != nil {
+		return since, echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call reaction changes: %w", err))
+	}
+
+	last := since
+
+	for _, reaction := range changes {
+		cursor := reactionChangeCursor(reaction)
+		// 切断した後に作成されたリアクションは、更新されていても作成イベントとして送る
+		createdAfter := newReactionCursor(reaction.CreatedAt, reaction.ID).after(since)
+
+		var data api.RollCallReactionEvent
+
+		switch {
+		case reaction.DeletedAt.Valid && createdAfter:
+			// クライアントが知らないまま削除されたリアクションは送らない
+			last = cursor
+			continue
+
+		case reaction.DeletedAt.Valid:
+			err = data.FromRollCallReactionDeletedEvent(api.RollCallReactionDeletedEvent{
+				Id:     int(reaction.ID),
+				Type:   api.Deleted,
+				UserId: reaction.UserID,
+			})
+
+		case createdAfter:
+			err = data.FromRollCallReactionCreatedEvent(api.RollCallReactionCreatedEvent{
+				Id:      int(reaction.ID),
+				Type:    api.Created,
+				UserId:  reaction.UserID,
+				Content: reaction.Content,
+			})
+
+		default:
+			err = data.FromRollCallReactionUpdatedEvent(api.RollCallReactionUpdatedEvent{
+				Id:      int(reaction.ID),
+				Type:    api.Updated,
+				UserId:  reaction.UserID,
+				Content: reaction.Content,
+			})
+		}
+
+		if err != nil {
+			return last, echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to create event data: %w", err))
+		}
+
+		if err := writeReactionEvent(res, cursor.String(), data); err != nil {
+			return last, err
+		}
+
+		last = cursor
+	}
+
+	if includeSummary && len(changes) > 0 {
+		if summary := s.rollCallSummaryEvent(ctx, rollCall); summary != nil {
+			if err := writeReactionEvent(res, "", *summary); err != nil {
+				return last, err
+			}
+		}
+	}
+
+	return last, nil
+}
+
+func writeReactionEvent(res *echo.Response, eventID string, data api.RollCallReactionEvent) error {
+	b, err := data.MarshalJSON()
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to marshal event data: %w", err))
+	}
+
+	if eventID != "" {
+		if _, err := fmt.Fprintf(res, "id: %s\n", eventID); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to write event ID: %w", err))
+		}
+	}
+
+	if _, err := fmt.Fprintf(res, "data: %s\n\n", b); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to write event data: %w", err))
+	}
+
+	res.Flush()
+
+	return nil
+}
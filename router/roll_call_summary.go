@@ -0,0 +1,150 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// GetRollCallSummary 点呼の集計結果を取得
+// (GET /api/roll-calls/{rollCallId}/summary)
+func (s *Server) GetRollCallSummary(e echo.Context, rollCallID api.RollCallId) error {
+	rollCall, err := s.repo.GetRollCallByID(e.Request().Context(), uint(rollCallID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
+	reactions, err := s.repo.GetRollCallReactions(e.Request().Context(), rollCall.ID)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call reactions: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, summarizeRollCall(rollCall, reactions))
+}
+
+// summarizeRollCall は選択肢ごとのリアクション数と未回答の対象者を集計する。
+// 回答が制限されている場合は、対象者から外れたユーザーのリアクションを数えない
+func summarizeRollCall(
+	rollCall *model.RollCall,
+	reactions []model.RollCallReaction,
+) api.RollCallSummary {
+	optionCounts := make([]api.RollCallOptionCount, len(rollCall.Options))
+
+	for i, option := range rollCall.Options {
+		optionCounts[i] = api.RollCallOptionCount{Option: option}
+	}
+
+	subjects := make(map[string]struct{}, len(rollCall.Subjects))
+
+	for _, subject := range rollCall.Subjects {
+		subjects[subject.ID] = struct{}{}
+	}
+
+	otherCount := 0
+	respondedUsers := make(map[string]struct{}, len(reactions))
+
+	for _, reaction := range reactions {
+		if _, ok := subjects[reaction.UserID]; rollCall.RestrictResponses && !ok {
+			continue
+		}
+
+		respondedUsers[reaction.UserID] = struct{}{}
+
+		i := slices.Index(rollCall.Options, reaction.Content)
+
+		if i < 0 {
+			otherCount++
+			continue
+		}
+
+		optionCounts[i].Count++
+	}
+
+	notRespondedSubjects := make([]string, 0)
+
+	for _, subject := range rollCall.Subjects {
+		if _, ok := respondedUsers[subject.ID]; !ok {
+			notRespondedSubjects = append(notRespondedSubjects, subject.ID)
+		}
+	}
+
+	return api.RollCallSummary{
+		OptionCounts:         optionCounts,
+		OtherCount:           otherCount,
+		NotRespondedSubjects: notRespondedSubjects,
+	}
+}
+
+// rollCallSummaryEvent は最新の集計結果のイベントを作成する。
+// リアクションの変更自体は完了しているので、失敗してもログに残してnilを返す
+func (s *Server) rollCallSummaryEvent(
+	ctx context.Context,
+	rollCall *model.RollCall,
+) *api.RollCallReactionEvent {
+	reactions, err := s.repo.GetRollCallReactions(ctx, rollCall.ID)
+
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get roll call reactions for summary",
+			slog.String("error", err.Error()),
+			slog.Int("rollCallId", int(rollCall.ID)),
+		)
+
+		return nil
+	}
+
+	var data api.RollCallReactionEvent
+
+	if err := data.FromRollCallSummaryEvent(api.RollCallSummaryEvent{
+		Type:    api.Summary,
+		Summary: summarizeRollCall(rollCall, reactions),
+	}); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to create roll call summary event",
+			slog.String("error", err.Error()),
+			slog.Int("rollCallId", int(rollCall.ID)),
+		)
+
+		return nil
+	}
+
+	return &data
+}
+
+// publishReactionEvent はリアクションのイベントを最新の集計結果とともに送信する。
+// 合宿のストリームにも点呼が更新されたことを通知する
+func (s *Server) publishReactionEvent(
+	ctx context.Context,
+	rollCall *model.RollCall,
+	cursor reactionCursor,
+	data api.RollCallReactionEvent,
+) {
+	go s.reactionPubSub.Send(reactionEvent{
+		rollCallID: rollCall.ID,
+		eventID:    cursor.String(),
+		data:       data,
+		summary:    s.rollCallSummaryEvent(ctx, rollCall),
+	})
+
+	s.eventBus.Publish(rollCall.CampID, eventbus.TopicRollCall, eventbus.EventTypeUpdated, rollCall.ID)
+}
@@ -0,0 +1,150 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_GetRollCallSummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		options := []string{random.AlphaNumericString(t, 10), random.AlphaNumericString(t, 10)}
+		respondedUserID := random.AlphaNumericString(t, 32)
+		notRespondedUserID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options:  options,
+			Subjects: []model.User{{ID: respondedUserID}, {ID: notRespondedUserID}},
+		}
+		reactions := []model.RollCallReaction{
+			{UserID: respondedUserID, RollCallID: rollCall.ID, Content: options[1]},
+			{
+				UserID:     random.AlphaNumericString(t, 32),
+				RollCallID: rollCall.ID,
+				Content:    random.AlphaNumericString(t, 20),
+			},
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return(reactions, nil).
+			Times(1)
+
+		res := h.expect.GET("/api/roll-calls/{rollCallId}/summary", rollCall.ID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("optionCounts", "otherCount", "notRespondedSubjects")
+
+		optionCounts := res.Value("optionCounts").Array()
+
+		optionCounts.Length().IsEqual(2)
+		optionCounts.Value(0).Object().Value("option").String().IsEqual(options[0])
+		optionCounts.Value(0).Object().Value("count").Number().IsEqual(0)
+		optionCounts.Value(1).Object().Value("option").String().IsEqual(options[1])
+		optionCounts.Value(1).Object().Value("count").Number().IsEqual(1)
+		res.Value("otherCount").Number().IsEqual(1)
+		res.Value("notRespondedSubjects").Array().IsEqual([]string{notRespondedUserID})
+	})
+
+	t.Run("Restricted - ignore reactions from non-subjects", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		options := []string{random.AlphaNumericString(t, 10)}
+		subjectID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:             gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options:           options,
+			Subjects:          []model.User{{ID: subjectID}},
+			RestrictResponses: true,
+		}
+		// 対象者から外れたユーザーのリアクションは数えない
+		reactions := []model.RollCallReaction{
+			{UserID: subjectID, RollCallID: rollCall.ID, Content: options[0]},
+			{
+				UserID:     random.AlphaNumericString(t, 32),
+				RollCallID: rollCall.ID,
+				Content:    options[0],
+			},
+			{
+				UserID:     random.AlphaNumericString(t, 32),
+				RollCallID: rollCall.ID,
+				Content:    random.AlphaNumericString(t, 20),
+			},
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return(reactions, nil).
+			Times(1)
+
+		res := h.expect.GET("/api/roll-calls/{rollCallId}/summary", rollCall.ID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Value("optionCounts").Array().Value(0).Object().Value("count").Number().IsEqual(1)
+		res.Value("otherCount").Number().IsEqual(0)
+		res.Value("notRespondedSubjects").Array().IsEmpty()
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		rollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(nil, repository.ErrRollCallNotFound).
+			Times(1)
+
+		h.expect.GET("/api/roll-calls/{rollCallId}/summary", rollCallID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("GetRollCallReactions error", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		rollCall := model.RollCall{Model: gorm.Model{ID: uint(random.PositiveInt(t))}}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return(nil, errors.New("database error")).
+			Times(1)
+
+		h.expect.GET("/api/roll-calls/{rollCallId}/summary", rollCall.ID).
+			Expect().
+			Status(http.StatusInternalServerError)
+	})
+}
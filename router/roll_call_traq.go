@@ -0,0 +1,269 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// traQで選択肢の番号として使うスタンプ名
+var rollCallNumberStampNames = []string{
+	"one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+}
+
+// postRollCallToTraq は点呼をtraQのチャンネルに投稿する。
+// 点呼の作成自体は完了しているので、失敗してもログに残すだけにする
+func (s *Server) postRollCallToTraq(ctx context.Context, rollCall *model.RollCall) {
+	if s.traqBotConfig.RollCallChannelID == "" {
+		return
+	}
+
+	messageID, err := s.traqService.PostChannelMessage(
+		ctx,
+		s.traqBotConfig.RollCallChannelID,
+		traqRollCallMessageContent(rollCall),
+	)
+
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to post roll call to traQ",
+			slog.String("error", err.Error()),
+			slog.Int("rollCallId", int(rollCall.ID)),
+		)
+
+		return
+	}
+
+	if err := s.repo.UpdateRollCallTraqMessageID(ctx, rollCall.ID, messageID); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to save traQ message ID of roll call",
+			slog.String("error", err.Error()),
+			slog.Int("rollCallId", int(rollCall.ID)),
+			slog.String("messageId", messageID),
+		)
+
+		return
+	}
+
+	rollCall.TraqMessageID = &messageID
+}
+
+// traqRollCallMessageContent はtraQのチャンネルに投稿する点呼の本文を作成する
+func traqRollCallMessageContent(rollCall *model.RollCall) string {
+	lines := []string{fmt.Sprintf("### 点呼「%s」", rollCall.Name)}
+
+	if rollCall.Description != "" {
+		lines = append(lines, rollCall.Description)
+	}
+
+	for i, option := range rollCall.Options {
+		if i < len(rollCallNumberStampNames) {
+			lines = append(lines, fmt.Sprintf(":%s: %s", rollCallNumberStampNames[i], option))
+		} else {
+			lines = append(lines, "- "+option)
+		}
+	}
+
+	if rollCall.Deadline != nil {
+		lines = append(lines, "締め切り: "+rollCall.Deadline.In(model.JST).Format("1/2 15:04"))
+	}
+
+	lines = append(lines, "このメッセージにスタンプを押すか、引用して返信して回答してください")
+
+	return strings.Join(lines, "\n")
+}
+
+// rollCallContentFromStamp はスタンプ名をリアクションの内容に変換する。
+// 番号のスタンプは対応する選択肢に、それ以外はスタンプの記法にする
+func rollCallContentFromStamp(rollCall *model.RollCall, stampName string) string {
+	if i := slices.Index(rollCallNumberStampNames, stampName); i >= 0 && i < len(rollCall.Options) {
+		return rollCall.Options[i]
+	}
+
+	if slices.Contains(rollCall.Options, stampName) {
+		return stampName
+	}
+
+	return ":" + stampName + ":"
+}
+
+// rollCallContentFromReply は返信の本文をリアクションの内容に変換する。
+// 選択肢の番号だけが書かれている場合は対応する選択肢にする
+func rollCallContentFromReply(rollCall *model.RollCall, text string) string {
+	if n, err := strconv.Atoi(text); err == nil && n >= 1 && n <= len(rollCall.Options) {
+		return rollCall.Options[n-1]
+	}
+
+	return text
+}
+
+// applyTraqRollCallReaction はtraQでの回答をリアクションとして保存し、ストリームに送信する。
+// traQではユーザーごとに1つのリアクションだけを持ち、回答し直すと内容を更新する。
+// fromStampはスタンプによる回答かどうかで、スタンプを外したときに削除するかを決める
+func (s *Server) applyTraqRollCallReaction(
+	ctx context.Context,
+	rollCall *model.RollCall,
+	userID string,
+	content string,
+	fromStamp bool,
+) error {
+	reactions, err := s.repo.GetRollCallReactions(ctx, rollCall.ID)
+
+	if err != nil {
+		return fmt.Errorf("failed to get roll call reactions: %w", err)
+	}
+
+	var existing *model.RollCallReaction
+
+	if i := slices.IndexFunc(reactions, func(reaction model.RollCallReaction) bool {
+		return reaction.UserID == userID
+	}); i >= 0 {
+		existing = &reactions[i]
+	}
+
+	return s.saveTraqRollCallReaction(ctx, rollCall, userID, content, fromStamp, existing)
+}
+
+// saveTraqRollCallReaction はユーザーの既存のリアクションを元に、traQでの回答を作成または更新する。
+// existingはユーザーの既存のリアクションで、まだ回答していない場合はnil
+func (s *Server) saveTraqRollCallReaction(
+	ctx context.Context,
+	rollCall *model.RollCall,
+	userID string,
+	content string,
+	fromStamp bool,
+	existing *model.RollCallReaction,
+) error {
+	if rollCall.RestrictResponses {
+		// Web UIと同じく、対象者以外や選択肢以外の回答は受け付けない
+		if !rollCall.IsSubject(userID) || !slices.Contains(rollCall.Options, content) {
+			return nil
+		}
+	}
+
+	var (
+		eventData api.RollCallReactionEvent
+		cursor    reactionCursor
+	)
+
+	if existing == nil {
+		user, err := s.repo.GetOrCreateUser(ctx, userID)
+
+		if err != nil {
+			return fmt.Errorf("failed to get or create user: %w", err)
+		}
+
+		reaction := model.RollCallReaction{
+			Content:       content,
+			UserID:        user.ID,
+			FromTraqStamp: fromStamp,
+			RollCallID:    rollCall.ID,
+		}
+
+		if err := s.repo.CreateUniqueRollCallReaction(ctx, &reaction); err != nil {
+			// 同時に回答された場合は、先に作成されたリアクションを取得し直して更新する
+			if errors.Is(err, repository.ErrRollCallReactionAlreadyExists) {
+				return s.applyTraqRollCallReaction(ctx, rollCall, userID, content, fromStamp)
+			}
+
+			return fmt.Errorf("failed to create roll call reaction: %w", err)
+		}
+
+		cursor = newReactionCursor(reaction.CreatedAt, reaction.ID)
+
+		if err := eventData.FromRollCallReactionCreatedEvent(api.RollCallReactionCreatedEvent{
+			Id:      int(reaction.ID),
+			Type:    api.Created,
+			UserId:  reaction.UserID,
+			Content: reaction.Content,
+		}); err != nil {
+			return fmt.Errorf("failed to create event data: %w", err)
+		}
+	} else {
+		reaction := *existing
+
+		if reaction.Content == content && reaction.FromTraqStamp == fromStamp {
+			return nil
+		}
+
+		reaction.Content = content
+		reaction.FromTraqStamp = fromStamp
+		// 保存される更新時刻より前の時刻を使い、再送時に取りこぼさないようにする
+		cursor = newReactionCursor(time.Now(), reaction.ID)
+
+		if err := s.repo.UpdateRollCallReaction(ctx, reaction.ID, &reaction); err != nil {
+			return fmt.Errorf("failed to update roll call reaction: %w", err)
+		}
+
+		if err := eventData.FromRollCallReactionUpdatedEvent(api.RollCallReactionUpdatedEvent{
+			Id:      int(reaction.ID),
+			Type:    api.Updated,
+			UserId:  reaction.UserID,
+			Content: reaction.Content,
+		}); err != nil {
+			return fmt.Errorf("failed to create event data: %w", err)
+		}
+	}
+
+	s.publishReactionEvent(ctx, rollCall, cursor, eventData)
+
+	return nil
+}
+
+// removeTraqStampReactions はスタンプを外したユーザーの、スタンプによるリアクションを削除する。
+// reactionsはイベントを処理する前に取得した点呼のリアクション、
+// stampedUserIDsは点呼のメッセージにスタンプを押しているユーザーのtraQ IDの集合
+func (s *Server) removeTraqStampReactions(
+	ctx context.Context,
+	rollCall *model.RollCall,
+	reactions []model.RollCallReaction,
+	stampedUserIDs map[string]struct{},
+) error {
+	for _, reaction := range reactions {
+		// Web UIや返信で回答した場合はスタンプを外しても残す
+		if !reaction.FromTraqStamp {
+			continue
+		}
+
+		if _, ok := stampedUserIDs[reaction.UserID]; ok {
+			continue
+		}
+
+		// 保存される削除時刻より前の時刻を使い、再送時に取りこぼさないようにする
+		cursor := newReactionCursor(time.Now(), reaction.ID)
+
+		if err := s.repo.DeleteRollCallReaction(ctx, reaction.ID); err != nil {
+			// 同時に届いたイベントで既に削除されている
+			if errors.Is(err, repository.ErrRollCallReactionNotFound) {
+				continue
+			}
+
+			return fmt.Errorf("failed to delete roll call reaction: %w", err)
+		}
+
+		var eventData api.RollCallReactionEvent
+
+		if err := eventData.FromRollCallReactionDeletedEvent(api.RollCallReactionDeletedEvent{
+			Id:     int(reaction.ID),
+			Type:   api.Deleted,
+			UserId: reaction.UserID,
+		}); err != nil {
+			return fmt.Errorf("failed to create event data: %w", err)
+		}
+
+		s.publishReactionEvent(ctx, rollCall, cursor, eventData)
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 func (s *Server) GetRollCalls(e echo.Context, campID api.CampId) error {
@@ -77,7 +80,30 @@ func (s *Server) AdminPostRollCall(
 			return err
 		}
 
-		return s.activityService.RecordRollCallCreated(ctx, tx, rollCall)
+		if err := s.activityService.RecordRollCallCreated(ctx, tx, rollCall); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		if !rollCall.IsOpen(now) {
+			return nil
+		}
+
+		subjectIDs := make([]string, len(rollCall.Subjects))
+
+		for i, subject := range rollCall.Subjects {
+			subjectIDs[i] = subject.ID
+		}
+
+		// 対象者にDMで点呼の作成を知らせる
+		messages := rollCallMessages(
+			subjectIDs,
+			rollCallMessageContent(&rollCall, fmt.Sprintf("点呼「%s」が作成されました", rollCall.Name)),
+			now,
+		)
+
+		return tx.CreateMessages(ctx, &messages)
 	}); err != nil {
 		if errors.Is(err, repository.ErrCampNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
@@ -91,6 +117,13 @@ func (s *Server) AdminPostRollCall(
 			SetInternal(fmt.Errorf("failed to create roll call: %w", err))
 	}
 
+	s.eventBus.Publish(rollCall.CampID, eventbus.TopicRollCall, eventbus.EventTypeCreated, rollCall.ID)
+	s.eventBus.Publish(rollCall.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
+	if rollCall.IsOpen(time.Now()) {
+		s.postRollCallToTraq(ctx, &rollCall)
+	}
+
 	res, err := converter.Convert[api.RollCallResponse](rollCall)
 
 	if err != nil {
@@ -101,6 +134,214 @@ func (s *Server) AdminPostRollCall(
 	return e.JSON(http.StatusCreated, res)
 }
 
+func (s *Server) AdminPutRollCall(
+	e echo.Context,
+	rollCallID api.RollCallId,
+	params api.AdminPutRollCallParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPutRollCallJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	existingRollCall, err := s.repo.GetRollCallByID(e.Request().Context(), uint(rollCallID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
+	rollCall, err := converter.Convert[model.RollCall](req)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert request body: %w", err))
+	}
+
+	// 締め切りは更新で解除されないように引き継ぐ
+	rollCall.ID = existingRollCall.ID
+	rollCall.ClosedAt = existingRollCall.ClosedAt
+	rollCall.CampID = existingRollCall.CampID
+
+	if err := s.repo.UpdateRollCall(
+		e.Request().Context(),
+		uint(rollCallID),
+		&rollCall,
+	); err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return echo.NewHTTPError(http.StatusBadRequest, "One or more subject users not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to update roll call: %w", err))
+	}
+
+	s.eventBus.Publish(rollCall.CampID, eventbus.TopicRollCall, eventbus.EventTypeUpdated, rollCall.ID)
+
+	now := time.Now()
+
+	// 締め切りを過去に変更した場合はストリームに締め切りを通知する
+	if existingRollCall.IsOpen(now) && !rollCall.IsOpen(now) {
+		if err := s.sendRollCallClosedEvent(rollCall.ID, *rollCall.Deadline); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to create event data: %w", err))
+		}
+	} else if !isSameDeadline(existingRollCall.Deadline, rollCall.Deadline) {
+		// ストリームが締め切りのタイマーを掛け直せるようにする
+		go s.reactionPubSub.Send(reactionEvent{
+			rollCallID:      rollCall.ID,
+			deadlineChanged: true,
+			deadline:        rollCall.Deadline,
+		})
+	}
+
+	res, err := converter.Convert[api.RollCallResponse](rollCall)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert roll call: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+func (s *Server) AdminDeleteRollCall(
+	e echo.Context,
+	rollCallID api.RollCallId,
+	params api.AdminDeleteRollCallParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	// 削除後は合宿を取得できないため、先に取得しておく
+	rollCall, err := s.repo.GetRollCallByID(e.Request().Context(), uint(rollCallID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
+	if err := s.repo.DeleteRollCall(e.Request().Context(), uint(rollCallID)); err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to delete roll call: %w", err))
+	}
+
+	s.eventBus.Publish(rollCall.CampID, eventbus.TopicRollCall, eventbus.EventTypeDeleted, rollCall.ID)
+
+	// 削除された点呼のストリームも終了させる
+	if err := s.sendRollCallClosedEvent(uint(rollCallID), time.Now()); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to create event data: %w", err))
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) AdminCloseRollCall(
+	e echo.Context,
+	rollCallID api.RollCallId,
+	params api.AdminCloseRollCallParams,
+) error {
+	if params.XForwardedUser == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "X-Forwarded-User header is required")
+	}
+
+	user, err := s.repo.GetOrCreateUser(e.Request().Context(), *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	rollCall, err := s.repo.GetRollCallByID(e.Request().Context(), uint(rollCallID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
+	now := time.Now()
+
+	if !rollCall.IsOpen(now) {
+		return echo.NewHTTPError(http.StatusConflict, "Roll call is already closed")
+	}
+
+	rollCall.ClosedAt = &now
+
+	if err := s.repo.UpdateRollCall(e.Request().Context(), rollCall.ID, rollCall); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to close roll call: %w", err))
+	}
+
+	s.eventBus.Publish(rollCall.CampID, eventbus.TopicRollCall, eventbus.EventTypeUpdated, rollCall.ID)
+
+	if err := s.sendRollCallClosedEvent(rollCall.ID, now); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to create event data: %w", err))
+	}
+
+	res, err := converter.Convert[api.RollCallResponse](*rollCall)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert roll call: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
 func (s *Server) GetRollCallReactions(e echo.Context, rollCallID api.RollCallId) error {
 	reactions, err := s.repo.GetRollCallReactions(e.Request().Context(), uint(rollCallID))
 
@@ -145,6 +386,22 @@ func (s *Server) PostRollCallReaction(
 		return err
 	}
 
+	rollCall, err := s.getOpenRollCall(e, uint(rollCallID))
+
+	if err != nil {
+		return err
+	}
+
+	if rollCall.RestrictResponses {
+		if !rollCall.IsSubject(user.ID) {
+			return echo.NewHTTPError(http.StatusForbidden, "Only subjects can react to this roll call")
+		}
+
+		if !slices.Contains(rollCall.Options, req.Content) {
+			return echo.NewHTTPError(http.StatusBadRequest, "Content must be one of the options")
+		}
+	}
+
 	reaction, err := converter.Convert[model.RollCallReaction](req)
 
 	if err != nil {
@@ -155,11 +412,22 @@ func (s *Server) PostRollCallReaction(
 	reaction.RollCallID = uint(rollCallID)
 	reaction.UserID = user.ID
 
-	if err := s.repo.CreateRollCallReaction(e.Request().Context(), &reaction); err != nil {
+	createReaction := s.repo.CreateRollCallReaction
+
+	// 回答を制限する点呼では1人1つだけリアクションできる
+	if rollCall.RestrictResponses {
+		createReaction = s.repo.CreateUniqueRollCallReaction
+	}
+
+	if err := createReaction(e.Request().Context(), &reaction); err != nil {
 		if errors.Is(err, repository.ErrRollCallNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
 		}
 
+		if errors.Is(err, repository.ErrRollCallReactionAlreadyExists) {
+			return echo.NewHTTPError(http.StatusConflict, "You have already reacted to this roll call")
+		}
+
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to create roll call reaction: %w", err))
 	}
@@ -176,10 +444,12 @@ func (s *Server) PostRollCallReaction(
 			SetInternal(fmt.Errorf("failed to create event data: %w", err))
 	}
 
-	go s.reactionPubSub.Send(reactionEvent{
-		rollCallID: uint(rollCallID),
-		data:       eventData,
-	})
+	s.publishReactionEvent(
+		e.Request().Context(),
+		rollCall,
+		newReactionCursor(reaction.CreatedAt, reaction.ID),
+		eventData,
+	)
 
 	res, err := converter.Convert[api.RollCallReactionResponse](reaction)
 
@@ -217,12 +487,29 @@ func (s *Server) PutReaction(
 		return echo.NewHTTPError(http.StatusForbidden, "You can only edit your own reactions")
 	}
 
+	rollCall, err := s.getOpenRollCall(e, existingReaction.RollCallID)
+
+	if err != nil {
+		return err
+	}
+
 	var req api.PutReactionJSONRequestBody
 
 	if err := e.Bind(&req); err != nil {
 		return err
 	}
 
+	// 対象者や回答の制限は作成後に変更されることがあるので、更新時にも確認する
+	if rollCall.RestrictResponses {
+		if !rollCall.IsSubject(existingReaction.UserID) {
+			return echo.NewHTTPError(http.StatusForbidden, "Only subjects can react to this roll call")
+		}
+
+		if !slices.Contains(rollCall.Options, req.Content) {
+			return echo.NewHTTPError(http.StatusBadRequest, "Content must be one of the options")
+		}
+	}
+
 	updateData, err := converter.Convert[model.RollCallReaction](req)
 
 	if err != nil {
@@ -259,10 +546,12 @@ func (s *Server) PutReaction(
 			SetInternal(fmt.Errorf("failed to create event data: %w", err))
 	}
 
-	go s.reactionPubSub.Send(reactionEvent{
-		rollCallID: uint(updatedReaction.RollCallID),
-		data:       eventData,
-	})
+	s.publishReactionEvent(
+		e.Request().Context(),
+		rollCall,
+		newReactionCursor(updatedReaction.UpdatedAt, updatedReaction.ID),
+		eventData,
+	)
 
 	res, err := converter.Convert[api.RollCallReactionResponse](*updatedReaction)
 
@@ -300,6 +589,16 @@ func (s *Server) DeleteReaction(
 		return echo.NewHTTPError(http.StatusForbidden, "You can only delete your own reactions")
 	}
 
+	// 締め切られた点呼の回答は取り消せない
+	rollCall, err := s.getOpenRollCall(e, existingReaction.RollCallID)
+
+	if err != nil {
+		return err
+	}
+
+	// 保存される削除時刻より前の時刻を使い、再送時に取りこぼさないようにする
+	cursor := newReactionCursor(time.Now(), existingReaction.ID)
+
 	if err := s.repo.DeleteRollCallReaction(e.Request().Context(), uint(reactionID)); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError).
 			SetInternal(fmt.Errorf("failed to delete roll call reaction: %w", err))
@@ -316,50 +615,263 @@ func (s *Server) DeleteReaction(
 			SetInternal(fmt.Errorf("failed to create event data: %w", err))
 	}
 
-	go s.reactionPubSub.Send(reactionEvent{
-		rollCallID: uint(existingReaction.RollCallID),
-		data:       eventData,
-	})
+	s.publishReactionEvent(e.Request().Context(), rollCall, cursor, eventData)
 
 	return e.NoContent(http.StatusNoContent)
 }
 
-func (s *Server) StreamRollCallReactions(e echo.Context, rollCallID api.RollCallId) error {
+func (s *Server) StreamRollCallReactions(
+	e echo.Context,
+	rollCallID api.RollCallId,
+	params api.StreamRollCallReactionsParams,
+) error {
+	includeSummary := params.IncludeSummary != nil && *params.IncludeSummary
+
+	var lastEventID *reactionCursor
+
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		cursor, err := parseReactionCursor(*params.LastEventID)
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Last-Event-ID header")
+		}
+
+		lastEventID = &cursor
+	}
+
+	ctx := e.Request().Context()
+	rollCall, err := s.repo.GetRollCallByID(ctx, uint(rollCallID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
 	res := e.Response()
 
 	res.Header().Set(echo.HeaderContentType, "text/event-stream")
 	res.WriteHeader(http.StatusOK)
-	res.Flush()
 
-	sub := s.reactionPubSub.Subscribe(e.Request().Context(), maxReactionEventBuffer)
+	if err := writeStreamMessage(
+		res,
+		fmt.Sprintf("retry: %d\n\n", reactionStreamRetryMillis),
+	); err != nil {
+		return err
+	}
+
+	isOpen := rollCall.IsOpen(time.Now())
+
+	// 再送中の変更を取りこぼさないように、再送より先に購読を始める
+	var sub <-chan reactionEvent
+
+	if isOpen {
+		sub = s.reactionPubSub.Subscribe(ctx, maxReactionEventBuffer)
+	}
+
+	// 再送した変更は購読しているイベントとして届いても送らない
+	var replayed *reactionCursor
+
+	if lastEventID != nil {
+		last, err := s.replayRollCallReactions(ctx, res, rollCall, *lastEventID, includeSummary)
+
+		if err != nil {
+			return err
+		}
+
+		replayed = &last
+	}
+
+	// 既に締め切られている場合はclosedイベントだけを送信して終了する
+	if !isOpen {
+		data, err := rollCallClosedEventData(rollCallClosedAt(rollCall))
+
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to create event data: %w", err))
+		}
+
+		return writeReactionEvent(res, "", data)
+	}
+
+	// 締め切りの時刻にclosedイベントを送信するためのタイマー。締め切りがない場合は発火しない
+	var (
+		timer    *time.Timer
+		deadline <-chan time.Time
+	)
+
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	armDeadline := func(newDeadline *time.Time) {
+		if timer != nil {
+			timer.Stop()
+		}
+
+		timer, deadline = nil, nil
+
+		if newDeadline != nil {
+			timer = time.NewTimer(time.Until(*newDeadline))
+			deadline = timer.C
+		}
+	}
+
+	armDeadline(rollCall.Deadline)
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+
+	defer keepAlive.Stop()
 
 	for {
 		select {
-		case <-e.Request().Context().Done():
+		case <-ctx.Done():
 			return nil
 
+		case <-s.shutdown:
+			return writeStreamShutdown(res)
+
+		case <-keepAlive.C:
+			if err := writeStreamKeepAlive(res); err != nil {
+				return err
+			}
+
+		case <-deadline:
+			// 締め切りが変更されている可能性があるので取得し直す
+			rollCall, err := s.repo.GetRollCallByID(ctx, uint(rollCallID))
+
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError).
+					SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+			}
+
+			if rollCall.IsOpen(time.Now()) {
+				armDeadline(rollCall.Deadline)
+
+				continue
+			}
+
+			data, err := rollCallClosedEventData(rollCallClosedAt(rollCall))
+
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError).
+					SetInternal(fmt.Errorf("failed to create event data: %w", err))
+			}
+
+			return writeReactionEvent(res, "", data)
+
 		case event, ok := <-sub:
 			if !ok {
-				return nil
+				// サーバーの終了によって購読が閉じられた場合もshutdownイベントを送る
+				select {
+				case <-s.shutdown:
+					return writeStreamShutdown(res)
+				default:
+					return nil
+				}
 			}
 
 			if event.rollCallID != uint(rollCallID) {
 				continue
 			}
 
-			b, err := event.data.MarshalJSON()
+			if event.deadlineChanged {
+				armDeadline(event.deadline)
 
-			if err != nil {
-				return echo.NewHTTPError(http.StatusInternalServerError).
-					SetInternal(fmt.Errorf("failed to marshal event data: %w", err))
+				continue
 			}
 
-			if _, err := fmt.Fprintf(res, "data: %s\n\n", b); err != nil {
-				return echo.NewHTTPError(http.StatusInternalServerError).
-					SetInternal(fmt.Errorf("failed to write event data: %w", err))
+			if replayed != nil && event.eventID != "" {
+				cursor, err := parseReactionCursor(event.eventID)
+
+				if err == nil && !cursor.after(*replayed) {
+					continue
+				}
 			}
 
-			res.Flush()
+			if err := writeReactionEvent(res, event.eventID, event.data); err != nil {
+				return err
+			}
+
+			if includeSummary && event.summary != nil {
+				if err := writeReactionEvent(res, "", *event.summary); err != nil {
+					return err
+				}
+			}
+
+			if event.closed {
+				return nil
+			}
+		}
+	}
+}
+
+// getOpenRollCall はリアクションを受け付けている点呼を取得する
+func (s *Server) getOpenRollCall(e echo.Context, rollCallID uint) (*model.RollCall, error) {
+	rollCall, err := s.repo.GetRollCallByID(e.Request().Context(), rollCallID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "Roll call not found")
 		}
+
+		return nil, echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get roll call: %w", err))
+	}
+
+	if !rollCall.IsOpen(time.Now()) {
+		return nil, echo.NewHTTPError(http.StatusConflict, "Roll call is closed")
+	}
+
+	return rollCall, nil
+}
+
+func (s *Server) sendRollCallClosedEvent(rollCallID uint, closedAt time.Time) error {
+	data, err := rollCallClosedEventData(closedAt)
+
+	if err != nil {
+		return err
+	}
+
+	go s.reactionPubSub.Send(reactionEvent{
+		rollCallID: rollCallID,
+		data:       data,
+		closed:     true,
+	})
+
+	return nil
+}
+
+// isSameDeadline は2つの締め切りが同じかどうかを返す
+func isSameDeadline(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
+
+	return a.Equal(*b)
+}
+
+// rollCallClosedAt は締め切られた点呼が締め切られた時刻を返す
+func rollCallClosedAt(rollCall *model.RollCall) time.Time {
+	if rollCall.ClosedAt != nil {
+		return *rollCall.ClosedAt
+	}
+
+	return *rollCall.Deadline
+}
+
+func rollCallClosedEventData(closedAt time.Time) (api.RollCallReactionEvent, error) {
+	var data api.RollCallReactionEvent
+
+	err := data.FromRollCallClosedEvent(api.RollCallClosedEvent{
+		Type:     api.Closed,
+		ClosedAt: closedAt,
+	})
+
+	return data, err
 }
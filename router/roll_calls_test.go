@@ -3,6 +3,7 @@ package router
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -21,6 +22,13 @@ import (
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/service/activity/mockactivity"
+	"github.com/traPtitech/rucQ/service/archive/mockarchive"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/service/notification/mocknotification"
+	"github.com/traPtitech/rucQ/service/reconciliation/mockreconciliation"
+	"github.com/traPtitech/rucQ/service/traq/mocktraq"
 	"github.com/traPtitech/rucQ/testutil/random"
 )
 
@@ -81,7 +89,7 @@ func TestServer_GetRollCalls(t *testing.T) {
 		res.Length().IsEqual(2)
 
 		res1 := res.Value(0).Object()
-		res1.Keys().ContainsOnly("id", "name", "description", "options", "subjects")
+		res1.Keys().ContainsOnly("id", "name", "description", "options", "subjects", "isOpen", "restrictResponses")
 		res1.Value("id").Number().IsEqual(rollCall1.ID)
 		res1.Value("name").String().IsEqual(rollCall1.Name)
 		res1.Value("description").String().IsEqual(rollCall1.Description)
@@ -89,7 +97,7 @@ func TestServer_GetRollCalls(t *testing.T) {
 		res1.Value("subjects").Array().IsEqual([]string{user1.ID, user2.ID})
 
 		res2 := res.Value(1).Object()
-		res2.Keys().ContainsOnly("id", "name", "description", "options", "subjects")
+		res2.Keys().ContainsOnly("id", "name", "description", "options", "subjects", "isOpen", "restrictResponses")
 		res2.Value("id").Number().IsEqual(rollCall2.ID)
 		res2.Value("name").String().IsEqual(rollCall2.Name)
 		res2.Value("description").String().IsEqual(rollCall2.Description)
@@ -171,6 +179,36 @@ func TestServer_AdminPostRollCall(t *testing.T) {
 			RecordRollCallCreated(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
 			Times(1)
+		// 対象者全員にDMを送る
+		h.repo.MockMessageRepository.EXPECT().
+			CreateMessages(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, messages *[]model.Message) error {
+				if assert.Len(t, *messages, len(requestBody.Subjects)) {
+					for i, message := range *messages {
+						assert.Equal(t, requestBody.Subjects[i], message.TargetUserID)
+						assert.Contains(t, message.Content, requestBody.Name)
+						assert.Contains(t, message.Content, requestBody.Options[0])
+					}
+				}
+
+				return nil
+			}).
+			Times(1)
+		// traQのチャンネルに投稿してメッセージのUUIDを記録する
+		messageID := random.AlphaNumericString(t, 36)
+		h.traqService.EXPECT().
+			PostChannelMessage(gomock.Any(), testTraqRollCallChannelID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, content string) (string, error) {
+				assert.Contains(t, content, requestBody.Name)
+				assert.Contains(t, content, ":one: "+requestBody.Options[0])
+
+				return messageID, nil
+			}).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			UpdateRollCallTraqMessageID(gomock.Any(), gomock.Any(), messageID).
+			Return(nil).
+			Times(1)
 
 		res := h.expect.POST("/api/admin/camps/{campId}/roll-calls", campID).
 			WithHeader("X-Forwarded-User", userID).
@@ -180,7 +218,7 @@ func TestServer_AdminPostRollCall(t *testing.T) {
 			JSON().
 			Object()
 
-		res.Keys().ContainsOnly("id", "name", "description", "options", "subjects")
+		res.Keys().ContainsOnly("id", "name", "description", "options", "subjects", "isOpen", "restrictResponses")
 		res.Value("name").String().IsEqual(requestBody.Name)
 		res.Value("description").String().IsEqual(requestBody.Description)
 		res.Value("options").Array().IsEqual(requestBody.Options)
@@ -452,6 +490,266 @@ func TestServer_AdminPostRollCall(t *testing.T) {
 	})
 }
 
+func TestServer_AdminPutRollCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		closedAt := time.Now().Add(-time.Hour)
+		existingRollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:   uint(random.PositiveInt(t)),
+			ClosedAt: &closedAt,
+		}
+		deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+		requestBody := api.RollCallRequest{
+			Name:        random.AlphaNumericString(t, 20),
+			Description: random.AlphaNumericString(t, 100),
+			Options:     []string{random.AlphaNumericString(t, 5)},
+			Subjects:    []string{random.AlphaNumericString(t, 32)},
+			Deadline:    &deadline,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingRollCall.ID).
+			Return(&existingRollCall, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			UpdateRollCall(gomock.Any(), existingRollCall.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, rollCall *model.RollCall) error {
+				assert.Equal(t, requestBody.Name, rollCall.Name)
+				assert.Equal(t, existingRollCall.CampID, rollCall.CampID)
+				// 締め切り済みの点呼は更新しても再開されない
+				assert.Equal(t, existingRollCall.ClosedAt, rollCall.ClosedAt)
+
+				if assert.NotNil(t, rollCall.Deadline) {
+					assert.True(t, deadline.Equal(*rollCall.Deadline))
+				}
+
+				return nil
+			})
+
+		res := h.expect.PUT("/api/admin/roll-calls/{rollCallId}", existingRollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(requestBody).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly(
+			"id",
+			"name",
+			"description",
+			"options",
+			"subjects",
+			"deadline",
+			"closedAt",
+			"isOpen",
+			"restrictResponses",
+		)
+		res.Value("id").Number().IsEqual(existingRollCall.ID)
+		res.Value("name").String().IsEqual(requestBody.Name)
+		res.Value("isOpen").Boolean().IsFalse()
+	})
+
+	t.Run("User not staff", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+
+		h.expect.PUT("/api/admin/roll-calls/{rollCallId}", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.RollCallRequest{}).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		rollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(nil, repository.ErrRollCallNotFound)
+
+		h.expect.PUT("/api/admin/roll-calls/{rollCallId}", rollCallID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.RollCallRequest{}).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_AdminDeleteRollCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID: uint(random.PositiveInt(t)),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			DeleteRollCall(gomock.Any(), rollCall.ID).
+			Return(nil)
+
+		events, _ := h.eventBus.Subscribe(t.Context(), rollCall.CampID, nil)
+
+		h.expect.DELETE("/api/admin/roll-calls/{rollCallId}", rollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNoContent)
+
+		// 合宿のストリームに削除を通知する
+		event := <-events
+
+		assert.Equal(t, eventbus.TopicRollCall, event.Topic)
+		assert.Equal(t, eventbus.EventTypeDeleted, event.Type)
+		assert.Equal(t, rollCall.ID, event.ResourceID)
+	})
+
+	t.Run("User not staff", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+
+		h.expect.DELETE("/api/admin/roll-calls/{rollCallId}", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		rollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(nil, repository.ErrRollCallNotFound)
+
+		h.expect.DELETE("/api/admin/roll-calls/{rollCallId}", rollCallID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestServer_AdminCloseRollCall(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:  random.AlphaNumericString(t, 20),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			UpdateRollCall(gomock.Any(), rollCall.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, rollCall *model.RollCall) error {
+				assert.NotNil(t, rollCall.ClosedAt)
+				return nil
+			})
+
+		res := h.expect.POST("/api/admin/roll-calls/{rollCallId}/close", rollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Value("isOpen").Boolean().IsFalse()
+		res.Value("closedAt").String().NotEmpty()
+	})
+
+	t.Run("Already closed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		deadline := time.Now().Add(-time.Minute)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Deadline: &deadline,
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/close", rollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("User not staff", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/close", random.PositiveInt(t)).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
 func TestServer_GetRollCallReactions(t *testing.T) {
 	t.Parallel()
 
@@ -566,6 +864,11 @@ func TestServer_PostRollCallReaction(t *testing.T) {
 			Return(&user, nil).
 			Times(1)
 
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}}, nil).
+			Times(1)
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			CreateRollCallReaction(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ any, reaction *model.RollCallReaction) error {
@@ -574,6 +877,12 @@ func TestServer_PostRollCallReaction(t *testing.T) {
 			}).
 			Times(1)
 
+		// 集計結果の送信のためにリアクションを取得する
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCallID).
+			Return([]model.RollCallReaction{expectedReaction}, nil).
+			Times(1)
+
 		res := h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
 			WithJSON(requestBody).
@@ -624,9 +933,9 @@ func TestServer_PostRollCallReaction(t *testing.T) {
 			Return(&user, nil).
 			Times(1)
 
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			CreateRollCallReaction(gomock.Any(), gomock.Any()).
-			Return(repository.ErrRollCallNotFound).
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(nil, repository.ErrRollCallNotFound).
 			Times(1)
 
 		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
@@ -639,231 +948,246 @@ func TestServer_PostRollCallReaction(t *testing.T) {
 			Value("message").String().IsEqual("Roll call not found")
 	})
 
-	t.Run("GetOrCreateUser Error", func(t *testing.T) {
+	t.Run("Roll call closed", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
-
-		requestBody := api.PostRollCallReactionJSONRequestBody{
-			Content: random.AlphaNumericString(t, 20),
-		}
+		closedAt := time.Now().Add(-time.Minute)
 
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), userID).
-			Return(nil, errors.New("user repository error")).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}, ClosedAt: &closedAt}, nil).
 			Times(1)
 
 		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(requestBody).
+			WithJSON(api.PostRollCallReactionJSONRequestBody{
+				Content: random.AlphaNumericString(t, 20),
+			}).
 			Expect().
-			Status(http.StatusInternalServerError)
+			Status(http.StatusConflict).
+			JSON().
+			Object().
+			Value("message").String().IsEqual("Roll call is closed")
 	})
 
-	t.Run("CreateRollCallReaction repository error", func(t *testing.T) {
+	t.Run("Restricted - not subject", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
-		user := model.User{ID: userID}
-
-		requestBody := api.PostRollCallReactionJSONRequestBody{
-			Content: random.AlphaNumericString(t, 20),
-		}
+		option := random.AlphaNumericString(t, 10)
 
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), userID).
-			Return(&user, nil).
+			Return(&model.User{ID: userID}, nil).
 			Times(1)
-
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			CreateRollCallReaction(gomock.Any(), gomock.Any()).
-			Return(errors.New("create reaction error")).
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{
+				Model:             gorm.Model{ID: rollCallID},
+				Options:           []string{option},
+				Subjects:          []model.User{{ID: random.AlphaNumericString(t, 32)}},
+				RestrictResponses: true,
+			}, nil).
 			Times(1)
 
 		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(requestBody).
+			WithJSON(api.PostRollCallReactionJSONRequestBody{Content: option}).
 			Expect().
-			Status(http.StatusInternalServerError)
+			Status(http.StatusForbidden)
 	})
-}
-
-func TestServer_PutReaction(t *testing.T) {
-	t.Parallel()
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Restricted - not an option", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
-		reactionID := uint(random.PositiveInt(t))
+		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
 
-		existingReaction := model.RollCallReaction{
-			Model:      gorm.Model{ID: reactionID},
-			Content:    random.AlphaNumericString(t, 10),
-			UserID:     userID,
-			RollCallID: uint(random.PositiveInt(t)),
-		}
-
-		requestBody := api.PutReactionJSONRequestBody{
-			Content: random.AlphaNumericString(t, 20),
-		}
-
-		updatedReaction := existingReaction
-		updatedReaction.Content = requestBody.Content
-
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&existingReaction, nil).
-			Times(1) // 最初の確認のみ
-
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			UpdateRollCallReaction(gomock.Any(), reactionID, gomock.Any()).
-			Return(nil).
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{
+				Model:             gorm.Model{ID: rollCallID},
+				Options:           []string{random.AlphaNumericString(t, 10)},
+				Subjects:          []model.User{{ID: userID}},
+				RestrictResponses: true,
+			}, nil).
 			Times(1)
 
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&updatedReaction, nil).
-			Times(1) // 更新後の取得
-
-		res := h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(requestBody).
+			WithJSON(api.PostRollCallReactionJSONRequestBody{
+				Content: random.AlphaNumericString(t, 20),
+			}).
 			Expect().
-			Status(http.StatusOK).
-			JSON().
-			Object()
-
-		res.Keys().ContainsOnly("id", "content", "userId")
-		res.Value("id").Number().IsEqual(reactionID)
-		res.Value("content").String().IsEqual(requestBody.Content)
-		res.Value("userId").String().IsEqual(userID)
+			Status(http.StatusBadRequest)
 	})
 
-	t.Run("Reaction not found", func(t *testing.T) {
+	t.Run("Restricted - Success", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
-		reactionID := uint(random.PositiveInt(t))
+		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
-
-		requestBody := api.PutReactionJSONRequestBody{
-			Content: random.AlphaNumericString(t, 20),
+		option := random.AlphaNumericString(t, 10)
+		expectedReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			Content:    option,
+			UserID:     userID,
+			RollCallID: rollCallID,
 		}
 
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{
+				Model:             gorm.Model{ID: rollCallID},
+				Options:           []string{option},
+				Subjects:          []model.User{{ID: userID}},
+				RestrictResponses: true,
+			}, nil).
+			Times(1)
+		// 1人1つになるよう、既存のリアクションの確認と作成をまとめて行う
 		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(nil, repository.ErrRollCallReactionNotFound).
+			CreateUniqueRollCallReaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, reaction *model.RollCallReaction) error {
+				assert.Equal(t, userID, reaction.UserID)
+				assert.Equal(t, rollCallID, reaction.RollCallID)
+
+				*reaction = expectedReaction
+
+				return nil
+			}).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCallID).
+			Return([]model.RollCallReaction{expectedReaction}, nil).
 			Times(1)
 
-		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(requestBody).
+			WithJSON(api.PostRollCallReactionJSONRequestBody{Content: option}).
 			Expect().
-			Status(http.StatusNotFound).
+			Status(http.StatusCreated).
 			JSON().
 			Object().
-			Value("message").String().IsEqual("Reaction not found")
+			Value("id").Number().IsEqual(expectedReaction.ID)
 	})
 
-	t.Run("Forbidden - not owner", func(t *testing.T) {
+	t.Run("Restricted - already reacted", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
-		reactionID := uint(random.PositiveInt(t))
+		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
-		otherUserID := random.AlphaNumericString(t, 32)
-
-		existingReaction := model.RollCallReaction{
-			Model:      gorm.Model{ID: reactionID},
-			Content:    random.AlphaNumericString(t, 10),
-			UserID:     otherUserID, // 異なるユーザー
-			RollCallID: uint(random.PositiveInt(t)),
-		}
-
-		requestBody := api.PutReactionJSONRequestBody{
-			Content: random.AlphaNumericString(t, 20),
-		}
+		option := random.AlphaNumericString(t, 10)
 
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{
+				Model:             gorm.Model{ID: rollCallID},
+				Options:           []string{option},
+				Subjects:          []model.User{{ID: userID}},
+				RestrictResponses: true,
+			}, nil).
+			Times(1)
 		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&existingReaction, nil).
+			CreateUniqueRollCallReaction(gomock.Any(), gomock.Any()).
+			Return(repository.ErrRollCallReactionAlreadyExists).
 			Times(1)
 
-		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(requestBody).
+			WithJSON(api.PostRollCallReactionJSONRequestBody{Content: option}).
 			Expect().
-			Status(http.StatusForbidden).
+			Status(http.StatusConflict).
 			JSON().
 			Object().
-			Value("message").String().IsEqual("You can only edit your own reactions")
+			Value("message").String().IsEqual("You have already reacted to this roll call")
 	})
 
-	t.Run("GetRollCallReactionByID error - initial check", func(t *testing.T) {
+	t.Run("GetOrCreateUser Error", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
-		reactionID := uint(random.PositiveInt(t))
+		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
 
-		requestBody := api.PutReactionJSONRequestBody{
+		requestBody := api.PostRollCallReactionJSONRequestBody{
 			Content: random.AlphaNumericString(t, 20),
 		}
 
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(nil, errors.New("get reaction error")).
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(nil, errors.New("user repository error")).
 			Times(1)
 
-		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
 			WithJSON(requestBody).
 			Expect().
 			Status(http.StatusInternalServerError)
 	})
 
-	t.Run("UpdateRollCallReaction error", func(t *testing.T) {
+	t.Run("CreateRollCallReaction repository error", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
-		reactionID := uint(random.PositiveInt(t))
+		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
+		user := model.User{ID: userID}
 
-		existingReaction := model.RollCallReaction{
-			Model:      gorm.Model{ID: reactionID},
-			Content:    random.AlphaNumericString(t, 10),
-			UserID:     userID,
-			RollCallID: uint(random.PositiveInt(t)),
-		}
-
-		requestBody := api.PutReactionJSONRequestBody{
+		requestBody := api.PostRollCallReactionJSONRequestBody{
 			Content: random.AlphaNumericString(t, 20),
 		}
 
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&existingReaction, nil).
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&user, nil).
+			Times(1)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}}, nil).
 			Times(1)
 
 		h.repo.MockRollCallReactionRepository.EXPECT().
-			UpdateRollCallReaction(gomock.Any(), reactionID, gomock.Any()).
-			Return(errors.New("update reaction error")).
+			CreateRollCallReaction(gomock.Any(), gomock.Any()).
+			Return(errors.New("create reaction error")).
 			Times(1)
 
-		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
 			WithHeader("X-Forwarded-User", userID).
 			WithJSON(requestBody).
 			Expect().
 			Status(http.StatusInternalServerError)
 	})
+}
 
-	t.Run("GetRollCallReactionByID error - after update", func(t *testing.T) {
+func TestServer_PutReaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
@@ -881,9 +1205,17 @@ func TestServer_PutReaction(t *testing.T) {
 			Content: random.AlphaNumericString(t, 20),
 		}
 
+		updatedReaction := existingReaction
+		updatedReaction.Content = requestBody.Content
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
 			Return(&existingReaction, nil).
+			Times(1) // 最初の確認のみ
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: existingReaction.RollCallID}}, nil).
 			Times(1)
 
 		h.repo.MockRollCallReactionRepository.EXPECT().
@@ -893,49 +1225,26 @@ func TestServer_PutReaction(t *testing.T) {
 
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(nil, errors.New("get updated reaction error")).
-			Times(1)
-
-		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
-			WithHeader("X-Forwarded-User", userID).
-			WithJSON(requestBody).
-			Expect().
-			Status(http.StatusInternalServerError)
-	})
-}
-
-func TestServer_DeleteReaction(t *testing.T) {
-	t.Parallel()
-
-	t.Run("Success", func(t *testing.T) {
-		t.Parallel()
-
-		h := setup(t)
-		reactionID := uint(random.PositiveInt(t))
-		userID := random.AlphaNumericString(t, 32)
-
-		existingReaction := model.RollCallReaction{
-			Model:      gorm.Model{ID: reactionID},
-			Content:    random.AlphaNumericString(t, 10),
-			UserID:     userID,
-			RollCallID: uint(random.PositiveInt(t)),
-		}
-
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&existingReaction, nil).
-			Times(1)
+			Return(&updatedReaction, nil).
+			Times(1) // 更新後の取得
 
 		h.repo.MockRollCallReactionRepository.EXPECT().
-			DeleteRollCallReaction(gomock.Any(), reactionID).
-			Return(nil).
+			GetRollCallReactions(gomock.Any(), existingReaction.RollCallID).
+			Return([]model.RollCallReaction{updatedReaction}, nil).
 			Times(1)
 
-		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+		res := h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
+			WithJSON(requestBody).
 			Expect().
-			Status(http.StatusNoContent).
-			NoContent()
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		res.Keys().ContainsOnly("id", "content", "userId")
+		res.Value("id").Number().IsEqual(reactionID)
+		res.Value("content").String().IsEqual(requestBody.Content)
+		res.Value("userId").String().IsEqual(userID)
 	})
 
 	t.Run("Reaction not found", func(t *testing.T) {
@@ -945,13 +1254,18 @@ func TestServer_DeleteReaction(t *testing.T) {
 		reactionID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
 
+		requestBody := api.PutReactionJSONRequestBody{
+			Content: random.AlphaNumericString(t, 20),
+		}
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
 			Return(nil, repository.ErrRollCallReactionNotFound).
 			Times(1)
 
-		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
+			WithJSON(requestBody).
 			Expect().
 			Status(http.StatusNotFound).
 			JSON().
@@ -974,48 +1288,58 @@ func TestServer_DeleteReaction(t *testing.T) {
 			RollCallID: uint(random.PositiveInt(t)),
 		}
 
+		requestBody := api.PutReactionJSONRequestBody{
+			Content: random.AlphaNumericString(t, 20),
+		}
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
 			Return(&existingReaction, nil).
 			Times(1)
 
-		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
+			WithJSON(requestBody).
 			Expect().
 			Status(http.StatusForbidden).
 			JSON().
 			Object().
-			Value("message").String().IsEqual("You can only delete your own reactions")
+			Value("message").String().IsEqual("You can only edit your own reactions")
 	})
 
-	t.Run("GetRollCallReactionByID error", func(t *testing.T) {
+	t.Run("GetRollCallReactionByID error - initial check", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		reactionID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
 
+		requestBody := api.PutReactionJSONRequestBody{
+			Content: random.AlphaNumericString(t, 20),
+		}
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
 			Return(nil, errors.New("get reaction error")).
 			Times(1)
 
-		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
+			WithJSON(requestBody).
 			Expect().
 			Status(http.StatusInternalServerError)
 	})
 
-	t.Run("DeleteRollCallReaction error", func(t *testing.T) {
+	t.Run("Roll call closed", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
 		reactionID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
+		deadline := time.Now().Add(-time.Minute)
 
 		existingReaction := model.RollCallReaction{
 			Model:      gorm.Model{ID: reactionID},
-			Content:    random.AlphaNumericString(t, 10),
 			UserID:     userID,
 			RollCallID: uint(random.PositiveInt(t)),
 		}
@@ -1024,362 +1348,431 @@ func TestServer_DeleteReaction(t *testing.T) {
 			GetRollCallReactionByID(gomock.Any(), reactionID).
 			Return(&existingReaction, nil).
 			Times(1)
-
-		h.repo.MockRollCallReactionRepository.EXPECT().
-			DeleteRollCallReaction(gomock.Any(), reactionID).
-			Return(errors.New("delete reaction error")).
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{
+				Model:    gorm.Model{ID: existingReaction.RollCallID},
+				Deadline: &deadline,
+			}, nil).
 			Times(1)
 
-		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.PutReactionJSONRequestBody{
+				Content: random.AlphaNumericString(t, 20),
+			}).
 			Expect().
-			Status(http.StatusInternalServerError)
+			Status(http.StatusConflict)
 	})
-}
-
-const eventStreamDataPrefix = "data: "
 
-func TestServer_StreamRollCallReactions(t *testing.T) {
-	t.Parallel()
-
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Restricted - not an option", func(t *testing.T) {
 		t.Parallel()
 
 		h := setup(t)
-		rollCallID := uint(random.PositiveInt(t))
+		reactionID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
-		user := model.User{ID: userID}
-
-		// SSEリクエストの準備
-		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
 
-		defer cancel()
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			UserID:     userID,
+			RollCallID: uint(random.PositiveInt(t)),
+		}
 
-		req, err := http.NewRequestWithContext(
-			ctx,
-			http.MethodGet,
-			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCallID),
-			nil,
-		)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&existingReaction, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{
+				Model:             gorm.Model{ID: existingReaction.RollCallID},
+				Options:           []string{random.AlphaNumericString(t, 10)},
+				Subjects:          []model.User{{ID: userID}},
+				RestrictResponses: true,
+			}, nil).
+			Times(1)
 
-		require.NoError(t, err)
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.PutReactionJSONRequestBody{
+				Content: random.AlphaNumericString(t, 20),
+			}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
 
-		res, err := http.DefaultClient.Do(req)
+	t.Run("Restricted - not a subject", func(t *testing.T) {
+		t.Parallel()
 
-		require.NoError(t, err)
+		h := setup(t)
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		options := []string{random.AlphaNumericString(t, 10)}
 
-		defer func() {
-			require.NoError(t, res.Body.Close())
-		}()
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			UserID:     userID,
+			RollCallID: uint(random.PositiveInt(t)),
+		}
 
-		assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&existingReaction, nil).
+			Times(1)
+		// リアクションした後に対象者から外された
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{
+				Model:             gorm.Model{ID: existingReaction.RollCallID},
+				Options:           options,
+				Subjects:          []model.User{{ID: random.AlphaNumericString(t, 32)}},
+				RestrictResponses: true,
+			}, nil).
+			Times(1)
 
-		// イベントを読み取るためのスキャナ
-		scanner := bufio.NewScanner(res.Body)
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.PutReactionJSONRequestBody{
+				Content: options[0],
+			}).
+			Expect().
+			Status(http.StatusForbidden)
+	})
 
-		// 1. Create Reaction
-		originalContent := random.AlphaNumericString(t, 20)
-		createReqBody := api.PostRollCallReactionJSONRequestBody{Content: originalContent}
+	t.Run("UpdateRollCallReaction error", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
 		reactionID := uint(random.PositiveInt(t))
-		h.repo.MockUserRepository.EXPECT().
-			GetOrCreateUser(gomock.Any(), userID).
-			Return(&user, nil).
-			Times(1)
+		userID := random.AlphaNumericString(t, 32)
+
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     userID,
+			RollCallID: uint(random.PositiveInt(t)),
+		}
+
+		requestBody := api.PutReactionJSONRequestBody{
+			Content: random.AlphaNumericString(t, 20),
+		}
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
-			CreateRollCallReaction(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
-				reaction.ID = reactionID
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&existingReaction, nil).
+			Times(1)
 
-				return nil
-			}).
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: existingReaction.RollCallID}}, nil).
 			Times(1)
 
-		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			UpdateRollCallReaction(gomock.Any(), reactionID, gomock.Any()).
+			Return(errors.New("update reaction error")).
+			Times(1)
+
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(createReqBody).
+			WithJSON(requestBody).
 			Expect().
-			Status(http.StatusCreated)
-
-		// SSEイベントの受信と検証 (Created)
-		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
-			line := scanner.Text()
+			Status(http.StatusInternalServerError)
+	})
 
-			if assert.True(
-				t,
-				strings.HasPrefix(line, eventStreamDataPrefix),
-				"line not start with 'data: '",
-				line,
-			) {
-				assert.JSONEq(
-					t,
-					fmt.Sprintf(
-						`{"id":%d,"type":"created","userId":"%s","content":"%s"}`,
-						reactionID,
-						userID,
-						originalContent,
-					),
-					strings.TrimPrefix(line, eventStreamDataPrefix),
-				)
-			}
-		}
+	t.Run("GetRollCallReactionByID error - after update", func(t *testing.T) {
+		t.Parallel()
 
-		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
-			assert.Empty(t, scanner.Text())
-		}
+		h := setup(t)
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
 
-		// 2. Update Reaction
-		updatedContent := random.AlphaNumericString(t, 20)
-		updateReqBody := api.PutReactionJSONRequestBody{Content: updatedContent}
 		existingReaction := model.RollCallReaction{
 			Model:      gorm.Model{ID: reactionID},
+			Content:    random.AlphaNumericString(t, 10),
 			UserID:     userID,
-			RollCallID: rollCallID,
-			Content:    originalContent,
+			RollCallID: uint(random.PositiveInt(t)),
 		}
-		updatedReaction := model.RollCallReaction{
-			Model:      gorm.Model{ID: reactionID},
-			UserID:     userID,
-			RollCallID: rollCallID,
-			Content:    updatedContent,
+
+		requestBody := api.PutReactionJSONRequestBody{
+			Content: random.AlphaNumericString(t, 20),
 		}
 
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
 			Return(&existingReaction, nil).
 			Times(1)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: existingReaction.RollCallID}}, nil).
+			Times(1)
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			UpdateRollCallReaction(gomock.Any(), reactionID, gomock.Any()).
 			Return(nil).
 			Times(1)
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&updatedReaction, nil).
+			Return(nil, errors.New("get updated reaction error")).
 			Times(1)
 
 		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(updateReqBody).
+			WithJSON(requestBody).
 			Expect().
-			Status(http.StatusOK)
+			Status(http.StatusInternalServerError)
+	})
+}
 
-		// SSEイベントの受信と検証 (Updated)
-		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
-			line := scanner.Text()
+func TestServer_DeleteReaction(t *testing.T) {
+	t.Parallel()
 
-			if assert.True(
-				t,
-				strings.HasPrefix(line, eventStreamDataPrefix),
-				"line not start with 'data: '",
-				line,
-			) {
-				assert.JSONEq(
-					t,
-					fmt.Sprintf(
-						`{"id":%d,"type":"updated","userId":"%s","content":"%s"}`,
-						reactionID,
-						userID,
-						updatedContent,
-					),
-					strings.TrimPrefix(line, eventStreamDataPrefix),
-				)
-			}
-		}
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
 
-		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
-			assert.Empty(t, scanner.Text())
+		h := setup(t)
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     userID,
+			RollCallID: uint(random.PositiveInt(t)),
 		}
 
-		// 3. Delete Reaction
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			GetRollCallReactionByID(gomock.Any(), reactionID).
-			Return(&updatedReaction, nil).
+			Return(&existingReaction, nil).
+			Times(1)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: existingReaction.RollCallID}}, nil).
 			Times(1)
+
 		h.repo.MockRollCallReactionRepository.EXPECT().
 			DeleteRollCallReaction(gomock.Any(), reactionID).
 			Return(nil).
 			Times(1)
 
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), existingReaction.RollCallID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(1)
+
 		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
 			Expect().
-			Status(http.StatusNoContent)
-
-			// SSEイベントの受信と検証 (Deleted)
-		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
-			line := scanner.Text()
-
-			if assert.True(
-				t,
-				strings.HasPrefix(line, eventStreamDataPrefix),
-				"line not start with 'data: '",
-				line,
-			) {
-				assert.JSONEq(
-					t,
-					fmt.Sprintf(`{"id":%d,"type":"deleted","userId":"%s"}`, reactionID, userID),
-					strings.TrimPrefix(line, eventStreamDataPrefix),
-				)
-			}
-		}
-
-		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
-			assert.Empty(t, scanner.Text())
-		}
+			Status(http.StatusNoContent).
+			NoContent()
 	})
 
-	t.Run("Filtering", func(t *testing.T) {
+	t.Run("Roll call closed", func(t *testing.T) {
 		t.Parallel()
+
 		h := setup(t)
-		rollCallID1 := uint(random.PositiveInt(t))
-		rollCallID2 := uint(random.PositiveInt(t))
+		reactionID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
-		user := model.User{ID: userID}
-
-		// rollCallID1のストリームに接続
-		ctx, cancel := context.WithTimeout(t.Context(), 1*time.Second)
-
-		defer cancel()
-
-		req, err := http.NewRequestWithContext(
-			ctx,
-			http.MethodGet,
-			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCallID1),
-			nil,
-		)
-
-		require.NoError(t, err)
+		rollCallID := uint(random.PositiveInt(t))
+		closedAt := time.Now().Add(-time.Minute)
 
-		res, err := http.DefaultClient.Do(req)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&model.RollCallReaction{
+				Model:      gorm.Model{ID: reactionID},
+				UserID:     userID,
+				RollCallID: rollCallID,
+			}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}, ClosedAt: &closedAt}, nil).
+			Times(1)
 
-		require.NoError(t, err)
+		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusConflict).
+			JSON().
+			Object().
+			Value("message").String().IsEqual("Roll call is closed")
+	})
 
-		defer func() {
-			require.NoError(t, res.Body.Close())
-		}()
+	t.Run("Reaction not found", func(t *testing.T) {
+		t.Parallel()
 
-		assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+		h := setup(t)
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
 
-		// rollCallID2のイベントを発生させる
-		content := random.AlphaNumericString(t, 20)
-		createReqBody := api.PostRollCallReactionJSONRequestBody{Content: content}
-		h.repo.MockUserRepository.EXPECT().
-			GetOrCreateUser(gomock.Any(), userID).
-			Return(&user, nil).
-			Times(1)
 		h.repo.MockRollCallReactionRepository.EXPECT().
-			CreateRollCallReaction(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
-				reaction.ID = uint(random.PositiveInt(t))
-				reaction.RollCallID = rollCallID2
-				reaction.Content = content
-				return nil
-			}).
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(nil, repository.ErrRollCallReactionNotFound).
 			Times(1)
 
-		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID2).
+		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
 			WithHeader("X-Forwarded-User", userID).
-			WithJSON(createReqBody).
 			Expect().
-			Status(http.StatusCreated)
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			Value("message").String().IsEqual("Reaction not found")
+	})
 
-		// ストリームにデータが流れてこないことを確認
-		body, err := io.ReadAll(res.Body)
+	t.Run("Forbidden - not owner", func(t *testing.T) {
+		t.Parallel()
 
-		require.ErrorIs(t, err, context.DeadlineExceeded)
+		h := setup(t)
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		otherUserID := random.AlphaNumericString(t, 32)
+
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     otherUserID, // 異なるユーザー
+			RollCallID: uint(random.PositiveInt(t)),
+		}
+
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&existingReaction, nil).
+			Times(1)
 
-		// SSEヘッダーのみでイベントデータは含まれていないことを確認
-		assert.Empty(t, body)
+		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden).
+			JSON().
+			Object().
+			Value("message").String().IsEqual("You can only delete your own reactions")
 	})
 
-	t.Run("Context Cancellation", func(t *testing.T) {
+	t.Run("GetRollCallReactionByID error", func(t *testing.T) {
 		t.Parallel()
+
 		h := setup(t)
-		rollCallID := uint(random.PositiveInt(t))
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
 
-		ctx, cancel := context.WithCancel(t.Context())
-		req := httptest.NewRequestWithContext(
-			ctx,
-			http.MethodGet,
-			fmt.Sprintf("/api/roll-calls/%d/reactions/stream", rollCallID),
-			nil,
-		)
-		rec := httptest.NewRecorder()
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(nil, errors.New("get reaction error")).
+			Times(1)
 
-		done := make(chan struct{})
-		go func() {
-			// ハンドラが終了したことを確認するためにServeHTTPとchannelを使用
-			h.e.ServeHTTP(rec, req)
-			close(done)
-		}()
+		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusInternalServerError)
+	})
 
-		// ResponseRecorderだとハンドラがflushする前にヘッダーを取得してしまうことがあるため、Eventuallyで待機する
-		// cancel後にヘッダーを確認するという手もあるが、切断前に正しくヘッダーが設定されることを確認するためこうしている
-		assert.Eventually(t, func() bool {
-			return rec.Header().Get(echo.HeaderContentType) == "text/event-stream"
-		}, time.Second, 10*time.Millisecond, "content-type not text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	t.Run("DeleteRollCallReaction error", func(t *testing.T) {
+		t.Parallel()
 
-		// コンテキストをキャンセル（クライアント切断をシミュレート）
-		cancel()
+		h := setup(t)
+		reactionID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
 
-		// ハンドラが正常に終了することを確認
-		select {
-		case <-done:
-			// 正常に終了
-		case <-time.After(2 * time.Second):
-			assert.Fail(t, "handler should finish when context is cancelled")
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			Content:    random.AlphaNumericString(t, 10),
+			UserID:     userID,
+			RollCallID: uint(random.PositiveInt(t)),
 		}
+
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&existingReaction, nil).
+			Times(1)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), existingReaction.RollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: existingReaction.RollCallID}}, nil).
+			Times(1)
+
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			DeleteRollCallReaction(gomock.Any(), reactionID).
+			Return(errors.New("delete reaction error")).
+			Times(1)
+
+		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusInternalServerError)
 	})
+}
 
-	t.Run("Multiple Clients", func(t *testing.T) {
+const eventStreamDataPrefix = "data: "
+
+// skipRetryHint はリアクションのストリームの最初に送られる再接続の間隔を読み飛ばす
+func skipRetryHint(t *testing.T, scanner *bufio.Scanner) {
+	t.Helper()
+
+	for _, expected := range []string{fmt.Sprintf("retry: %d", reactionStreamRetryMillis), ""} {
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			assert.Equal(t, expected, scanner.Text())
+		}
+	}
+}
+
+func TestServer_StreamRollCallReactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
 		t.Parallel()
+
 		h := setup(t)
 		rollCallID := uint(random.PositiveInt(t))
 		userID := random.AlphaNumericString(t, 32)
 		user := model.User{ID: userID}
 
-		// 複数のクライアントを用意
-		const numClients = 3
-		scanners := make([]*bufio.Scanner, numClients)
-		resBodies := make([]io.Closer, numClients)
-		cancels := make([]context.CancelFunc, numClients)
+		// ストリームの開始時、リアクションの作成時、更新時、削除時に点呼を取得する
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}}, nil).
+			Times(4)
+		// リアクションの作成時、更新時、削除時に集計結果を送信する
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCallID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(3)
 
-		// 各クライアントのSSEストリーム接続を準備
-		for i := range numClients {
-			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
-			req, err := http.NewRequestWithContext(
-				ctx,
-				http.MethodGet,
-				fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCallID),
-				nil,
-			)
+		// SSEリクエストの準備
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
 
-			require.NoError(t, err)
+		defer cancel()
 
-			res, err := http.DefaultClient.Do(req)
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCallID),
+			nil,
+		)
 
-			require.NoError(t, err)
-			assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+		require.NoError(t, err)
 
-			scanners[i] = bufio.NewScanner(res.Body)
-			resBodies[i] = res.Body
-			cancels[i] = cancel
-		}
+		res, err := http.DefaultClient.Do(req)
 
-		// クリーンアップ
-		defer func() {
-			for _, body := range resBodies {
-				require.NoError(t, body.Close())
-			}
+		require.NoError(t, err)
 
-			for _, cancel := range cancels {
-				cancel()
-			}
+		defer func() {
+			require.NoError(t, res.Body.Close())
 		}()
 
-		// リアクション作成イベントを発生させる
+		assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+		// イベントを読み取るためのスキャナ
+		scanner := bufio.NewScanner(res.Body)
+
+		skipRetryHint(t, scanner)
+
+		// 1. Create Reaction
 		originalContent := random.AlphaNumericString(t, 20)
 		createReqBody := api.PostRollCallReactionJSONRequestBody{Content: originalContent}
 		reactionID := uint(random.PositiveInt(t))
+		createdAt := time.Now()
 		h.repo.MockUserRepository.EXPECT().
 			GetOrCreateUser(gomock.Any(), userID).
 			Return(&user, nil).
@@ -1388,9 +1781,8 @@ func TestServer_StreamRollCallReactions(t *testing.T) {
 			CreateRollCallReaction(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
 				reaction.ID = reactionID
-				reaction.UserID = userID
-				reaction.RollCallID = rollCallID
-				reaction.Content = originalContent
+				reaction.CreatedAt = createdAt
+
 				return nil
 			}).
 			Times(1)
@@ -1401,57 +1793,38 @@ func TestServer_StreamRollCallReactions(t *testing.T) {
 			Expect().
 			Status(http.StatusCreated)
 
-		// 全てのクライアントが同じイベントを受信することを確認
-		expectedJSON := fmt.Sprintf(
-			`{"id":%d,"type":"created","userId":"%s","content":"%s"}`,
-			reactionID,
-			userID,
-			originalContent,
-		)
+		// SSEイベントの受信と検証 (Created)
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			assert.Equal(t, fmt.Sprintf("id: %d-%d", createdAt.UnixMilli(), reactionID), scanner.Text())
+		}
 
-		for i := range numClients {
-			if assert.Eventually(
-				t,
-				scanners[i].Scan,
-				2*time.Second,
-				50*time.Millisecond,
-				"client %d should receive created event line 1",
-				i,
-			) {
-				line := scanners[i].Text()
-				if assert.True(
-					t,
-					strings.HasPrefix(line, eventStreamDataPrefix),
-					"client %d line not start with 'data: '",
-					i,
-				) {
-					assert.JSONEq(
-						t,
-						expectedJSON,
-						strings.TrimPrefix(line, eventStreamDataPrefix),
-						"client %d should receive correct created JSON",
-						i,
-					)
-				}
-			}
-			if assert.Eventually(
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			line := scanner.Text()
+
+			if assert.True(
 				t,
-				scanners[i].Scan,
-				2*time.Second,
-				50*time.Millisecond,
-				"client %d should receive created event line 2",
-				i,
+				strings.HasPrefix(line, eventStreamDataPrefix),
+				"line not start with 'data: '",
+				line,
 			) {
-				assert.Empty(
+				assert.JSONEq(
 					t,
-					scanners[i].Text(),
-					"client %d second line should be empty",
-					i,
+					fmt.Sprintf(
+						`{"id":%d,"type":"created","userId":"%s","content":"%s"}`,
+						reactionID,
+						userID,
+						originalContent,
+					),
+					strings.TrimPrefix(line, eventStreamDataPrefix),
 				)
 			}
 		}
 
-		// リアクション更新イベントを発生させる
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			assert.Empty(t, scanner.Text())
+		}
+
+		// 2. Update Reaction
 		updatedContent := random.AlphaNumericString(t, 20)
 		updateReqBody := api.PutReactionJSONRequestBody{Content: updatedContent}
 		existingReaction := model.RollCallReaction{
@@ -1460,8 +1833,9 @@ func TestServer_StreamRollCallReactions(t *testing.T) {
 			RollCallID: rollCallID,
 			Content:    originalContent,
 		}
+		updatedAt := time.Now()
 		updatedReaction := model.RollCallReaction{
-			Model:      gorm.Model{ID: reactionID},
+			Model:      gorm.Model{ID: reactionID, CreatedAt: createdAt, UpdatedAt: updatedAt},
 			UserID:     userID,
 			RollCallID: rollCallID,
 			Content:    updatedContent,
@@ -1486,56 +1860,949 @@ func TestServer_StreamRollCallReactions(t *testing.T) {
 			Expect().
 			Status(http.StatusOK)
 
-		// 全てのクライアントが更新イベントを受信することを確認
-		expectedUpdatedJSON := fmt.Sprintf(
-			`{"id":%d,"type":"updated","userId":"%s","content":"%s"}`,
-			reactionID,
-			userID,
-			updatedContent,
-		)
+		// SSEイベントの受信と検証 (Updated)
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			assert.Equal(t, fmt.Sprintf("id: %d-%d", updatedAt.UnixMilli(), reactionID), scanner.Text())
+		}
 
-		for i := range numClients {
-			if assert.Eventually(
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			line := scanner.Text()
+
+			if assert.True(
 				t,
-				scanners[i].Scan,
-				2*time.Second,
-				50*time.Millisecond,
-				"client %d should receive updated event line 1",
-				i,
+				strings.HasPrefix(line, eventStreamDataPrefix),
+				"line not start with 'data: '",
+				line,
 			) {
-				line := scanners[i].Text()
-
-				if assert.True(
+				assert.JSONEq(
 					t,
-					strings.HasPrefix(line, eventStreamDataPrefix),
-					"client %d update line not start with 'data: '",
-					i,
-				) {
-					assert.JSONEq(
-						t,
-						expectedUpdatedJSON,
-						strings.TrimPrefix(line, eventStreamDataPrefix),
-						"client %d should receive correct update JSON",
-						i,
-					)
-				}
+					fmt.Sprintf(
+						`{"id":%d,"type":"updated","userId":"%s","content":"%s"}`,
+						reactionID,
+						userID,
+						updatedContent,
+					),
+					strings.TrimPrefix(line, eventStreamDataPrefix),
+				)
 			}
+		}
 
-			if assert.Eventually(
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			assert.Empty(t, scanner.Text())
+		}
+
+		// 3. Delete Reaction
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&updatedReaction, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			DeleteRollCallReaction(gomock.Any(), reactionID).
+			Return(nil).
+			Times(1)
+
+		h.expect.DELETE("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusNoContent)
+
+		// SSEイベントの受信と検証 (Deleted)
+		// 削除の時刻はハンドラ内で決まるので、リアクションのIDだけを確認する
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			line := scanner.Text()
+
+			assert.True(t, strings.HasPrefix(line, "id: "), line)
+			assert.True(t, strings.HasSuffix(line, fmt.Sprintf("-%d", reactionID)), line)
+		}
+
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			line := scanner.Text()
+
+			if assert.True(
 				t,
-				scanners[i].Scan,
-				2*time.Second,
-				50*time.Millisecond,
-				"client %d should receive updated event line 2",
-				i,
+				strings.HasPrefix(line, eventStreamDataPrefix),
+				"line not start with 'data: '",
+				line,
 			) {
-				assert.Empty(
+				assert.JSONEq(
 					t,
-					scanners[i].Text(),
-					"client %d second update line should be empty",
-					i,
+					fmt.Sprintf(`{"id":%d,"type":"deleted","userId":"%s"}`, reactionID, userID),
+					strings.TrimPrefix(line, eventStreamDataPrefix),
 				)
 			}
 		}
+
+		if assert.Eventually(t, scanner.Scan, 2*time.Second, 50*time.Millisecond) {
+			assert.Empty(t, scanner.Text())
+		}
+	})
+
+	t.Run("Filtering", func(t *testing.T) {
+		t.Parallel()
+		h := setup(t)
+		rollCallID1 := uint(random.PositiveInt(t))
+		rollCallID2 := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		user := model.User{ID: userID}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID1).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID1}}, nil).
+			Times(1)
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID2).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID2}}, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCallID2).
+			Return([]model.RollCallReaction{}, nil).
+			Times(1)
+
+		// rollCallID1のストリームに接続
+		ctx, cancel := context.WithTimeout(t.Context(), 1*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCallID1),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+		// rollCallID2のイベントを発生させる
+		content := random.AlphaNumericString(t, 20)
+		createReqBody := api.PostRollCallReactionJSONRequestBody{Content: content}
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&user, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			CreateRollCallReaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
+				reaction.ID = uint(random.PositiveInt(t))
+				reaction.RollCallID = rollCallID2
+				reaction.Content = content
+				return nil
+			}).
+			Times(1)
+
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID2).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(createReqBody).
+			Expect().
+			Status(http.StatusCreated)
+
+		// ストリームにデータが流れてこないことを確認
+		body, err := io.ReadAll(res.Body)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// 再接続の間隔のみでイベントデータは含まれていないことを確認
+		assert.Equal(t, fmt.Sprintf("retry: %d\n\n", reactionStreamRetryMillis), string(body))
+	})
+
+	t.Run("Context Cancellation", func(t *testing.T) {
+		t.Parallel()
+		h := setup(t)
+		rollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}}, nil).
+			Times(1)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		req := httptest.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("/api/roll-calls/%d/reactions/stream", rollCallID),
+			nil,
+		)
+		rec := httptest.NewRecorder()
+
+		done := make(chan struct{})
+		go func() {
+			// ハンドラが終了したことを確認するためにServeHTTPとchannelを使用
+			h.e.ServeHTTP(rec, req)
+			close(done)
+		}()
+
+		// ResponseRecorderだとハンドラがflushする前にヘッダーを取得してしまうことがあるため、Eventuallyで待機する
+		// cancel後にヘッダーを確認するという手もあるが、切断前に正しくヘッダーが設定されることを確認するためこうしている
+		assert.Eventually(t, func() bool {
+			return rec.Header().Get(echo.HeaderContentType) == "text/event-stream"
+		}, time.Second, 10*time.Millisecond, "content-type not text/event-stream", rec.Header().Get(echo.HeaderContentType))
+
+		// コンテキストをキャンセル（クライアント切断をシミュレート）
+		cancel()
+
+		// ハンドラが正常に終了することを確認
+		select {
+		case <-done:
+			// 正常に終了
+		case <-time.After(2 * time.Second):
+			assert.Fail(t, "handler should finish when context is cancelled")
+		}
+	})
+
+	t.Run("Multiple Clients", func(t *testing.T) {
+		t.Parallel()
+		h := setup(t)
+		rollCallID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		user := model.User{ID: userID}
+
+		// 複数のクライアントを用意
+		const numClients = 3
+
+		// 各クライアントの接続時、リアクションの作成時、更新時に点呼を取得する
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}}, nil).
+			Times(numClients + 2)
+		// リアクションの作成時、更新時に集計結果を送信する
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCallID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(2)
+		scanners := make([]*bufio.Scanner, numClients)
+		resBodies := make([]io.Closer, numClients)
+		cancels := make([]context.CancelFunc, numClients)
+
+		// 各クライアントのSSEストリーム接続を準備
+		for i := range numClients {
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			req, err := http.NewRequestWithContext(
+				ctx,
+				http.MethodGet,
+				fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCallID),
+				nil,
+			)
+
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+
+			require.NoError(t, err)
+			assert.Equal(t, "text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+			scanners[i] = bufio.NewScanner(res.Body)
+			resBodies[i] = res.Body
+			cancels[i] = cancel
+
+			skipRetryHint(t, scanners[i])
+		}
+
+		// クリーンアップ
+		defer func() {
+			for _, body := range resBodies {
+				require.NoError(t, body.Close())
+			}
+
+			for _, cancel := range cancels {
+				cancel()
+			}
+		}()
+
+		// リアクション作成イベントを発生させる
+		originalContent := random.AlphaNumericString(t, 20)
+		createReqBody := api.PostRollCallReactionJSONRequestBody{Content: originalContent}
+		reactionID := uint(random.PositiveInt(t))
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&user, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			CreateRollCallReaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
+				reaction.ID = reactionID
+				reaction.UserID = userID
+				reaction.RollCallID = rollCallID
+				reaction.Content = originalContent
+				return nil
+			}).
+			Times(1)
+
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCallID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(createReqBody).
+			Expect().
+			Status(http.StatusCreated)
+
+		// 全てのクライアントが同じイベントを受信することを確認
+		expectedJSON := fmt.Sprintf(
+			`{"id":%d,"type":"created","userId":"%s","content":"%s"}`,
+			reactionID,
+			userID,
+			originalContent,
+		)
+
+		for i := range numClients {
+			if assert.Eventually(
+				t,
+				scanners[i].Scan,
+				2*time.Second,
+				50*time.Millisecond,
+				"client %d should receive created event ID",
+				i,
+			) {
+				assert.True(t, strings.HasPrefix(scanners[i].Text(), "id: "), scanners[i].Text())
+			}
+
+			if assert.Eventually(
+				t,
+				scanners[i].Scan,
+				2*time.Second,
+				50*time.Millisecond,
+				"client %d should receive created event line 1",
+				i,
+			) {
+				line := scanners[i].Text()
+				if assert.True(
+					t,
+					strings.HasPrefix(line, eventStreamDataPrefix),
+					"client %d line not start with 'data: '",
+					i,
+				) {
+					assert.JSONEq(
+						t,
+						expectedJSON,
+						strings.TrimPrefix(line, eventStreamDataPrefix),
+						"client %d should receive correct created JSON",
+						i,
+					)
+				}
+			}
+			if assert.Eventually(
+				t,
+				scanners[i].Scan,
+				2*time.Second,
+				50*time.Millisecond,
+				"client %d should receive created event line 2",
+				i,
+			) {
+				assert.Empty(
+					t,
+					scanners[i].Text(),
+					"client %d second line should be empty",
+					i,
+				)
+			}
+		}
+
+		// リアクション更新イベントを発生させる
+		updatedContent := random.AlphaNumericString(t, 20)
+		updateReqBody := api.PutReactionJSONRequestBody{Content: updatedContent}
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			UserID:     userID,
+			RollCallID: rollCallID,
+			Content:    originalContent,
+		}
+		updatedReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: reactionID},
+			UserID:     userID,
+			RollCallID: rollCallID,
+			Content:    updatedContent,
+		}
+
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&existingReaction, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			UpdateRollCallReaction(gomock.Any(), reactionID, gomock.Any()).
+			Return(nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionByID(gomock.Any(), reactionID).
+			Return(&updatedReaction, nil).
+			Times(1)
+
+		h.expect.PUT("/api/reactions/{reactionId}", reactionID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(updateReqBody).
+			Expect().
+			Status(http.StatusOK)
+
+		// 全てのクライアントが更新イベントを受信することを確認
+		expectedUpdatedJSON := fmt.Sprintf(
+			`{"id":%d,"type":"updated","userId":"%s","content":"%s"}`,
+			reactionID,
+			userID,
+			updatedContent,
+		)
+
+		for i := range numClients {
+			if assert.Eventually(
+				t,
+				scanners[i].Scan,
+				2*time.Second,
+				50*time.Millisecond,
+				"client %d should receive updated event ID",
+				i,
+			) {
+				assert.True(t, strings.HasPrefix(scanners[i].Text(), "id: "), scanners[i].Text())
+			}
+
+			if assert.Eventually(
+				t,
+				scanners[i].Scan,
+				2*time.Second,
+				50*time.Millisecond,
+				"client %d should receive updated event line 1",
+				i,
+			) {
+				line := scanners[i].Text()
+
+				if assert.True(
+					t,
+					strings.HasPrefix(line, eventStreamDataPrefix),
+					"client %d update line not start with 'data: '",
+					i,
+				) {
+					assert.JSONEq(
+						t,
+						expectedUpdatedJSON,
+						strings.TrimPrefix(line, eventStreamDataPrefix),
+						"client %d should receive correct update JSON",
+						i,
+					)
+				}
+			}
+
+			if assert.Eventually(
+				t,
+				scanners[i].Scan,
+				2*time.Second,
+				50*time.Millisecond,
+				"client %d should receive updated event line 2",
+				i,
+			) {
+				assert.Empty(
+					t,
+					scanners[i].Text(),
+					"client %d second update line should be empty",
+					i,
+				)
+			}
+		}
+	})
+	t.Run("Include summary", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		option := random.AlphaNumericString(t, 10)
+		userID := random.AlphaNumericString(t, 32)
+		notRespondedUserID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options:  []string{option},
+			Subjects: []model.User{{ID: userID}, {ID: notRespondedUserID}},
+		}
+		reaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:     userID,
+			RollCallID: rollCall.ID,
+			Content:    option,
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(2)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{reaction}, nil).
+			Times(1)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf(
+				"%s/api/roll-calls/%d/reactions/stream?includeSummary=true",
+				h.testServerURL,
+				rollCall.ID,
+			),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		scanner := bufio.NewScanner(res.Body)
+
+		skipRetryHint(t, scanner)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			CreateRollCallReaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, r *model.RollCallReaction) error {
+				*r = reaction
+
+				return nil
+			}).
+			Times(1)
+
+		h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.PostRollCallReactionJSONRequestBody{Content: option}).
+			Expect().
+			Status(http.StatusCreated)
+
+		// createdイベントの後に集計結果のイベントが届く
+		lines := make([]string, 0, 5)
+
+		for len(lines) < 5 && scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+
+		require.Len(t, lines, 5)
+		assert.Contains(t, lines[1], `"type":"created"`)
+		assert.Empty(t, lines[2])
+
+		line, ok := strings.CutPrefix(lines[3], eventStreamDataPrefix)
+
+		if assert.True(t, ok, "line not start with 'data: '", lines[3]) {
+			var event api.RollCallSummaryEvent
+
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			assert.Equal(t, api.Summary, event.Type)
+			assert.Equal(t, []api.RollCallOptionCount{{Option: option, Count: 1}}, event.Summary.OptionCounts)
+			assert.Zero(t, event.Summary.OtherCount)
+			assert.Equal(t, []string{notRespondedUserID}, event.Summary.NotRespondedSubjects)
+		}
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		rollCall := model.RollCall{Model: gorm.Model{ID: uint(random.PositiveInt(t))}}
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(2)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCall.ID),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			UpdateRollCall(gomock.Any(), rollCall.ID, gomock.Any()).
+			Return(nil)
+
+		h.expect.POST("/api/admin/roll-calls/{rollCallId}/close", rollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusOK)
+
+		// closedイベントを送信した後にストリームが終了する
+		body, err := io.ReadAll(res.Body)
+
+		require.NoError(t, err)
+
+		line, ok := strings.CutPrefix(
+			strings.TrimSpace(strings.TrimPrefix(string(body), fmt.Sprintf("retry: %d\n\n", reactionStreamRetryMillis))),
+			eventStreamDataPrefix,
+		)
+
+		if assert.True(t, ok, "line not start with 'data: '", string(body)) {
+			var event api.RollCallClosedEvent
+
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			assert.Equal(t, api.Closed, event.Type)
+		}
+	})
+
+	t.Run("Deadline moved earlier", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		userID := random.AlphaNumericString(t, 32)
+		oldDeadline := time.Now().Add(time.Hour)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:   uint(random.PositiveInt(t)),
+			Deadline: &oldDeadline,
+		}
+		newDeadline := time.Now().Add(time.Second).Truncate(time.Millisecond)
+		updatedRollCall := rollCall
+		updatedRollCall.Deadline = &newDeadline
+
+		gomock.InOrder(
+			// ストリームの開始時と更新時
+			h.repo.MockRollCallRepository.EXPECT().
+				GetRollCallByID(gomock.Any(), rollCall.ID).
+				Return(&rollCall, nil).
+				Times(2),
+			// 変更後の締め切りにタイマーが発火したとき
+			h.repo.MockRollCallRepository.EXPECT().
+				GetRollCallByID(gomock.Any(), rollCall.ID).
+				Return(&updatedRollCall, nil),
+		)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID, IsStaff: true}, nil)
+		h.repo.MockRollCallRepository.EXPECT().
+			UpdateRollCall(gomock.Any(), rollCall.ID, gomock.Any()).
+			Return(nil)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCall.ID),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		h.expect.PUT("/api/admin/roll-calls/{rollCallId}", rollCall.ID).
+			WithHeader("X-Forwarded-User", userID).
+			WithJSON(api.RollCallRequest{
+				Name:     random.AlphaNumericString(t, 20),
+				Options:  []string{random.AlphaNumericString(t, 5)},
+				Subjects: []string{},
+				Deadline: &newDeadline,
+			}).
+			Expect().
+			Status(http.StatusOK)
+
+		// 変更前の締め切りまで待たずに、変更後の締め切りでclosedイベントを送信して終了する
+		body, err := io.ReadAll(res.Body)
+
+		require.NoError(t, err)
+
+		line, ok := strings.CutPrefix(
+			strings.TrimSpace(strings.TrimPrefix(string(body), fmt.Sprintf("retry: %d\n\n", reactionStreamRetryMillis))),
+			eventStreamDataPrefix,
+		)
+
+		if assert.True(t, ok, "line not start with 'data: '", string(body)) {
+			var event api.RollCallClosedEvent
+
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			assert.Equal(t, api.Closed, event.Type)
+			assert.True(t, newDeadline.Equal(event.ClosedAt))
+		}
+	})
+
+	t.Run("Already closed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		deadline := time.Now().Add(-time.Hour).Truncate(time.Second)
+		rollCall := model.RollCall{
+			Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+			Deadline: &deadline,
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil)
+
+		body := h.expect.GET("/api/roll-calls/{rollCallId}/reactions/stream", rollCall.ID).
+			Expect().
+			Status(http.StatusOK).
+			Body().
+			Raw()
+
+		line, ok := strings.CutPrefix(
+			strings.TrimSpace(strings.TrimPrefix(body, fmt.Sprintf("retry: %d\n\n", reactionStreamRetryMillis))),
+			eventStreamDataPrefix,
+		)
+
+		if assert.True(t, ok, "line not start with 'data: '", body) {
+			var event api.RollCallClosedEvent
+
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			assert.Equal(t, api.Closed, event.Type)
+			assert.True(t, deadline.Equal(event.ClosedAt))
+		}
+	})
+
+	t.Run("Roll call not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		rollCallID := uint(random.PositiveInt(t))
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(nil, repository.ErrRollCallNotFound)
+
+		h.expect.GET("/api/roll-calls/{rollCallId}/reactions/stream", rollCallID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Replay from Last-Event-ID", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		rollCall := model.RollCall{Model: gorm.Model{ID: uint(random.PositiveInt(t))}}
+		userID := random.AlphaNumericString(t, 32)
+		since := time.UnixMilli(time.Now().Add(-time.Minute).UnixMilli())
+		sinceReactionID := uint(random.PositiveIntN(t, 100))
+		// 切断前に作成され、切断中に更新されたリアクション
+		updated := model.RollCallReaction{
+			Model: gorm.Model{
+				ID:        sinceReactionID + 1,
+				CreatedAt: since.Add(-time.Second),
+				UpdatedAt: since.Add(3 * time.Second),
+			},
+			UserID:  random.AlphaNumericString(t, 32),
+			Content: random.AlphaNumericString(t, 10),
+		}
+		// 切断中に作成されたリアクション
+		created := model.RollCallReaction{
+			Model: gorm.Model{
+				ID:        sinceReactionID + 2,
+				CreatedAt: since.Add(time.Second),
+				UpdatedAt: since.Add(time.Second),
+			},
+			UserID:  random.AlphaNumericString(t, 32),
+			Content: random.AlphaNumericString(t, 10),
+		}
+		// 切断中に作成されて削除されたリアクションは送らない
+		createdAndDeleted := model.RollCallReaction{
+			Model: gorm.Model{
+				ID:        sinceReactionID + 3,
+				CreatedAt: since.Add(time.Second),
+				UpdatedAt: since.Add(time.Second),
+				DeletedAt: gorm.DeletedAt{Time: since.Add(2 * time.Second), Valid: true},
+			},
+			UserID: random.AlphaNumericString(t, 32),
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCall.ID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactionChanges(gomock.Any(), rollCall.ID, since, sinceReactionID).
+			Return([]model.RollCallReaction{created, createdAndDeleted, updated}, nil).
+			Times(1)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", h.testServerURL, rollCall.ID),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		req.Header.Set("Last-Event-ID", fmt.Sprintf("%d-%d", since.UnixMilli(), sinceReactionID))
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		scanner := bufio.NewScanner(res.Body)
+
+		skipRetryHint(t, scanner)
+
+		lines := readStreamLines(t, scanner, 6)
+
+		assert.Equal(t, fmt.Sprintf("id: %d-%d", created.CreatedAt.UnixMilli(), created.ID), lines[0])
+		assert.Contains(t, lines[1], `"type":"created"`)
+		assert.Empty(t, lines[2])
+		assert.Equal(t, fmt.Sprintf("id: %d-%d", updated.UpdatedAt.UnixMilli(), updated.ID), lines[3])
+		assert.Contains(t, lines[4], `"type":"updated"`)
+		assert.Empty(t, lines[5])
+
+		// 再送した変更より前の変更は、購読しているイベントとして届いても送らない
+		for _, reaction := range []model.RollCallReaction{
+			{Model: gorm.Model{ID: created.ID + 10, CreatedAt: since.Add(2 * time.Second)}},
+			{Model: gorm.Model{ID: created.ID + 11, CreatedAt: since.Add(4 * time.Second)}},
+		} {
+			h.repo.MockUserRepository.EXPECT().
+				GetOrCreateUser(gomock.Any(), userID).
+				Return(&model.User{ID: userID}, nil).
+				Times(1)
+			h.repo.MockRollCallReactionRepository.EXPECT().
+				CreateRollCallReaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, r *model.RollCallReaction) error {
+					r.Model = reaction.Model
+
+					return nil
+				}).
+				Times(1)
+			h.repo.MockRollCallRepository.EXPECT().
+				GetRollCallByID(gomock.Any(), rollCall.ID).
+				Return(&rollCall, nil).
+				Times(1)
+			h.repo.MockRollCallReactionRepository.EXPECT().
+				GetRollCallReactions(gomock.Any(), rollCall.ID).
+				Return([]model.RollCallReaction{}, nil).
+				Times(1)
+
+			h.expect.POST("/api/roll-calls/{rollCallId}/reactions", rollCall.ID).
+				WithHeader("X-Forwarded-User", userID).
+				WithJSON(api.PostRollCallReactionJSONRequestBody{
+					Content: random.AlphaNumericString(t, 10),
+				}).
+				Expect().
+				Status(http.StatusCreated)
+		}
+
+		lines = readStreamLines(t, scanner, 3)
+
+		assert.Equal(
+			t,
+			fmt.Sprintf("id: %d-%d", since.Add(4*time.Second).UnixMilli(), created.ID+11),
+			lines[0],
+		)
+		assert.Contains(t, lines[1], `"type":"created"`)
+		assert.Empty(t, lines[2])
+	})
+
+	t.Run("Invalid Last-Event-ID", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.GET("/api/roll-calls/{rollCallId}/reactions/stream", random.PositiveInt(t)).
+			WithHeader("Last-Event-ID", "invalid").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		// サーバーの終了を再現するため、キャンセルできるコンテキストでサーバーを作成する
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		serverCtx, shutdown := context.WithCancel(t.Context())
+		server := NewServer(
+			serverCtx,
+			repo,
+			mockactivity.NewMockActivityService(ctrl),
+			mockarchive.NewMockArchiveService(ctrl),
+			mockreconciliation.NewMockReconciliationService(ctrl),
+			mocknotification.NewMockNotificationService(ctrl),
+			mocktraq.NewMockTraqService(ctrl),
+			eventbus.NewEventBus(100),
+			TraqBotConfig{},
+			PubSubConfig{},
+			false,
+		)
+		e := echo.New()
+
+		api.RegisterHandlers(e, server)
+
+		rollCallID := uint(random.PositiveInt(t))
+
+		repo.MockRollCallRepository.EXPECT().
+			GetRollCallByID(gomock.Any(), rollCallID).
+			Return(&model.RollCall{Model: gorm.Model{ID: rollCallID}}, nil).
+			Times(1)
+
+		httptestServer := httptest.NewServer(e)
+
+		t.Cleanup(httptestServer.Close)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("%s/api/roll-calls/%d/reactions/stream", httptestServer.URL, rollCallID),
+			nil,
+		)
+
+		require.NoError(t, err)
+
+		res, err := http.DefaultClient.Do(req)
+
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		scanner := bufio.NewScanner(res.Body)
+
+		skipRetryHint(t, scanner)
+		shutdown()
+
+		// shutdownイベントを送信した後にストリームが終了する
+		body, err := io.ReadAll(res.Body)
+
+		require.NoError(t, err)
+		assert.Equal(t, "event: shutdown\ndata: {}\n\n", string(body))
 	})
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 const roomStatusTopicMaxLength = 64
@@ -82,6 +83,8 @@ func (s *Server) PutRoomStatus(
 			SetInternal(fmt.Errorf("failed to set room status: %w", err))
 	}
 
+	s.eventBus.Publish(campID, eventbus.TopicRoomStatus, eventbus.EventTypeUpdated, uint(roomID))
+
 	return e.NoContent(http.StatusNoContent)
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/traPtitech/rucQ/converter"
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
 )
 
 func (s *Server) AdminPostRoom(e echo.Context, params api.AdminPostRoomParams) error {
@@ -65,6 +66,11 @@ func (s *Server) AdminPostRoom(e echo.Context, params api.AdminPostRoomParams) e
 			SetInternal(err)
 	}
 
+	if campID, ok := s.roomCampID(ctx, updatedRoom.ID); ok {
+		s.eventBus.Publish(campID, eventbus.TopicRoom, eventbus.EventTypeCreated, updatedRoom.ID)
+		s.eventBus.Publish(campID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+	}
+
 	res, err := converter.Convert[api.RoomResponse](updatedRoom)
 
 	if err != nil {
@@ -136,6 +142,10 @@ func (s *Server) AdminPutRoom(
 			SetInternal(fmt.Errorf("failed to get room by ID: %w", err))
 	}
 
+	if campID, ok := s.roomCampID(e.Request().Context(), updatedRoom.ID); ok {
+		s.eventBus.Publish(campID, eventbus.TopicRoom, eventbus.EventTypeUpdated, updatedRoom.ID)
+	}
+
 	res, err := converter.Convert[api.RoomResponse](updatedRoom)
 
 	if err != nil {
@@ -162,6 +172,18 @@ func (s *Server) AdminDeleteRoom(
 		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
 	}
 
+	// 削除後は合宿を取得できないため、先に取得しておく
+	campID, err := s.repo.GetRoomCampID(e.Request().Context(), uint(roomID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRoomNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Room not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp ID of room: %w", err))
+	}
+
 	if err := s.repo.DeleteRoom(e.Request().Context(), uint(roomID)); err != nil {
 		if errors.Is(err, repository.ErrRoomNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "Room not found")
@@ -171,5 +193,7 @@ func (s *Server) AdminDeleteRoom(
 			SetInternal(fmt.Errorf("failed to delete room: %w", err))
 	}
 
+	s.eventBus.Publish(campID, eventbus.TopicRoom, eventbus.EventTypeDeleted, uint(roomID))
+
 	return e.NoContent(http.StatusNoContent)
 }
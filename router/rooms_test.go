@@ -66,6 +66,10 @@ func TestServer_AdminPostRoom(t *testing.T) {
 				},
 			}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), roomID).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 		h.activityService.EXPECT().
 			RecordRoomCreated(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
@@ -134,6 +138,10 @@ func TestServer_AdminPostRoom(t *testing.T) {
 				Members:     []model.User{},
 			}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), roomID).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 		h.activityService.EXPECT().
 			RecordRoomCreated(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil).
@@ -390,6 +398,10 @@ func TestServer_AdminPutRoom(t *testing.T) {
 				},
 			}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), roomID).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 
 		res := h.expect.PUT("/api/admin/rooms/{roomId}", roomID).
 			WithJSON(req).
@@ -457,6 +469,10 @@ func TestServer_AdminPutRoom(t *testing.T) {
 				Members:     []model.User{},
 			}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), roomID).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 
 		res := h.expect.PUT("/api/admin/rooms/{roomId}", roomID).
 			WithJSON(req).
@@ -521,6 +537,10 @@ func TestServer_AdminPutRoom(t *testing.T) {
 				},
 			}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), roomID).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 
 		res := h.expect.PUT("/api/admin/rooms/{roomId}", roomID).
 			WithJSON(req).
@@ -769,6 +789,10 @@ func TestServer_AdminDeleteRoom(t *testing.T) {
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), uint(roomID)).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 		h.repo.MockRoomRepository.EXPECT().
 			DeleteRoom(gomock.Any(), uint(roomID)).
 			Return(nil).
@@ -809,6 +833,10 @@ func TestServer_AdminDeleteRoom(t *testing.T) {
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), uint(roomID)).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 		h.repo.MockRoomRepository.EXPECT().
 			DeleteRoom(gomock.Any(), uint(roomID)).
 			Return(repository.ErrRoomNotFound).
@@ -849,6 +877,10 @@ func TestServer_AdminDeleteRoom(t *testing.T) {
 			GetOrCreateUser(gomock.Any(), username).
 			Return(&model.User{IsStaff: true}, nil).
 			Times(1)
+		h.repo.MockRoomRepository.EXPECT().
+			GetRoomCampID(gomock.Any(), uint(roomID)).
+			Return(uint(random.PositiveInt(t)), nil).
+			Times(1)
 		h.repo.MockRoomRepository.EXPECT().
 			DeleteRoom(gomock.Any(), uint(roomID)).
 			Return(errors.New("database error")).
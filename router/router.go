@@ -2,49 +2,150 @@ package router
 
 import (
 	"context"
-
-	"github.com/sesopenko/genericpubsub"
+	"encoding/json"
+	"time"
 
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/repository"
 	activityservice "github.com/traPtitech/rucQ/service/activity"
+	archiveservice "github.com/traPtitech/rucQ/service/archive"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/notification"
+	"github.com/traPtitech/rucQ/service/pubsub"
+	"github.com/traPtitech/rucQ/service/reconciliation"
 	"github.com/traPtitech/rucQ/service/traq"
 )
 
 type reactionEvent struct {
 	rollCallID uint
+	eventID    string // SSEのidとして送るイベントID。空の場合はidを送らない
 	data       api.RollCallReactionEvent
+	closed     bool // 点呼が締め切られたことを表すイベントか
+	// dataの直後に送る集計結果のイベント。includeSummaryを指定したストリームにだけ送信する
+	summary *api.RollCallReactionEvent
+	// 点呼の締め切りが変更されたことを表すイベントか。
+	// ストリームには送信せず、締め切りのタイマーをdeadlineで掛け直す
+	deadlineChanged bool
+	deadline        *time.Time
+}
+
+// reactionEventJSON はインスタンス間でreactionEventを受け渡すための表現
+type reactionEventJSON struct {
+	RollCallID uint                       `json:"rollCallId"`
+	EventID    string                     `json:"eventId,omitempty"`
+	Data       api.RollCallReactionEvent  `json:"data"`
+	Closed     bool                       `json:"closed"`
+	Summary    *api.RollCallReactionEvent `json:"summary,omitempty"`
+
+	DeadlineChanged bool       `json:"deadlineChanged,omitempty"`
+	Deadline        *time.Time `json:"deadline,omitempty"`
+}
+
+func (e reactionEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(reactionEventJSON{
+		RollCallID: e.rollCallID,
+		EventID:    e.eventID,
+		Data:       e.data,
+		Closed:     e.closed,
+		Summary:    e.summary,
+
+		DeadlineChanged: e.deadlineChanged,
+		Deadline:        e.deadline,
+	})
+}
+
+func (e *reactionEvent) UnmarshalJSON(b []byte) error {
+	var v reactionEventJSON
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*e = reactionEvent{
+		rollCallID: v.RollCallID,
+		eventID:    v.EventID,
+		data:       v.Data,
+		closed:     v.Closed,
+		summary:    v.Summary,
+
+		deadlineChanged: v.DeadlineChanged,
+		deadline:        v.Deadline,
+	}
+
+	return nil
 }
 
 type Server struct {
-	repo                repository.Repository
-	activityService     activityservice.ActivityService
-	notificationService notification.NotificationService
-	traqService         traq.TraqService
-	reactionPubSub      *genericpubsub.PubSub[reactionEvent]
-	isDev               bool
+	repo                  repository.Repository
+	activityService       activityservice.ActivityService
+	archiveService        archiveservice.ArchiveService
+	reconciliationService reconciliation.ReconciliationService
+	notificationService   notification.NotificationService
+	traqService           traq.TraqService
+	eventBus              eventbus.EventBus
+	traqBotConfig         TraqBotConfig
+	reactionPubSub        pubsub.PubSub[reactionEvent]
+	shutdown              <-chan struct{} // サーバーの終了時に閉じられ、SSEのストリームを終了させる
+	isDev                 bool
+}
+
+// TraqBotConfig はtraQ botとの連携に関する設定です
+type TraqBotConfig struct {
+	VerificationToken string // traQからのリクエストのX-TRAQ-BOT-TOKENヘッダーの値
+	RollCallChannelID string // 点呼を投稿するチャンネルのUUID。空の場合は投稿しない
+}
+
+// PubSubConfig は点呼のリアクションのストリームに使うPub/Subの設定です。
+// 合宿のイベントのストリームにはNewServerに渡すEventBusを使います
+type PubSubConfig struct {
+	// trueの場合はデータベースを介してイベントを共有し、複数のインスタンスで動かせるようにする
+	UseDatabase  bool
+	PollInterval time.Duration // データベースを確認する間隔
 }
 
-const maxReactionEventBuffer = 100
+const (
+	maxReactionEventBuffer = 100
+	reactionPubSubChannel  = "roll_call_reactions"
+)
 
 func NewServer(
 	ctx context.Context,
 	repo repository.Repository,
 	activityService activityservice.ActivityService,
+	archiveService archiveservice.ArchiveService,
+	reconciliationService reconciliation.ReconciliationService,
 	notificationService notification.NotificationService,
 	traqService traq.TraqService,
+	eventBus eventbus.EventBus,
+	traqBotConfig TraqBotConfig,
+	pubSubConfig PubSubConfig,
 	isDev bool,
 ) *Server {
-	return &Server{
-		repo:                repo,
-		activityService:     activityService,
-		notificationService: notificationService,
-		traqService:         traqService,
-		reactionPubSub: genericpubsub.New[reactionEvent](
+	var reactionPubSub pubsub.PubSub[reactionEvent]
+
+	if pubSubConfig.UseDatabase {
+		reactionPubSub = pubsub.NewOutboxPubSub[reactionEvent](
 			ctx,
+			repo,
+			reactionPubSubChannel,
+			pubSubConfig.PollInterval,
 			maxReactionEventBuffer,
-		),
-		isDev: isDev,
+		)
+	} else {
+		reactionPubSub = pubsub.NewMemoryPubSub[reactionEvent](ctx, maxReactionEventBuffer)
+	}
+
+	return &Server{
+		repo:                  repo,
+		activityService:       activityService,
+		archiveService:        archiveService,
+		reconciliationService: reconciliationService,
+		notificationService:   notificationService,
+		traqService:           traqService,
+		eventBus:              eventBus,
+		traqBotConfig:         traqBotConfig,
+		reactionPubSub:        reactionPubSub,
+		shutdown:              ctx.Done(),
+		isDev:                 isDev,
 	}
 }
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"encoding/json"
 	"net/http/httptest"
 	"sync"
 	"testing"
@@ -8,21 +9,36 @@ import (
 
 	"github.com/gavv/httpexpect/v2"
 	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/traPtitech/rucQ/api"
 	"github.com/traPtitech/rucQ/repository/mockrepository"
 	"github.com/traPtitech/rucQ/service/activity/mockactivity"
+	"github.com/traPtitech/rucQ/service/archive/mockarchive"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/notification/mocknotification"
+	"github.com/traPtitech/rucQ/service/reconciliation/mockreconciliation"
 	"github.com/traPtitech/rucQ/service/traq/mocktraq"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+const (
+	testTraqBotVerificationToken = "test-verification-token"
+	testTraqRollCallChannelID    = "00000000-0000-4000-8000-000000000001"
 )
 
 type testHandler struct {
-	expect              *httpexpect.Expect
-	repo                *mockrepository.MockRepository
-	activityService     *mockactivity.MockActivityService
-	notificationService *mocknotification.MockNotificationService
-	traqService         *mocktraq.MockTraqService
+	expect                *httpexpect.Expect
+	repo                  *mockrepository.MockRepository
+	activityService       *mockactivity.MockActivityService
+	archiveService        *mockarchive.MockArchiveService
+	reconciliationService *mockreconciliation.MockReconciliationService
+	notificationService   *mocknotification.MockNotificationService
+	traqService           *mocktraq.MockTraqService
+	// 送信されたイベントを確認しやすいように、モックではなく実際の実装を使う
+	eventBus eventbus.EventBus
 	// 基本的にはexpectを使うこと。
 	// SSEなど、httpexpectでテストしづらいものをテストするときにのみ使用する
 	e             *echo.Echo
@@ -37,9 +53,28 @@ func setup(t *testing.T) *testHandler {
 	traqService := mocktraq.NewMockTraqService(ctrl)
 	notificationService := mocknotification.NewMockNotificationService(ctrl)
 	activityService := mockactivity.NewMockActivityService(ctrl)
-	server := NewServer(t.Context(), repo, activityService, notificationService, traqService, false)
+	archiveService := mockarchive.NewMockArchiveService(ctrl)
+	reconciliationService := mockreconciliation.NewMockReconciliationService(ctrl)
+	eventBus := eventbus.NewEventBus(100)
+	server := NewServer(
+		t.Context(),
+		repo,
+		activityService,
+		archiveService,
+		reconciliationService,
+		notificationService,
+		traqService,
+		eventBus,
+		TraqBotConfig{
+			VerificationToken: testTraqBotVerificationToken,
+			RollCallChannelID: testTraqRollCallChannelID,
+		},
+		PubSubConfig{},
+		false,
+	)
 	e := echo.New()
 
+	e.POST("/api/traq-events", server.TraqEventHandler)
 	api.RegisterHandlers(e, server)
 
 	httptestServer := httptest.NewServer(e)
@@ -54,13 +89,16 @@ func setup(t *testing.T) *testHandler {
 	})
 
 	return &testHandler{
-		expect:              expect,
-		repo:                repo,
-		activityService:     activityService,
-		notificationService: notificationService,
-		traqService:         traqService,
-		e:                   e,
-		testServerURL:       httptestServer.URL,
+		expect:                expect,
+		repo:                  repo,
+		activityService:       activityService,
+		eventBus:              eventBus,
+		archiveService:        archiveService,
+		reconciliationService: reconciliationService,
+		notificationService:   notificationService,
+		traqService:           traqService,
+		e:                     e,
+		testServerURL:         httptestServer.URL,
 	}
 }
 
@@ -81,3 +119,53 @@ func waitWithTimeout(t *testing.T, wg *sync.WaitGroup, timeout time.Duration) {
 		t.Error("timeout waiting for goroutine to finish")
 	}
 }
+
+func TestReactionEvent_JSON(t *testing.T) {
+	t.Parallel()
+
+	// データベースを介したPub/Subでは、reactionEventをJSONにして受け渡す
+	var data api.RollCallReactionEvent
+
+	require.NoError(t, data.FromRollCallReactionCreatedEvent(api.RollCallReactionCreatedEvent{
+		Id:      random.PositiveInt(t),
+		Type:    api.Created,
+		UserId:  random.AlphaNumericString(t, 32),
+		Content: random.AlphaNumericString(t, 20),
+	}))
+
+	event := reactionEvent{
+		rollCallID: uint(random.PositiveInt(t)),
+		eventID:    random.AlphaNumericString(t, 20),
+		data:       data,
+		closed:     random.Bool(t),
+		summary:    &data,
+	}
+
+	b, err := json.Marshal(event)
+
+	require.NoError(t, err)
+
+	var decoded reactionEvent
+
+	require.NoError(t, json.Unmarshal(b, &decoded))
+
+	assert.Equal(t, event.rollCallID, decoded.rollCallID)
+	assert.Equal(t, event.eventID, decoded.eventID)
+	assert.Equal(t, event.closed, decoded.closed)
+
+	expectedData, err := event.data.MarshalJSON()
+
+	require.NoError(t, err)
+
+	actualData, err := decoded.data.MarshalJSON()
+
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedData), string(actualData))
+
+	if assert.NotNil(t, decoded.summary) {
+		actualSummary, err := decoded.summary.MarshalJSON()
+
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expectedData), string(actualSummary))
+	}
+}
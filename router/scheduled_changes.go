@@ -0,0 +1,190 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/converter"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// AdminGetScheduledChanges 予約した変更の一覧を取得（管理者用）
+func (s *Server) AdminGetScheduledChanges(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminGetScheduledChangesParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	changes, err := s.repo.GetScheduledChanges(ctx, uint(campID))
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get scheduled changes: %w", err))
+	}
+
+	res, err := converter.Convert[[]api.ScheduledChangeResponse](changes)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert scheduled changes to response: %w", err))
+	}
+
+	return e.JSON(http.StatusOK, res)
+}
+
+// AdminPostScheduledChange 合宿や質問グループの変更を予約（管理者用）
+func (s *Server) AdminPostScheduledChange(
+	e echo.Context,
+	campID api.CampId,
+	params api.AdminPostScheduledChangeParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	var req api.AdminPostScheduledChangeJSONRequestBody
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if !req.TargetType.Valid() {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid target type")
+	}
+
+	if !req.Field.Valid() {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid field")
+	}
+
+	if _, err := s.repo.GetCampByID(ctx, uint(campID)); err != nil {
+		if errors.Is(err, repository.ErrCampNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Camp not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get camp: %w", err))
+	}
+
+	change, err := converter.Convert[model.ScheduledChange](req)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert request to model: %w", err))
+	}
+
+	change.CampID = uint(campID)
+	change.CreatedByID = user.ID
+
+	switch change.TargetType {
+	case model.ScheduledChangeTargetTypeCamp:
+		change.TargetID = uint(campID)
+
+	case model.ScheduledChangeTargetTypeQuestionGroup:
+		if req.TargetId == nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "targetId is required for question_group")
+		}
+
+		questionGroup, err := s.repo.GetQuestionGroup(ctx, change.TargetID)
+
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to get question group: %w", err))
+		}
+
+		// 他の合宿の質問グループは存在しないものとして扱う
+		if err != nil || questionGroup.CampID != uint(campID) {
+			return echo.NewHTTPError(http.StatusNotFound, "Question group not found")
+		}
+	}
+
+	if err := change.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := s.repo.CreateScheduledChange(ctx, &change); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to create scheduled change: %w", err))
+	}
+
+	res, err := converter.Convert[api.ScheduledChangeResponse](change)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to convert model to response: %w", err))
+	}
+
+	return e.JSON(http.StatusCreated, res)
+}
+
+// AdminDeleteScheduledChange 予約した変更を取り消し（管理者用）
+func (s *Server) AdminDeleteScheduledChange(
+	e echo.Context,
+	scheduledChangeID api.ScheduledChangeId,
+	params api.AdminDeleteScheduledChangeParams,
+) error {
+	ctx := e.Request().Context()
+	user, err := s.repo.GetOrCreateUser(ctx, *params.XForwardedUser)
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get or create user: %w", err))
+	}
+
+	if !user.IsStaff {
+		return echo.NewHTTPError(http.StatusForbidden, "Forbidden")
+	}
+
+	change, err := s.repo.GetScheduledChangeByID(ctx, uint(scheduledChangeID))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrScheduledChangeNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Scheduled change not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to get scheduled change: %w", err))
+	}
+
+	// 処理済みの変更は履歴として残す
+	if change.ProcessedAt != nil {
+		return echo.NewHTTPError(
+			http.StatusConflict,
+			"Scheduled change has already been processed",
+		)
+	}
+
+	if err := s.repo.DeleteScheduledChange(ctx, change.ID); err != nil {
+		if errors.Is(err, repository.ErrScheduledChangeNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "Scheduled change not found")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to delete scheduled change: %w", err))
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
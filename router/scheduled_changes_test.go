@@ -0,0 +1,436 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/api"
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_AdminGetScheduledChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		processedAt := random.Time(t)
+		failureReason := random.AlphaNumericString(t, 20)
+		changes := []model.ScheduledChange{
+			{
+				Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+				CampID:      uint(campID),
+				TargetType:  model.ScheduledChangeTargetTypeCamp,
+				TargetID:    uint(campID),
+				Field:       model.ScheduledChangeFieldStatus,
+				Value:       string(model.CampStatusRegistration),
+				ScheduledAt: random.Time(t),
+				CreatedByID: adminUserID,
+			},
+			{
+				Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+				CampID:        uint(campID),
+				TargetType:    model.ScheduledChangeTargetTypeQuestionGroup,
+				TargetID:      uint(random.PositiveInt(t)),
+				Field:         model.ScheduledChangeFieldDue,
+				Value:         "2026-08-31",
+				ScheduledAt:   random.Time(t),
+				CreatedByID:   adminUserID,
+				ProcessedAt:   &processedAt,
+				FailureReason: &failureReason,
+			},
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			GetScheduledChanges(gomock.Any(), uint(campID)).
+			Return(changes, nil)
+
+		res := h.expect.GET("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+
+		res.Length().IsEqual(len(changes))
+
+		first := res.Value(0).Object()
+
+		first.HasValue("id", changes[0].ID)
+		first.HasValue("campId", campID)
+		first.HasValue("targetType", api.ScheduledChangeTargetTypeCamp)
+		first.HasValue("targetId", campID)
+		first.HasValue("field", api.Status)
+		first.HasValue("value", changes[0].Value)
+		first.HasValue("createdBy", adminUserID)
+		first.NotContainsKey("processedAt")
+		first.NotContainsKey("failureReason")
+
+		second := res.Value(1).Object()
+
+		second.HasValue("targetType", api.ScheduledChangeTargetTypeQuestionGroup)
+		second.HasValue("field", api.Due)
+		second.HasValue("failureReason", failureReason)
+		second.Value("processedAt").String().AsDateTime(time.RFC3339).InRange(
+			processedAt.Add(-time.Second),
+			processedAt.Add(time.Second),
+		)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.GET("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminPostScheduledChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success (camp)", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		req := api.AdminPostScheduledChangeJSONRequestBody{
+			TargetType:  api.ScheduledChangeTargetTypeCamp,
+			Field:       api.Name,
+			Value:       random.AlphaNumericString(t, 20),
+			ScheduledAt: scheduledAt,
+		}
+		changeID := uint(random.PositiveInt(t))
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			CreateScheduledChange(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, change *model.ScheduledChange) error {
+				assert.Equal(t, uint(campID), change.CampID)
+				assert.Equal(t, uint(campID), change.TargetID)
+				assert.Equal(t, adminUserID, change.CreatedByID)
+				assert.True(t, scheduledAt.Equal(change.ScheduledAt))
+
+				change.ID = changeID
+
+				return nil
+			})
+
+		h.expect.POST("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			HasValue("id", changeID).
+			HasValue("targetType", api.ScheduledChangeTargetTypeCamp).
+			HasValue("targetId", campID).
+			HasValue("field", api.Name).
+			HasValue("value", req.Value).
+			HasValue("createdBy", adminUserID)
+	})
+
+	t.Run("Success (question group)", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		questionGroupID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		req := api.AdminPostScheduledChangeJSONRequestBody{
+			TargetType:  api.ScheduledChangeTargetTypeQuestionGroup,
+			TargetId:    &questionGroupID,
+			Field:       api.Due,
+			Value:       "2026-08-31",
+			ScheduledAt: time.Now().Add(time.Hour),
+		}
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				Model:  gorm.Model{ID: uint(questionGroupID)},
+				CampID: uint(campID),
+			}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			CreateScheduledChange(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithJSON(req).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().
+			Object().
+			HasValue("targetType", api.ScheduledChangeTargetTypeQuestionGroup).
+			HasValue("targetId", questionGroupID).
+			HasValue("field", api.Due)
+	})
+
+	t.Run("Question group of another camp", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		questionGroupID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+		h.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), uint(questionGroupID)).
+			Return(&model.QuestionGroup{
+				Model:  gorm.Model{ID: uint(questionGroupID)},
+				CampID: uint(campID) + 1,
+			}, nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithJSON(api.AdminPostScheduledChangeJSONRequestBody{
+				TargetType:  api.ScheduledChangeTargetTypeQuestionGroup,
+				TargetId:    &questionGroupID,
+				Field:       api.Name,
+				Value:       random.AlphaNumericString(t, 20),
+				ScheduledAt: time.Now().Add(time.Hour),
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Camp not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+		h.repo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), uint(campID)).
+			Return(nil, repository.ErrCampNotFound)
+
+		h.expect.POST("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithJSON(api.AdminPostScheduledChangeJSONRequestBody{
+				TargetType:  api.ScheduledChangeTargetTypeCamp,
+				Field:       api.Name,
+				Value:       random.AlphaNumericString(t, 20),
+				ScheduledAt: time.Now().Add(time.Hour),
+			}).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			req  api.AdminPostScheduledChangeJSONRequestBody
+		}{
+			{
+				name: "Field not allowed for target",
+				req: api.AdminPostScheduledChangeJSONRequestBody{
+					TargetType: api.ScheduledChangeTargetTypeCamp,
+					Field:      api.Due,
+					Value:      "2026-08-31",
+				},
+			},
+			{
+				name: "Camp status",
+				req: api.AdminPostScheduledChangeJSONRequestBody{
+					TargetType: api.ScheduledChangeTargetTypeCamp,
+					Field:      api.Status,
+					Value:      string(model.CampStatusRegistration),
+				},
+			},
+			{
+				name: "Empty name",
+				req: api.AdminPostScheduledChangeJSONRequestBody{
+					TargetType: api.ScheduledChangeTargetTypeCamp,
+					Field:      api.Name,
+					Value:      "",
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				h := setup(t)
+				campID := random.PositiveInt(t)
+				adminUserID := random.AlphaNumericString(t, 32)
+
+				tc.req.ScheduledAt = time.Now().Add(time.Hour)
+
+				h.repo.MockUserRepository.EXPECT().
+					GetOrCreateUser(gomock.Any(), adminUserID).
+					Return(&model.User{ID: adminUserID, IsStaff: true}, nil)
+				h.repo.MockCampRepository.EXPECT().
+					GetCampByID(gomock.Any(), uint(campID)).
+					Return(&model.Camp{Model: gorm.Model{ID: uint(campID)}}, nil)
+
+				h.expect.POST("/api/admin/camps/{campId}/scheduled-changes", campID).
+					WithJSON(tc.req).
+					WithHeader("X-Forwarded-User", adminUserID).
+					Expect().
+					Status(http.StatusBadRequest)
+			})
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		campID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.POST("/api/admin/camps/{campId}/scheduled-changes", campID).
+			WithJSON(api.AdminPostScheduledChangeJSONRequestBody{
+				TargetType:  api.ScheduledChangeTargetTypeCamp,
+				Field:       api.Name,
+				Value:       random.AlphaNumericString(t, 20),
+				ScheduledAt: time.Now().Add(time.Hour),
+			}).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
+
+func TestServer_AdminDeleteScheduledChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		changeID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			GetScheduledChangeByID(gomock.Any(), uint(changeID)).
+			Return(&model.ScheduledChange{Model: gorm.Model{ID: uint(changeID)}}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			DeleteScheduledChange(gomock.Any(), uint(changeID)).
+			Return(nil)
+
+		h.expect.DELETE("/api/admin/scheduled-changes/{scheduledChangeId}", changeID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Already processed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		changeID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+		processedAt := random.Time(t)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			GetScheduledChangeByID(gomock.Any(), uint(changeID)).
+			Return(&model.ScheduledChange{
+				Model:       gorm.Model{ID: uint(changeID)},
+				ProcessedAt: &processedAt,
+			}, nil)
+
+		h.expect.DELETE("/api/admin/scheduled-changes/{scheduledChangeId}", changeID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		changeID := random.PositiveInt(t)
+		adminUserID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), adminUserID).
+			Return(&model.User{IsStaff: true}, nil)
+		h.repo.MockScheduledChangeRepository.EXPECT().
+			GetScheduledChangeByID(gomock.Any(), uint(changeID)).
+			Return(nil, repository.ErrScheduledChangeNotFound)
+
+		h.expect.DELETE("/api/admin/scheduled-changes/{scheduledChangeId}", changeID).
+			WithHeader("X-Forwarded-User", adminUserID).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		changeID := random.PositiveInt(t)
+		userID := random.AlphaNumericString(t, 32)
+
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{IsStaff: false}, nil)
+
+		h.expect.DELETE("/api/admin/scheduled-changes/{scheduledChangeId}", changeID).
+			WithHeader("X-Forwarded-User", userID).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+}
@@ -0,0 +1,34 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// プロキシに接続を切られないようにコメントを送る間隔
+const streamKeepAliveInterval = 15 * time.Second
+
+// writeStreamMessage はイベント以外のメッセージ(再接続の間隔やコメントなど)を書き込む
+func writeStreamMessage(res *echo.Response, message string) error {
+	if _, err := fmt.Fprint(res, message); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).
+			SetInternal(fmt.Errorf("failed to write stream message: %w", err))
+	}
+
+	res.Flush()
+
+	return nil
+}
+
+// writeStreamKeepAlive は接続を保つためのコメントを書き込む
+func writeStreamKeepAlive(res *echo.Response) error {
+	return writeStreamMessage(res, ": keep-alive\n\n")
+}
+
+// writeStreamShutdown はクライアントにサーバーの終了を伝え、別のインスタンスへの再接続を促す
+func writeStreamShutdown(res *echo.Response) error {
+	return writeStreamMessage(res, "event: shutdown\ndata: {}\n\n")
+}
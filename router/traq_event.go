@@ -1,7 +1,256 @@
 package router
 
-import "github.com/labstack/echo/v4"
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/traq"
+)
+
+const (
+	traqBotTokenHeader = "X-TRAQ-BOT-TOKEN"
+	traqBotEventHeader = "X-TRAQ-BOT-EVENT"
+
+	traqBotEventMessageCreated       = "MESSAGE_CREATED"
+	traqBotEventMessageStampsUpdated = "BOT_MESSAGE_STAMPS_UPDATED"
+)
+
+// メッセージの引用に使われるURLからメッセージのUUIDを取り出す
+var traqMessageURLPattern = regexp.MustCompile(
+	`https?://\S+?/messages/([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`,
+)
+
+type traqBotUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Bot  bool   `json:"bot"`
+}
+
+type traqBotMessage struct {
+	ID        string      `json:"id"`
+	User      traqBotUser `json:"user"`
+	ChannelID string      `json:"channelId"`
+	Text      string      `json:"text"`
+}
+
+type traqBotMessageCreatedPayload struct {
+	Message traqBotMessage `json:"message"`
+}
+
+type traqBotMessageStamp struct {
+	StampID   string    `json:"stampId"`
+	UserID    string    `json:"userId"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type traqBotMessageStampsUpdatedPayload struct {
+	MessageID string                `json:"messageId"`
+	Stamps    []traqBotMessageStamp `json:"stamps"`
+}
+
+// TraqEventHandler traQ botのイベントを受け取る
+// (POST /api/traq-events)
+func (s *Server) TraqEventHandler(e echo.Context) error {
+	// 検証用のトークンが設定されていない場合はbotとの連携を無効にする
+	if s.traqBotConfig.VerificationToken == "" {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "traQ bot is not configured")
+	}
+
+	token := e.Request().Header.Get(traqBotTokenHeader)
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.traqBotConfig.VerificationToken)) != 1 {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid bot token")
+	}
+
+	ctx := e.Request().Context()
+
+	switch e.Request().Header.Get(traqBotEventHeader) {
+	case traqBotEventMessageStampsUpdated:
+		var payload traqBotMessageStampsUpdatedPayload
+
+		if err := e.Bind(&payload); err != nil {
+			return err
+		}
+
+		if err := s.handleTraqMessageStampsUpdated(ctx, payload); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to handle stamps updated event: %w", err))
+		}
+
+	case traqBotEventMessageCreated:
+		var payload traqBotMessageCreatedPayload
+
+		if err := e.Bind(&payload); err != nil {
+			return err
+		}
+
+		if err := s.handleTraqMessageCreated(ctx, payload); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError).
+				SetInternal(fmt.Errorf("failed to handle message created event: %w", err))
+		}
+	}
+
+	// PINGやその他のイベントは何もせずに成功を返す
+	return e.NoContent(http.StatusNoContent)
+}
+
+// handleTraqMessageStampsUpdated は点呼のメッセージに押されたスタンプをリアクションにする。
+// ユーザーごとに最後に押したスタンプを回答とし、スタンプを全て外したユーザーのリアクションは削除する
+func (s *Server) handleTraqMessageStampsUpdated(
+	ctx context.Context,
+	payload traqBotMessageStampsUpdatedPayload,
+) error {
+	rollCall, err := s.getOpenRollCallByTraqMessageID(ctx, payload.MessageID)
+
+	if err != nil || rollCall == nil {
+		return err
+	}
+
+	latestStamps := make(map[string]traqBotMessageStamp, len(payload.Stamps))
+
+	for _, stamp := range payload.Stamps {
+		if latest, ok := latestStamps[stamp.UserID]; !ok || stamp.UpdatedAt.After(latest.UpdatedAt) {
+			latestStamps[stamp.UserID] = stamp
+		}
+	}
+
+	userUUIDs := make([]string, 0, len(latestStamps))
+
+	for userUUID := range latestStamps {
+		userUUIDs = append(userUUIDs, userUUID)
+	}
+
+	slices.Sort(userUUIDs)
+
+	// イベントには全てのスタンプが含まれるため、リアクションはイベントごとに1回だけ取得する
+	reactions, err := s.repo.GetRollCallReactions(ctx, rollCall.ID)
+
+	if err != nil {
+		return fmt.Errorf("failed to get roll call reactions: %w", err)
+	}
+
+	reactionsByUserID := make(map[string]*model.RollCallReaction, len(reactions))
+
+	for i := range reactions {
+		reactionsByUserID[reactions[i].UserID] = &reactions[i]
+	}
+
+	stampNames := make(map[string]string)
+	stampedUserIDs := make(map[string]struct{}, len(userUUIDs))
+
+	for _, userUUID := range userUUIDs {
+		stamp := latestStamps[userUUID]
+		userName, err := s.traqService.GetUserName(ctx, userUUID)
+
+		if err != nil {
+			if errors.Is(err, traq.ErrUserNotFound) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get traQ user name: %w", err)
+		}
+
+		stampedUserIDs[userName] = struct{}{}
+
+		stampName, ok := stampNames[stamp.StampID]
+
+		if !ok {
+			stampName, err = s.traqService.GetStampName(ctx, stamp.StampID)
+
+			if err != nil {
+				if errors.Is(err, traq.ErrStampNotFound) {
+					continue
+				}
+
+				return fmt.Errorf("failed to get stamp name: %w", err)
+			}
+
+			stampNames[stamp.StampID] = stampName
+		}
+
+		if err := s.saveTraqRollCallReaction(
+			ctx,
+			rollCall,
+			userName,
+			rollCallContentFromStamp(rollCall, stampName),
+			true,
+			reactionsByUserID[userName],
+		); err != nil {
+			return err
+		}
+	}
+
+	return s.removeTraqStampReactions(ctx, rollCall, reactions, stampedUserIDs)
+}
+
+// handleTraqMessageCreated は点呼のメッセージを引用した返信をリアクションにする
+func (s *Server) handleTraqMessageCreated(
+	ctx context.Context,
+	payload traqBotMessageCreatedPayload,
+) error {
+	if payload.Message.User.Bot {
+		return nil
+	}
+
+	for _, match := range traqMessageURLPattern.FindAllStringSubmatch(payload.Message.Text, -1) {
+		rollCall, err := s.getOpenRollCallByTraqMessageID(ctx, strings.ToLower(match[1]))
+
+		if err != nil {
+			return err
+		}
+
+		if rollCall == nil {
+			continue
+		}
+
+		text := strings.TrimSpace(traqMessageURLPattern.ReplaceAllString(payload.Message.Text, ""))
+
+		if text == "" {
+			return nil
+		}
+
+		return s.applyTraqRollCallReaction(
+			ctx,
+			rollCall,
+			payload.Message.User.Name,
+			rollCallContentFromReply(rollCall, text),
+			false,
+		)
+	}
 
-func TraqEventHandler(_ echo.Context) error {
 	return nil
 }
+
+// getOpenRollCallByTraqMessageID はメッセージに対応する受付中の点呼を取得する。
+// 点呼のメッセージでない場合や締め切られている場合はnilを返す
+func (s *Server) getOpenRollCallByTraqMessageID(
+	ctx context.Context,
+	messageID string,
+) (*model.RollCall, error) {
+	rollCall, err := s.repo.GetRollCallByTraqMessageID(ctx, messageID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrRollCallNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get roll call: %w", err)
+	}
+
+	if !rollCall.IsOpen(time.Now()) {
+		return nil, nil
+	}
+
+	return rollCall, nil
+}
@@ -0,0 +1,484 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestServer_TraqEventHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, random.AlphaNumericString(t, 32)).
+			WithHeader(traqBotEventHeader, "PING").
+			WithJSON(map[string]any{}).
+			Expect().
+			Status(http.StatusUnauthorized)
+	})
+
+	t.Run("Verification token not configured", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewServer(
+			t.Context(),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			eventbus.NewEventBus(1),
+			TraqBotConfig{},
+			PubSubConfig{},
+			false,
+		)
+		req := httptest.NewRequest(http.MethodPost, "/api/traq-events", strings.NewReader("{}"))
+		rec := httptest.NewRecorder()
+
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(traqBotEventHeader, "PING")
+
+		err := server.TraqEventHandler(echo.New().NewContext(req, rec))
+
+		var httpErr *echo.HTTPError
+
+		if assert.ErrorAs(t, err, &httpErr) {
+			// トークンが空のリクエストも受け付けないよう、設定の不備として扱う
+			assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+		}
+	})
+
+	t.Run("Ping", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, "PING").
+			WithJSON(map[string]any{"eventTime": time.Now()}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - create reaction", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+		userUUID := random.AlphaNumericString(t, 36)
+		userID := random.AlphaNumericString(t, 32)
+		oldStampID := random.AlphaNumericString(t, 36)
+		newStampID := random.AlphaNumericString(t, 36)
+		rollCall := model.RollCall{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options: []string{random.AlphaNumericString(t, 5), random.AlphaNumericString(t, 5)},
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.traqService.EXPECT().GetUserName(gomock.Any(), userUUID).Return(userID, nil).Times(1)
+		// 最後に押したスタンプだけを回答とする
+		h.traqService.EXPECT().GetStampName(gomock.Any(), newStampID).Return("two", nil).Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		// イベントの処理前と集計で2回取得する
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(2)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			CreateUniqueRollCallReaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
+				assert.Equal(t, rollCall.ID, reaction.RollCallID)
+				assert.Equal(t, userID, reaction.UserID)
+				assert.Equal(t, rollCall.Options[1], reaction.Content)
+				assert.True(t, reaction.FromTraqStamp)
+
+				return nil
+			}).
+			Times(1)
+
+		now := time.Now()
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"eventTime": now,
+				"messageId": messageID,
+				"stamps": []map[string]any{
+					{
+						"stampId":   oldStampID,
+						"userId":    userUUID,
+						"count":     1,
+						"createdAt": now.Add(-time.Minute),
+						"updatedAt": now.Add(-time.Minute),
+					},
+					{
+						"stampId":   newStampID,
+						"userId":    userUUID,
+						"count":     1,
+						"createdAt": now,
+						"updatedAt": now,
+					},
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - update reaction", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+		userUUID := random.AlphaNumericString(t, 36)
+		userID := random.AlphaNumericString(t, 32)
+		stampID := random.AlphaNumericString(t, 36)
+		stampName := random.AlphaNumericString(t, 10)
+		rollCall := model.RollCall{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options: []string{random.AlphaNumericString(t, 5)},
+		}
+		existingReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:     userID,
+			RollCallID: rollCall.ID,
+			Content:    rollCall.Options[0],
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.traqService.EXPECT().GetUserName(gomock.Any(), userUUID).Return(userID, nil).Times(1)
+		h.traqService.EXPECT().GetStampName(gomock.Any(), stampID).Return(stampName, nil).Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{existingReaction}, nil).
+			Times(2)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			UpdateRollCallReaction(gomock.Any(), existingReaction.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, reaction *model.RollCallReaction) error {
+				// 選択肢にないスタンプはスタンプの記法で保存する
+				assert.Equal(t, ":"+stampName+":", reaction.Content)
+				assert.True(t, reaction.FromTraqStamp)
+
+				return nil
+			}).
+			Times(1)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"messageId": messageID,
+				"stamps": []map[string]any{
+					{"stampId": stampID, "userId": userUUID, "updatedAt": time.Now()},
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - restricted to subjects", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+		userUUID := random.AlphaNumericString(t, 36)
+		userID := random.AlphaNumericString(t, 32)
+		stampID := random.AlphaNumericString(t, 36)
+		rollCall := model.RollCall{
+			Model:             gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options:           []string{random.AlphaNumericString(t, 5)},
+			Subjects:          []model.User{{ID: random.AlphaNumericString(t, 32)}},
+			RestrictResponses: true,
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.traqService.EXPECT().GetUserName(gomock.Any(), userUUID).Return(userID, nil).Times(1)
+		h.traqService.EXPECT().GetStampName(gomock.Any(), stampID).Return("one", nil).Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(1)
+
+		// 対象者以外のスタンプはリアクションにならない
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"messageId": messageID,
+				"stamps": []map[string]any{
+					{"stampId": stampID, "userId": userUUID, "updatedAt": time.Now()},
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - unchanged stamps", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+		stampID := random.AlphaNumericString(t, 36)
+		rollCall := model.RollCall{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options: []string{random.AlphaNumericString(t, 5)},
+		}
+		userUUIDs := []string{
+			random.AlphaNumericString(t, 36),
+			random.AlphaNumericString(t, 36),
+		}
+		reactions := make([]model.RollCallReaction, len(userUUIDs))
+		stamps := make([]map[string]any, len(userUUIDs))
+
+		for i, userUUID := range userUUIDs {
+			userID := random.AlphaNumericString(t, 32)
+			reactions[i] = model.RollCallReaction{
+				Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+				UserID:        userID,
+				RollCallID:    rollCall.ID,
+				Content:       rollCall.Options[0],
+				FromTraqStamp: true,
+			}
+			stamps[i] = map[string]any{
+				"stampId":   stampID,
+				"userId":    userUUID,
+				"updatedAt": time.Now(),
+			}
+
+			h.traqService.EXPECT().GetUserName(gomock.Any(), userUUID).Return(userID, nil).Times(1)
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&rollCall, nil).
+			Times(1)
+		// 同じスタンプの名前は1回だけ取得する
+		h.traqService.EXPECT().GetStampName(gomock.Any(), stampID).Return("one", nil).Times(1)
+		// スタンプを押したユーザーの数によらず、リアクションは1回だけ取得する
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return(reactions, nil).
+			Times(1)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"messageId": messageID,
+				"stamps":    stamps,
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - remove reaction", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+		rollCall := model.RollCall{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options: []string{random.AlphaNumericString(t, 5)},
+		}
+		stampReaction := model.RollCallReaction{
+			Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:        random.AlphaNumericString(t, 32),
+			RollCallID:    rollCall.ID,
+			Content:       rollCall.Options[0],
+			FromTraqStamp: true,
+		}
+		webReaction := model.RollCallReaction{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:     random.AlphaNumericString(t, 32),
+			RollCallID: rollCall.ID,
+			Content:    rollCall.Options[0],
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&rollCall, nil).
+			Times(1)
+		gomock.InOrder(
+			h.repo.MockRollCallReactionRepository.EXPECT().
+				GetRollCallReactions(gomock.Any(), rollCall.ID).
+				Return([]model.RollCallReaction{stampReaction, webReaction}, nil),
+			// スタンプによるリアクションだけを削除する
+			h.repo.MockRollCallReactionRepository.EXPECT().
+				DeleteRollCallReaction(gomock.Any(), stampReaction.ID).
+				Return(nil),
+			// 集計結果の送信のために取得する
+			h.repo.MockRollCallReactionRepository.EXPECT().
+				GetRollCallReactions(gomock.Any(), rollCall.ID).
+				Return([]model.RollCallReaction{webReaction}, nil),
+		)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"messageId": messageID,
+				"stamps":    []map[string]any{},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - not a roll call message", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(nil, repository.ErrRollCallNotFound).
+			Times(1)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"messageId": messageID,
+				"stamps": []map[string]any{
+					{
+						"stampId":   random.AlphaNumericString(t, 36),
+						"userId":    random.AlphaNumericString(t, 36),
+						"updatedAt": time.Now(),
+					},
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Stamps updated - roll call closed", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := random.AlphaNumericString(t, 36)
+		closedAt := time.Now().Add(-time.Minute)
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&model.RollCall{
+				Model:    gorm.Model{ID: uint(random.PositiveInt(t))},
+				ClosedAt: &closedAt,
+			}, nil).
+			Times(1)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageStampsUpdated).
+			WithJSON(map[string]any{
+				"messageId": messageID,
+				"stamps": []map[string]any{
+					{
+						"stampId":   random.AlphaNumericString(t, 36),
+						"userId":    random.AlphaNumericString(t, 36),
+						"updatedAt": time.Now(),
+					},
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Message created - reply", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+		messageID := "0190a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b"
+		userID := random.AlphaNumericString(t, 32)
+		rollCall := model.RollCall{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Options: []string{random.AlphaNumericString(t, 5), random.AlphaNumericString(t, 5)},
+		}
+
+		h.repo.MockRollCallRepository.EXPECT().
+			GetRollCallByTraqMessageID(gomock.Any(), messageID).
+			Return(&rollCall, nil).
+			Times(1)
+		h.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(gomock.Any(), userID).
+			Return(&model.User{ID: userID}, nil).
+			Times(1)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			GetRollCallReactions(gomock.Any(), rollCall.ID).
+			Return([]model.RollCallReaction{}, nil).
+			Times(2)
+		h.repo.MockRollCallReactionRepository.EXPECT().
+			CreateUniqueRollCallReaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reaction *model.RollCallReaction) error {
+				// 番号だけの返信は対応する選択肢にする
+				assert.Equal(t, rollCall.Options[1], reaction.Content)
+				assert.Equal(t, userID, reaction.UserID)
+
+				return nil
+			}).
+			Times(1)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageCreated).
+			WithJSON(map[string]any{
+				"message": map[string]any{
+					"id":        random.AlphaNumericString(t, 36),
+					"user":      map[string]any{"name": userID, "bot": false},
+					"channelId": testTraqRollCallChannelID,
+					"text":      "2\nhttps://q.trap.jp/messages/" + messageID,
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+
+	t.Run("Message created - bot", func(t *testing.T) {
+		t.Parallel()
+
+		h := setup(t)
+
+		h.expect.POST("/api/traq-events").
+			WithHeader(traqBotTokenHeader, testTraqBotVerificationToken).
+			WithHeader(traqBotEventHeader, traqBotEventMessageCreated).
+			WithJSON(map[string]any{
+				"message": map[string]any{
+					"user": map[string]any{"name": random.AlphaNumericString(t, 32), "bot": true},
+					"text": "1\nhttps://q.trap.jp/messages/0190a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b",
+				},
+			}).
+			Expect().
+			Status(http.StatusNoContent)
+	})
+}
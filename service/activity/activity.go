@@ -15,6 +15,13 @@ type ActivityService interface {
 		campID uint,
 		userID string,
 	) ([]ActivityResponse, error)
+	// GetRecentActivities はユーザーに見せるアクティビティを新しい順にlimit件まで取得します
+	GetRecentActivities(
+		ctx context.Context,
+		campID uint,
+		userID string,
+		limit int,
+	) ([]ActivityResponse, error)
 	RecordRoomCreated(
 		ctx context.Context,
 		repo repository.Repository,
@@ -45,6 +52,16 @@ type ActivityService interface {
 		repo repository.Repository,
 		questionGroup model.QuestionGroup,
 	) error
+	RecordScheduledChangeApplied(
+		ctx context.Context,
+		repo repository.Repository,
+		change model.ScheduledChange,
+	) error
+	RecordGuidebookPublished(
+		ctx context.Context,
+		repo repository.Repository,
+		revision model.GuidebookRevision,
+	) error
 }
 
 type ActivityResponse struct {
@@ -60,6 +77,9 @@ type ActivityResponse struct {
 	PaymentPaidChanged   *PaymentChangedDetail
 	RollCallCreated      *RollCallCreatedDetail
 	QuestionCreated      *QuestionCreatedDetail
+
+	ScheduledChangeApplied *ScheduledChangeAppliedDetail
+	GuidebookPublished     *GuidebookPublishedDetail
 }
 
 type RoomCreatedDetail struct{}
@@ -85,3 +105,14 @@ type QuestionCreatedDetail struct {
 	Due             time.Time
 	NeedsResponse   bool
 }
+
+type ScheduledChangeAppliedDetail struct {
+	TargetType model.ScheduledChangeTargetType
+	TargetID   uint
+	Field      model.ScheduledChangeField
+	Value      string
+}
+
+type GuidebookPublishedDetail struct {
+	RevisionID uint
+}
@@ -106,10 +106,50 @@ func (s *activityServiceImpl) RecordQuestionCreated(
 	return repo.CreateActivity(ctx, activity)
 }
 
+func (s *activityServiceImpl) RecordScheduledChangeApplied(
+	ctx context.Context,
+	repo repository.Repository,
+	change model.ScheduledChange,
+) error {
+	activity := &model.Activity{
+		Type:        model.ActivityTypeScheduledChangeApplied,
+		CampID:      change.CampID,
+		ReferenceID: change.ID,
+	}
+	return repo.CreateActivity(ctx, activity)
+}
+
+func (s *activityServiceImpl) RecordGuidebookPublished(
+	ctx context.Context,
+	repo repository.Repository,
+	revision model.GuidebookRevision,
+) error {
+	activity := &model.Activity{
+		Type:        model.ActivityTypeGuidebookPublished,
+		CampID:      revision.CampID,
+		ReferenceID: revision.ID,
+	}
+	return repo.CreateActivity(ctx, activity)
+}
+
 // 部屋はユーザーごとに1つ、Paymentの変更は支払い金額の設定と入金確認で最低2回は
 // 発生するため、たまに返金処理などが起こることも考慮して5件以内には収まると想定
 const estimatedUserSpecificActivitiesCount = 5
 
+// 時系列のページングで1度に取得するアクティビティの最小数
+const minRecentActivitiesPageSize = 20
+
+// activityDetails はアクティビティをユーザーごとに絞り込み、付加情報を付けるための情報です
+type activityDetails struct {
+	userID              string
+	userRoom            *model.Room
+	rollCallMap         map[uint]model.RollCall
+	questionGroupMap    map[uint]model.QuestionGroup
+	scheduledChangeMap  map[uint]model.ScheduledChange
+	answeredQuestionIDs map[uint]bool
+	estimatedCount      int
+}
+
 func (s *activityServiceImpl) GetActivities(
 	ctx context.Context,
 	campID uint,
@@ -124,6 +164,64 @@ func (s *activityServiceImpl) GetActivities(
 		return []ActivityResponse{}, nil
 	}
 
+	d, err := s.getActivityDetails(ctx, campID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ActivityResponse, 0, d.estimatedCount)
+
+	return d.appendResponses(result, activities), nil
+}
+
+func (s *activityServiceImpl) GetRecentActivities(
+	ctx context.Context,
+	campID uint,
+	userID string,
+	limit int,
+) ([]ActivityResponse, error) {
+	result := make([]ActivityResponse, 0, limit)
+	pageSize := max(limit, minRecentActivitiesPageSize)
+
+	var (
+		d        *activityDetails
+		beforeID *uint
+	)
+
+	// 他のユーザーの部屋などは除かれるので、limit件に達するまで古い方へ読み進める
+	for len(result) < limit {
+		activities, err := s.repo.GetRecentActivities(ctx, campID, userID, beforeID, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(activities) == 0 {
+			break
+		}
+
+		if d == nil {
+			d, err = s.getActivityDetails(ctx, campID, userID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = d.appendResponses(result, activities)
+		beforeID = &activities[len(activities)-1].ID
+
+		if len(activities) < pageSize {
+			break
+		}
+	}
+
+	return result[:min(len(result), limit)], nil
+}
+
+func (s *activityServiceImpl) getActivityDetails(
+	ctx context.Context,
+	campID uint,
+	userID string,
+) (*activityDetails, error) {
 	// ユーザーの部屋を取得（room_created のフィルタリング用）
 	userRoom, err := s.repo.GetRoomByUserID(ctx, campID, userID)
 	if err != nil && !errors.Is(err, repository.ErrRoomNotFound) {
@@ -152,6 +250,17 @@ func (s *activityServiceImpl) GetActivities(
 		questionGroupMap[qg.ID] = qg
 	}
 
+	// 予約した変更を取得（scheduled_change_applied の付加情報用）
+	scheduledChanges, err := s.repo.GetScheduledChanges(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledChangeMap := make(map[uint]model.ScheduledChange, len(scheduledChanges))
+	for _, sc := range scheduledChanges {
+		scheduledChangeMap[sc.ID] = sc
+	}
+
 	// ユーザーの回答を取得（needsResponse 判定用）
 	answers, err := s.repo.GetAnswers(ctx, repository.GetAnswersQuery{
 		UserID:                &userID,
@@ -167,18 +276,27 @@ func (s *activityServiceImpl) GetActivities(
 		answeredQuestionIDs[a.QuestionID] = true
 	}
 
-	// 点呼など全体に影響するActivityとユーザー固有のActivityを合わせて要素数を見積もる
-	estimatedActivitiesCount := len(
-		rollCalls,
-	) + len(
-		questionGroups,
-	) + estimatedUserSpecificActivitiesCount
-	result := make([]ActivityResponse, 0, estimatedActivitiesCount)
+	return &activityDetails{
+		userID:              userID,
+		userRoom:            userRoom,
+		rollCallMap:         rollCallMap,
+		questionGroupMap:    questionGroupMap,
+		scheduledChangeMap:  scheduledChangeMap,
+		answeredQuestionIDs: answeredQuestionIDs,
+		// 点呼など全体に影響するActivityとユーザー固有のActivityを合わせて要素数を見積もる
+		estimatedCount: len(rollCalls) + len(questionGroups) + estimatedUserSpecificActivitiesCount,
+	}, nil
+}
 
+// appendResponses はユーザーに見せるアクティビティを付加情報を付けてresultに追加する
+func (d *activityDetails) appendResponses(
+	result []ActivityResponse,
+	activities []model.Activity,
+) []ActivityResponse {
 	for _, a := range activities {
 		switch a.Type {
 		case model.ActivityTypeRoomCreated:
-			if userRoom == nil || userRoom.ID != a.ReferenceID {
+			if d.userRoom == nil || d.userRoom.ID != a.ReferenceID {
 				continue
 			}
 
@@ -192,7 +310,7 @@ func (s *activityServiceImpl) GetActivities(
 		case model.ActivityTypePaymentCreated,
 			model.ActivityTypePaymentAmountChanged,
 			model.ActivityTypePaymentPaidChanged:
-			if a.UserID == nil || *a.UserID != userID {
+			if a.UserID == nil || *a.UserID != d.userID {
 				continue
 			}
 
@@ -218,17 +336,17 @@ func (s *activityServiceImpl) GetActivities(
 			result = append(result, resp)
 
 		case model.ActivityTypeRollCallCreated:
-			rc, ok := rollCallMap[a.ReferenceID]
+			rc, ok := d.rollCallMap[a.ReferenceID]
 			if !ok {
 				continue
 			}
 
 			isSubject := slices.ContainsFunc(rc.Subjects, func(u model.User) bool {
-				return u.ID == userID
+				return u.ID == d.userID
 			})
 
 			hasReaction := slices.ContainsFunc(rc.Reactions, func(r model.RollCallReaction) bool {
-				return r.UserID == userID
+				return r.UserID == d.userID
 			})
 			answered := hasReaction
 
@@ -245,14 +363,14 @@ func (s *activityServiceImpl) GetActivities(
 			})
 
 		case model.ActivityTypeQuestionCreated:
-			qg, ok := questionGroupMap[a.ReferenceID]
+			qg, ok := d.questionGroupMap[a.ReferenceID]
 			if !ok {
 				continue
 			}
 
 			// IsRequired な質問で未回答のものがあるか
 			needsResponse := slices.ContainsFunc(qg.Questions, func(q model.Question) bool {
-				return q.IsRequired && !answeredQuestionIDs[q.ID]
+				return q.IsRequired && !d.answeredQuestionIDs[q.ID]
 			})
 
 			result = append(result, ActivityResponse{
@@ -266,8 +384,36 @@ func (s *activityServiceImpl) GetActivities(
 					NeedsResponse:   needsResponse,
 				},
 			})
+
+		case model.ActivityTypeScheduledChangeApplied:
+			sc, ok := d.scheduledChangeMap[a.ReferenceID]
+			if !ok {
+				continue
+			}
+
+			result = append(result, ActivityResponse{
+				ID:   a.ID,
+				Type: a.Type,
+				Time: a.CreatedAt,
+				ScheduledChangeApplied: &ScheduledChangeAppliedDetail{
+					TargetType: sc.TargetType,
+					TargetID:   sc.TargetID,
+					Field:      sc.Field,
+					Value:      sc.Value,
+				},
+			})
+
+		case model.ActivityTypeGuidebookPublished:
+			result = append(result, ActivityResponse{
+				ID:   a.ID,
+				Type: a.Type,
+				Time: a.CreatedAt,
+				GuidebookPublished: &GuidebookPublishedDetail{
+					RevisionID: a.ReferenceID,
+				},
+			})
 		}
 	}
 
-	return result, nil
+	return result
 }
@@ -255,6 +255,68 @@ func TestActivityServiceImpl_RecordQuestionCreated(t *testing.T) {
 	})
 }
 
+func TestActivityServiceImpl_RecordScheduledChangeApplied(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		changeID := uint(random.PositiveInt(t))
+		change := model.ScheduledChange{
+			Model:  gorm.Model{ID: changeID},
+			CampID: campID,
+		}
+
+		s.repo.MockActivityRepository.EXPECT().
+			CreateActivity(ctx, gomock.AssignableToTypeOf(&model.Activity{})).
+			DoAndReturn(func(_ context.Context, activity *model.Activity) error {
+				assert.Equal(t, model.ActivityTypeScheduledChangeApplied, activity.Type)
+				assert.Equal(t, campID, activity.CampID)
+				assert.Equal(t, changeID, activity.ReferenceID)
+				assert.Nil(t, activity.UserID)
+				return nil
+			})
+
+		err := s.service.RecordScheduledChangeApplied(ctx, s.repo, change)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestActivityServiceImpl_RecordGuidebookPublished(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		revisionID := uint(random.PositiveInt(t))
+		revision := model.GuidebookRevision{
+			Model:  gorm.Model{ID: revisionID},
+			CampID: campID,
+		}
+
+		s.repo.MockActivityRepository.EXPECT().
+			CreateActivity(ctx, gomock.AssignableToTypeOf(&model.Activity{})).
+			DoAndReturn(func(_ context.Context, activity *model.Activity) error {
+				assert.Equal(t, model.ActivityTypeGuidebookPublished, activity.Type)
+				assert.Equal(t, campID, activity.CampID)
+				assert.Equal(t, revisionID, activity.ReferenceID)
+				assert.Nil(t, activity.UserID)
+				return nil
+			})
+
+		err := s.service.RecordGuidebookPublished(ctx, s.repo, revision)
+
+		assert.NoError(t, err)
+	})
+}
+
 func TestActivityServiceImpl_GetActivities(t *testing.T) {
 	t.Parallel()
 
@@ -273,6 +335,9 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 		timeRoom := baseTime.Add(3 * time.Minute)
 		timePaymentPaid := baseTime.Add(2 * time.Minute)
 		timeQuestion := baseTime.Add(1 * time.Minute)
+		timeScheduledChange := baseTime
+		timeGuidebook := baseTime.Add(-time.Minute)
+		guidebookRevisionID := uint(random.PositiveInt(t))
 
 		roomID := uint(random.PositiveInt(t))
 		userRoom := &model.Room{Model: gorm.Model{ID: roomID}}
@@ -315,6 +380,15 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 			{QuestionID: optionalQuestionID, UserID: userID},
 		}
 
+		scheduledChange := model.ScheduledChange{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:     campID,
+			TargetType: model.ScheduledChangeTargetTypeCamp,
+			TargetID:   campID,
+			Field:      model.ScheduledChangeFieldStatus,
+			Value:      string(model.CampStatusRegistration),
+		}
+
 		activities := []model.Activity{
 			{
 				Model:       gorm.Model{ID: 3, CreatedAt: timePaymentCreated},
@@ -358,6 +432,18 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 				CampID:      campID,
 				ReferenceID: questionGroupID,
 			},
+			{
+				Model:       gorm.Model{ID: 7, CreatedAt: timeScheduledChange},
+				Type:        model.ActivityTypeScheduledChangeApplied,
+				CampID:      campID,
+				ReferenceID: scheduledChange.ID,
+			},
+			{
+				Model:       gorm.Model{ID: 8, CreatedAt: timeGuidebook},
+				Type:        model.ActivityTypeGuidebookPublished,
+				CampID:      campID,
+				ReferenceID: guidebookRevisionID,
+			},
 		}
 
 		s.repo.MockActivityRepository.EXPECT().
@@ -376,6 +462,10 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 			GetQuestionGroups(ctx, campID).
 			Return([]model.QuestionGroup{questionGroup}, nil)
 
+		s.repo.MockScheduledChangeRepository.EXPECT().
+			GetScheduledChanges(ctx, campID).
+			Return([]model.ScheduledChange{scheduledChange}, nil)
+
 		s.repo.MockAnswerRepository.EXPECT().
 			GetAnswers(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, query repository.GetAnswersQuery) ([]model.Answer, error) {
@@ -389,7 +479,7 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 		responses, err := s.service.GetActivities(ctx, campID, userID)
 
 		require.NoError(t, err)
-		require.Len(t, responses, 6)
+		require.Len(t, responses, 8)
 
 		assert.Equal(t, model.ActivityTypePaymentCreated, responses[0].Type)
 		assert.Equal(t, timePaymentCreated, responses[0].Time)
@@ -430,6 +520,33 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 			assert.Equal(t, timeQuestion, responses[5].QuestionCreated.Due)
 			assert.True(t, responses[5].QuestionCreated.NeedsResponse)
 		}
+
+		assert.Equal(t, model.ActivityTypeScheduledChangeApplied, responses[6].Type)
+		assert.Equal(t, timeScheduledChange, responses[6].Time)
+		if assert.NotNil(t, responses[6].ScheduledChangeApplied) {
+			assert.Equal(
+				t,
+				model.ScheduledChangeTargetTypeCamp,
+				responses[6].ScheduledChangeApplied.TargetType,
+			)
+			assert.Equal(t, campID, responses[6].ScheduledChangeApplied.TargetID)
+			assert.Equal(
+				t,
+				model.ScheduledChangeFieldStatus,
+				responses[6].ScheduledChangeApplied.Field,
+			)
+			assert.Equal(
+				t,
+				string(model.CampStatusRegistration),
+				responses[6].ScheduledChangeApplied.Value,
+			)
+		}
+
+		assert.Equal(t, model.ActivityTypeGuidebookPublished, responses[7].Type)
+		assert.Equal(t, timeGuidebook, responses[7].Time)
+		if assert.NotNil(t, responses[7].GuidebookPublished) {
+			assert.Equal(t, guidebookRevisionID, responses[7].GuidebookPublished.RevisionID)
+		}
 	})
 
 	t.Run("Error (GetActivitiesByCampID)", func(t *testing.T) {
@@ -450,3 +567,100 @@ func TestActivityServiceImpl_GetActivities(t *testing.T) {
 		assert.Nil(t, responses)
 	})
 }
+
+func TestActivityServiceImpl_GetRecentActivities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+		limit := 2
+
+		// 1ページ目は他のユーザーの部屋のアクティビティだけ
+		otherRoomActivities := make([]model.Activity, minRecentActivitiesPageSize)
+
+		for i := range otherRoomActivities {
+			otherRoomActivities[i] = model.Activity{
+				Model:       gorm.Model{ID: uint(100 - i)},
+				Type:        model.ActivityTypeRoomCreated,
+				CampID:      campID,
+				ReferenceID: uint(random.PositiveInt(t)),
+			}
+		}
+
+		lastID := otherRoomActivities[len(otherRoomActivities)-1].ID
+		guidebookActivities := []model.Activity{
+			{
+				Model:       gorm.Model{ID: lastID - 1},
+				Type:        model.ActivityTypeGuidebookPublished,
+				CampID:      campID,
+				ReferenceID: uint(random.PositiveInt(t)),
+			},
+			{
+				Model:       gorm.Model{ID: lastID - 2},
+				Type:        model.ActivityTypeGuidebookPublished,
+				CampID:      campID,
+				ReferenceID: uint(random.PositiveInt(t)),
+			},
+			{
+				Model:       gorm.Model{ID: lastID - 3},
+				Type:        model.ActivityTypeGuidebookPublished,
+				CampID:      campID,
+				ReferenceID: uint(random.PositiveInt(t)),
+			},
+		}
+
+		gomock.InOrder(
+			s.repo.MockActivityRepository.EXPECT().
+				GetRecentActivities(ctx, campID, userID, (*uint)(nil), minRecentActivitiesPageSize).
+				Return(otherRoomActivities, nil),
+			s.repo.MockActivityRepository.EXPECT().
+				GetRecentActivities(ctx, campID, userID, &lastID, minRecentActivitiesPageSize).
+				Return(guidebookActivities, nil),
+		)
+		s.repo.MockRoomRepository.EXPECT().
+			GetRoomByUserID(ctx, campID, userID).
+			Return(nil, repository.ErrRoomNotFound)
+		s.repo.MockRollCallRepository.EXPECT().
+			GetRollCalls(ctx, campID).
+			Return([]model.RollCall{}, nil)
+		s.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroups(ctx, campID).
+			Return([]model.QuestionGroup{}, nil)
+		s.repo.MockScheduledChangeRepository.EXPECT().
+			GetScheduledChanges(ctx, campID).
+			Return([]model.ScheduledChange{}, nil)
+		s.repo.MockAnswerRepository.EXPECT().
+			GetAnswers(gomock.Any(), gomock.Any()).
+			Return([]model.Answer{}, nil)
+
+		responses, err := s.service.GetRecentActivities(ctx, campID, userID, limit)
+
+		require.NoError(t, err)
+		require.Len(t, responses, limit)
+		assert.Equal(t, guidebookActivities[0].ID, responses[0].ID)
+		assert.Equal(t, guidebookActivities[1].ID, responses[1].ID)
+	})
+
+	t.Run("Error (GetRecentActivities)", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		userID := random.AlphaNumericString(t, 32)
+
+		s.repo.MockActivityRepository.EXPECT().
+			GetRecentActivities(ctx, campID, userID, (*uint)(nil), minRecentActivitiesPageSize).
+			Return(nil, errors.New("db error"))
+
+		responses, err := s.service.GetRecentActivities(ctx, campID, userID, 1)
+
+		assert.Error(t, err)
+		assert.Nil(t, responses)
+	})
+}
@@ -58,6 +58,35 @@ func (mr *MockActivityServiceMockRecorder) GetActivities(ctx, campID, userID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivities", reflect.TypeOf((*MockActivityService)(nil).GetActivities), ctx, campID, userID)
 }
 
+// GetRecentActivities mocks base method.
+func (m *MockActivityService) GetRecentActivities(ctx context.Context, campID uint, userID string, limit int) ([]activity.ActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentActivities", ctx, campID, userID, limit)
+	ret0, _ := ret[0].([]activity.ActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentActivities indicates an expected call of GetRecentActivities.
+func (mr *MockActivityServiceMockRecorder) GetRecentActivities(ctx, campID, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentActivities", reflect.TypeOf((*MockActivityService)(nil).GetRecentActivities), ctx, campID, userID, limit)
+}
+
+// RecordGuidebookPublished mocks base method.
+func (m *MockActivityService) RecordGuidebookPublished(ctx context.Context, repo repository.Repository, revision model.GuidebookRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordGuidebookPublished", ctx, repo, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordGuidebookPublished indicates an expected call of RecordGuidebookPublished.
+func (mr *MockActivityServiceMockRecorder) RecordGuidebookPublished(ctx, repo, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGuidebookPublished", reflect.TypeOf((*MockActivityService)(nil).RecordGuidebookPublished), ctx, repo, revision)
+}
+
 // RecordPaymentAmountChanged mocks base method.
 func (m *MockActivityService) RecordPaymentAmountChanged(ctx context.Context, repo repository.Repository, payment model.Payment) error {
 	m.ctrl.T.Helper()
@@ -141,3 +170,17 @@ func (mr *MockActivityServiceMockRecorder) RecordRoomCreated(ctx, repo, room any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRoomCreated", reflect.TypeOf((*MockActivityService)(nil).RecordRoomCreated), ctx, repo, room)
 }
+
+// RecordScheduledChangeApplied mocks base method.
+func (m *MockActivityService) RecordScheduledChangeApplied(ctx context.Context, repo repository.Repository, change model.ScheduledChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordScheduledChangeApplied", ctx, repo, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordScheduledChangeApplied indicates an expected call of RecordScheduledChangeApplied.
+func (mr *MockActivityServiceMockRecorder) RecordScheduledChangeApplied(ctx, repo, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordScheduledChangeApplied", reflect.TypeOf((*MockActivityService)(nil).RecordScheduledChangeApplied), ctx, repo, change)
+}
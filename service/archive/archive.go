@@ -0,0 +1,208 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockarchive/$GOFILE -package=mockarchive
+package archive
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+// CurrentVersion はエクスポートするアーカイブの形式のバージョンです。
+// 互換性のない変更を加えた場合はインクリメントしてください。
+const CurrentVersion = 1
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	ErrInvalidArchive     = errors.New("invalid archive")
+)
+
+// ArchiveService は合宿のデータを他のrucQに移すためのエクスポート・インポートを担当するサービスです。
+type ArchiveService interface {
+	// ExportCamp は合宿とそれに紐づくデータをアーカイブとして書き出します。
+	ExportCamp(ctx context.Context, campID uint) (*Archive, error)
+	// ImportCamp はアーカイブから合宿を新規作成します。IDはすべて振り直されます。
+	// operatorIDは部屋のステータスの変更者として記録されます。
+	ImportCamp(ctx context.Context, archive Archive, operatorID string) (*model.Camp, error)
+}
+
+// Archive の各IDはエクスポート元のものであり、アーカイブ内の参照にのみ使われる
+type Archive struct {
+	Version        int             `json:"version"`
+	ExportedAt     time.Time       `json:"exportedAt"`
+	Camp           Camp            `json:"camp"`
+	Participants   []string        `json:"participants"`
+	Payments       []Payment       `json:"payments"`
+	Events         []Event         `json:"events"`
+	QuestionGroups []QuestionGroup `json:"questionGroups"`
+	Answers        []Answer        `json:"answers"`
+	RoomGroups     []RoomGroup     `json:"roomGroups"`
+	RollCalls      []RollCall      `json:"rollCalls"`
+	Activities     []Activity      `json:"activities"`
+	// しおりの版がないアーカイブでは、Camp.Guidebookを公開済みの最初の版としてインポートする
+	GuidebookRevisions []GuidebookRevision `json:"guidebookRevisions,omitempty"`
+}
+
+type Camp struct {
+	DisplayID string           `json:"displayId"`
+	Name      string           `json:"name"`
+	Guidebook string           `json:"guidebook"`
+	Status    model.CampStatus `json:"status,omitempty"`
+	DateStart time.Time        `json:"dateStart"`
+	DateEnd   time.Time        `json:"dateEnd"`
+
+	RegistrationScheduledAt *time.Time `json:"registrationScheduledAt,omitempty"`
+	ConfirmedScheduledAt    *time.Time `json:"confirmedScheduledAt,omitempty"`
+	OngoingScheduledAt      *time.Time `json:"ongoingScheduledAt,omitempty"`
+	FinishedScheduledAt     *time.Time `json:"finishedScheduledAt,omitempty"`
+	ArchivedScheduledAt     *time.Time `json:"archivedScheduledAt,omitempty"`
+
+	// statusがないアーカイブでは、これらのフラグから状態を決める
+	IsDraft            bool `json:"isDraft,omitempty"`
+	IsRegistrationOpen bool `json:"isRegistrationOpen,omitempty"`
+}
+
+type Payment struct {
+	ID         uint   `json:"id"`
+	UserID     string `json:"userId"`
+	Amount     int    `json:"amount"`
+	AmountPaid int    `json:"amountPaid"`
+	// 料金ルールはアーカイブに含まれないため、料金ルールから作られた明細も手動の明細として書き出す
+	LineItems []PaymentLineItem `json:"lineItems,omitempty"`
+	// 入出金の記録がないアーカイブでは、AmountPaidを1件の記録としてインポートする
+	Transactions []PaymentTransaction `json:"transactions,omitempty"`
+}
+
+type PaymentTransaction struct {
+	Amount     int                 `json:"amount"`
+	Method     model.PaymentMethod `json:"method"`
+	ReceivedBy *string             `json:"receivedBy,omitempty"`
+	ReceivedAt time.Time           `json:"receivedAt"`
+	Memo       string              `json:"memo"`
+}
+
+type PaymentLineItem struct {
+	Category    model.PaymentLineItemCategory `json:"category"`
+	Description string                        `json:"description"`
+	Amount      int                           `json:"amount"`
+}
+
+type Event struct {
+	ID           uint              `json:"id"`
+	Type         model.EventType   `json:"type"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Location     string            `json:"location"`
+	TimeStart    time.Time         `json:"timeStart"`
+	TimeEnd      *time.Time        `json:"timeEnd,omitempty"`
+	OrganizerID  *string           `json:"organizerId,omitempty"`
+	DisplayColor *string           `json:"displayColor,omitempty"`
+	Capacity     *int              `json:"capacity,omitempty"`
+	Attendances  []EventAttendance `json:"attendances"`
+}
+
+type EventAttendance struct {
+	UserID       string                      `json:"userId"`
+	Status       model.EventAttendanceStatus `json:"status"`
+	IsWaitlisted bool                        `json:"isWaitlisted"`
+}
+
+// 表示順がないアーカイブでは、質問グループ・質問・選択肢をスライスの順に並べる
+type QuestionGroup struct {
+	ID          uint       `json:"id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	Due         time.Time  `json:"due"`
+	SortOrder   int        `json:"sortOrder,omitempty"`
+	Questions   []Question `json:"questions"`
+}
+
+type Question struct {
+	ID          uint               `json:"id"`
+	Type        model.QuestionType `json:"type"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description,omitempty"`
+	IsPublic    bool               `json:"isPublic"`
+	IsOpen      bool               `json:"isOpen"`
+	IsRequired  bool               `json:"isRequired"`
+	SortOrder   int                `json:"sortOrder,omitempty"`
+	// 以下はTypeがscaleの場合のみ使用する
+	ScaleMin      *int     `json:"scaleMin,omitempty"`
+	ScaleMax      *int     `json:"scaleMax,omitempty"`
+	ScaleMinLabel *string  `json:"scaleMinLabel,omitempty"`
+	ScaleMaxLabel *string  `json:"scaleMaxLabel,omitempty"`
+	Options       []Option `json:"options"`
+}
+
+type Option struct {
+	ID        uint   `json:"id"`
+	Content   string `json:"content"`
+	SortOrder int    `json:"sortOrder,omitempty"`
+}
+
+type Answer struct {
+	QuestionID        uint               `json:"questionId"`
+	UserID            string             `json:"userId"`
+	Type              model.QuestionType `json:"type"`
+	FreeTextContent   *string            `json:"freeTextContent,omitempty"`
+	FreeNumberContent *float64           `json:"freeNumberContent,omitempty"`
+	DateContent       *time.Time         `json:"dateContent,omitempty"`
+	ScaleContent      *int               `json:"scaleContent,omitempty"`
+	SelectedOptionIDs []uint             `json:"selectedOptionIds"`
+	// 順位の高い順に並ぶ
+	RankedOptionIDs []uint `json:"rankedOptionIds,omitempty"`
+}
+
+type RoomGroup struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Rooms []Room `json:"rooms"`
+}
+
+type Room struct {
+	ID        uint        `json:"id"`
+	Name      string      `json:"name"`
+	MemberIDs []string    `json:"memberIds"`
+	Status    *RoomStatus `json:"status,omitempty"`
+}
+
+type RoomStatus struct {
+	Type  *string `json:"type"`
+	Topic string  `json:"topic"`
+}
+
+type RollCall struct {
+	ID                uint               `json:"id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	Options           []string           `json:"options"`
+	SubjectIDs        []string           `json:"subjectIds"`
+	Deadline          *time.Time         `json:"deadline,omitempty"`
+	ClosedAt          *time.Time         `json:"closedAt,omitempty"`
+	RestrictResponses bool               `json:"restrictResponses"`
+	Reactions         []RollCallReaction `json:"reactions"`
+}
+
+type RollCallReaction struct {
+	UserID    string    `json:"userId"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type GuidebookRevision struct {
+	ID          uint       `json:"id"`
+	Content     string     `json:"content"`
+	AuthorID    *string    `json:"authorId,omitempty"`
+	PublishAt   *time.Time `json:"publishAt,omitempty"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type Activity struct {
+	Type        model.ActivityType `json:"type"`
+	UserID      *string            `json:"userId,omitempty"`
+	ReferenceID uint               `json:"referenceId"` // ReferenceIDが指すものはTypeによって異なる
+	Amount      *int               `json:"amount,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt"`
+}
@@ -0,0 +1,928 @@
+package archive
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+type archiveServiceImpl struct {
+	repo repository.Repository
+}
+
+func NewArchiveService(repo repository.Repository) *archiveServiceImpl {
+	return &archiveServiceImpl{repo: repo}
+}
+
+func (s *archiveServiceImpl) ExportCamp(ctx context.Context, campID uint) (*Archive, error) {
+	camp, err := s.repo.GetCampByID(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := s.repo.GetCampParticipants(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.GetPayments(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.GetEvents(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	questionGroups, err := s.repo.GetQuestionGroups(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	roomGroups, err := s.repo.GetRoomGroups(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	rollCalls, err := s.repo.GetRollCalls(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := s.repo.GetActivitiesByCampID(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	guidebookRevisions, err := s.repo.GetGuidebookRevisions(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &Archive{
+		Version:    CurrentVersion,
+		ExportedAt: time.Now(),
+		Camp: Camp{
+			DisplayID:               camp.DisplayID,
+			Name:                    camp.Name,
+			Guidebook:               camp.Guidebook,
+			Status:                  camp.Status,
+			DateStart:               camp.DateStart,
+			DateEnd:                 camp.DateEnd,
+			RegistrationScheduledAt: camp.RegistrationScheduledAt,
+			ConfirmedScheduledAt:    camp.ConfirmedScheduledAt,
+			OngoingScheduledAt:      camp.OngoingScheduledAt,
+			FinishedScheduledAt:     camp.FinishedScheduledAt,
+			ArchivedScheduledAt:     camp.ArchivedScheduledAt,
+		},
+		Participants:   make([]string, len(participants)),
+		Payments:       make([]Payment, len(payments)),
+		Events:         make([]Event, len(events)),
+		QuestionGroups: make([]QuestionGroup, len(questionGroups)),
+		Answers:        []Answer{},
+		RoomGroups:     make([]RoomGroup, len(roomGroups)),
+		RollCalls:      make([]RollCall, len(rollCalls)),
+		Activities:     make([]Activity, 0, len(activities)),
+
+		GuidebookRevisions: make([]GuidebookRevision, len(guidebookRevisions)),
+	}
+
+	for i, participant := range participants {
+		archive.Participants[i] = participant.ID
+	}
+
+	for i, payment := range payments {
+		var lineItems []PaymentLineItem
+
+		for _, lineItem := range payment.LineItems {
+			lineItems = append(lineItems, PaymentLineItem{
+				Category:    lineItem.Category,
+				Description: lineItem.Description,
+				Amount:      lineItem.Amount,
+			})
+		}
+
+		paymentTransactions, err := s.repo.GetPaymentTransactions(ctx, payment.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		var transactions []PaymentTransaction
+
+		for _, transaction := range paymentTransactions {
+			transactions = append(transactions, PaymentTransaction{
+				Amount:     transaction.Amount,
+				Method:     transaction.Method,
+				ReceivedBy: transaction.ReceivedByID,
+				ReceivedAt: transaction.ReceivedAt,
+				Memo:       transaction.Memo,
+			})
+		}
+
+		archive.Payments[i] = Payment{
+			ID:           payment.ID,
+			UserID:       payment.UserID,
+			Amount:       payment.Amount,
+			AmountPaid:   payment.AmountPaid,
+			LineItems:    lineItems,
+			Transactions: transactions,
+		}
+	}
+
+	for i, event := range events {
+		attendances := make([]EventAttendance, len(event.Attendances))
+
+		for j, attendance := range event.Attendances {
+			attendances[j] = EventAttendance{
+				UserID:       attendance.UserID,
+				Status:       attendance.Status,
+				IsWaitlisted: attendance.IsWaitlisted,
+			}
+		}
+
+		archive.Events[i] = Event{
+			ID:           event.ID,
+			Type:         event.Type,
+			Name:         event.Name,
+			Description:  event.Description,
+			Location:     event.Location,
+			TimeStart:    event.TimeStart,
+			TimeEnd:      event.TimeEnd,
+			OrganizerID:  event.OrganizerID,
+			DisplayColor: event.DisplayColor,
+			Capacity:     event.Capacity,
+			Attendances:  attendances,
+		}
+	}
+
+	// 質問から取り除かれた選択肢は書き出さないため、回答からも除く
+	exportedOptionIDs := make(map[uint]struct{})
+
+	for i, questionGroup := range questionGroups {
+		questions := make([]Question, len(questionGroup.Questions))
+
+		for j, question := range questionGroup.Questions {
+			options := make([]Option, len(question.Options))
+
+			for k, option := range question.Options {
+				options[k] = Option{
+					ID:        option.ID,
+					Content:   option.Content,
+					SortOrder: option.SortOrder,
+				}
+				exportedOptionIDs[option.ID] = struct{}{}
+			}
+
+			questions[j] = Question{
+				ID:            question.ID,
+				Type:          question.Type,
+				Title:         question.Title,
+				Description:   question.Description,
+				IsPublic:      question.IsPublic,
+				IsOpen:        question.IsOpen,
+				IsRequired:    question.IsRequired,
+				SortOrder:     question.SortOrder,
+				ScaleMin:      question.ScaleMin,
+				ScaleMax:      question.ScaleMax,
+				ScaleMinLabel: question.ScaleMinLabel,
+				ScaleMaxLabel: question.ScaleMaxLabel,
+				Options:       options,
+			}
+		}
+
+		archive.QuestionGroups[i] = QuestionGroup{
+			ID:          questionGroup.ID,
+			Name:        questionGroup.Name,
+			Description: questionGroup.Description,
+			Due:         questionGroup.Due,
+			SortOrder:   questionGroup.SortOrder,
+			Questions:   questions,
+		}
+
+		answers, err := s.repo.GetAnswers(ctx, repository.GetAnswersQuery{
+			QuestionGroupID:        &questionGroup.ID,
+			IncludePrivateAnswers:  true,
+			IncludeNonParticipants: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, answer := range answers {
+			// 画像はアーカイブに含まれないため、ファイルの回答は書き出さない
+			if answer.Type == model.FileQuestion {
+				continue
+			}
+
+			selectedOptionIDs := make([]uint, 0, len(answer.SelectedOptions))
+
+			for _, option := range answer.SelectedOptions {
+				if _, ok := exportedOptionIDs[option.ID]; ok {
+					selectedOptionIDs = append(selectedOptionIDs, option.ID)
+				}
+			}
+
+			var rankedOptionIDs []uint
+
+			for _, rankedOption := range answer.RankedOptions {
+				if _, ok := exportedOptionIDs[rankedOption.OptionID]; ok {
+					rankedOptionIDs = append(rankedOptionIDs, rankedOption.OptionID)
+				}
+			}
+
+			archive.Answers = append(archive.Answers, Answer{
+				QuestionID:        answer.QuestionID,
+				UserID:            answer.UserID,
+				Type:              answer.Type,
+				FreeTextContent:   answer.FreeTextContent,
+				FreeNumberContent: answer.FreeNumberContent,
+				DateContent:       answer.DateContent,
+				ScaleContent:      answer.ScaleContent,
+				SelectedOptionIDs: selectedOptionIDs,
+				RankedOptionIDs:   rankedOptionIDs,
+			})
+		}
+	}
+
+	for i, roomGroup := range roomGroups {
+		rooms := make([]Room, len(roomGroup.Rooms))
+
+		for j, room := range roomGroup.Rooms {
+			memberIDs := make([]string, len(room.Members))
+
+			for k, member := range room.Members {
+				memberIDs[k] = member.ID
+			}
+
+			rooms[j] = Room{
+				ID:        room.ID,
+				Name:      room.Name,
+				MemberIDs: memberIDs,
+			}
+
+			// ステータスが一度も設定されていない部屋はIDがゼロ値になる
+			if room.Status.ID != 0 {
+				rooms[j].Status = &RoomStatus{
+					Type:  room.Status.Type,
+					Topic: room.Status.Topic,
+				}
+			}
+		}
+
+		archive.RoomGroups[i] = RoomGroup{
+			ID:    roomGroup.ID,
+			Name:  roomGroup.Name,
+			Rooms: rooms,
+		}
+	}
+
+	for i, rollCall := range rollCalls {
+		subjectIDs := make([]string, len(rollCall.Subjects))
+
+		for j, subject := range rollCall.Subjects {
+			subjectIDs[j] = subject.ID
+		}
+
+		reactions := make([]RollCallReaction, len(rollCall.Reactions))
+
+		for j, reaction := range rollCall.Reactions {
+			reactions[j] = RollCallReaction{
+				UserID:    reaction.UserID,
+				Content:   reaction.Content,
+				CreatedAt: reaction.CreatedAt,
+			}
+		}
+
+		archive.RollCalls[i] = RollCall{
+			ID:                rollCall.ID,
+			Name:              rollCall.Name,
+			Description:       rollCall.Description,
+			Options:           rollCall.Options,
+			SubjectIDs:        subjectIDs,
+			Deadline:          rollCall.Deadline,
+			ClosedAt:          rollCall.ClosedAt,
+			RestrictResponses: rollCall.RestrictResponses,
+			Reactions:         reactions,
+		}
+	}
+
+	for i, revision := range guidebookRevisions {
+		archive.GuidebookRevisions[i] = GuidebookRevision{
+			ID:          revision.ID,
+			Content:     revision.Content,
+			AuthorID:    revision.AuthorID,
+			PublishAt:   revision.PublishAt,
+			PublishedAt: revision.PublishedAt,
+			CreatedAt:   revision.CreatedAt,
+		}
+	}
+
+	for _, activity := range activities {
+		// 予約した変更はアーカイブに含まれないため、適用を記録したアクティビティも書き出さない
+		if activity.Type == model.ActivityTypeScheduledChangeApplied {
+			continue
+		}
+
+		archive.Activities = append(archive.Activities, Activity{
+			Type:        activity.Type,
+			UserID:      activity.UserID,
+			ReferenceID: activity.ReferenceID,
+			Amount:      activity.Amount,
+			CreatedAt:   activity.CreatedAt,
+		})
+	}
+
+	return archive, nil
+}
+
+func (s *archiveServiceImpl) ImportCamp(
+	ctx context.Context,
+	archive Archive,
+	operatorID string,
+) (*model.Camp, error) {
+	if archive.Version != CurrentVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, archive.Version)
+	}
+
+	var camp *model.Camp
+
+	if err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		im := newImporter(tx, operatorID)
+
+		var err error
+		camp, err = im.importArchive(ctx, archive)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return camp, nil
+}
+
+// importer はアーカイブ内のIDから新しく作成したレコードのIDへの対応を保持する
+type importer struct {
+	repo       repository.Repository
+	operatorID string
+
+	paymentIDs       map[uint]uint
+	questionGroupIDs map[uint]uint
+	questionIDs      map[uint]uint
+	optionIDs        map[uint]uint
+	roomIDs          map[uint]uint
+	rollCallIDs      map[uint]uint
+
+	guidebookRevisionIDs map[uint]uint
+}
+
+func newImporter(repo repository.Repository, operatorID string) *importer {
+	return &importer{
+		repo:             repo,
+		operatorID:       operatorID,
+		paymentIDs:       make(map[uint]uint),
+		questionGroupIDs: make(map[uint]uint),
+		questionIDs:      make(map[uint]uint),
+		optionIDs:        make(map[uint]uint),
+		roomIDs:          make(map[uint]uint),
+		rollCallIDs:      make(map[uint]uint),
+
+		guidebookRevisionIDs: make(map[uint]uint),
+	}
+}
+
+func (im *importer) importArchive(ctx context.Context, archive Archive) (*model.Camp, error) {
+	if err := im.importUsers(ctx, archive); err != nil {
+		return nil, err
+	}
+
+	camp := model.Camp{
+		DisplayID:               archive.Camp.DisplayID,
+		Name:                    archive.Camp.Name,
+		Guidebook:               archive.Camp.Guidebook,
+		Status:                  archive.Camp.Status,
+		DateStart:               archive.Camp.DateStart,
+		DateEnd:                 archive.Camp.DateEnd,
+		RegistrationScheduledAt: archive.Camp.RegistrationScheduledAt,
+		ConfirmedScheduledAt:    archive.Camp.ConfirmedScheduledAt,
+		OngoingScheduledAt:      archive.Camp.OngoingScheduledAt,
+		FinishedScheduledAt:     archive.Camp.FinishedScheduledAt,
+		ArchivedScheduledAt:     archive.Camp.ArchivedScheduledAt,
+	}
+
+	if camp.Status == "" {
+		camp.Status = legacyCampStatus(archive)
+	}
+
+	if err := im.repo.CreateCamp(&camp); err != nil {
+		return nil, err
+	}
+
+	for _, participantID := range archive.Participants {
+		if err := im.repo.AddCampParticipant(
+			ctx,
+			camp.ID,
+			&model.User{ID: participantID},
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, payment := range archive.Payments {
+		newPayment := model.Payment{
+			Amount:     payment.Amount,
+			AmountPaid: payment.AmountPaid,
+			UserID:     payment.UserID,
+			CampID:     camp.ID,
+		}
+
+		for _, lineItem := range payment.LineItems {
+			newPayment.LineItems = append(newPayment.LineItems, model.PaymentLineItem{
+				Category:    lineItem.Category,
+				Description: lineItem.Description,
+				Amount:      lineItem.Amount,
+			})
+		}
+
+		for _, transaction := range payment.Transactions {
+			newPayment.Transactions = append(newPayment.Transactions, model.PaymentTransaction{
+				Amount:       transaction.Amount,
+				Method:       transaction.Method,
+				ReceivedByID: transaction.ReceivedBy,
+				ReceivedAt:   transaction.ReceivedAt,
+				Memo:         transaction.Memo,
+			})
+		}
+
+		if len(payment.Transactions) == 0 && payment.AmountPaid != 0 {
+			newPayment.Transactions = []model.PaymentTransaction{
+				{
+					Amount:     payment.AmountPaid,
+					Method:     model.PaymentMethodOther,
+					ReceivedAt: archive.ExportedAt,
+					Memo:       "記録開始前の入金額",
+				},
+			}
+		}
+
+		if err := im.repo.CreatePayment(ctx, &newPayment); err != nil {
+			return nil, err
+		}
+
+		im.paymentIDs[payment.ID] = newPayment.ID
+	}
+
+	for _, event := range archive.Events {
+		newEvent := model.Event{
+			Type:         event.Type,
+			Name:         event.Name,
+			Description:  event.Description,
+			Location:     event.Location,
+			TimeStart:    event.TimeStart,
+			TimeEnd:      event.TimeEnd,
+			OrganizerID:  event.OrganizerID,
+			DisplayColor: event.DisplayColor,
+			Capacity:     event.Capacity,
+			CampID:       camp.ID,
+		}
+
+		if err := im.repo.CreateEvent(&newEvent); err != nil {
+			return nil, err
+		}
+
+		for _, attendance := range event.Attendances {
+			if err := im.repo.SaveEventAttendance(ctx, &model.EventAttendance{
+				EventID:      newEvent.ID,
+				UserID:       attendance.UserID,
+				Status:       attendance.Status,
+				IsWaitlisted: attendance.IsWaitlisted,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := im.importQuestionGroups(ctx, camp.ID, archive); err != nil {
+		return nil, err
+	}
+
+	if err := im.importRoomGroups(ctx, camp.ID, archive); err != nil {
+		return nil, err
+	}
+
+	if err := im.importRollCalls(ctx, camp.ID, archive); err != nil {
+		return nil, err
+	}
+
+	if err := im.importGuidebookRevisions(ctx, camp.ID, archive); err != nil {
+		return nil, err
+	}
+
+	if err := im.importActivities(ctx, camp.ID, archive); err != nil {
+		return nil, err
+	}
+
+	return &camp, nil
+}
+
+// legacyCampStatus はstatusがないアーカイブの合宿の状態を、フラグとエクスポートした日時から決める
+func legacyCampStatus(archive Archive) model.CampStatus {
+	switch {
+	case archive.Camp.IsDraft:
+		return model.CampStatusDraft
+	case archive.Camp.IsRegistrationOpen:
+		return model.CampStatusRegistration
+	case archive.Camp.DateEnd.Before(archive.ExportedAt):
+		return model.CampStatusFinished
+	case !archive.Camp.DateStart.After(archive.ExportedAt):
+		return model.CampStatusOngoing
+	default:
+		return model.CampStatusConfirmed
+	}
+}
+
+// importUsers はアーカイブに登場するユーザーを外部キー制約を満たすために事前に作成する
+func (im *importer) importUsers(ctx context.Context, archive Archive) error {
+	userIDs := make(map[string]struct{}, len(archive.Participants))
+
+	userIDs[im.operatorID] = struct{}{}
+
+	for _, participantID := range archive.Participants {
+		userIDs[participantID] = struct{}{}
+	}
+
+	for _, payment := range archive.Payments {
+		userIDs[payment.UserID] = struct{}{}
+
+		for _, transaction := range payment.Transactions {
+			if transaction.ReceivedBy != nil {
+				userIDs[*transaction.ReceivedBy] = struct{}{}
+			}
+		}
+	}
+
+	for _, event := range archive.Events {
+		if event.OrganizerID != nil {
+			userIDs[*event.OrganizerID] = struct{}{}
+		}
+
+		for _, attendance := range event.Attendances {
+			userIDs[attendance.UserID] = struct{}{}
+		}
+	}
+
+	for _, answer := range archive.Answers {
+		userIDs[answer.UserID] = struct{}{}
+	}
+
+	for _, roomGroup := range archive.RoomGroups {
+		for _, room := range roomGroup.Rooms {
+			for _, memberID := range room.MemberIDs {
+				userIDs[memberID] = struct{}{}
+			}
+		}
+	}
+
+	for _, rollCall := range archive.RollCalls {
+		for _, subjectID := range rollCall.SubjectIDs {
+			userIDs[subjectID] = struct{}{}
+		}
+
+		for _, reaction := range rollCall.Reactions {
+			userIDs[reaction.UserID] = struct{}{}
+		}
+	}
+
+	for _, activity := range archive.Activities {
+		if activity.UserID != nil {
+			userIDs[*activity.UserID] = struct{}{}
+		}
+	}
+
+	for _, revision := range archive.GuidebookRevisions {
+		if revision.AuthorID != nil {
+			userIDs[*revision.AuthorID] = struct{}{}
+		}
+	}
+
+	for userID := range userIDs {
+		if userID == "" {
+			return fmt.Errorf("%w: empty user ID", ErrInvalidArchive)
+		}
+
+		if _, err := im.repo.GetOrCreateUser(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) importQuestionGroups(ctx context.Context, campID uint, archive Archive) error {
+	// 作成時に表示順がスライスの順に振り直されるため、表示順に並べてから作成する
+	questionGroups := sortBySortOrder(
+		archive.QuestionGroups,
+		func(questionGroup QuestionGroup) int { return questionGroup.SortOrder },
+	)
+
+	for _, questionGroup := range questionGroups {
+		questionGroup.Questions = sortBySortOrder(
+			questionGroup.Questions,
+			func(question Question) int { return question.SortOrder },
+		)
+
+		for i := range questionGroup.Questions {
+			questionGroup.Questions[i].Options = sortBySortOrder(
+				questionGroup.Questions[i].Options,
+				func(option Option) int { return option.SortOrder },
+			)
+		}
+
+		newQuestionGroup := model.QuestionGroup{
+			Name:        questionGroup.Name,
+			Description: questionGroup.Description,
+			Due:         questionGroup.Due,
+			Questions:   make([]model.Question, len(questionGroup.Questions)),
+			CampID:      campID,
+		}
+
+		for i, question := range questionGroup.Questions {
+			options := make([]model.Option, len(question.Options))
+
+			for j, option := range question.Options {
+				options[j] = model.Option{Content: option.Content}
+			}
+
+			newQuestionGroup.Questions[i] = model.Question{
+				Type:          question.Type,
+				Title:         question.Title,
+				Description:   question.Description,
+				IsPublic:      question.IsPublic,
+				IsOpen:        question.IsOpen,
+				IsRequired:    question.IsRequired,
+				ScaleMin:      question.ScaleMin,
+				ScaleMax:      question.ScaleMax,
+				ScaleMinLabel: question.ScaleMinLabel,
+				ScaleMaxLabel: question.ScaleMaxLabel,
+				Options:       options,
+			}
+		}
+
+		// 質問と選択肢も同時に作成され、IDが元のスライスと同じ順番で設定される
+		if err := im.repo.CreateQuestionGroup(ctx, &newQuestionGroup); err != nil {
+			return err
+		}
+
+		im.questionGroupIDs[questionGroup.ID] = newQuestionGroup.ID
+
+		for i, question := range questionGroup.Questions {
+			newQuestion := newQuestionGroup.Questions[i]
+			im.questionIDs[question.ID] = newQuestion.ID
+
+			for j, option := range question.Options {
+				im.optionIDs[option.ID] = newQuestion.Options[j].ID
+			}
+		}
+	}
+
+	if len(archive.Answers) == 0 {
+		return nil
+	}
+
+	answers := make([]model.Answer, len(archive.Answers))
+
+	for i, answer := range archive.Answers {
+		questionID, ok := im.questionIDs[answer.QuestionID]
+		if !ok {
+			return fmt.Errorf("%w: question %d not found", ErrInvalidArchive, answer.QuestionID)
+		}
+
+		selectedOptions := make([]model.Option, len(answer.SelectedOptionIDs))
+
+		for j, optionID := range answer.SelectedOptionIDs {
+			newOptionID, ok := im.optionIDs[optionID]
+			if !ok {
+				return fmt.Errorf("%w: option %d not found", ErrInvalidArchive, optionID)
+			}
+
+			selectedOptions[j] = model.Option{Model: gorm.Model{ID: newOptionID}}
+		}
+
+		rankedOptions := make([]model.AnswerRankedOption, len(answer.RankedOptionIDs))
+
+		for j, optionID := range answer.RankedOptionIDs {
+			newOptionID, ok := im.optionIDs[optionID]
+			if !ok {
+				return fmt.Errorf("%w: option %d not found", ErrInvalidArchive, optionID)
+			}
+
+			rankedOptions[j] = model.AnswerRankedOption{Position: j + 1, OptionID: newOptionID}
+		}
+
+		answers[i] = model.Answer{
+			QuestionID:        questionID,
+			UserID:            answer.UserID,
+			Type:              answer.Type,
+			FreeTextContent:   answer.FreeTextContent,
+			FreeNumberContent: answer.FreeNumberContent,
+			DateContent:       answer.DateContent,
+			ScaleContent:      answer.ScaleContent,
+			SelectedOptions:   selectedOptions,
+			RankedOptions:     rankedOptions,
+		}
+	}
+
+	return im.repo.CreateAnswers(ctx, &answers, im.operatorID)
+}
+
+// sortBySortOrder は表示順の昇順に並べたコピーを返す。表示順が同じ場合は元の順番を保つ
+func sortBySortOrder[T any](items []T, sortOrder func(T) int) []T {
+	sorted := slices.Clone(items)
+
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return cmp.Compare(sortOrder(a), sortOrder(b))
+	})
+
+	return sorted
+}
+
+func (im *importer) importRoomGroups(ctx context.Context, campID uint, archive Archive) error {
+	for _, roomGroup := range archive.RoomGroups {
+		newRoomGroup := model.RoomGroup{
+			Name:   roomGroup.Name,
+			Rooms:  make([]model.Room, len(roomGroup.Rooms)),
+			CampID: campID,
+		}
+
+		for i, room := range roomGroup.Rooms {
+			members := make([]model.User, len(room.MemberIDs))
+
+			for j, memberID := range room.MemberIDs {
+				members[j] = model.User{ID: memberID}
+			}
+
+			newRoomGroup.Rooms[i] = model.Room{
+				Name:    room.Name,
+				Members: members,
+			}
+		}
+
+		if err := im.repo.CreateRoomGroup(ctx, &newRoomGroup); err != nil {
+			return err
+		}
+
+		for i, room := range roomGroup.Rooms {
+			newRoomID := newRoomGroup.Rooms[i].ID
+			im.roomIDs[room.ID] = newRoomID
+
+			if room.Status == nil {
+				continue
+			}
+
+			if err := im.repo.SetRoomStatus(ctx, newRoomID, model.RoomStatus{
+				Type:  room.Status.Type,
+				Topic: room.Status.Topic,
+			}, im.operatorID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) importRollCalls(ctx context.Context, campID uint, archive Archive) error {
+	for _, rollCall := range archive.RollCalls {
+		subjects := make([]model.User, len(rollCall.SubjectIDs))
+
+		for i, subjectID := range rollCall.SubjectIDs {
+			subjects[i] = model.User{ID: subjectID}
+		}
+
+		newRollCall := model.RollCall{
+			Name:              rollCall.Name,
+			Description:       rollCall.Description,
+			Options:           rollCall.Options,
+			Subjects:          subjects,
+			Deadline:          rollCall.Deadline,
+			ClosedAt:          rollCall.ClosedAt,
+			RestrictResponses: rollCall.RestrictResponses,
+			CampID:            campID,
+		}
+
+		if err := im.repo.CreateRollCall(ctx, &newRollCall); err != nil {
+			return err
+		}
+
+		im.rollCallIDs[rollCall.ID] = newRollCall.ID
+
+		for _, reaction := range rollCall.Reactions {
+			newReaction := model.RollCallReaction{
+				Model:      gorm.Model{CreatedAt: reaction.CreatedAt},
+				Content:    reaction.Content,
+				UserID:     reaction.UserID,
+				RollCallID: newRollCall.ID,
+			}
+
+			if err := im.repo.CreateRollCallReaction(ctx, &newReaction); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) importGuidebookRevisions(
+	ctx context.Context,
+	campID uint,
+	archive Archive,
+) error {
+	revisions := archive.GuidebookRevisions
+
+	// しおりの版がないアーカイブでは、マイグレーションと同じく既存のしおりを最初の版とする
+	if len(revisions) == 0 && archive.Camp.Guidebook != "" {
+		revisions = []GuidebookRevision{
+			{
+				Content:     archive.Camp.Guidebook,
+				PublishedAt: &archive.ExportedAt,
+				CreatedAt:   archive.ExportedAt,
+			},
+		}
+	}
+
+	// 合宿のしおりはCamp.Guidebookから作られているため、版を公開し直す必要はない
+	for _, revision := range revisions {
+		newRevision := model.GuidebookRevision{
+			Model:       gorm.Model{CreatedAt: revision.CreatedAt},
+			CampID:      campID,
+			Content:     revision.Content,
+			AuthorID:    revision.AuthorID,
+			PublishAt:   revision.PublishAt,
+			PublishedAt: revision.PublishedAt,
+		}
+
+		if err := im.repo.CreateGuidebookRevision(ctx, &newRevision); err != nil {
+			return err
+		}
+
+		im.guidebookRevisionIDs[revision.ID] = newRevision.ID
+	}
+
+	return nil
+}
+
+func (im *importer) importActivities(ctx context.Context, campID uint, archive Archive) error {
+	for _, activity := range archive.Activities {
+		var referenceIDs map[uint]uint
+
+		switch activity.Type {
+		case model.ActivityTypeRoomCreated:
+			referenceIDs = im.roomIDs
+		case model.ActivityTypePaymentCreated,
+			model.ActivityTypePaymentAmountChanged,
+			model.ActivityTypePaymentPaidChanged:
+			referenceIDs = im.paymentIDs
+		case model.ActivityTypeRollCallCreated:
+			referenceIDs = im.rollCallIDs
+		case model.ActivityTypeQuestionCreated:
+			referenceIDs = im.questionGroupIDs
+		case model.ActivityTypeGuidebookPublished:
+			referenceIDs = im.guidebookRevisionIDs
+		case model.ActivityTypeScheduledChangeApplied:
+			// 予約した変更はアーカイブに含まれないため移さない
+			continue
+		default:
+			return fmt.Errorf("%w: unknown activity type %s", ErrInvalidArchive, activity.Type)
+		}
+
+		referenceID, ok := referenceIDs[activity.ReferenceID]
+
+		// 参照先がアーカイブに含まれていない（削除済みなど）アクティビティは移さない
+		if !ok {
+			continue
+		}
+
+		newActivity := model.Activity{
+			Model:       gorm.Model{CreatedAt: activity.CreatedAt},
+			Type:        activity.Type,
+			CampID:      campID,
+			UserID:      activity.UserID,
+			ReferenceID: referenceID,
+			Amount:      activity.Amount,
+		}
+
+		if err := im.repo.CreateActivity(ctx, &newActivity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,687 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+type archiveTestSetup struct {
+	service *archiveServiceImpl
+	repo    *mockrepository.MockRepository
+}
+
+func setup(t *testing.T) *archiveTestSetup {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mockrepository.NewMockRepository(ctrl)
+	service := NewArchiveService(repo)
+
+	return &archiveTestSetup{
+		service: service,
+		repo:    repo,
+	}
+}
+
+func TestArchiveServiceImpl_ExportCamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		camp := model.Camp{
+			Model:     gorm.Model{ID: campID},
+			DisplayID: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			DateStart: random.Time(t),
+			DateEnd:   random.Time(t),
+		}
+		user := model.User{ID: random.AlphaNumericString(t, 32)}
+		option := model.Option{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Content:   random.AlphaNumericString(t, 20),
+			SortOrder: random.PositiveInt(t),
+		}
+		question := model.Question{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.SingleChoiceQuestion,
+			Title:     random.AlphaNumericString(t, 20),
+			SortOrder: random.PositiveInt(t),
+			Options:   []model.Option{option},
+		}
+		questionGroup := model.QuestionGroup{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:      random.AlphaNumericString(t, 20),
+			SortOrder: random.PositiveInt(t),
+			Questions: []model.Question{question},
+			CampID:    campID,
+		}
+		answer := model.Answer{
+			QuestionID:      question.ID,
+			UserID:          user.ID,
+			Type:            model.SingleChoiceQuestion,
+			SelectedOptions: []model.Option{option},
+		}
+		roomWithStatus := model.Room{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:    random.AlphaNumericString(t, 20),
+			Members: []model.User{user},
+			Status: model.RoomStatus{
+				Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+				Topic: random.AlphaNumericString(t, 20),
+			},
+		}
+		roomWithoutStatus := model.Room{
+			Model: gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:  random.AlphaNumericString(t, 20),
+		}
+		roomGroup := model.RoomGroup{
+			Model:  gorm.Model{ID: uint(random.PositiveInt(t))},
+			Name:   random.AlphaNumericString(t, 20),
+			Rooms:  []model.Room{roomWithStatus, roomWithoutStatus},
+			CampID: campID,
+		}
+		publishedAt := random.Time(t)
+		revision := model.GuidebookRevision{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:      campID,
+			Content:     random.AlphaNumericString(t, 100),
+			AuthorID:    &user.ID,
+			PublishedAt: &publishedAt,
+		}
+
+		s.repo.MockCampRepository.EXPECT().GetCampByID(ctx, campID).Return(&camp, nil)
+		s.repo.MockCampRepository.EXPECT().
+			GetCampParticipants(ctx, campID).
+			Return([]model.User{user}, nil)
+		s.repo.MockPaymentRepository.EXPECT().GetPayments(ctx, campID).Return(nil, nil)
+		s.repo.MockEventRepository.EXPECT().GetEvents(ctx, campID).Return(nil, nil)
+		s.repo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroups(ctx, campID).
+			Return([]model.QuestionGroup{questionGroup}, nil)
+		s.repo.MockAnswerRepository.EXPECT().
+			GetAnswers(ctx, repository.GetAnswersQuery{
+				QuestionGroupID:        &questionGroup.ID,
+				IncludePrivateAnswers:  true,
+				IncludeNonParticipants: true,
+			}).
+			Return([]model.Answer{answer}, nil)
+		s.repo.MockRoomGroupRepository.EXPECT().
+			GetRoomGroups(ctx, campID).
+			Return([]model.RoomGroup{roomGroup}, nil)
+		s.repo.MockRollCallRepository.EXPECT().GetRollCalls(ctx, campID).Return(nil, nil)
+		s.repo.MockActivityRepository.EXPECT().
+			GetActivitiesByCampID(ctx, campID).
+			Return(nil, nil)
+		s.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisions(ctx, campID).
+			Return([]model.GuidebookRevision{revision}, nil)
+
+		archive, err := s.service.ExportCamp(ctx, campID)
+
+		require.NoError(t, err)
+		assert.Equal(t, CurrentVersion, archive.Version)
+		assert.Equal(t, camp.DisplayID, archive.Camp.DisplayID)
+		assert.Equal(t, []string{user.ID}, archive.Participants)
+		require.Len(t, archive.QuestionGroups, 1)
+		require.Len(t, archive.QuestionGroups[0].Questions, 1)
+		assert.Equal(t, question.ID, archive.QuestionGroups[0].Questions[0].ID)
+		assert.Equal(t, questionGroup.SortOrder, archive.QuestionGroups[0].SortOrder)
+		assert.Equal(t, question.SortOrder, archive.QuestionGroups[0].Questions[0].SortOrder)
+		require.Len(t, archive.QuestionGroups[0].Questions[0].Options, 1)
+		assert.Equal(
+			t,
+			option.SortOrder,
+			archive.QuestionGroups[0].Questions[0].Options[0].SortOrder,
+		)
+		require.Len(t, archive.Answers, 1)
+		assert.Equal(t, []uint{option.ID}, archive.Answers[0].SelectedOptionIDs)
+		require.Len(t, archive.RoomGroups, 1)
+		require.Len(t, archive.RoomGroups[0].Rooms, 2)
+		assert.Equal(t, []string{user.ID}, archive.RoomGroups[0].Rooms[0].MemberIDs)
+		require.NotNil(t, archive.RoomGroups[0].Rooms[0].Status)
+		assert.Equal(t, roomWithStatus.Status.Topic, archive.RoomGroups[0].Rooms[0].Status.Topic)
+		assert.Nil(t, archive.RoomGroups[0].Rooms[1].Status)
+		require.Len(t, archive.GuidebookRevisions, 1)
+		assert.Equal(t, revision.ID, archive.GuidebookRevisions[0].ID)
+		assert.Equal(t, revision.Content, archive.GuidebookRevisions[0].Content)
+		assert.Equal(t, revision.AuthorID, archive.GuidebookRevisions[0].AuthorID)
+	})
+
+	t.Run("合宿が存在しない", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+
+		s.repo.MockCampRepository.EXPECT().
+			GetCampByID(ctx, campID).
+			Return(nil, repository.ErrCampNotFound)
+
+		_, err := s.service.ExportCamp(ctx, campID)
+
+		assert.ErrorIs(t, err, repository.ErrCampNotFound)
+	})
+}
+
+func TestArchiveServiceImpl_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("予約した変更の適用のアクティビティ", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		newCampID := uint(random.PositiveInt(t))
+		operatorID := random.AlphaNumericString(t, 32)
+		camp := model.Camp{
+			Model:     gorm.Model{ID: campID},
+			DisplayID: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Status:    model.CampStatusRegistration,
+			DateStart: random.Time(t),
+			DateEnd:   random.Time(t),
+		}
+		scheduledChangeApplied := model.Activity{
+			Type:        model.ActivityTypeScheduledChangeApplied,
+			CampID:      campID,
+			ReferenceID: uint(random.PositiveInt(t)),
+		}
+
+		s.repo.MockCampRepository.EXPECT().GetCampByID(ctx, campID).Return(&camp, nil)
+		s.repo.MockCampRepository.EXPECT().GetCampParticipants(ctx, campID).Return(nil, nil)
+		s.repo.MockPaymentRepository.EXPECT().GetPayments(ctx, campID).Return(nil, nil)
+		s.repo.MockEventRepository.EXPECT().GetEvents(ctx, campID).Return(nil, nil)
+		s.repo.MockQuestionGroupRepository.EXPECT().GetQuestionGroups(ctx, campID).Return(nil, nil)
+		s.repo.MockRoomGroupRepository.EXPECT().GetRoomGroups(ctx, campID).Return(nil, nil)
+		s.repo.MockRollCallRepository.EXPECT().GetRollCalls(ctx, campID).Return(nil, nil)
+		s.repo.MockActivityRepository.EXPECT().
+			GetActivitiesByCampID(ctx, campID).
+			Return([]model.Activity{scheduledChangeApplied}, nil)
+		s.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisions(ctx, campID).
+			Return(nil, nil)
+
+		archive, err := s.service.ExportCamp(ctx, campID)
+
+		require.NoError(t, err)
+		assert.Empty(t, archive.Activities)
+
+		// 以前にエクスポートされたアーカイブには含まれている場合がある
+		archive.Activities = append(archive.Activities, Activity{
+			Type:        scheduledChangeApplied.Type,
+			ReferenceID: scheduledChangeApplied.ReferenceID,
+			CreatedAt:   random.Time(t),
+		})
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, operatorID).
+			Return(&model.User{ID: operatorID}, nil)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			DoAndReturn(func(newCamp *model.Camp) error {
+				assert.Equal(t, camp.DisplayID, newCamp.DisplayID)
+				assert.Equal(t, camp.Status, newCamp.Status)
+				newCamp.ID = newCampID
+				return nil
+			})
+
+		importedCamp, err := s.service.ImportCamp(ctx, *archive, operatorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, newCampID, importedCamp.ID)
+	})
+
+	t.Run("しおりの版と公開のアクティビティ", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		newCampID := uint(random.PositiveInt(t))
+		newRevisionID := uint(random.PositiveInt(t))
+		operatorID := random.AlphaNumericString(t, 32)
+		authorID := random.AlphaNumericString(t, 32)
+		publishedAt := random.Time(t)
+		camp := model.Camp{
+			Model:     gorm.Model{ID: campID},
+			DisplayID: random.AlphaNumericString(t, 10),
+			Name:      random.AlphaNumericString(t, 20),
+			Guidebook: random.AlphaNumericString(t, 100),
+			Status:    model.CampStatusRegistration,
+			DateStart: random.Time(t),
+			DateEnd:   random.Time(t),
+		}
+		revision := model.GuidebookRevision{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t)), CreatedAt: publishedAt},
+			CampID:      campID,
+			Content:     camp.Guidebook,
+			AuthorID:    &authorID,
+			PublishedAt: &publishedAt,
+		}
+		guidebookPublished := model.Activity{
+			Model:       gorm.Model{CreatedAt: publishedAt},
+			Type:        model.ActivityTypeGuidebookPublished,
+			CampID:      campID,
+			ReferenceID: revision.ID,
+		}
+
+		s.repo.MockCampRepository.EXPECT().GetCampByID(ctx, campID).Return(&camp, nil)
+		s.repo.MockCampRepository.EXPECT().GetCampParticipants(ctx, campID).Return(nil, nil)
+		s.repo.MockPaymentRepository.EXPECT().GetPayments(ctx, campID).Return(nil, nil)
+		s.repo.MockEventRepository.EXPECT().GetEvents(ctx, campID).Return(nil, nil)
+		s.repo.MockQuestionGroupRepository.EXPECT().GetQuestionGroups(ctx, campID).Return(nil, nil)
+		s.repo.MockRoomGroupRepository.EXPECT().GetRoomGroups(ctx, campID).Return(nil, nil)
+		s.repo.MockRollCallRepository.EXPECT().GetRollCalls(ctx, campID).Return(nil, nil)
+		s.repo.MockActivityRepository.EXPECT().
+			GetActivitiesByCampID(ctx, campID).
+			Return([]model.Activity{guidebookPublished}, nil)
+		s.repo.MockGuidebookRevisionRepository.EXPECT().
+			GetGuidebookRevisions(ctx, campID).
+			Return([]model.GuidebookRevision{revision}, nil)
+
+		archive, err := s.service.ExportCamp(ctx, campID)
+
+		require.NoError(t, err)
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, gomock.Any()).
+			Return(&model.User{}, nil).
+			Times(2)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			DoAndReturn(func(newCamp *model.Camp) error {
+				assert.Equal(t, camp.Guidebook, newCamp.Guidebook)
+				newCamp.ID = newCampID
+				return nil
+			})
+		s.repo.MockGuidebookRevisionRepository.EXPECT().
+			CreateGuidebookRevision(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, newRevision *model.GuidebookRevision) error {
+				assert.Equal(t, newCampID, newRevision.CampID)
+				assert.Equal(t, revision.Content, newRevision.Content)
+				assert.Equal(t, revision.AuthorID, newRevision.AuthorID)
+				require.NotNil(t, newRevision.PublishedAt)
+				assert.WithinDuration(t, publishedAt, *newRevision.PublishedAt, time.Second)
+				newRevision.ID = newRevisionID
+				return nil
+			})
+		s.repo.MockActivityRepository.EXPECT().
+			CreateActivity(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, activity *model.Activity) error {
+				assert.Equal(t, model.ActivityTypeGuidebookPublished, activity.Type)
+				assert.Equal(t, newRevisionID, activity.ReferenceID)
+				return nil
+			})
+
+		importedCamp, err := s.service.ImportCamp(ctx, *archive, operatorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, newCampID, importedCamp.ID)
+	})
+
+	t.Run("しおりの版がないアーカイブ", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		newCampID := uint(random.PositiveInt(t))
+		operatorID := random.AlphaNumericString(t, 32)
+		exportedAt := random.Time(t)
+		archive := Archive{
+			Version:    CurrentVersion,
+			ExportedAt: exportedAt,
+			Camp: Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+				Name:      random.AlphaNumericString(t, 20),
+				Guidebook: random.AlphaNumericString(t, 100),
+			},
+		}
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, operatorID).
+			Return(&model.User{ID: operatorID}, nil)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			DoAndReturn(func(newCamp *model.Camp) error {
+				newCamp.ID = newCampID
+				return nil
+			})
+		// 既存のしおりを公開済みの最初の版とする
+		s.repo.MockGuidebookRevisionRepository.EXPECT().
+			CreateGuidebookRevision(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, revision *model.GuidebookRevision) error {
+				assert.Equal(t, newCampID, revision.CampID)
+				assert.Equal(t, archive.Camp.Guidebook, revision.Content)
+				assert.Nil(t, revision.AuthorID)
+				require.NotNil(t, revision.PublishedAt)
+				assert.WithinDuration(t, exportedAt, *revision.PublishedAt, time.Second)
+				return nil
+			})
+
+		_, err := s.service.ImportCamp(ctx, archive, operatorID)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestArchiveServiceImpl_ImportCamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		operatorID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		oldOptionID := uint(random.PositiveInt(t))
+		oldQuestionID := uint(random.PositiveInt(t))
+		oldPaymentID := uint(random.PositiveInt(t))
+		newCampID := uint(random.PositiveInt(t))
+		newOptionID := uint(random.PositiveInt(t))
+		newQuestionID := uint(random.PositiveInt(t))
+		newPaymentID := uint(random.PositiveInt(t))
+		activityCreatedAt := random.Time(t)
+		archive := Archive{
+			Version: CurrentVersion,
+			Camp: Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+				Name:      random.AlphaNumericString(t, 20),
+			},
+			Participants: []string{userID},
+			Payments: []Payment{
+				{ID: oldPaymentID, UserID: userID, Amount: random.PositiveInt(t)},
+			},
+			QuestionGroups: []QuestionGroup{
+				{
+					ID:   uint(random.PositiveInt(t)),
+					Name: random.AlphaNumericString(t, 20),
+					Questions: []Question{
+						{
+							ID:   oldQuestionID,
+							Type: model.SingleChoiceQuestion,
+							Options: []Option{
+								{ID: oldOptionID, Content: random.AlphaNumericString(t, 20)},
+							},
+						},
+					},
+				},
+			},
+			Answers: []Answer{
+				{
+					QuestionID:        oldQuestionID,
+					UserID:            userID,
+					Type:              model.SingleChoiceQuestion,
+					SelectedOptionIDs: []uint{oldOptionID},
+				},
+			},
+			Activities: []Activity{
+				{
+					Type:        model.ActivityTypePaymentCreated,
+					UserID:      &userID,
+					ReferenceID: oldPaymentID,
+					CreatedAt:   activityCreatedAt,
+				},
+			},
+		}
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, gomock.Any()).
+			Return(&model.User{}, nil).
+			Times(2)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			DoAndReturn(func(camp *model.Camp) error {
+				assert.Equal(t, archive.Camp.DisplayID, camp.DisplayID)
+				camp.ID = newCampID
+				return nil
+			})
+		s.repo.MockCampRepository.EXPECT().
+			AddCampParticipant(ctx, newCampID, &model.User{ID: userID}).
+			Return(nil)
+		s.repo.MockPaymentRepository.EXPECT().
+			CreatePayment(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, payment *model.Payment) error {
+				assert.Equal(t, newCampID, payment.CampID)
+				payment.ID = newPaymentID
+				return nil
+			})
+		s.repo.MockQuestionGroupRepository.EXPECT().
+			CreateQuestionGroup(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, questionGroup *model.QuestionGroup) error {
+				assert.Equal(t, newCampID, questionGroup.CampID)
+				questionGroup.Questions[0].ID = newQuestionID
+				questionGroup.Questions[0].Options[0].ID = newOptionID
+				return nil
+			})
+		s.repo.MockAnswerRepository.EXPECT().
+			CreateAnswers(ctx, gomock.Any(), operatorID).
+			DoAndReturn(func(_ context.Context, answers *[]model.Answer, _ string) error {
+				require.Len(t, *answers, 1)
+				assert.Equal(t, newQuestionID, (*answers)[0].QuestionID)
+				require.Len(t, (*answers)[0].SelectedOptions, 1)
+				assert.Equal(t, newOptionID, (*answers)[0].SelectedOptions[0].ID)
+				return nil
+			})
+		s.repo.MockActivityRepository.EXPECT().
+			CreateActivity(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, activity *model.Activity) error {
+				assert.Equal(t, newPaymentID, activity.ReferenceID)
+				assert.Equal(t, newCampID, activity.CampID)
+				assert.WithinDuration(t, activityCreatedAt, activity.CreatedAt, time.Second)
+				return nil
+			})
+
+		camp, err := s.service.ImportCamp(ctx, archive, operatorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, newCampID, camp.ID)
+	})
+
+	t.Run("入出金の記録がない支払い", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		operatorID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		newCampID := uint(random.PositiveInt(t))
+		exportedAt := random.Time(t)
+		amountPaid := random.PositiveInt(t)
+		archive := Archive{
+			Version:    CurrentVersion,
+			ExportedAt: exportedAt,
+			Camp: Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+				Name:      random.AlphaNumericString(t, 20),
+			},
+			Payments: []Payment{
+				{
+					ID:         uint(random.PositiveInt(t)),
+					UserID:     userID,
+					Amount:     random.PositiveInt(t),
+					AmountPaid: amountPaid,
+				},
+			},
+		}
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, gomock.Any()).
+			Return(&model.User{}, nil).
+			Times(2)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			DoAndReturn(func(camp *model.Camp) error {
+				camp.ID = newCampID
+				return nil
+			})
+		s.repo.MockPaymentRepository.EXPECT().
+			CreatePayment(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, payment *model.Payment) error {
+				assert.Equal(t, amountPaid, payment.AmountPaid)
+				require.Len(t, payment.Transactions, 1)
+				assert.Equal(t, amountPaid, payment.Transactions[0].Amount)
+				assert.Equal(t, model.PaymentMethodOther, payment.Transactions[0].Method)
+				assert.Nil(t, payment.Transactions[0].ReceivedByID)
+				assert.WithinDuration(t, exportedAt, payment.Transactions[0].ReceivedAt, time.Second)
+				return nil
+			})
+
+		camp, err := s.service.ImportCamp(ctx, archive, operatorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, newCampID, camp.ID)
+	})
+
+	t.Run("表示順", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		operatorID := random.AlphaNumericString(t, 32)
+		newCampID := uint(random.PositiveInt(t))
+		// アーカイブ内の順番と表示順が異なる
+		archive := Archive{
+			Version: CurrentVersion,
+			Camp: Camp{
+				DisplayID: random.AlphaNumericString(t, 10),
+				Name:      random.AlphaNumericString(t, 20),
+			},
+			QuestionGroups: []QuestionGroup{
+				{ID: 1, Name: "second", SortOrder: 1},
+				{
+					ID:   2,
+					Name: "first",
+					Questions: []Question{
+						{
+							ID:        1,
+							Title:     "second",
+							Type:      model.SingleChoiceQuestion,
+							SortOrder: 1,
+						},
+						{
+							ID:    2,
+							Title: "first",
+							Type:  model.SingleChoiceQuestion,
+							Options: []Option{
+								{ID: 1, Content: "second", SortOrder: 1},
+								{ID: 2, Content: "first"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, operatorID).
+			Return(&model.User{ID: operatorID}, nil)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			DoAndReturn(func(camp *model.Camp) error {
+				camp.ID = newCampID
+				return nil
+			})
+		// 作成時に表示順がスライスの順に振り直されるため、表示順に並べて作成する
+		gomock.InOrder(
+			s.repo.MockQuestionGroupRepository.EXPECT().
+				CreateQuestionGroup(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, questionGroup *model.QuestionGroup) error {
+					assert.Equal(t, "first", questionGroup.Name)
+					require.Len(t, questionGroup.Questions, 2)
+					assert.Equal(t, "first", questionGroup.Questions[0].Title)
+					assert.Equal(t, "second", questionGroup.Questions[1].Title)
+					require.Len(t, questionGroup.Questions[0].Options, 2)
+					assert.Equal(t, "first", questionGroup.Questions[0].Options[0].Content)
+					assert.Equal(t, "second", questionGroup.Questions[0].Options[1].Content)
+					return nil
+				}),
+			s.repo.MockQuestionGroupRepository.EXPECT().
+				CreateQuestionGroup(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, questionGroup *model.QuestionGroup) error {
+					assert.Equal(t, "second", questionGroup.Name)
+					return nil
+				}),
+		)
+
+		camp, err := s.service.ImportCamp(ctx, archive, operatorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, newCampID, camp.ID)
+	})
+
+	t.Run("未対応のバージョン", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+
+		_, err := s.service.ImportCamp(
+			t.Context(),
+			Archive{Version: CurrentVersion + 1},
+			random.AlphaNumericString(t, 32),
+		)
+
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("displayIdが重複", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		operatorID := random.AlphaNumericString(t, 32)
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, operatorID).
+			Return(&model.User{ID: operatorID}, nil)
+		s.repo.MockCampRepository.EXPECT().
+			CreateCamp(gomock.Any()).
+			Return(repository.ErrCampAlreadyExists)
+
+		_, err := s.service.ImportCamp(ctx, Archive{Version: CurrentVersion}, operatorID)
+
+		assert.ErrorIs(t, err, repository.ErrCampAlreadyExists)
+	})
+
+	t.Run("存在しない質問への回答", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		operatorID := random.AlphaNumericString(t, 32)
+		userID := random.AlphaNumericString(t, 32)
+		archive := Archive{
+			Version: CurrentVersion,
+			Answers: []Answer{
+				{QuestionID: uint(random.PositiveInt(t)), UserID: userID},
+			},
+		}
+
+		s.repo.MockUserRepository.EXPECT().
+			GetOrCreateUser(ctx, gomock.Any()).
+			Return(&model.User{}, nil).
+			Times(2)
+		s.repo.MockCampRepository.EXPECT().CreateCamp(gomock.Any()).Return(nil)
+
+		_, err := s.service.ImportCamp(ctx, archive, operatorID)
+
+		assert.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
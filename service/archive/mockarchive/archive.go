@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: archive.go
+//
+// Generated by this command:
+//
+//	mockgen -source=archive.go -destination=mockarchive/archive.go -package=mockarchive
+//
+
+// Package mockarchive is a generated GoMock package.
+package mockarchive
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/traPtitech/rucQ/model"
+	archive "github.com/traPtitech/rucQ/service/archive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArchiveService is a mock of ArchiveService interface.
+type MockArchiveService struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveServiceMockRecorder is the mock recorder for MockArchiveService.
+type MockArchiveServiceMockRecorder struct {
+	mock *MockArchiveService
+}
+
+// NewMockArchiveService creates a new mock instance.
+func NewMockArchiveService(ctrl *gomock.Controller) *MockArchiveService {
+	mock := &MockArchiveService{ctrl: ctrl}
+	mock.recorder = &MockArchiveServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveService) EXPECT() *MockArchiveServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportCamp mocks base method.
+func (m *MockArchiveService) ExportCamp(ctx context.Context, campID uint) (*archive.Archive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCamp", ctx, campID)
+	ret0, _ := ret[0].(*archive.Archive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCamp indicates an expected call of ExportCamp.
+func (mr *MockArchiveServiceMockRecorder) ExportCamp(ctx, campID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCamp", reflect.TypeOf((*MockArchiveService)(nil).ExportCamp), ctx, campID)
+}
+
+// ImportCamp mocks base method.
+func (m *MockArchiveService) ImportCamp(ctx context.Context, arg1 archive.Archive, operatorID string) (*model.Camp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCamp", ctx, arg1, operatorID)
+	ret0, _ := ret[0].(*model.Camp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCamp indicates an expected call of ImportCamp.
+func (mr *MockArchiveServiceMockRecorder) ImportCamp(ctx, arg1, operatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCamp", reflect.TypeOf((*MockArchiveService)(nil).ImportCamp), ctx, arg1, operatorID)
+}
@@ -0,0 +1,58 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockeventbus/$GOFILE -package=mockeventbus
+package eventbus
+
+import (
+	"context"
+	"time"
+)
+
+// Topic はイベントの対象となるリソースの種類です。
+type Topic string
+
+const (
+	TopicAnswer     Topic = "answer"
+	TopicPayment    Topic = "payment"
+	TopicRoom       Topic = "room"
+	TopicRoomStatus Topic = "roomStatus"
+	TopicEvent      Topic = "event"
+	TopicActivity   Topic = "activity"
+	TopicRollCall   Topic = "rollCall"
+	// TopicCamp は合宿の状態の変更に使い、ResourceIDには合宿のIDを入れる
+	TopicCamp Topic = "camp"
+)
+
+// EventType はリソースに対する操作の種類です。
+type EventType string
+
+const (
+	EventTypeCreated EventType = "created"
+	EventTypeUpdated EventType = "updated"
+	EventTypeDeleted EventType = "deleted"
+)
+
+// Event は合宿内のリソースが変更されたことを表します。
+// 閲覧権限はリソースごとに異なるので、内容は含めずIDだけを通知します。
+type Event struct {
+	ID         uint64 // 全ての合宿で共通の連番
+	CampID     uint
+	Topic      Topic
+	Type       EventType
+	ResourceID uint // 変更されたリソースのID。特定のリソースを指さない場合は0
+	Time       time.Time
+}
+
+// EventBus は合宿ごとのイベントを購読者に配信します。
+type EventBus interface {
+	// Publish はイベントにIDを付けて合宿の購読者に送信します。
+	Publish(campID uint, topic Topic, eventType EventType, resourceID uint)
+	// Subscribe は合宿のイベントを購読します。ctxがキャンセルされるとチャンネルは閉じられます。
+	// lastEventIDを指定すると、それより後のイベントをバッファから再送します。
+	// 再送すべきイベントが既にバッファから消えていた場合や、
+	// lastEventIDがまだ発行されていないIDの場合（再起動前に発行されたIDなど）、completeはfalseになります。
+	// 購読者の受信が追いつかない場合もチャンネルは閉じられるので、lastEventIDを指定して購読し直してください。
+	Subscribe(
+		ctx context.Context,
+		campID uint,
+		lastEventID *uint64,
+	) (events <-chan Event, complete bool)
+}
@@ -0,0 +1,146 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 購読者ごとのチャンネルのバッファサイズ
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	events chan Event
+	closed bool
+}
+
+type campBuffer struct {
+	events []Event
+	// バッファから消えたイベントの最大のID
+	evictedID   uint64
+	subscribers map[*subscriber]struct{}
+}
+
+type eventBusImpl struct {
+	mu         sync.Mutex
+	bufferSize int
+	lastID     uint64
+	camps      map[uint]*campBuffer
+}
+
+// NewEventBus はプロセス内で完結するEventBusを作成します。
+// bufferSizeは再送のために合宿ごとに保持するイベントの数です。
+func NewEventBus(bufferSize int) *eventBusImpl {
+	return &eventBusImpl{
+		bufferSize: bufferSize,
+		camps:      make(map[uint]*campBuffer),
+	}
+}
+
+func (b *eventBusImpl) Publish(campID uint, topic Topic, eventType EventType, resourceID uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.publish(Event{
+		ID:         b.lastID + 1,
+		CampID:     campID,
+		Topic:      topic,
+		Type:       eventType,
+		ResourceID: resourceID,
+		Time:       time.Now(),
+	})
+}
+
+// publish はIDが付いたイベントを合宿の購読者に送信する。b.muをロックした状態で呼び出すこと
+func (b *eventBusImpl) publish(event Event) {
+	b.lastID = max(b.lastID, event.ID)
+	camp := b.getCamp(event.CampID)
+
+	camp.events = append(camp.events, event)
+
+	if len(camp.events) > b.bufferSize {
+		evicted := len(camp.events) - b.bufferSize
+		// 他のインスタンスから届くイベントはIDの順に並ぶとは限らない
+		for _, e := range camp.events[:evicted] {
+			camp.evictedID = max(camp.evictedID, e.ID)
+		}
+		camp.events = append([]Event(nil), camp.events[evicted:]...)
+	}
+
+	for sub := range camp.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			// 受信が追いつかない購読者は切断し、再接続時に再送させる
+			b.closeSubscriber(camp, sub)
+		}
+	}
+}
+
+func (b *eventBusImpl) Subscribe(
+	ctx context.Context,
+	campID uint,
+	lastEventID *uint64,
+) (<-chan Event, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	camp := b.getCamp(campID)
+	complete := true
+
+	var replay []Event
+
+	if lastEventID != nil {
+		// まだ発行していないIDはプロセスの再起動前に発行されたものなので、再送できるか分からない
+		complete = *lastEventID >= camp.evictedID && *lastEventID <= b.lastID
+
+		for _, event := range camp.events {
+			if event.ID > *lastEventID {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	sub := &subscriber{events: make(chan Event, len(replay)+subscriberBufferSize)}
+
+	for _, event := range replay {
+		sub.events <- event
+	}
+
+	camp.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.closeSubscriber(camp, sub)
+	}()
+
+	return sub.events, complete
+}
+
+// getCamp は合宿のバッファを取得する。b.muをロックした状態で呼び出すこと
+func (b *eventBusImpl) getCamp(campID uint) *campBuffer {
+	camp, ok := b.camps[campID]
+
+	if !ok {
+		camp = &campBuffer{subscribers: make(map[*subscriber]struct{})}
+		b.camps[campID] = camp
+	}
+
+	return camp
+}
+
+// closeSubscriber は購読者を削除してチャンネルを閉じる。b.muをロックした状態で呼び出すこと
+func (b *eventBusImpl) closeSubscriber(camp *campBuffer, sub *subscriber) {
+	if sub.closed {
+		return
+	}
+
+	sub.closed = true
+
+	delete(camp.subscribers, sub)
+	close(sub.events)
+}
@@ -0,0 +1,176 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func receiveAll(t *testing.T, events <-chan Event, n int) []Event {
+	t.Helper()
+
+	received := make([]Event, 0, n)
+
+	for range n {
+		select {
+		case event, ok := <-events:
+			require.True(t, ok, "channel closed unexpectedly")
+
+			received = append(received, event)
+		default:
+			require.FailNow(t, "not enough events", "received %d of %d", len(received), n)
+		}
+	}
+
+	return received
+}
+
+func TestEventBusImpl_Publish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("購読している合宿のイベントだけを受信する", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewEventBus(10)
+		campID := uint(random.PositiveInt(t))
+		otherCampID := campID + 1
+		resourceID := uint(random.PositiveInt(t))
+
+		events, complete := b.Subscribe(t.Context(), campID, nil)
+
+		assert.True(t, complete)
+
+		b.Publish(otherCampID, TopicRoom, EventTypeCreated, resourceID)
+		b.Publish(campID, TopicPayment, EventTypeUpdated, resourceID)
+
+		received := receiveAll(t, events, 1)
+
+		assert.Equal(t, campID, received[0].CampID)
+		assert.Equal(t, TopicPayment, received[0].Topic)
+		assert.Equal(t, EventTypeUpdated, received[0].Type)
+		assert.Equal(t, resourceID, received[0].ResourceID)
+		assert.Equal(t, uint64(2), received[0].ID)
+		assert.Empty(t, events)
+	})
+
+	t.Run("受信が追いつかない購読者は切断される", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewEventBus(subscriberBufferSize * 2)
+		campID := uint(random.PositiveInt(t))
+
+		events, _ := b.Subscribe(t.Context(), campID, nil)
+
+		for range subscriberBufferSize + 1 {
+			b.Publish(campID, TopicEvent, EventTypeCreated, 0)
+		}
+
+		receiveAll(t, events, subscriberBufferSize)
+
+		_, ok := <-events
+
+		assert.False(t, ok)
+	})
+}
+
+func TestEventBusImpl_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lastEventIDより後のイベントを再送する", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewEventBus(10)
+		campID := uint(random.PositiveInt(t))
+
+		for range 3 {
+			b.Publish(campID, TopicAnswer, EventTypeCreated, 0)
+		}
+
+		lastEventID := uint64(1)
+		events, complete := b.Subscribe(t.Context(), campID, &lastEventID)
+
+		assert.True(t, complete)
+
+		received := receiveAll(t, events, 2)
+
+		assert.Equal(t, uint64(2), received[0].ID)
+		assert.Equal(t, uint64(3), received[1].ID)
+
+		// 再送の後に新しいイベントを受信する
+		b.Publish(campID, TopicAnswer, EventTypeUpdated, 0)
+
+		received = receiveAll(t, events, 1)
+
+		assert.Equal(t, uint64(4), received[0].ID)
+	})
+
+	t.Run("再送すべきイベントがバッファから消えている場合はcompleteがfalseになる", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewEventBus(2)
+		campID := uint(random.PositiveInt(t))
+
+		for range 4 {
+			b.Publish(campID, TopicRollCall, EventTypeUpdated, 0)
+		}
+
+		lastEventID := uint64(1)
+		events, complete := b.Subscribe(t.Context(), campID, &lastEventID)
+
+		assert.False(t, complete)
+
+		// バッファに残っているイベントは再送する
+		received := receiveAll(t, events, 2)
+
+		assert.Equal(t, uint64(3), received[0].ID)
+		assert.Equal(t, uint64(4), received[1].ID)
+
+		lastEventID = 2
+		_, complete = b.Subscribe(t.Context(), campID, &lastEventID)
+
+		assert.True(t, complete)
+	})
+
+	t.Run("まだ発行していないlastEventIDの場合はcompleteがfalseになる", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewEventBus(10)
+		campID := uint(random.PositiveInt(t))
+
+		b.Publish(campID, TopicRollCall, EventTypeUpdated, 0)
+
+		// 再起動前のプロセスで発行されたIDを送ってきた場合
+		lastEventID := uint64(100)
+		events, complete := b.Subscribe(t.Context(), campID, &lastEventID)
+
+		assert.False(t, complete)
+		assert.Empty(t, events)
+	})
+
+	t.Run("コンテキストがキャンセルされるとチャンネルが閉じられる", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			b := NewEventBus(10)
+			campID := uint(random.PositiveInt(t))
+			ctx, cancel := context.WithCancel(t.Context())
+
+			events, _ := b.Subscribe(ctx, campID, nil)
+
+			cancel()
+			synctest.Wait()
+
+			_, ok := <-events
+
+			assert.False(t, ok)
+
+			// 切断後に送信してもパニックしない
+			b.Publish(campID, TopicRoomStatus, EventTypeUpdated, 0)
+		})
+	})
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: eventbus.go
+//
+// Generated by this command:
+//
+//	mockgen -source=eventbus.go -destination=mockeventbus/eventbus.go -package=mockeventbus
+//
+
+// Package mockeventbus is a generated GoMock package.
+package mockeventbus
+
+import (
+	context "context"
+	reflect "reflect"
+
+	eventbus "github.com/traPtitech/rucQ/service/eventbus"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventBus is a mock of EventBus interface.
+type MockEventBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBusMockRecorder
+	isgomock struct{}
+}
+
+// MockEventBusMockRecorder is the mock recorder for MockEventBus.
+type MockEventBusMockRecorder struct {
+	mock *MockEventBus
+}
+
+// NewMockEventBus creates a new mock instance.
+func NewMockEventBus(ctrl *gomock.Controller) *MockEventBus {
+	mock := &MockEventBus{ctrl: ctrl}
+	mock.recorder = &MockEventBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBus) EXPECT() *MockEventBusMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventBus) Publish(campID uint, topic eventbus.Topic, eventType eventbus.EventType, resourceID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", campID, topic, eventType, resourceID)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventBusMockRecorder) Publish(campID, topic, eventType, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventBus)(nil).Publish), campID, topic, eventType, resourceID)
+}
+
+// Subscribe mocks base method.
+func (m *MockEventBus) Subscribe(ctx context.Context, campID uint, lastEventID *uint64) (<-chan eventbus.Event, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, campID, lastEventID)
+	ret0, _ := ret[0].(<-chan eventbus.Event)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventBusMockRecorder) Subscribe(ctx, campID, lastEventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventBus)(nil).Subscribe), ctx, campID, lastEventID)
+}
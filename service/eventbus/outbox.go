@@ -0,0 +1,109 @@
+package eventbus
+
+import (
+	"context"
+	"time"
+
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/pubsub"
+)
+
+const outboxChannel = "camp_events"
+
+// outboxEvent はデータベースを介して共有するイベントです。
+// IDはデータベースに保存したメッセージのIDで、全てのインスタンスで共通です
+type outboxEvent struct {
+	ID         uint      `json:"-"`
+	CampID     uint      `json:"campId"`
+	Topic      Topic     `json:"topic"`
+	Type       EventType `json:"type"`
+	ResourceID uint      `json:"resourceId"`
+	Time       time.Time `json:"time"`
+}
+
+func (e *outboxEvent) SetMessageID(id uint) {
+	e.ID = id
+}
+
+type outboxEventBus struct {
+	local  *eventBusImpl
+	pubSub pubsub.PubSub[outboxEvent]
+}
+
+// NewOutboxEventBus はデータベースを介して複数のインスタンス間でイベントを共有するEventBusを作成します。
+// イベントのIDにはデータベースのメッセージのIDを使うため、
+// クライアントが別のインスタンスに再接続してもLast-Event-IDから再送できます。
+// Publishしたイベントは、pollIntervalごとのポーリングで読み出された後に購読者に届きます。
+func NewOutboxEventBus(
+	ctx context.Context,
+	repo repository.PubSubMessageRepository,
+	pollInterval time.Duration,
+	bufferSize int,
+) *outboxEventBus {
+	return newOutboxEventBus(
+		ctx,
+		pubsub.NewOutboxPubSub[outboxEvent](ctx, repo, outboxChannel, pollInterval, bufferSize),
+		bufferSize,
+	)
+}
+
+func newOutboxEventBus(
+	ctx context.Context,
+	pubSub pubsub.PubSub[outboxEvent],
+	bufferSize int,
+) *outboxEventBus {
+	b := &outboxEventBus{
+		local:  NewEventBus(bufferSize),
+		pubSub: pubSub,
+	}
+	events := pubSub.Subscribe(ctx, bufferSize)
+
+	go func() {
+		for {
+			var event outboxEvent
+
+			// 購読のチャンネルはctxがキャンセルされても閉じられないことがあるので、ctxも確認する
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-events:
+				if !ok {
+					return
+				}
+
+				event = e
+			}
+
+			b.local.mu.Lock()
+			b.local.publish(Event{
+				ID:         uint64(event.ID),
+				CampID:     event.CampID,
+				Topic:      event.Topic,
+				Type:       event.Type,
+				ResourceID: event.ResourceID,
+				Time:       event.Time,
+			})
+			b.local.mu.Unlock()
+		}
+	}()
+
+	return b
+}
+
+func (b *outboxEventBus) Publish(campID uint, topic Topic, eventType EventType, resourceID uint) {
+	b.pubSub.Send(outboxEvent{
+		CampID:     campID,
+		Topic:      topic,
+		Type:       eventType,
+		ResourceID: resourceID,
+		Time:       time.Now(),
+	})
+}
+
+func (b *outboxEventBus) Subscribe(
+	ctx context.Context,
+	campID uint,
+	lastEventID *uint64,
+) (<-chan Event, bool) {
+	return b.local.Subscribe(ctx, campID, lastEventID)
+}
@@ -0,0 +1,116 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestOutboxEventBus_Publish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockrepository.NewMockRepository(ctrl)
+			campID := uint(random.PositiveInt(t))
+			resourceID := uint(random.PositiveInt(t))
+			b := NewOutboxEventBus(t.Context(), repo, time.Hour, 10)
+
+			repo.MockPubSubMessageRepository.EXPECT().
+				CreatePubSubMessage(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, message *model.PubSubMessage) error {
+					var event outboxEvent
+
+					require.NoError(t, json.Unmarshal([]byte(message.Payload), &event))
+					assert.Equal(t, outboxChannel, message.Channel)
+					assert.Equal(t, campID, event.CampID)
+					assert.Equal(t, TopicRoom, event.Topic)
+					assert.Equal(t, EventTypeUpdated, event.Type)
+					assert.Equal(t, resourceID, event.ResourceID)
+
+					return nil
+				}).
+				Times(1)
+
+			b.Publish(campID, TopicRoom, EventTypeUpdated, resourceID)
+		})
+	})
+}
+
+func TestOutboxEventBus_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("データベースのメッセージのIDをイベントのIDとして配信する", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockrepository.NewMockRepository(ctrl)
+			campID := uint(random.PositiveInt(t))
+			resourceID := uint(random.PositiveInt(t))
+			latestID := uint(random.PositiveIntN(t, 1000))
+			payload, err := json.Marshal(outboxEvent{
+				CampID:     campID,
+				Topic:      TopicPayment,
+				Type:       EventTypeCreated,
+				ResourceID: resourceID,
+				Time:       time.Now(),
+			})
+
+			require.NoError(t, err)
+
+			gomock.InOrder(
+				repo.MockPubSubMessageRepository.EXPECT().
+					GetLatestPubSubMessageID(gomock.Any()).
+					Return(latestID, nil),
+				repo.MockPubSubMessageRepository.EXPECT().
+					GetPubSubMessages(gomock.Any(), latestID, gomock.Any()).
+					Return([]model.PubSubMessage{
+						{
+							ID:      latestID + 1,
+							Channel: outboxChannel,
+							Payload: string(payload),
+						},
+					}, nil),
+			)
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetPubSubMessages(gomock.Any(), latestID+1, gomock.Any()).
+				Return([]model.PubSubMessage{}, nil).
+				AnyTimes()
+
+			b := NewOutboxEventBus(t.Context(), repo, time.Second, 10)
+			events, complete := b.Subscribe(t.Context(), campID, nil)
+
+			require.True(t, complete)
+
+			time.Sleep(2 * time.Second)
+			synctest.Wait()
+
+			received := receiveAll(t, events, 1)
+
+			assert.Equal(t, uint64(latestID+1), received[0].ID)
+			assert.Equal(t, TopicPayment, received[0].Topic)
+			assert.Equal(t, EventTypeCreated, received[0].Type)
+			assert.Equal(t, resourceID, received[0].ResourceID)
+
+			// 別のインスタンスで受け取ったIDを指定して再接続できる
+			lastEventID := uint64(latestID)
+			replayed, complete := b.Subscribe(t.Context(), campID, &lastEventID)
+
+			assert.True(t, complete)
+			assert.Len(t, receiveAll(t, replayed, 1), 1)
+		})
+	})
+}
@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -150,6 +151,34 @@ func (s *notificationServiceImpl) SendAnswerChangeMessage(
 				messageBuilder.WriteString("\n")
 			}
 		}
+
+	case model.DateQuestion,
+		model.DateTimeQuestion,
+		model.ScaleQuestion,
+		model.RankingQuestion,
+		model.FileQuestion:
+		newContent, err := formatAnswerContent(question, newAnswer)
+
+		if err != nil {
+			return fmt.Errorf("failed to format new answer: %w", err)
+		}
+
+		messageBuilder.WriteString("### 変更前\n")
+
+		if oldAnswer == nil {
+			messageBuilder.WriteString("未回答\n")
+		} else {
+			oldContent, err := formatAnswerContent(question, *oldAnswer)
+
+			if err != nil {
+				return fmt.Errorf("failed to format old answer: %w", err)
+			}
+
+			messageBuilder.WriteString(oldContent)
+		}
+
+		messageBuilder.WriteString("### 変更後\n")
+		messageBuilder.WriteString(newContent)
 	}
 
 	if err := s.traqService.PostDirectMessage(
@@ -162,3 +191,63 @@ func (s *notificationServiceImpl) SendAnswerChangeMessage(
 
 	return nil
 }
+
+// formatAnswerContent はDMに載せるために回答の内容を文字列にする。末尾には改行が付く
+func formatAnswerContent(question *model.Question, answer model.Answer) (string, error) {
+	switch answer.Type {
+	case model.DateQuestion:
+		if answer.DateContent == nil {
+			return "", errors.New("DateContent is nil")
+		}
+
+		return answer.DateContent.In(model.JST).Format("2006/01/02") + "\n", nil
+
+	case model.DateTimeQuestion:
+		if answer.DateContent == nil {
+			return "", errors.New("DateContent is nil")
+		}
+
+		return answer.DateContent.In(model.JST).Format("2006/01/02 15:04") + "\n", nil
+
+	case model.ScaleQuestion:
+		if answer.ScaleContent == nil {
+			return "", errors.New("ScaleContent is nil")
+		}
+
+		content := strconv.Itoa(*answer.ScaleContent)
+
+		// 両端の値にラベルが付いていれば併せて表示する
+		switch {
+		case question.ScaleMin != nil && *question.ScaleMin == *answer.ScaleContent &&
+			question.ScaleMinLabel != nil:
+			content += " (" + *question.ScaleMinLabel + ")"
+		case question.ScaleMax != nil && *question.ScaleMax == *answer.ScaleContent &&
+			question.ScaleMaxLabel != nil:
+			content += " (" + *question.ScaleMaxLabel + ")"
+		}
+
+		return content + "\n", nil
+
+	case model.RankingQuestion:
+		var builder strings.Builder
+
+		for _, rankedOption := range answer.RankedOptions {
+			builder.WriteString(strconv.Itoa(rankedOption.Position))
+			builder.WriteString(". ")
+			builder.WriteString(rankedOption.Option.Content)
+			builder.WriteString("\n")
+		}
+
+		return builder.String(), nil
+
+	case model.FileQuestion:
+		if answer.FileImageID == nil {
+			return "", errors.New("FileImageID is nil")
+		}
+
+		return "画像ID: " + strconv.FormatUint(uint64(*answer.FileImageID), 10) + "\n", nil
+
+	default:
+		return "", fmt.Errorf("unsupported answer type: %s", answer.Type)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -577,6 +578,151 @@ func TestNotificationServiceImpl_SendAnswerChangeMessage(t *testing.T) {
 		assert.Equal(t, "the number of selected options for old answer is not 1", err.Error())
 	})
 
+	t.Run("Scale - 新規回答", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		traqService := mocktraq.NewMockTraqService(ctrl)
+		s := NewNotificationService(repo, traqService)
+
+		scaleMin := 1
+		scaleMax := 5
+		scaleMaxLabel := random.AlphaNumericString(t, 10)
+		scaleQuestion := &model.Question{
+			Model:         gorm.Model{ID: questionID},
+			Title:         questionTitle,
+			Type:          model.ScaleQuestion,
+			ScaleMin:      &scaleMin,
+			ScaleMax:      &scaleMax,
+			ScaleMaxLabel: &scaleMaxLabel,
+		}
+		newAnswer := model.Answer{
+			Model:        gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:       userID,
+			QuestionID:   questionID,
+			Type:         model.ScaleQuestion,
+			ScaleContent: &scaleMax,
+		}
+
+		expectedMessage := "@" + editorUserID + "がアンケート「" + questionTitle + "」のあなたの回答を変更しました\n" +
+			"### 変更前\n" +
+			"未回答\n" +
+			"### 変更後\n" +
+			"5 (" + scaleMaxLabel + ")\n"
+
+		repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(scaleQuestion, nil)
+
+		traqService.EXPECT().
+			PostDirectMessage(ctx, userID, expectedMessage).
+			Return(nil)
+
+		err := s.SendAnswerChangeMessage(ctx, editorUserID, nil, newAnswer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("DateTime - 回答更新", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		traqService := mocktraq.NewMockTraqService(ctrl)
+		s := NewNotificationService(repo, traqService)
+
+		oldDate := time.Date(2025, time.August, 1, 1, 0, 0, 0, time.UTC)
+		newDate := time.Date(2025, time.August, 2, 15, 30, 0, 0, time.UTC)
+		oldAnswer := &model.Answer{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:      userID,
+			QuestionID:  questionID,
+			Type:        model.DateTimeQuestion,
+			DateContent: &oldDate,
+		}
+		newAnswer := model.Answer{
+			Model:       gorm.Model{ID: oldAnswer.ID},
+			UserID:      userID,
+			QuestionID:  questionID,
+			Type:        model.DateTimeQuestion,
+			DateContent: &newDate,
+		}
+
+		// 日本時間で表示される
+		expectedMessage := "@" + editorUserID + "がアンケート「" + questionTitle + "」のあなたの回答を変更しました\n" +
+			"### 変更前\n" +
+			"2025/08/01 10:00\n" +
+			"### 変更後\n" +
+			"2025/08/03 00:30\n"
+
+		repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(question, nil)
+
+		traqService.EXPECT().
+			PostDirectMessage(ctx, userID, expectedMessage).
+			Return(nil)
+
+		err := s.SendAnswerChangeMessage(ctx, editorUserID, oldAnswer, newAnswer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Ranking - 回答更新", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		traqService := mocktraq.NewMockTraqService(ctrl)
+		s := NewNotificationService(repo, traqService)
+
+		options := []model.Option{
+			{Model: gorm.Model{ID: 1}, Content: random.AlphaNumericString(t, 20)},
+			{Model: gorm.Model{ID: 2}, Content: random.AlphaNumericString(t, 20)},
+		}
+		oldAnswer := &model.Answer{
+			Model:      gorm.Model{ID: uint(random.PositiveInt(t))},
+			UserID:     userID,
+			QuestionID: questionID,
+			Type:       model.RankingQuestion,
+			RankedOptions: []model.AnswerRankedOption{
+				{Position: 1, OptionID: 1, Option: options[0]},
+				{Position: 2, OptionID: 2, Option: options[1]},
+			},
+		}
+		newAnswer := model.Answer{
+			Model:      gorm.Model{ID: oldAnswer.ID},
+			UserID:     userID,
+			QuestionID: questionID,
+			Type:       model.RankingQuestion,
+			RankedOptions: []model.AnswerRankedOption{
+				{Position: 1, OptionID: 2, Option: options[1]},
+				{Position: 2, OptionID: 1, Option: options[0]},
+			},
+		}
+
+		expectedMessage := "@" + editorUserID + "がアンケート「" + questionTitle + "」のあなたの回答を変更しました\n" +
+			"### 変更前\n" +
+			"1. " + options[0].Content + "\n" +
+			"2. " + options[1].Content + "\n" +
+			"### 変更後\n" +
+			"1. " + options[1].Content + "\n" +
+			"2. " + options[0].Content + "\n"
+
+		repo.MockQuestionRepository.EXPECT().
+			GetQuestionByID(questionID).
+			Return(question, nil)
+
+		traqService.EXPECT().
+			PostDirectMessage(ctx, userID, expectedMessage).
+			Return(nil)
+
+		err := s.SendAnswerChangeMessage(ctx, editorUserID, oldAnswer, newAnswer)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("TraqService エラー", func(t *testing.T) {
 		t.Parallel()
 
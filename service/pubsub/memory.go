@@ -0,0 +1,27 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/sesopenko/genericpubsub"
+)
+
+type memoryPubSub[T any] struct {
+	pubSub *genericpubsub.PubSub[T]
+}
+
+// NewMemoryPubSub はプロセス内で完結するPubSubを作成します。
+// 単一のインスタンスで動かす場合に使います
+func NewMemoryPubSub[T any](ctx context.Context, bufferSize int) *memoryPubSub[T] {
+	return &memoryPubSub[T]{
+		pubSub: genericpubsub.New[T](ctx, bufferSize),
+	}
+}
+
+func (p *memoryPubSub[T]) Send(message T) {
+	p.pubSub.Send(message)
+}
+
+func (p *memoryPubSub[T]) Subscribe(ctx context.Context, bufferSize int) <-chan T {
+	return p.pubSub.Subscribe(ctx, bufferSize)
+}
@@ -0,0 +1,214 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+const (
+	// 欠番のIDを遅れてコミットされるメッセージとして待つ時間
+	outboxGapTimeout = 10 * time.Second
+	// 1回のポーリングで欠番として扱うIDの最大数。これより大きい欠番は待たない
+	outboxMaxGapSize = 100
+	// 全てのインスタンスに配信されたとみなしてメッセージを削除するまでの時間
+	outboxRetention = 10 * time.Minute
+	// 古いメッセージを削除する間隔
+	outboxCleanupInterval = time.Minute
+)
+
+type outboxPubSub[T any] struct {
+	ctx     context.Context
+	repo    repository.PubSubMessageRepository
+	channel string
+	local   *memoryPubSub[T]
+
+	// 以下はポーリングを行うgoroutineだけが触る
+	initialized bool
+	lastID      uint
+	// 欠番のIDと、それを見つけた時刻
+	gaps        map[uint]time.Time
+	lastCleanup time.Time
+}
+
+// NewOutboxPubSub はデータベースを介して複数のインスタンス間でメッセージを共有するPubSubを作成します。
+// Sendしたメッセージはデータベースに書き込まれ、各インスタンスがpollIntervalごとに読み出して
+// そのインスタンスの購読者に配信します。channelが異なるメッセージは配信しません。
+// メッセージはJSONにエンコードして保存するため、TはJSONに変換できる必要があります
+func NewOutboxPubSub[T any](
+	ctx context.Context,
+	repo repository.PubSubMessageRepository,
+	channel string,
+	pollInterval time.Duration,
+	bufferSize int,
+) *outboxPubSub[T] {
+	p := newOutboxPubSub[T](ctx, repo, channel, bufferSize)
+
+	go p.run(pollInterval)
+
+	return p
+}
+
+func newOutboxPubSub[T any](
+	ctx context.Context,
+	repo repository.PubSubMessageRepository,
+	channel string,
+	bufferSize int,
+) *outboxPubSub[T] {
+	return &outboxPubSub[T]{
+		ctx:         ctx,
+		repo:        repo,
+		channel:     channel,
+		local:       NewMemoryPubSub[T](ctx, bufferSize),
+		gaps:        make(map[uint]time.Time),
+		lastCleanup: time.Now(),
+	}
+}
+
+func (p *outboxPubSub[T]) Send(message T) {
+	payload, err := json.Marshal(message)
+
+	if err != nil {
+		slog.ErrorContext(
+			p.ctx,
+			"failed to marshal pub/sub message",
+			slog.String("error", err.Error()),
+			slog.String("channel", p.channel),
+		)
+
+		return
+	}
+
+	if err := p.repo.CreatePubSubMessage(p.ctx, &model.PubSubMessage{
+		Channel: p.channel,
+		Payload: string(payload),
+	}); err != nil {
+		slog.ErrorContext(
+			p.ctx,
+			"failed to create pub/sub message",
+			slog.String("error", err.Error()),
+			slog.String("channel", p.channel),
+		)
+	}
+}
+
+func (p *outboxPubSub[T]) Subscribe(ctx context.Context, bufferSize int) <-chan T {
+	return p.local.Subscribe(ctx, bufferSize)
+}
+
+func (p *outboxPubSub[T]) run(pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case now := <-ticker.C:
+			p.poll(now)
+		}
+	}
+}
+
+// poll はデータベースから新しいメッセージを読み出して購読者に配信する
+func (p *outboxPubSub[T]) poll(now time.Time) {
+	// 起動前に送信されたメッセージは配信しない
+	if !p.initialized {
+		latestID, err := p.repo.GetLatestPubSubMessageID(p.ctx)
+
+		if err != nil {
+			slog.ErrorContext(
+				p.ctx,
+				"failed to get latest pub/sub message ID",
+				slog.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		p.lastID = latestID
+		p.initialized = true
+
+		return
+	}
+
+	messages, err := p.repo.GetPubSubMessages(
+		p.ctx,
+		p.lastID,
+		slices.Sorted(maps.Keys(p.gaps)),
+	)
+
+	if err != nil {
+		slog.ErrorContext(
+			p.ctx,
+			"failed to get pub/sub messages",
+			slog.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	for _, message := range messages {
+		if message.ID > p.lastID {
+			// 自動採番のIDはコミット順に並ぶとは限らないため、欠番は後から読めるように覚えておく
+			if message.ID-p.lastID <= outboxMaxGapSize {
+				for id := p.lastID + 1; id < message.ID; id++ {
+					p.gaps[id] = now
+				}
+			}
+
+			p.lastID = message.ID
+		} else {
+			delete(p.gaps, message.ID)
+		}
+
+		if message.Channel == p.channel {
+			p.deliver(message)
+		}
+	}
+
+	for id, foundAt := range p.gaps {
+		if now.Sub(foundAt) >= outboxGapTimeout {
+			delete(p.gaps, id)
+		}
+	}
+
+	if now.Sub(p.lastCleanup) >= outboxCleanupInterval {
+		p.lastCleanup = now
+
+		if err := p.repo.DeletePubSubMessagesBefore(p.ctx, now.Add(-outboxRetention)); err != nil {
+			slog.ErrorContext(
+				p.ctx,
+				"failed to delete old pub/sub messages",
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+func (p *outboxPubSub[T]) deliver(message model.PubSubMessage) {
+	var value T
+
+	if err := json.Unmarshal([]byte(message.Payload), &value); err != nil {
+		slog.ErrorContext(
+			p.ctx,
+			"failed to unmarshal pub/sub message",
+			slog.String("error", err.Error()),
+			slog.Int("messageId", int(message.ID)),
+		)
+
+		return
+	}
+
+	if setter, ok := any(&value).(MessageIDSetter); ok {
+		setter.SetMessageID(message.ID)
+	}
+
+	p.local.Send(value)
+}
@@ -0,0 +1,247 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+type testMessage struct {
+	Value string `json:"value"`
+}
+
+func pubSubMessage(t *testing.T, id uint, channel string, value string) model.PubSubMessage {
+	t.Helper()
+
+	payload, err := json.Marshal(testMessage{Value: value})
+
+	require.NoError(t, err)
+
+	return model.PubSubMessage{
+		ID:      id,
+		Channel: channel,
+		Payload: string(payload),
+	}
+}
+
+// receiveValues はチャンネルに届いているメッセージを全て取り出す。
+// 配信はメッセージごとのgoroutineで行われるため順序は保証されない
+func receiveValues(events <-chan testMessage) []string {
+	var values []string
+
+	for {
+		select {
+		case message := <-events:
+			values = append(values, message.Value)
+		default:
+			return values
+		}
+	}
+}
+
+type identifiedMessage struct {
+	ID    uint   `json:"-"`
+	Value string `json:"value"`
+}
+
+func (m *identifiedMessage) SetMessageID(id uint) {
+	m.ID = id
+}
+
+func TestOutboxPubSub_Send(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		channel := random.AlphaNumericString(t, 10)
+		value := random.AlphaNumericString(t, 20)
+		p := newOutboxPubSub[testMessage](t.Context(), repo, channel, 10)
+
+		repo.MockPubSubMessageRepository.EXPECT().
+			CreatePubSubMessage(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, message *model.PubSubMessage) error {
+				assert.Equal(t, channel, message.Channel)
+				assert.JSONEq(t, `{"value":"`+value+`"}`, message.Payload)
+
+				return nil
+			}).
+			Times(1)
+
+		p.Send(testMessage{Value: value})
+	})
+}
+
+func TestOutboxPubSub_poll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("起動前のメッセージは配信しない", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockrepository.NewMockRepository(ctrl)
+			channel := random.AlphaNumericString(t, 10)
+			p := newOutboxPubSub[testMessage](t.Context(), repo, channel, 10)
+			events := p.Subscribe(t.Context(), 10)
+			latestID := uint(random.PositiveIntN(t, 1000))
+
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetLatestPubSubMessageID(gomock.Any()).
+				Return(latestID, nil).
+				Times(1)
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetPubSubMessages(gomock.Any(), latestID, []uint(nil)).
+				Return([]model.PubSubMessage{
+					pubSubMessage(t, latestID+1, channel, "a"),
+					pubSubMessage(t, latestID+2, random.AlphaNumericString(t, 11), "b"),
+					pubSubMessage(t, latestID+3, channel, "c"),
+				}, nil).
+				Times(1)
+
+			p.poll(time.Now())
+			p.poll(time.Now())
+			synctest.Wait()
+
+			// 他のチャンネルのメッセージは配信しない
+			assert.ElementsMatch(t, []string{"a", "c"}, receiveValues(events))
+			assert.Equal(t, latestID+3, p.lastID)
+			assert.Empty(t, p.gaps)
+		})
+	})
+
+	t.Run("遅れてコミットされた欠番のメッセージを配信する", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockrepository.NewMockRepository(ctrl)
+			channel := random.AlphaNumericString(t, 10)
+			p := newOutboxPubSub[testMessage](t.Context(), repo, channel, 10)
+			events := p.Subscribe(t.Context(), 10)
+
+			p.initialized = true
+			p.lastID = 10
+
+			gomock.InOrder(
+				repo.MockPubSubMessageRepository.EXPECT().
+					GetPubSubMessages(gomock.Any(), uint(10), []uint(nil)).
+					Return([]model.PubSubMessage{pubSubMessage(t, 13, channel, "c")}, nil),
+				repo.MockPubSubMessageRepository.EXPECT().
+					GetPubSubMessages(gomock.Any(), uint(13), []uint{11, 12}).
+					Return([]model.PubSubMessage{pubSubMessage(t, 11, channel, "a")}, nil),
+			)
+
+			p.poll(time.Now())
+			p.poll(time.Now())
+			synctest.Wait()
+
+			assert.ElementsMatch(t, []string{"c", "a"}, receiveValues(events))
+			assert.Equal(t, uint(13), p.lastID)
+			assert.Equal(t, []uint{12}, slices.Sorted(maps.Keys(p.gaps)))
+
+			// 一定時間が経った欠番は待たない
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetPubSubMessages(gomock.Any(), uint(13), []uint{12}).
+				Return([]model.PubSubMessage{}, nil)
+
+			p.poll(time.Now().Add(outboxGapTimeout))
+
+			assert.Empty(t, p.gaps)
+		})
+	})
+
+	t.Run("MessageIDSetterを実装するメッセージにはIDを設定する", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockrepository.NewMockRepository(ctrl)
+			channel := random.AlphaNumericString(t, 10)
+			p := newOutboxPubSub[identifiedMessage](t.Context(), repo, channel, 10)
+			events := p.Subscribe(t.Context(), 10)
+
+			p.initialized = true
+			p.lastID = 10
+
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetPubSubMessages(gomock.Any(), uint(10), []uint(nil)).
+				Return([]model.PubSubMessage{pubSubMessage(t, 11, channel, "a")}, nil).
+				Times(1)
+
+			p.poll(time.Now())
+			synctest.Wait()
+
+			message := <-events
+
+			assert.Equal(t, uint(11), message.ID)
+			assert.Equal(t, "a", message.Value)
+		})
+	})
+
+	t.Run("古いメッセージを削除する", func(t *testing.T) {
+		t.Parallel()
+
+		synctest.Test(t, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockrepository.NewMockRepository(ctrl)
+			p := newOutboxPubSub[testMessage](t.Context(), repo, random.AlphaNumericString(t, 10), 10)
+			now := time.Now().Add(outboxCleanupInterval)
+
+			p.initialized = true
+
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetPubSubMessages(gomock.Any(), uint(0), []uint(nil)).
+				Return([]model.PubSubMessage{}, nil).
+				Times(1)
+			repo.MockPubSubMessageRepository.EXPECT().
+				DeletePubSubMessagesBefore(gomock.Any(), now.Add(-outboxRetention)).
+				Return(nil).
+				Times(1)
+
+			p.poll(now)
+
+			assert.Equal(t, now, p.lastCleanup)
+		})
+	})
+
+	t.Run("取得に失敗した場合は次のポーリングで再試行する", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := mockrepository.NewMockRepository(ctrl)
+		p := newOutboxPubSub[testMessage](t.Context(), repo, random.AlphaNumericString(t, 10), 10)
+
+		gomock.InOrder(
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetLatestPubSubMessageID(gomock.Any()).
+				Return(uint(0), errors.New("database error")),
+			repo.MockPubSubMessageRepository.EXPECT().
+				GetLatestPubSubMessageID(gomock.Any()).
+				Return(uint(5), nil),
+		)
+
+		p.poll(time.Now())
+
+		assert.False(t, p.initialized)
+
+		p.poll(time.Now())
+
+		assert.True(t, p.initialized)
+		assert.Equal(t, uint(5), p.lastID)
+	})
+}
@@ -0,0 +1,19 @@
+package pubsub
+
+import "context"
+
+// PubSub はSSEのストリームなどにメッセージを配信するためのPub/Subです
+type PubSub[T any] interface {
+	// Send は全ての購読者にメッセージを送信します
+	Send(message T)
+	// Subscribe はメッセージを受信するチャンネルを返します。
+	// チャンネルはctxがキャンセルされると閉じられます
+	Subscribe(ctx context.Context, bufferSize int) <-chan T
+}
+
+// MessageIDSetter はデータベースに保存されたメッセージのIDを受け取るためのインターフェースです。
+// NewOutboxPubSubで配信するメッセージの型のポインタがこれを実装している場合、配信前にIDを設定します。
+// IDは全てのインスタンスで共通です
+type MessageIDSetter interface {
+	SetMessageID(id uint)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reconciliation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reconciliation.go -destination=mockreconciliation/reconciliation.go -package=mockreconciliation
+//
+
+// Package mockreconciliation is a generated GoMock package.
+package mockreconciliation
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	reconciliation "github.com/traPtitech/rucQ/service/reconciliation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReconciliationService is a mock of ReconciliationService interface.
+type MockReconciliationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReconciliationServiceMockRecorder is the mock recorder for MockReconciliationService.
+type MockReconciliationServiceMockRecorder struct {
+	mock *MockReconciliationService
+}
+
+// NewMockReconciliationService creates a new mock instance.
+func NewMockReconciliationService(ctrl *gomock.Controller) *MockReconciliationService {
+	mock := &MockReconciliationService{ctrl: ctrl}
+	mock.recorder = &MockReconciliationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationService) EXPECT() *MockReconciliationServiceMockRecorder {
+	return m.recorder
+}
+
+// ProposeMatches mocks base method.
+func (m *MockReconciliationService) ProposeMatches(ctx context.Context, campID uint, statement io.Reader, mapping reconciliation.ColumnMapping) ([]reconciliation.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposeMatches", ctx, campID, statement, mapping)
+	ret0, _ := ret[0].([]reconciliation.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeMatches indicates an expected call of ProposeMatches.
+func (mr *MockReconciliationServiceMockRecorder) ProposeMatches(ctx, campID, statement, mapping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeMatches", reflect.TypeOf((*MockReconciliationService)(nil).ProposeMatches), ctx, campID, statement, mapping)
+}
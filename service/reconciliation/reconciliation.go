@@ -0,0 +1,67 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mockreconciliation/$GOFILE -package=mockreconciliation
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+var (
+	ErrInvalidColumnMapping = errors.New("invalid column mapping")
+	ErrInvalidStatement     = errors.New("invalid bank statement")
+)
+
+// ReconciliationService は銀行の入出金明細と支払い情報の照合を担当するサービスです。
+type ReconciliationService interface {
+	// ProposeMatches は銀行の入出金明細のCSVを読み取り、入金の行ごとに対応する支払いの候補を返します。
+	// 支払いの登録は行わないため、スタッフが確認した上で入出金を記録してください。
+	ProposeMatches(
+		ctx context.Context,
+		campID uint,
+		statement io.Reader,
+		mapping ColumnMapping,
+	) ([]Proposal, error)
+}
+
+// ColumnMapping は明細のCSVのどの列に何が書かれているかを0始まりの列番号で表す
+type ColumnMapping struct {
+	// 先頭から読み飛ばす見出しの行数
+	HeaderRows int
+	Date       *int
+	Amount     int
+	// 振込依頼人名の列
+	Name int
+	// 摘要など、依頼人名の他に照合用のコードが書かれうる列
+	Memo *int
+}
+
+type StatementRow struct {
+	// CSVの行番号（1始まり）
+	Line   int
+	Date   *time.Time
+	Amount int
+	Name   string
+	Memo   string
+}
+
+type MatchMethod string
+
+const (
+	// 依頼人名か摘要に支払いの照合用のコードが含まれていた
+	MatchMethodReferenceCode MatchMethod = "reference_code"
+	// 依頼人名にユーザーIDが含まれ、金額が未払いの金額と一致した
+	MatchMethodNameAndAmount MatchMethod = "name_and_amount"
+)
+
+type Proposal struct {
+	Row StatementRow
+	// 対応する支払いが見つからなかった場合はnil
+	Payment *model.Payment
+	Method  MatchMethod
+	// 同じ日付と金額の銀行振込が既にその支払いに記録されている
+	AlreadyRecorded bool
+}
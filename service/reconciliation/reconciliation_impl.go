@@ -0,0 +1,294 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+)
+
+// statementDateLayouts は銀行の明細でよく使われる日付の形式
+var statementDateLayouts = []string{
+	"2006/01/02",
+	"2006/1/2",
+	"2006-01-02",
+	"2006-1-2",
+	"20060102",
+	"2006年1月2日",
+}
+
+// amountReplacer は金額の表記から数字と符号以外を取り除く
+var amountReplacer = strings.NewReplacer(",", "", "¥", "", "￥", "", "円", "", " ", "", "+", "")
+
+type reconciliationServiceImpl struct {
+	repo repository.Repository
+}
+
+func NewReconciliationService(repo repository.Repository) *reconciliationServiceImpl {
+	return &reconciliationServiceImpl{repo: repo}
+}
+
+func (s *reconciliationServiceImpl) ProposeMatches(
+	ctx context.Context,
+	campID uint,
+	statement io.Reader,
+	mapping ColumnMapping,
+) ([]Proposal, error) {
+	rows, err := parseStatement(statement, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.GetPayments(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentsByID := make(map[uint]*model.Payment, len(payments))
+
+	for i := range payments {
+		paymentsByID[payments[i].ID] = &payments[i]
+	}
+
+	// 同じ支払いの入出金の記録を何度も取得しないようにする
+	transactionsByPaymentID := make(map[uint][]model.PaymentTransaction)
+	proposals := make([]Proposal, len(rows))
+
+	for i, row := range rows {
+		proposal := Proposal{Row: row}
+		text := normalizeWidth(row.Name + " " + row.Memo)
+
+		if id, ok := model.ParsePaymentReferenceCode(text); ok && paymentsByID[id] != nil {
+			proposal.Payment = paymentsByID[id]
+			proposal.Method = MatchMethodReferenceCode
+		} else if payment := matchByNameAndAmount(payments, text, row.Amount); payment != nil {
+			proposal.Payment = payment
+			proposal.Method = MatchMethodNameAndAmount
+		}
+
+		if proposal.Payment != nil && row.Date != nil {
+			transactions, ok := transactionsByPaymentID[proposal.Payment.ID]
+
+			if !ok {
+				transactions, err = s.repo.GetPaymentTransactions(ctx, proposal.Payment.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				transactionsByPaymentID[proposal.Payment.ID] = transactions
+			}
+
+			proposal.AlreadyRecorded = isAlreadyRecorded(transactions, row)
+		}
+
+		proposals[i] = proposal
+	}
+
+	return proposals, nil
+}
+
+// matchByNameAndAmount は依頼人名にユーザーIDが含まれ、未払いの金額が一致する支払いが
+// 1つだけある場合にその支払いを返す
+func matchByNameAndAmount(payments []model.Payment, text string, amount int) *model.Payment {
+	text = strings.ToLower(strings.ReplaceAll(text, " ", ""))
+
+	var matched *model.Payment
+
+	for i := range payments {
+		payment := &payments[i]
+
+		if payment.Amount-payment.AmountPaid != amount {
+			continue
+		}
+
+		if !strings.Contains(text, strings.ToLower(payment.UserID)) {
+			continue
+		}
+
+		if matched != nil {
+			// 候補が複数ある場合はどちらか判断できない
+			return nil
+		}
+
+		matched = payment
+	}
+
+	return matched
+}
+
+func isAlreadyRecorded(transactions []model.PaymentTransaction, row StatementRow) bool {
+	for _, transaction := range transactions {
+		if transaction.IsBankTransferOn(row.Amount, *row.Date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseStatement は明細のCSVから入金の行を読み取る。出金や金額が空の行は読み飛ばす
+func parseStatement(statement io.Reader, mapping ColumnMapping) ([]StatementRow, error) {
+	if err := validateColumnMapping(mapping); err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(statement)
+
+	// 銀行によって列の数が行ごとに異なることがあるため、列数は検査しない
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var rows []StatementRow
+
+	for recordIndex := 0; ; recordIndex++ {
+		record, err := reader.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidStatement, err)
+		}
+
+		if recordIndex < mapping.HeaderRows {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		row, ok, err := parseRecord(record, line, mapping)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, nil
+}
+
+func validateColumnMapping(mapping ColumnMapping) error {
+	if mapping.HeaderRows < 0 || mapping.Amount < 0 || mapping.Name < 0 {
+		return ErrInvalidColumnMapping
+	}
+
+	if mapping.Date != nil && *mapping.Date < 0 {
+		return ErrInvalidColumnMapping
+	}
+
+	if mapping.Memo != nil && *mapping.Memo < 0 {
+		return ErrInvalidColumnMapping
+	}
+
+	return nil
+}
+
+func parseRecord(record []string, line int, mapping ColumnMapping) (StatementRow, bool, error) {
+	column := func(index int) (string, error) {
+		if index >= len(record) {
+			return "", fmt.Errorf("%w: line %d has no column %d", ErrInvalidStatement, line, index)
+		}
+
+		// Excelで保存したCSVの先頭に付くBOMを取り除く
+		return strings.TrimSpace(strings.TrimPrefix(record[index], "\ufeff")), nil
+	}
+
+	amountText, err := column(mapping.Amount)
+	if err != nil {
+		return StatementRow{}, false, err
+	}
+
+	amountText = amountReplacer.Replace(normalizeWidth(amountText))
+
+	if amountText == "" {
+		return StatementRow{}, false, nil
+	}
+
+	amount, err := strconv.Atoi(amountText)
+	if err != nil {
+		return StatementRow{}, false, fmt.Errorf(
+			"%w: line %d has invalid amount %q",
+			ErrInvalidStatement,
+			line,
+			amountText,
+		)
+	}
+
+	if amount <= 0 {
+		return StatementRow{}, false, nil
+	}
+
+	name, err := column(mapping.Name)
+	if err != nil {
+		return StatementRow{}, false, err
+	}
+
+	row := StatementRow{
+		Line:   line,
+		Amount: amount,
+		Name:   name,
+	}
+
+	if mapping.Memo != nil {
+		row.Memo, err = column(*mapping.Memo)
+		if err != nil {
+			return StatementRow{}, false, err
+		}
+	}
+
+	if mapping.Date != nil {
+		dateText, err := column(*mapping.Date)
+		if err != nil {
+			return StatementRow{}, false, err
+		}
+
+		date, err := parseStatementDate(normalizeWidth(dateText))
+		if err != nil {
+			return StatementRow{}, false, fmt.Errorf(
+				"%w: line %d has invalid date %q",
+				ErrInvalidStatement,
+				line,
+				dateText,
+			)
+		}
+
+		row.Date = &date
+	}
+
+	return row, true, nil
+}
+
+func parseStatementDate(s string) (time.Time, error) {
+	for _, layout := range statementDateLayouts {
+		if date, err := time.ParseInLocation(layout, s, model.JST); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported date format: %q", s)
+}
+
+// normalizeWidth は全角の英数字・記号・空白を半角にする
+func normalizeWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			return r - '！' + '!'
+		case r == '　':
+			return ' '
+		default:
+			return r
+		}
+	}, s)
+}
@@ -0,0 +1,242 @@
+package reconciliation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+type reconciliationTestSetup struct {
+	service *reconciliationServiceImpl
+	repo    *mockrepository.MockRepository
+}
+
+func setup(t *testing.T) *reconciliationTestSetup {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mockrepository.NewMockRepository(ctrl)
+
+	return &reconciliationTestSetup{
+		service: NewReconciliationService(repo),
+		repo:    repo,
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestReconciliationServiceImpl_ProposeMatches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+		byCode := model.Payment{
+			Model:  gorm.Model{ID: 42},
+			Amount: 10000,
+			UserID: "alice",
+			CampID: campID,
+		}
+		byName := model.Payment{
+			Model:      gorm.Model{ID: 43},
+			Amount:     12000,
+			AmountPaid: 2000,
+			UserID:     "bob",
+			CampID:     campID,
+		}
+		recorded := model.Payment{
+			Model:  gorm.Model{ID: 44},
+			Amount: 8000,
+			UserID: "carol",
+			CampID: campID,
+		}
+		statement := strings.Join([]string{
+			"日付,摘要,出金,入金,依頼人名",
+			"2026/08/01,振込,,\"10,000\",ﾀﾅｶ ﾊﾅｺ ＲＱ０００４２",
+			"2026/08/02,振込,,10000,BOB ﾔﾏﾀﾞ",
+			"2026/08/02,振込,,8000,RQ00044",
+			"2026/08/03,振込,,5000,ｽｽﾞｷ ｲﾁﾛｳ",
+			"2026/08/04,手数料,440,,",
+		}, "\n")
+
+		s.repo.MockPaymentRepository.EXPECT().
+			GetPayments(ctx, campID).
+			Return([]model.Payment{byCode, byName, recorded}, nil)
+		s.repo.MockPaymentRepository.EXPECT().
+			GetPaymentTransactions(ctx, byCode.ID).
+			Return(nil, nil)
+		s.repo.MockPaymentRepository.EXPECT().
+			GetPaymentTransactions(ctx, byName.ID).
+			Return([]model.PaymentTransaction{
+				{Amount: 2000, Method: model.PaymentMethodCash},
+			}, nil)
+		s.repo.MockPaymentRepository.EXPECT().
+			GetPaymentTransactions(ctx, recorded.ID).
+			Return([]model.PaymentTransaction{
+				{
+					Amount:     8000,
+					Method:     model.PaymentMethodBankTransfer,
+					ReceivedAt: time.Date(2026, 8, 1, 16, 0, 0, 0, time.UTC),
+				},
+			}, nil)
+
+		proposals, err := s.service.ProposeMatches(
+			ctx,
+			campID,
+			strings.NewReader(statement),
+			ColumnMapping{
+				HeaderRows: 1,
+				Date:       ptr(0),
+				Amount:     3,
+				Name:       4,
+				Memo:       ptr(1),
+			},
+		)
+
+		require.NoError(t, err)
+		require.Len(t, proposals, 4)
+
+		assert.Equal(t, 2, proposals[0].Row.Line)
+		assert.Equal(t, 10000, proposals[0].Row.Amount)
+		require.NotNil(t, proposals[0].Row.Date)
+		assert.Equal(t, "2026-08-01", proposals[0].Row.Date.Format(time.DateOnly))
+		require.NotNil(t, proposals[0].Payment)
+		assert.Equal(t, byCode.ID, proposals[0].Payment.ID)
+		assert.Equal(t, MatchMethodReferenceCode, proposals[0].Method)
+		assert.False(t, proposals[0].AlreadyRecorded)
+
+		require.NotNil(t, proposals[1].Payment)
+		assert.Equal(t, byName.ID, proposals[1].Payment.ID)
+		assert.Equal(t, MatchMethodNameAndAmount, proposals[1].Method)
+		assert.False(t, proposals[1].AlreadyRecorded)
+
+		// 日本時間では8月2日に記録されている
+		require.NotNil(t, proposals[2].Payment)
+		assert.Equal(t, recorded.ID, proposals[2].Payment.ID)
+		assert.True(t, proposals[2].AlreadyRecorded)
+
+		assert.Equal(t, 5, proposals[3].Row.Line)
+		assert.Nil(t, proposals[3].Payment)
+	})
+
+	t.Run("名前と金額で複数の候補がある", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+
+		s.repo.MockPaymentRepository.EXPECT().
+			GetPayments(ctx, campID).
+			Return([]model.Payment{
+				{Model: gorm.Model{ID: 1}, Amount: 5000, UserID: "ab"},
+				{Model: gorm.Model{ID: 2}, Amount: 5000, UserID: "abc"},
+			}, nil)
+
+		proposals, err := s.service.ProposeMatches(
+			ctx,
+			campID,
+			strings.NewReader("5000,ABC\n"),
+			ColumnMapping{Amount: 0, Name: 1},
+		)
+
+		require.NoError(t, err)
+		require.Len(t, proposals, 1)
+		assert.Nil(t, proposals[0].Payment)
+	})
+
+	t.Run("他の合宿の照合用のコード", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		ctx := t.Context()
+		campID := uint(random.PositiveInt(t))
+
+		s.repo.MockPaymentRepository.EXPECT().
+			GetPayments(ctx, campID).
+			Return([]model.Payment{}, nil)
+
+		proposals, err := s.service.ProposeMatches(
+			ctx,
+			campID,
+			strings.NewReader("5000,RQ00001\n"),
+			ColumnMapping{Amount: 0, Name: 1},
+		)
+
+		require.NoError(t, err)
+		require.Len(t, proposals, 1)
+		assert.Nil(t, proposals[0].Payment)
+	})
+
+	t.Run("不正な明細", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name      string
+			statement string
+			mapping   ColumnMapping
+		}{
+			{
+				name:      "金額が数値ではない",
+				statement: "abc,name\n",
+				mapping:   ColumnMapping{Amount: 0, Name: 1},
+			},
+			{
+				name:      "列が足りない",
+				statement: "1000\n",
+				mapping:   ColumnMapping{Amount: 0, Name: 1},
+			},
+			{
+				name:      "日付の形式が不正",
+				statement: "Aug 1,1000,name\n",
+				mapping:   ColumnMapping{Date: ptr(0), Amount: 1, Name: 2},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				s := setup(t)
+
+				_, err := s.service.ProposeMatches(
+					t.Context(),
+					uint(random.PositiveInt(t)),
+					strings.NewReader(tc.statement),
+					tc.mapping,
+				)
+
+				assert.ErrorIs(t, err, ErrInvalidStatement)
+			})
+		}
+	})
+
+	t.Run("不正な列の指定", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+
+		_, err := s.service.ProposeMatches(
+			t.Context(),
+			uint(random.PositiveInt(t)),
+			strings.NewReader("1000,name\n"),
+			ColumnMapping{Amount: -1, Name: 1},
+		)
+
+		assert.ErrorIs(t, err, ErrInvalidColumnMapping)
+	})
+}
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// processDueCampStatuses は予定時刻を過ぎた合宿の状態を進めます
+func (s *schedulerServiceImpl) processDueCampStatuses(ctx context.Context) {
+	now := time.Now()
+	camps, err := s.repo.GetCampsWithDueSchedule(ctx, now)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get camps with due schedule",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, camp := range camps {
+		// スケジューラーが止まっていた間に複数の予定時刻を過ぎた場合は、まとめて進める
+		status := camp.DueStatus(now)
+
+		if status == camp.Status {
+			continue
+		}
+
+		previousStatus := camp.Status
+		camp.Status = status
+
+		if err := s.repo.UpdateCampStatus(ctx, camp.ID, previousStatus, &camp); err != nil {
+			// 取得してから更新するまでの間にスタッフが状態を変更した場合は、その変更を優先する
+			if errors.Is(err, repository.ErrCampStatusChanged) {
+				slog.InfoContext(
+					ctx,
+					"skipped scheduled camp status change",
+					slog.String("reason", err.Error()),
+					slog.Int("campId", int(camp.ID)),
+				)
+				continue
+			}
+
+			slog.ErrorContext(
+				ctx,
+				"failed to update camp status",
+				slog.String("error", err.Error()),
+				slog.Int("campId", int(camp.ID)),
+			)
+			continue
+		}
+
+		// 手動で変更した場合と同じく、合宿のストリームに通知する
+		s.eventBus.Publish(camp.ID, eventbus.TopicCamp, eventbus.EventTypeUpdated, camp.ID)
+
+		slog.InfoContext(
+			ctx,
+			"camp status changed by schedule",
+			slog.Int("campId", int(camp.ID)),
+			slog.String("from", string(previousStatus)),
+			slog.String("to", string(status)),
+		)
+	}
+}
@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestSchedulerServiceImpl_processDueCampStatuses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		longAgo := time.Now().Add(-2 * time.Hour)
+		past := time.Now().Add(-time.Hour)
+		future := time.Now().Add(time.Hour)
+		// 止まっていた間に申し込み開始と締め切りの両方を過ぎた合宿
+		skippedCamp := model.Camp{
+			Model:                   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Status:                  model.CampStatusDraft,
+			RegistrationScheduledAt: &longAgo,
+			ConfirmedScheduledAt:    &past,
+			OngoingScheduledAt:      &future,
+		}
+		// 過ぎた予定時刻の状態にすでに移行している合宿
+		unchangedCamp := model.Camp{
+			Model:                   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Status:                  model.CampStatusRegistration,
+			RegistrationScheduledAt: &past,
+			ConfirmedScheduledAt:    &future,
+		}
+
+		s.mockRepo.MockCampRepository.EXPECT().
+			GetCampsWithDueSchedule(gomock.Any(), gomock.Any()).
+			Return([]model.Camp{skippedCamp, unchangedCamp}, nil)
+		s.mockRepo.MockCampRepository.EXPECT().
+			UpdateCampStatus(gomock.Any(), skippedCamp.ID, model.CampStatusDraft, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, _ model.CampStatus, camp *model.Camp) error {
+				assert.Equal(t, model.CampStatusConfirmed, camp.Status)
+				return nil
+			})
+
+		events, _ := s.eventBus.Subscribe(t.Context(), skippedCamp.ID, nil)
+
+		s.scheduler.processDueCampStatuses(t.Context())
+
+		// 手動で変更した場合と同じく合宿のストリームに通知する
+		select {
+		case event := <-events:
+			assert.Equal(t, eventbus.TopicCamp, event.Topic)
+			assert.Equal(t, eventbus.EventTypeUpdated, event.Type)
+			assert.Equal(t, skippedCamp.ID, event.ResourceID)
+		case <-time.After(time.Second):
+			t.Fatal("camp event was not published")
+		}
+	})
+
+	t.Run("Status changed by staff", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		past := time.Now().Add(-time.Hour)
+		camp := model.Camp{
+			Model:                   gorm.Model{ID: uint(random.PositiveInt(t))},
+			Status:                  model.CampStatusDraft,
+			RegistrationScheduledAt: &past,
+		}
+
+		s.mockRepo.MockCampRepository.EXPECT().
+			GetCampsWithDueSchedule(gomock.Any(), gomock.Any()).
+			Return([]model.Camp{camp}, nil)
+		// 取得した後にスタッフが状態を変更したので、予定時刻による変更はしない
+		s.mockRepo.MockCampRepository.EXPECT().
+			UpdateCampStatus(gomock.Any(), camp.ID, model.CampStatusDraft, gomock.Any()).
+			Return(repository.ErrCampStatusChanged)
+
+		events, _ := s.eventBus.Subscribe(t.Context(), camp.ID, nil)
+
+		s.scheduler.processDueCampStatuses(t.Context())
+
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected event: %+v", event)
+		default:
+		}
+	})
+
+	t.Run("Update failure", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		past := time.Now().Add(-time.Hour)
+		camps := []model.Camp{
+			{
+				Model:               gorm.Model{ID: uint(random.PositiveInt(t))},
+				Status:              model.CampStatusOngoing,
+				FinishedScheduledAt: &past,
+			},
+			{
+				Model:                   gorm.Model{ID: uint(random.PositiveInt(t))},
+				Status:                  model.CampStatusDraft,
+				RegistrationScheduledAt: &past,
+			},
+		}
+
+		// 1つ目の更新に失敗しても2つ目の更新は行う
+		gomock.InOrder(
+			s.mockRepo.MockCampRepository.EXPECT().
+				GetCampsWithDueSchedule(gomock.Any(), gomock.Any()).
+				Return(camps, nil),
+			s.mockRepo.MockCampRepository.EXPECT().
+				UpdateCampStatus(gomock.Any(), camps[0].ID, gomock.Any(), gomock.Any()).
+				Return(errors.New("update failed")),
+			s.mockRepo.MockCampRepository.EXPECT().
+				UpdateCampStatus(gomock.Any(), camps[1].ID, gomock.Any(), gomock.Any()).
+				Return(nil),
+		)
+
+		s.scheduler.processDueCampStatuses(t.Context())
+	})
+
+	t.Run("Get failure", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+
+		s.mockRepo.MockCampRepository.EXPECT().
+			GetCampsWithDueSchedule(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("database error"))
+
+		s.scheduler.processDueCampStatuses(t.Context())
+	})
+}
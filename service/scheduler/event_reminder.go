@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+)
+
+// processReadyEventReminders は送信予定時刻を過ぎたイベントのリマインダーを処理します
+func (s *schedulerServiceImpl) processReadyEventReminders(ctx context.Context) {
+	reminders, err := s.repo.GetReadyToSendEventReminders(ctx)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get ready event reminders",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, reminder := range reminders {
+		// スケジューラーが止まっていた間に開始したイベントのリマインダーは送信しない
+		if reminder.Event != nil && reminder.Event.TimeStart.After(time.Now()) {
+			if err := s.sendEventReminder(ctx, &reminder); err != nil {
+				slog.ErrorContext(
+					ctx,
+					"failed to send event reminder",
+					slog.String("error", err.Error()),
+					slog.Int("reminderId", int(reminder.ID)),
+					slog.Int("eventId", int(reminder.EventID)),
+				)
+				continue
+			}
+		}
+
+		now := time.Now()
+		reminder.SentAt = &now
+		if err := s.repo.UpdateEventReminder(ctx, reminder.ID, &reminder); err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to update event reminder sent status",
+				slog.String("error", err.Error()),
+				slog.Int("reminderId", int(reminder.ID)),
+			)
+		}
+	}
+}
+
+// sendEventReminder はリマインダーの対象者にDMを送信します。
+// 一部のユーザーへの送信に失敗しても他のユーザーへの送信は続けます
+func (s *schedulerServiceImpl) sendEventReminder(
+	ctx context.Context,
+	reminder *model.EventReminder,
+) error {
+	recipients, err := s.eventReminderRecipients(ctx, reminder.Event)
+	if err != nil {
+		return err
+	}
+
+	content := eventReminderContent(reminder.Event)
+
+	for _, userID := range recipients {
+		if err := s.traqService.PostDirectMessage(ctx, userID, content); err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to send event reminder to user",
+				slog.String("error", err.Error()),
+				slog.Int("reminderId", int(reminder.ID)),
+				slog.String("targetUserId", userID),
+			)
+		}
+	}
+
+	return nil
+}
+
+// eventReminderRecipients はリマインダーの送信先を返します。
+// officialとmomentイベントは合宿の参加者全員、それ以外はキャンセル待ちを除いてgoingを登録した人です
+func (s *schedulerServiceImpl) eventReminderRecipients(
+	ctx context.Context,
+	event *model.Event,
+) ([]string, error) {
+	if event.Type == model.EventTypeOfficial || event.Type == model.EventTypeMoment {
+		participants, err := s.repo.GetCampParticipants(ctx, event.CampID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get camp participants: %w", err)
+		}
+
+		recipients := make([]string, len(participants))
+
+		for i, participant := range participants {
+			recipients[i] = participant.ID
+		}
+
+		return recipients, nil
+	}
+
+	attendances, err := s.repo.GetEventAttendances(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event attendances: %w", err)
+	}
+
+	recipients := make([]string, 0, len(attendances))
+
+	for _, attendance := range attendances {
+		if attendance.Status == model.EventAttendanceStatusGoing && !attendance.IsWaitlisted {
+			recipients = append(recipients, attendance.UserID)
+		}
+	}
+
+	return recipients, nil
+}
+
+func eventReminderContent(event *model.Event) string {
+	var sb strings.Builder
+
+	sb.WriteString("イベント「" + event.Name + "」が" +
+		event.TimeStart.In(model.JST).Format("1/2 15:04") + "から始まります\n")
+
+	if event.Location != "" {
+		sb.WriteString("場所: " + event.Location + "\n")
+	}
+
+	return sb.String()
+}
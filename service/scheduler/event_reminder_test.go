@@ -0,0 +1,171 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestSchedulerServiceImpl_processReadyEventReminders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success (duration)", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		event := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeDuration,
+			Name:      random.AlphaNumericString(t, 20),
+			Location:  random.AlphaNumericString(t, 20),
+			TimeStart: time.Now().Add(15 * time.Minute),
+		}
+		reminder := model.EventReminder{
+			Model:         gorm.Model{ID: uint(random.PositiveInt(t))},
+			EventID:       event.ID,
+			Event:         &event,
+			MinutesBefore: 15,
+			SendAt:        time.Now(),
+		}
+		goingUserID := random.AlphaNumericString(t, 32)
+		attendances := []model.EventAttendance{
+			{UserID: goingUserID, Status: model.EventAttendanceStatusGoing},
+			{
+				UserID:       random.AlphaNumericString(t, 32),
+				Status:       model.EventAttendanceStatusGoing,
+				IsWaitlisted: true,
+			},
+			{UserID: random.AlphaNumericString(t, 32), Status: model.EventAttendanceStatusMaybe},
+		}
+
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			GetReadyToSendEventReminders(gomock.Any()).
+			Return([]model.EventReminder{reminder}, nil)
+		s.mockRepo.MockEventAttendanceRepository.EXPECT().
+			GetEventAttendances(gomock.Any(), event.ID).
+			Return(attendances, nil)
+		// キャンセル待ちとmaybeの人には送信しない
+		s.mockTraq.EXPECT().
+			PostDirectMessage(gomock.Any(), goingUserID, eventReminderContent(&event)).
+			Return(nil)
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			UpdateEventReminder(gomock.Any(), reminder.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, reminder *model.EventReminder) error {
+				assert.NotNil(t, reminder.SentAt)
+				return nil
+			})
+
+		s.scheduler.processReadyEventReminders(t.Context())
+	})
+
+	t.Run("Success (official)", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		event := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeOfficial,
+			Name:      random.AlphaNumericString(t, 20),
+			TimeStart: time.Now().Add(time.Hour),
+			CampID:    uint(random.PositiveInt(t)),
+		}
+		reminder := model.EventReminder{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			EventID: event.ID,
+			Event:   &event,
+		}
+		participants := []model.User{
+			{ID: random.AlphaNumericString(t, 32)},
+			{ID: random.AlphaNumericString(t, 32)},
+		}
+
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			GetReadyToSendEventReminders(gomock.Any()).
+			Return([]model.EventReminder{reminder}, nil)
+		s.mockRepo.MockCampRepository.EXPECT().
+			GetCampParticipants(gomock.Any(), event.CampID).
+			Return(participants, nil)
+
+		for _, participant := range participants {
+			s.mockTraq.EXPECT().
+				PostDirectMessage(gomock.Any(), participant.ID, gomock.Any()).
+				Return(nil)
+		}
+
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			UpdateEventReminder(gomock.Any(), reminder.ID, gomock.Any()).
+			Return(nil)
+
+		s.scheduler.processReadyEventReminders(t.Context())
+	})
+
+	t.Run("開始済みのイベントには送信しない", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		event := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeOfficial,
+			TimeStart: time.Now().Add(-time.Hour),
+		}
+		reminder := model.EventReminder{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			EventID: event.ID,
+			Event:   &event,
+		}
+
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			GetReadyToSendEventReminders(gomock.Any()).
+			Return([]model.EventReminder{reminder}, nil)
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			UpdateEventReminder(gomock.Any(), reminder.ID, gomock.Any()).
+			Return(nil)
+
+		s.scheduler.processReadyEventReminders(t.Context())
+	})
+
+	t.Run("送信先の取得に失敗した場合は送信済みにしない", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		event := model.Event{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			Type:      model.EventTypeDuration,
+			TimeStart: time.Now().Add(time.Hour),
+		}
+		reminder := model.EventReminder{
+			Model:   gorm.Model{ID: uint(random.PositiveInt(t))},
+			EventID: event.ID,
+			Event:   &event,
+		}
+
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			GetReadyToSendEventReminders(gomock.Any()).
+			Return([]model.EventReminder{reminder}, nil)
+		s.mockRepo.MockEventAttendanceRepository.EXPECT().
+			GetEventAttendances(gomock.Any(), event.ID).
+			Return(nil, errors.New("database error"))
+
+		s.scheduler.processReadyEventReminders(t.Context())
+	})
+
+	t.Run("Get Reminders Error", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+
+		s.mockRepo.MockEventReminderRepository.EXPECT().
+			GetReadyToSendEventReminders(gomock.Any()).
+			Return(nil, errors.New("database error"))
+
+		s.scheduler.processReadyEventReminders(t.Context())
+	})
+}
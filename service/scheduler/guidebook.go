@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// processDueGuidebookRevisions は公開予定時刻を過ぎたしおりの版を公開し、Activityに記録します。
+// 同じ合宿の版が複数ある場合は公開予定時刻の順に公開するため、最後の版が合宿のしおりになります
+func (s *schedulerServiceImpl) processDueGuidebookRevisions(ctx context.Context) {
+	now := time.Now()
+	revisions, err := s.repo.GetDueGuidebookRevisions(ctx, now)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get due guidebook revisions",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, revision := range revisions {
+		err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+			if err := tx.PublishGuidebookRevision(ctx, revision.ID, now); err != nil {
+				return fmt.Errorf("failed to publish guidebook revision: %w", err)
+			}
+
+			revision.PublishedAt = &now
+
+			return s.activityService.RecordGuidebookPublished(ctx, tx, revision)
+		})
+
+		// 待っている間に他の版が公開された場合は公開しない
+		if errors.Is(err, repository.ErrGuidebookRevisionAlreadyPublished) ||
+			errors.Is(err, repository.ErrGuidebookRevisionOutdated) {
+			slog.InfoContext(
+				ctx,
+				"skipped scheduled guidebook revision",
+				slog.String("reason", err.Error()),
+				slog.Int("guidebookRevisionId", int(revision.ID)),
+			)
+			continue
+		}
+
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to publish scheduled guidebook revision",
+				slog.String("error", err.Error()),
+				slog.Int("guidebookRevisionId", int(revision.ID)),
+			)
+			continue
+		}
+
+		s.eventBus.Publish(revision.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+
+		slog.InfoContext(
+			ctx,
+			"guidebook revision published by schedule",
+			slog.Int("campId", int(revision.CampID)),
+			slog.Int("guidebookRevisionId", int(revision.ID)),
+		)
+	}
+}
@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestSchedulerServiceImpl_processDueGuidebookRevisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		publishAt := time.Now().Add(-time.Minute)
+		revisions := []model.GuidebookRevision{
+			{
+				Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+				CampID:    uint(random.PositiveInt(t)),
+				Content:   random.AlphaNumericString(t, 100),
+				PublishAt: &publishAt,
+			},
+			{
+				Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+				CampID:    uint(random.PositiveInt(t)),
+				Content:   random.AlphaNumericString(t, 100),
+				PublishAt: &publishAt,
+			},
+		}
+
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			GetDueGuidebookRevisions(gomock.Any(), gomock.Any()).
+			Return(revisions, nil)
+
+		for _, revision := range revisions {
+			s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+				PublishGuidebookRevision(gomock.Any(), revision.ID, gomock.Any()).
+				Return(nil)
+			s.mockActivity.EXPECT().
+				RecordGuidebookPublished(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ repository.Repository, published model.GuidebookRevision) error {
+					assert.Equal(t, revision.ID, published.ID)
+					assert.NotNil(t, published.PublishedAt)
+					return nil
+				})
+		}
+
+		events, _ := s.eventBus.Subscribe(t.Context(), revisions[0].CampID, nil)
+
+		s.scheduler.processDueGuidebookRevisions(t.Context())
+
+		// 購読中のクライアントにアクティビティの追加を通知する
+		select {
+		case event := <-events:
+			assert.Equal(t, eventbus.TopicActivity, event.Topic)
+			assert.Equal(t, eventbus.EventTypeCreated, event.Type)
+		default:
+			t.Error("activity event was not published")
+		}
+	})
+
+	t.Run("Publish failure", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		publishAt := time.Now().Add(-time.Minute)
+		failed := model.GuidebookRevision{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:    uint(random.PositiveInt(t)),
+			PublishAt: &publishAt,
+		}
+		next := model.GuidebookRevision{
+			Model:     gorm.Model{ID: failed.ID + 1},
+			CampID:    uint(random.PositiveInt(t)),
+			PublishAt: &publishAt,
+		}
+
+		// 失敗した版は次回に再試行し、残りの版は公開する
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			GetDueGuidebookRevisions(gomock.Any(), gomock.Any()).
+			Return([]model.GuidebookRevision{failed, next}, nil)
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			PublishGuidebookRevision(gomock.Any(), failed.ID, gomock.Any()).
+			Return(errors.New("connection error"))
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			PublishGuidebookRevision(gomock.Any(), next.ID, gomock.Any()).
+			Return(nil)
+		s.mockActivity.EXPECT().
+			RecordGuidebookPublished(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		s.scheduler.processDueGuidebookRevisions(t.Context())
+	})
+
+	t.Run("Outdated", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		publishAt := time.Now().Add(-time.Minute)
+		revision := model.GuidebookRevision{
+			Model:     gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:    uint(random.PositiveInt(t)),
+			PublishAt: &publishAt,
+		}
+
+		// 後に作成された版が先に公開された場合はActivityに記録しない
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			GetDueGuidebookRevisions(gomock.Any(), gomock.Any()).
+			Return([]model.GuidebookRevision{revision}, nil)
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			PublishGuidebookRevision(gomock.Any(), revision.ID, gomock.Any()).
+			Return(repository.ErrGuidebookRevisionOutdated)
+
+		s.scheduler.processDueGuidebookRevisions(t.Context())
+	})
+
+	t.Run("Get failure", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+
+		s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+			GetDueGuidebookRevisions(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("connection error"))
+
+		s.scheduler.processDueGuidebookRevisions(t.Context())
+	})
+}
@@ -0,0 +1,186 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+)
+
+// scheduledChangeTargetLabels はDMに書く変更の対象の種類
+var scheduledChangeTargetLabels = map[model.ScheduledChangeTargetType]string{
+	model.ScheduledChangeTargetTypeCamp:          "合宿",
+	model.ScheduledChangeTargetTypeQuestionGroup: "質問グループ",
+}
+
+// scheduledChangeFieldLabels はDMに書く変更した項目
+var scheduledChangeFieldLabels = map[model.ScheduledChangeField]string{
+	model.ScheduledChangeFieldName:        "名前",
+	model.ScheduledChangeFieldStatus:      "状態",
+	model.ScheduledChangeFieldDescription: "説明",
+	model.ScheduledChangeFieldDue:         "締切",
+}
+
+// processDueScheduledChanges は予定時刻を過ぎた予約済みの変更を適用し、予約したスタッフにDMで知らせます。
+// 対象が削除されているなど適用できない変更は失敗として記録し、それ以外のエラーの場合は次回に再試行します
+func (s *schedulerServiceImpl) processDueScheduledChanges(ctx context.Context) {
+	now := time.Now()
+	changes, err := s.repo.GetDueScheduledChanges(ctx, now)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to get due scheduled changes",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, change := range changes {
+		targetName, err := s.applyScheduledChange(ctx, &change, now)
+
+		if err != nil && !isRejectedScheduledChange(err) {
+			slog.ErrorContext(
+				ctx,
+				"failed to apply scheduled change",
+				slog.String("error", err.Error()),
+				slog.Int("scheduledChangeId", int(change.ID)),
+			)
+			continue
+		}
+
+		if err == nil {
+			s.eventBus.Publish(change.CampID, eventbus.TopicActivity, eventbus.EventTypeCreated, 0)
+		}
+
+		if err != nil {
+			reason := err.Error()
+			change.ProcessedAt = &now
+			change.FailureReason = &reason
+
+			if err := s.repo.UpdateScheduledChange(ctx, change.ID, &change); err != nil {
+				slog.ErrorContext(
+					ctx,
+					"failed to update scheduled change failure",
+					slog.String("error", err.Error()),
+					slog.Int("scheduledChangeId", int(change.ID)),
+				)
+				continue
+			}
+		}
+
+		content := scheduledChangeContent(&change, targetName)
+
+		if err := s.traqService.PostDirectMessage(ctx, change.CreatedByID, content); err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to send scheduled change notification",
+				slog.String("error", err.Error()),
+				slog.Int("scheduledChangeId", int(change.ID)),
+				slog.String("targetUserId", change.CreatedByID),
+			)
+		}
+	}
+}
+
+// applyScheduledChange は変更を対象に適用し、処理済みとしてActivityに記録します。
+// DMに書くため対象の名前を返します
+func (s *schedulerServiceImpl) applyScheduledChange(
+	ctx context.Context,
+	change *model.ScheduledChange,
+	now time.Time,
+) (string, error) {
+	var targetName string
+
+	err := s.repo.Transaction(ctx, func(tx repository.Repository) error {
+		switch change.TargetType {
+		case model.ScheduledChangeTargetTypeCamp:
+			camp, err := tx.GetCampByID(ctx, change.TargetID)
+			if err != nil {
+				return fmt.Errorf("failed to get camp: %w", err)
+			}
+
+			targetName = camp.Name
+
+			if err := change.ApplyToCamp(camp); err != nil {
+				return err
+			}
+
+			if err := tx.UpdateCamp(ctx, camp.ID, camp); err != nil {
+				return fmt.Errorf("failed to update camp: %w", err)
+			}
+
+		case model.ScheduledChangeTargetTypeQuestionGroup:
+			questionGroup, err := tx.GetQuestionGroup(ctx, change.TargetID)
+			if err != nil {
+				return fmt.Errorf("failed to get question group: %w", err)
+			}
+
+			targetName = questionGroup.Name
+
+			if err := change.ApplyToQuestionGroup(questionGroup); err != nil {
+				return err
+			}
+
+			if err := tx.UpdateQuestionGroup(ctx, questionGroup.ID, *questionGroup); err != nil {
+				return fmt.Errorf("failed to update question group: %w", err)
+			}
+
+		default:
+			return fmt.Errorf(
+				"%w: unknown target type %s",
+				model.ErrInvalidScheduledChange,
+				change.TargetType,
+			)
+		}
+
+		change.ProcessedAt = &now
+		change.FailureReason = nil
+
+		if err := tx.UpdateScheduledChange(ctx, change.ID, change); err != nil {
+			return fmt.Errorf("failed to update scheduled change: %w", err)
+		}
+
+		return s.activityService.RecordScheduledChangeApplied(ctx, tx, *change)
+	})
+
+	return targetName, err
+}
+
+// isRejectedScheduledChange は再試行しても適用できない変更のエラーかを返します
+func isRejectedScheduledChange(err error) bool {
+	return errors.Is(err, model.ErrInvalidScheduledChange) ||
+		errors.Is(err, model.ErrNotFound) ||
+		errors.Is(err, repository.ErrCampNotFound)
+}
+
+func scheduledChangeContent(change *model.ScheduledChange, targetName string) string {
+	var sb strings.Builder
+
+	if change.FailureReason == nil {
+		sb.WriteString("予約した変更を適用しました\n")
+	} else {
+		sb.WriteString("予約した変更を適用できませんでした\n")
+	}
+
+	target := scheduledChangeTargetLabels[change.TargetType]
+
+	if targetName != "" {
+		target += "「" + targetName + "」"
+	}
+
+	sb.WriteString("対象: " + target + "\n")
+	sb.WriteString("項目: " + scheduledChangeFieldLabels[change.Field] + "\n")
+	sb.WriteString("値: " + change.Value + "\n")
+
+	if change.FailureReason != nil {
+		sb.WriteString("理由: " + *change.FailureReason + "\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,216 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/traPtitech/rucQ/model"
+	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/eventbus"
+	"github.com/traPtitech/rucQ/testutil/random"
+)
+
+func TestSchedulerServiceImpl_processDueScheduledChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Camp name", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		campID := uint(random.PositiveInt(t))
+		campName := random.AlphaNumericString(t, 20)
+		staffID := random.AlphaNumericString(t, 32)
+		change := model.ScheduledChange{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:      campID,
+			TargetType:  model.ScheduledChangeTargetTypeCamp,
+			TargetID:    campID,
+			Field:       model.ScheduledChangeFieldName,
+			Value:       random.AlphaNumericString(t, 20),
+			ScheduledAt: time.Now().Add(-time.Minute),
+			CreatedByID: staffID,
+		}
+
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+			Return([]model.ScheduledChange{change}, nil)
+		s.mockRepo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(&model.Camp{
+				Model:  gorm.Model{ID: campID},
+				Name:   campName,
+				Status: model.CampStatusDraft,
+			}, nil)
+		s.mockRepo.MockCampRepository.EXPECT().
+			UpdateCamp(gomock.Any(), campID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, camp *model.Camp) error {
+				assert.Equal(t, change.Value, camp.Name)
+				return nil
+			})
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			UpdateScheduledChange(gomock.Any(), change.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, change *model.ScheduledChange) error {
+				assert.NotNil(t, change.ProcessedAt)
+				assert.Nil(t, change.FailureReason)
+				return nil
+			})
+		s.mockActivity.EXPECT().
+			RecordScheduledChangeApplied(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ repository.Repository, applied model.ScheduledChange) error {
+				assert.Equal(t, change.ID, applied.ID)
+				return nil
+			})
+		s.mockTraq.EXPECT().
+			PostDirectMessage(gomock.Any(), staffID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, content string) error {
+				assert.Contains(t, content, "予約した変更を適用しました")
+				assert.Contains(t, content, campName)
+				return nil
+			})
+
+		events, _ := s.eventBus.Subscribe(t.Context(), campID, nil)
+
+		s.scheduler.processDueScheduledChanges(t.Context())
+
+		select {
+		case event := <-events:
+			assert.Equal(t, eventbus.TopicActivity, event.Topic)
+			assert.Equal(t, eventbus.EventTypeCreated, event.Type)
+		default:
+			t.Error("activity event was not published")
+		}
+	})
+
+	t.Run("Question group due", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		questionGroupID := uint(random.PositiveInt(t))
+		staffID := random.AlphaNumericString(t, 32)
+		change := model.ScheduledChange{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:      uint(random.PositiveInt(t)),
+			TargetType:  model.ScheduledChangeTargetTypeQuestionGroup,
+			TargetID:    questionGroupID,
+			Field:       model.ScheduledChangeFieldDue,
+			Value:       "2026-08-31",
+			ScheduledAt: time.Now().Add(-time.Minute),
+			CreatedByID: staffID,
+		}
+
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+			Return([]model.ScheduledChange{change}, nil)
+		s.mockRepo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), questionGroupID).
+			Return(&model.QuestionGroup{
+				Model: gorm.Model{ID: questionGroupID},
+				Name:  random.AlphaNumericString(t, 20),
+				Due:   random.Time(t),
+			}, nil)
+		s.mockRepo.MockQuestionGroupRepository.EXPECT().
+			UpdateQuestionGroup(gomock.Any(), questionGroupID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, questionGroup model.QuestionGroup) error {
+				assert.Equal(t, time.Date(2026, 8, 31, 0, 0, 0, 0, time.UTC), questionGroup.Due)
+				return nil
+			})
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			UpdateScheduledChange(gomock.Any(), change.ID, gomock.Any()).
+			Return(nil)
+		s.mockActivity.EXPECT().
+			RecordScheduledChangeApplied(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil)
+		s.mockTraq.EXPECT().
+			PostDirectMessage(gomock.Any(), staffID, gomock.Any()).
+			Return(nil)
+
+		s.scheduler.processDueScheduledChanges(t.Context())
+	})
+
+	t.Run("Rejected change", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		campID := uint(random.PositiveInt(t))
+		staffID := random.AlphaNumericString(t, 32)
+		// 合宿の状態は予約した変更では変更できない
+		change := model.ScheduledChange{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			CampID:      campID,
+			TargetType:  model.ScheduledChangeTargetTypeCamp,
+			TargetID:    campID,
+			Field:       model.ScheduledChangeFieldStatus,
+			Value:       string(model.CampStatusRegistration),
+			ScheduledAt: time.Now().Add(-time.Minute),
+			CreatedByID: staffID,
+		}
+
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+			Return([]model.ScheduledChange{change}, nil)
+		s.mockRepo.MockCampRepository.EXPECT().
+			GetCampByID(gomock.Any(), campID).
+			Return(&model.Camp{
+				Model:  gorm.Model{ID: campID},
+				Status: model.CampStatusDraft,
+			}, nil)
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			UpdateScheduledChange(gomock.Any(), change.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, change *model.ScheduledChange) error {
+				assert.NotNil(t, change.ProcessedAt)
+				assert.NotNil(t, change.FailureReason)
+				return nil
+			})
+		s.mockTraq.EXPECT().
+			PostDirectMessage(gomock.Any(), staffID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, content string) error {
+				assert.Contains(t, content, "予約した変更を適用できませんでした")
+				return nil
+			})
+
+		s.scheduler.processDueScheduledChanges(t.Context())
+	})
+
+	t.Run("Transient failure", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+		questionGroupID := uint(random.PositiveInt(t))
+		change := model.ScheduledChange{
+			Model:       gorm.Model{ID: uint(random.PositiveInt(t))},
+			TargetType:  model.ScheduledChangeTargetTypeQuestionGroup,
+			TargetID:    questionGroupID,
+			Field:       model.ScheduledChangeFieldName,
+			Value:       random.AlphaNumericString(t, 20),
+			CreatedByID: random.AlphaNumericString(t, 32),
+		}
+
+		// 次回に再試行するため、処理済みにもせずDMも送らない
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+			Return([]model.ScheduledChange{change}, nil)
+		s.mockRepo.MockQuestionGroupRepository.EXPECT().
+			GetQuestionGroup(gomock.Any(), questionGroupID).
+			Return(nil, errors.New("connection error"))
+
+		s.scheduler.processDueScheduledChanges(t.Context())
+	})
+
+	t.Run("Get failure", func(t *testing.T) {
+		t.Parallel()
+
+		s := setup(t)
+
+		s.mockRepo.MockScheduledChangeRepository.EXPECT().
+			GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("connection error"))
+
+		s.scheduler.processDueScheduledChanges(t.Context())
+	})
+}
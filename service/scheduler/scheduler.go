@@ -8,6 +8,8 @@ import (
 
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository"
+	"github.com/traPtitech/rucQ/service/activity"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/traq"
 )
 
@@ -18,20 +20,26 @@ type SchedulerService interface {
 }
 
 type schedulerServiceImpl struct {
-	repo        repository.Repository
-	traqService traq.TraqService
-	interval    time.Duration
+	repo            repository.Repository
+	activityService activity.ActivityService
+	traqService     traq.TraqService
+	eventBus        eventbus.EventBus
+	interval        time.Duration
 }
 
 // NewSchedulerService はSchedulerServiceの新しいインスタンスを作成します
 func NewSchedulerService(
 	repo repository.Repository,
+	activityService activity.ActivityService,
 	traqService traq.TraqService,
+	eventBus eventbus.EventBus,
 ) *schedulerServiceImpl {
 	return &schedulerServiceImpl{
-		repo:        repo,
-		traqService: traqService,
-		interval:    time.Minute, // 1分間隔でチェック
+		repo:            repo,
+		activityService: activityService,
+		traqService:     traqService,
+		eventBus:        eventBus,
+		interval:        time.Minute, // 1分間隔でチェック
 	}
 }
 
@@ -49,6 +57,10 @@ func (s *schedulerServiceImpl) Start(ctx context.Context) {
 			return
 		case <-ticker.C:
 			s.processReadyMessages(ctx)
+			s.processReadyEventReminders(ctx)
+			s.processDueCampStatuses(ctx)
+			s.processDueScheduledChanges(ctx)
+			s.processDueGuidebookRevisions(ctx)
 		}
 	}
 }
@@ -12,14 +12,18 @@ import (
 
 	"github.com/traPtitech/rucQ/model"
 	"github.com/traPtitech/rucQ/repository/mockrepository"
+	"github.com/traPtitech/rucQ/service/activity/mockactivity"
+	"github.com/traPtitech/rucQ/service/eventbus"
 	"github.com/traPtitech/rucQ/service/traq/mocktraq"
 	"github.com/traPtitech/rucQ/testutil/random"
 )
 
 type schedulerTestSetup struct {
-	scheduler *schedulerServiceImpl
-	mockRepo  *mockrepository.MockRepository
-	mockTraq  *mocktraq.MockTraqService
+	scheduler    *schedulerServiceImpl
+	mockRepo     *mockrepository.MockRepository
+	mockActivity *mockactivity.MockActivityService
+	mockTraq     *mocktraq.MockTraqService
+	eventBus     eventbus.EventBus
 }
 
 func setup(t *testing.T) *schedulerTestSetup {
@@ -27,13 +31,17 @@ func setup(t *testing.T) *schedulerTestSetup {
 
 	ctrl := gomock.NewController(t)
 	mockRepo := mockrepository.NewMockRepository(ctrl)
+	mockActivity := mockactivity.NewMockActivityService(ctrl)
 	mockTraq := mocktraq.NewMockTraqService(ctrl)
-	scheduler := NewSchedulerService(mockRepo, mockTraq)
+	eventBus := eventbus.NewEventBus(100)
+	scheduler := NewSchedulerService(mockRepo, mockActivity, mockTraq, eventBus)
 
 	return &schedulerTestSetup{
-		scheduler: scheduler,
-		mockRepo:  mockRepo,
-		mockTraq:  mockTraq,
+		scheduler:    scheduler,
+		mockRepo:     mockRepo,
+		mockActivity: mockActivity,
+		mockTraq:     mockTraq,
+		eventBus:     eventBus,
 	}
 }
 
@@ -137,6 +145,22 @@ func TestSchedulerServiceImpl_Start(t *testing.T) {
 				GetReadyToSendMessages(gomock.Any()).
 				Return([]model.Message{}, nil).
 				Times(2)
+			s.mockRepo.MockEventReminderRepository.EXPECT().
+				GetReadyToSendEventReminders(gomock.Any()).
+				Return([]model.EventReminder{}, nil).
+				Times(2)
+			s.mockRepo.MockCampRepository.EXPECT().
+				GetCampsWithDueSchedule(gomock.Any(), gomock.Any()).
+				Return([]model.Camp{}, nil).
+				Times(2)
+			s.mockRepo.MockScheduledChangeRepository.EXPECT().
+				GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+				Return([]model.ScheduledChange{}, nil).
+				Times(2)
+			s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+				GetDueGuidebookRevisions(gomock.Any(), gomock.Any()).
+				Return([]model.GuidebookRevision{}, nil).
+				Times(2)
 
 			// Startを別のgoroutineで実行
 			ctx, cancel := context.WithCancel(t.Context())
@@ -217,6 +241,24 @@ func TestSchedulerServiceImpl_Start(t *testing.T) {
 				Return([]model.Message{}, nil).
 				Times(1)
 
+			// リマインダーは毎回なし
+			s.mockRepo.MockEventReminderRepository.EXPECT().
+				GetReadyToSendEventReminders(gomock.Any()).
+				Return([]model.EventReminder{}, nil).
+				Times(2)
+			s.mockRepo.MockCampRepository.EXPECT().
+				GetCampsWithDueSchedule(gomock.Any(), gomock.Any()).
+				Return([]model.Camp{}, nil).
+				Times(2)
+			s.mockRepo.MockScheduledChangeRepository.EXPECT().
+				GetDueScheduledChanges(gomock.Any(), gomock.Any()).
+				Return([]model.ScheduledChange{}, nil).
+				Times(2)
+			s.mockRepo.MockGuidebookRevisionRepository.EXPECT().
+				GetDueGuidebookRevisions(gomock.Any(), gomock.Any()).
+				Return([]model.GuidebookRevision{}, nil).
+				Times(2)
+
 			// Startを別のgoroutineで実行
 			ctx, cancel := context.WithCancel(t.Context())
 			go s.scheduler.Start(ctx)
@@ -55,6 +55,51 @@ func (mr *MockTraqServiceMockRecorder) GetCanonicalUserName(ctx, userID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCanonicalUserName", reflect.TypeOf((*MockTraqService)(nil).GetCanonicalUserName), ctx, userID)
 }
 
+// GetStampName mocks base method.
+func (m *MockTraqService) GetStampName(ctx context.Context, stampID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStampName", ctx, stampID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStampName indicates an expected call of GetStampName.
+func (mr *MockTraqServiceMockRecorder) GetStampName(ctx, stampID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStampName", reflect.TypeOf((*MockTraqService)(nil).GetStampName), ctx, stampID)
+}
+
+// GetUserName mocks base method.
+func (m *MockTraqService) GetUserName(ctx context.Context, userUUID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserName", ctx, userUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserName indicates an expected call of GetUserName.
+func (mr *MockTraqServiceMockRecorder) GetUserName(ctx, userUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserName", reflect.TypeOf((*MockTraqService)(nil).GetUserName), ctx, userUUID)
+}
+
+// PostChannelMessage mocks base method.
+func (m *MockTraqService) PostChannelMessage(ctx context.Context, channelID, content string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostChannelMessage", ctx, channelID, content)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostChannelMessage indicates an expected call of PostChannelMessage.
+func (mr *MockTraqServiceMockRecorder) PostChannelMessage(ctx, channelID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostChannelMessage", reflect.TypeOf((*MockTraqService)(nil).PostChannelMessage), ctx, channelID, content)
+}
+
 // PostDirectMessage mocks base method.
 func (m *MockTraqService) PostDirectMessage(ctx context.Context, userID, content string) error {
 	m.ctrl.T.Helper()
@@ -6,11 +6,20 @@ import (
 	"errors"
 )
 
-var ErrUserNotFound = errors.New("user not found")
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrStampNotFound = errors.New("stamp not found")
+)
 
 // TraqService はtraQ APIとの連携を担当するサービスです。
 type TraqService interface {
 	GetCanonicalUserName(ctx context.Context, userID string) (string, error)
 	// PostDirectMessage は指定したユーザーにダイレクトメッセージを送信します。
 	PostDirectMessage(ctx context.Context, userID string, content string) error
+	// PostChannelMessage は指定したチャンネルにメッセージを投稿し、投稿したメッセージのUUIDを返します。
+	PostChannelMessage(ctx context.Context, channelID string, content string) (string, error)
+	// GetUserName はユーザーUUIDからtraQ IDを取得します。取得したtraQ IDはキャッシュされます。
+	GetUserName(ctx context.Context, userUUID string) (string, error)
+	// GetStampName はスタンプUUIDからスタンプ名を取得します。
+	GetStampName(ctx context.Context, stampID string) (string, error)
 }
@@ -2,6 +2,8 @@ package traq
 
 import (
 	"context"
+	"net/http"
+	"sync"
 
 	traq "github.com/traPtitech/go-traq"
 )
@@ -9,6 +11,8 @@ import (
 type traqServiceImpl struct {
 	client      *traq.APIClient
 	accessToken string
+	// userNames はユーザーUUIDからtraQ IDへのキャッシュ。traQ IDは変更できないため期限を設けない
+	userNames sync.Map
 }
 
 func NewTraqService(baseURL, accessToken string) *traqServiceImpl {
@@ -71,3 +75,59 @@ func (s *traqServiceImpl) PostDirectMessage(
 	_, _, err = req.Execute()
 	return err
 }
+
+func (s *traqServiceImpl) PostChannelMessage(
+	ctx context.Context,
+	channelID string,
+	content string,
+) (string, error) {
+	authCtx := context.WithValue(ctx, traq.ContextAccessToken, s.accessToken)
+	postMessageRequest := *traq.NewPostMessageRequest(content)
+	postMessageRequest.SetEmbed(true)
+
+	message, _, err := s.client.MessageAPI.PostMessage(authCtx, channelID).
+		PostMessageRequest(postMessageRequest).
+		Execute()
+
+	if err != nil {
+		return "", err
+	}
+
+	return message.Id, nil
+}
+
+func (s *traqServiceImpl) GetUserName(ctx context.Context, userUUID string) (string, error) {
+	if userName, ok := s.userNames.Load(userUUID); ok {
+		return userName.(string), nil
+	}
+
+	authCtx := context.WithValue(ctx, traq.ContextAccessToken, s.accessToken)
+	user, res, err := s.client.UserAPI.GetUser(authCtx, userUUID).Execute()
+
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return "", ErrUserNotFound
+		}
+
+		return "", err
+	}
+
+	s.userNames.Store(userUUID, user.Name)
+
+	return user.Name, nil
+}
+
+func (s *traqServiceImpl) GetStampName(ctx context.Context, stampID string) (string, error) {
+	authCtx := context.WithValue(ctx, traq.ContextAccessToken, s.accessToken)
+	stamp, res, err := s.client.StampAPI.GetStamp(authCtx, stampID).Execute()
+
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return "", ErrStampNotFound
+		}
+
+		return "", err
+	}
+
+	return stamp.Name, nil
+}
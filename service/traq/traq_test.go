@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go/modules/compose"
 	"github.com/testcontainers/testcontainers-go/wait"
+	traq "github.com/traPtitech/go-traq"
 
 	"github.com/traPtitech/rucQ/testutil/bot"
 	"github.com/traPtitech/rucQ/testutil/random"
@@ -154,3 +156,64 @@ func TestTraqServiceImpl_PostDirectMessage(t *testing.T) {
 		}
 	})
 }
+
+// 存在しないUUID
+const nonexistentUUID = "00000000-0000-4000-8000-000000000000"
+
+func TestTraqServiceImpl_GetUserName(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ユーザーUUIDからtraQ IDを取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		authCtx := context.WithValue(t.Context(), traq.ContextAccessToken, s.accessToken)
+		users, _, err := s.client.UserAPI.GetUsers(authCtx).Name(existingUserID).Execute()
+
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+
+		userName, err := s.GetUserName(t.Context(), users[0].Id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, existingUserID, userName)
+	})
+
+	t.Run("取得したtraQ IDをキャッシュする", func(t *testing.T) {
+		t.Parallel()
+
+		authCtx := context.WithValue(t.Context(), traq.ContextAccessToken, s.accessToken)
+		users, _, err := s.client.UserAPI.GetUsers(authCtx).Name(existingUserID).Execute()
+
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+
+		_, err = s.GetUserName(t.Context(), users[0].Id)
+
+		require.NoError(t, err)
+
+		userName, ok := s.userNames.Load(users[0].Id)
+
+		assert.True(t, ok)
+		assert.Equal(t, existingUserID, userName)
+	})
+
+	t.Run("存在しないユーザーの場合はErrUserNotFoundを返す", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := s.GetUserName(t.Context(), nonexistentUUID)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestTraqServiceImpl_GetStampName(t *testing.T) {
+	t.Parallel()
+
+	t.Run("存在しないスタンプの場合はErrStampNotFoundを返す", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := s.GetStampName(t.Context(), nonexistentUUID)
+
+		assert.ErrorIs(t, err, ErrStampNotFound)
+	})
+}